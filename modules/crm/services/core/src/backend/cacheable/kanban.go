@@ -51,6 +51,11 @@ func MakeKanbanTicketListCacheKey(namespace string, filter models.TicketsFilter)
 	return fmt.Sprintf("%s_%s_%s_%s", kanbanTicketListCacheKeyPrefix, namespace, departmentUUID, performerUUID)
 }
 
+// Pattern that matches ticket lists of the namespace with any filter
+func MakeAllKanbanTicketListsCacheKeyPattern(namespace string) string {
+	return fmt.Sprintf("%s_%s_*", kanbanTicketListCacheKeyPrefix, namespace)
+}
+
 func MakePossibleCacheKeysForKanbanTicket(tiket *models.Ticket) []string {
 	return []string{
 		MakeKanbanTicketDataCacheKey(tiket.Namespace, tiket.UUID),
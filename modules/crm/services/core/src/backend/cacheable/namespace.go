@@ -0,0 +1,27 @@
+package cacheable
+
+import (
+	"context"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+)
+
+// Removes all the cached entries of the namespace. Used when namespace is deleted.
+func RemoveNamespaceFromCache(ctx context.Context, systemStub *system.SystemStub, namespace string) error {
+	return systemStub.Cache.RemoveByPattern(
+		ctx,
+		MakeClientDataCacheKey(namespace, "*"),
+		MakeAllClientsCacheKey(namespace),
+		MakeAllClientContactPersonsCacheKey(namespace, "*"),
+		MakeDepartmentDataCacheKey(namespace, "*"),
+		MakeAllDepartmentsDataCacheKey(namespace),
+		MakeKanbanStageDataCacheKey(namespace, "*"),
+		MakeKanbanStageListCacheKey(namespace, "*"),
+		MakeKanbanTicketDataCacheKey(namespace, "*"),
+		MakeAllKanbanTicketListsCacheKeyPattern(namespace),
+		MakePerformerDataCacheKey(namespace, "*"),
+		MakeAllPerformersDataCacheKey(namespace),
+		MakeProjectDataCacheKey(namespace, "*"),
+		MakeAllProjectsDataCacheKey(namespace, "*", "*"),
+	)
+}
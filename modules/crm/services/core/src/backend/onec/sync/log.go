@@ -57,6 +57,11 @@ func GetSyncLogCollection(systemStub *system.SystemStub, namespace string) *mong
 	return systemStub.DB.Database(dbName).Collection(syncLogCollectionName)
 }
 
+// Drops synchronization log of the namespace. Used when namespace is deleted.
+func DeleteSyncLogForNamespace(ctx context.Context, systemStub *system.SystemStub, namespace string) error {
+	return GetSyncLogCollection(systemStub, namespace).Drop(ctx)
+}
+
 func addSyncLog(ctx context.Context, systemStub *system.SystemStub, event SyncEvent) error {
 	syncLogCollection := GetSyncLogCollection(systemStub, event.Namespace)
 
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/slamy-solutions/openbp/modules/crm/services/core/src/backend/cacheable"
+	"github.com/slamy-solutions/openbp/modules/crm/services/core/src/backend/onec/sync"
+	namespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	systemNATS "github.com/slamy-solutions/openbp/modules/system/libs/golang/nats"
+)
+
+const namespaceDeletionEventConsumerName = "crm_core_namespacedeletion"
+
+type EventHandler struct {
+	systemStub                       *system.SystemStub
+	js                               nats.JetStreamContext
+	namespaceDeleteEventSubscription *nats.Subscription
+
+	logger *slog.Logger
+}
+
+func NewEventHandler(logger *slog.Logger, systemStub *system.SystemStub) (*EventHandler, error) {
+	handler := &EventHandler{
+		systemStub: systemStub,
+		logger:     logger,
+	}
+
+	js, err := systemStub.Nats.JetStream()
+	if err != nil {
+		return nil, errors.New("Error while opening jetsteram context. " + err.Error())
+	}
+	handler.js = js
+
+	_, err = js.AddConsumer("native_namespace_deletion", &nats.ConsumerConfig{
+		Durable:        namespaceDeletionEventConsumerName,
+		Name:           namespaceDeletionEventConsumerName,
+		Description:    "Listens on native_namespace deletion requests for crm_core",
+		AckPolicy:      nats.AckExplicitPolicy,
+		FilterSubject:  "native.namespace.deletion.requested",
+		DeliverSubject: "crm.deliver.namespace.delete",
+		DeliverGroup:   "crm.deliver.namespace.delete",
+	})
+	if err != nil {
+		return nil, errors.New("Error while creating consumer. " + err.Error())
+	}
+	subscribtion, err := js.QueueSubscribe("native.namespace.deletion.requested", "crm.deliver.namespace.delete", handler.handleNamespaceDeletionEvent, nats.Bind("native_namespace_deletion", namespaceDeletionEventConsumerName))
+	if err != nil {
+		return nil, errors.New("Error while creating subscribtion. " + err.Error())
+	}
+
+	handler.namespaceDeleteEventSubscription = subscribtion
+
+	return handler, nil
+}
+
+func (h *EventHandler) Close() error {
+	err := h.namespaceDeleteEventSubscription.Unsubscribe()
+	if err != nil {
+		return errors.New("Error while unsubscribing from namespace deletion requests. " + err.Error())
+	}
+	return nil
+}
+
+func (h *EventHandler) cleanupNamespace(ctx context.Context, namespace string) error {
+	err := cacheable.RemoveNamespaceFromCache(ctx, h.systemStub, namespace)
+	if err != nil {
+		return errors.New("failed to remove cache: " + err.Error())
+	}
+
+	err = sync.DeleteSyncLogForNamespace(ctx, h.systemStub, namespace)
+	if err != nil {
+		return errors.New("failed to delete 1C synchronization log: " + err.Error())
+	}
+
+	return nil
+}
+
+func (h *EventHandler) handleNamespaceDeletionEvent(msg *nats.Msg) {
+	ctx, span := systemNATS.StartTelemetrySpanFromMessage(context.Background(), msg, "Handle namespace deletion event")
+	defer span.End()
+
+	logger := h.logger.With(slog.String("event", "namespace_deletion"))
+
+	var namespace namespaceGRPC.Namespace
+	err := proto.Unmarshal(msg.Data, &namespace)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to unmarshal namespace from event: "+err.Error())
+		logger.Error("Failed to unmarshal namespace from event: " + err.Error())
+		span.RecordError(err)
+		// TODO: Dead leter queue
+		msg.Ack()
+		return
+	}
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "namespace",
+		Value: attribute.StringValue(namespace.Name),
+	})
+	logger = logger.With(slog.String("namespace", namespace.Name))
+
+	cleanupErr := h.cleanupNamespace(ctx, namespace.Name)
+
+	acknowledgement := &namespaceGRPC.NamespaceDeletionAcknowledgement{
+		Namespace:   namespace.Name,
+		Participant: namespaceDeletionEventConsumerName,
+		Attempt:     namespace.DeletionAttempt,
+	}
+	if cleanupErr != nil {
+		acknowledgement.Error = cleanupErr.Error()
+	}
+	acknowledgementBytes, _ := proto.Marshal(acknowledgement)
+	_, err = h.js.Publish("native.namespace.deletion.acknowledged", acknowledgementBytes)
+	if err != nil {
+		err = errors.New("failed to publish acknowledgement: " + err.Error())
+		logger.Error(err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		msg.NakWithDelay(time.Second * 5)
+		return
+	}
+
+	if cleanupErr != nil {
+		logger.Error(cleanupErr.Error())
+		span.SetStatus(codes.Error, cleanupErr.Error())
+		msg.NakWithDelay(time.Second * 5) //TODO: Dead letter queue
+		return
+	}
+
+	msg.Ack()
+	span.SetStatus(codes.Ok, "")
+	logger.Info("Successfully cleaned up data of the deleted namespace.")
+}
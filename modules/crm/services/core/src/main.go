@@ -25,7 +25,11 @@ import (
 	"github.com/slamy-solutions/openbp/modules/crm/services/core/src/services"
 	"github.com/slamy-solutions/openbp/modules/crm/services/core/src/settings"
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	namespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
 )
 
 const (
@@ -53,8 +57,14 @@ func main() {
 	}
 	defer systemStub.Close(context.Background())
 
+	serviceAuthConfig := serviceauth.NewConfigFromEnv("crm_core")
+	serviceAuthDialOptions, err := serviceauth.DialOptions(serviceAuthConfig, native.NewServiceTokenIssuer(serviceAuthConfig.APIKey))
+	if err != nil {
+		panic("Failed to setup service authentication: " + err.Error())
+	}
+
 	// Connect to the "native" module services
-	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithKeyValueStorageService().WithIAMService())
+	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithKeyValueStorageService().WithIAMService().WithDialOptions(serviceAuthDialOptions...))
 	err = nativeStub.Connect()
 	if err != nil {
 		panic("Failed to connect to native services: " + err.Error())
@@ -99,9 +109,21 @@ func main() {
 	projectService := services.NewProjectServer(backendFactory, logger.With(slog.String("service", "project")))
 	projectRGPC.RegisterProjectServiceServer(grpcServer, projectService)
 
-	kanbanService := services.NewKanbanServer(backendFactory, logger.With(slog.String("service", "kanban")))
+	auditPublisher, err := audit.NewPublisher(systemStub.Nats, "crm_core")
+	if err != nil {
+		panic("Failed to create audit publisher: " + err.Error())
+	}
+	kanbanService := services.NewKanbanServer(backendFactory, auditPublisher, logger.With(slog.String("service", "kanban")))
 	kanbanRGPC.RegisterKanbanServiceServer(grpcServer, kanbanService)
 
+	namespaceGRPC.RegisterNamespaceSectionExporterServiceServer(grpcServer, namespaceGRPC.NewSectionExporterServer("crm_core", db.NewNamespaceArchiver(systemStub.DB, namespaceArchiveCollections...)))
+
+	eventHandler, err := NewEventHandler(logger.With(slog.String("service", "event_handler")), systemStub)
+	if err != nil {
+		panic("Failed to create event handler: " + err.Error())
+	}
+	defer eventHandler.Close()
+
 	logger.Info("Start listening for gRPC connections")
 	lis, err := net.Listen("tcp", ":80")
 	if err != nil {
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
+)
+
+// Collections of the namespace database that are included in the namespace archive. Settings are stored in the native_keyvaluestorage and are exported by it.
+// 1C synchronization log is not exported.
+var namespaceArchiveCollections = []db.ArchiveCollection{
+	{Name: "crm_native_client"},
+	{Name: "crm_native_client_contactperson"},
+	{Name: "crm_native_department"},
+	{Name: "crm_native_performer"},
+	{Name: "crm_native_project"},
+	{Name: "crm_native_kanban_stage"},
+	{Name: "crm_native_kanban_ticket"},
+	{Name: "crm_onec_performer", HexReferenceFields: []string{"userUUID"}},
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/slamy-solutions/openbp/modules/crm/libs/golang/core/kanban"
 	"github.com/slamy-solutions/openbp/modules/crm/services/core/src/backend"
 	"github.com/slamy-solutions/openbp/modules/crm/services/core/src/backend/models"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -15,14 +16,30 @@ import (
 type KanbanService struct {
 	kanban.UnimplementedKanbanServiceServer
 
-	backend backend.BackendFactory
-	logger  *slog.Logger
+	backend        backend.BackendFactory
+	auditPublisher *audit.Publisher
+	logger         *slog.Logger
 }
 
-func NewKanbanServer(backend backend.BackendFactory, logger *slog.Logger) *KanbanService {
+func NewKanbanServer(backend backend.BackendFactory, auditPublisher *audit.Publisher, logger *slog.Logger) *KanbanService {
 	return &KanbanService{
-		backend: backend,
-		logger:  logger,
+		backend:        backend,
+		auditPublisher: auditPublisher,
+		logger:         logger,
+	}
+}
+
+// Publishes record about the ticket change to the audit log. Failure to publish doesnt fail the request.
+func (s *KanbanService) publishTicketAuditRecord(ctx context.Context, namespace string, ticketUUID string, action string, beforeVersion int, afterVersion int) {
+	err := s.auditPublisher.Publish(ctx, audit.Change{
+		Namespace:     namespace,
+		Resource:      "crm.kanban.ticket." + ticketUUID,
+		Action:        "crm.kanban.ticket." + action,
+		BeforeVersion: uint64(beforeVersion),
+		AfterVersion:  uint64(afterVersion),
+	})
+	if err != nil {
+		s.logger.With(slog.String("action", action)).Error("failed to publish audit record: " + err.Error())
 	}
 }
 
@@ -179,6 +196,8 @@ func (s *KanbanService) CreateTicket(ctx context.Context, in *kanban.CreateTicke
 		return nil, status.Errorf(codes.Internal, "failed to create ticket: %s", err.Error())
 	}
 
+	s.publishTicketAuditRecord(ctx, in.Namespace, ticket.UUID, "create", 0, ticket.Version)
+
 	return &kanban.CreateTicketResponse{
 		Ticket: ticket.ToGRPC(),
 	}, status.Error(codes.OK, "")
@@ -248,6 +267,8 @@ func (s *KanbanService) UpdateTicketBasicInfo(ctx context.Context, in *kanban.Up
 		return nil, status.Errorf(codes.Internal, "failed to update ticket basic info: %s", err.Error())
 	}
 
+	s.publishTicketAuditRecord(ctx, in.Namespace, in.UUID, "update", ticket.Version-1, ticket.Version)
+
 	return &kanban.UpdateTicketBasicInfoResponse{
 		Ticket: ticket.ToGRPC(),
 	}, status.Error(codes.OK, "")
@@ -258,7 +279,7 @@ func (s *KanbanService) DeleteTicket(ctx context.Context, in *kanban.DeleteTicke
 		return nil, err
 	}
 
-	_, err = bkd.KanbanRepository().DeleteTicket(ctx, in.UUID)
+	ticket, err := bkd.KanbanRepository().DeleteTicket(ctx, in.UUID)
 	if err != nil {
 		if errors.Is(err, models.ErrTicketNotFound) {
 			return nil, status.Errorf(codes.NotFound, "ticket not found")
@@ -269,6 +290,8 @@ func (s *KanbanService) DeleteTicket(ctx context.Context, in *kanban.DeleteTicke
 		return nil, status.Errorf(codes.Internal, "failed to delete ticket: %s", err.Error())
 	}
 
+	s.publishTicketAuditRecord(ctx, in.Namespace, in.UUID, "delete", ticket.Version, 0)
+
 	return &kanban.DeleteTicketResponse{}, status.Error(codes.OK, "")
 }
 func (s *KanbanService) UpdateTicketStage(ctx context.Context, in *kanban.UpdateTicketStageRequest) (*kanban.UpdateTicketStageResponse, error) {
@@ -291,6 +314,8 @@ func (s *KanbanService) UpdateTicketStage(ctx context.Context, in *kanban.Update
 		return nil, status.Errorf(codes.Internal, "failed to update ticket stage: %s", err.Error())
 	}
 
+	s.publishTicketAuditRecord(ctx, in.Namespace, in.UUID, "stage.update", ticket.Version-1, ticket.Version)
+
 	return &kanban.UpdateTicketStageResponse{
 		Ticket: ticket.ToGRPC(),
 	}, status.Error(codes.OK, "")
@@ -312,6 +337,8 @@ func (s *KanbanService) UpdateTicketPriority(ctx context.Context, in *kanban.Upd
 		return nil, status.Errorf(codes.Internal, "failed to update ticket priority: %s", err.Error())
 	}
 
+	s.publishTicketAuditRecord(ctx, in.Namespace, in.UUID, "priority.update", ticket.Version-1, ticket.Version)
+
 	return &kanban.UpdateTicketPriorityResponse{
 		Ticket: ticket.ToGRPC(),
 	}, status.Error(codes.OK, "")
@@ -333,6 +360,8 @@ func (s *KanbanService) CloseTicket(ctx context.Context, in *kanban.CloseTicketR
 		return nil, status.Errorf(codes.Internal, "failed to close ticket: %s", err.Error())
 	}
 
+	s.publishTicketAuditRecord(ctx, in.Namespace, in.UUID, "close", ticket.Version-1, ticket.Version)
+
 	return &kanban.CloseTicketResponse{
 		Ticket: ticket.ToGRPC(),
 	}, status.Error(codes.OK, "")
@@ -6,8 +6,11 @@ replace github.com/slamy-solutions/openbp/modules/system/libs/golang => ../../..
 
 replace github.com/slamy-solutions/openbp/modules/erp/libs/golang => ../../libs/golang
 
+replace github.com/slamy-solutions/openbp/modules/native/libs/golang => ../../../native/libs/golang
+
 require (
 	github.com/slamy-solutions/openbp/modules/erp/libs/golang v0.0.0-00010101000000-000000000000
+	github.com/slamy-solutions/openbp/modules/native/libs/golang v0.0.0-00010101000000-000000000000
 	github.com/slamy-solutions/openbp/modules/system/libs/golang v0.0.0-00010101000000-000000000000
 	go.mongodb.org/mongo-driver v1.13.0
 	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.46.1
@@ -13,7 +13,9 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 
 	"github.com/slamy-solutions/openbp/modules/erp/services/core/src/services/catalog"
+	namespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
 )
 
 const (
@@ -56,6 +58,10 @@ func main() {
 
 	catalog.RegisterCatalogModule(logger.With(slog.String("module", "catalog")), grpcServer, systemStub)
 
+	// Catalogs are stored in the "openbp_<namespace>" database
+	namespaceArchiver := db.NewNamespaceArchiver(systemStub.DB, namespaceArchiveCollections...).WithDatabasePrefix("openbp_")
+	namespaceGRPC.RegisterNamespaceSectionExporterServiceServer(grpcServer, namespaceGRPC.NewSectionExporterServer("erp_core", namespaceArchiver))
+
 	/*eventHandler, err := NewEventHandler(logger.WithField("service", "event_handler"), systemStub)
 	if err != nil {
 		panic("Failed to start event handler: " + err.Error())
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
+)
+
+// Collections of the namespace database that are included in the namespace archive
+var namespaceArchiveCollections = []db.ArchiveCollection{
+	{Name: "catalogs"},
+	{Name: "catalog_{name}"},
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/slamy-solutions/openbp/modules/iot/services/core/src/services/device"
 	"github.com/slamy-solutions/openbp/modules/iot/services/core/src/services/fleet"
+	"github.com/slamy-solutions/openbp/modules/iot/services/core/src/services/integrations/balena"
 	"github.com/slamy-solutions/openbp/modules/iot/services/core/src/services/telemetry"
 	namespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
@@ -19,10 +20,13 @@ import (
 )
 
 const namespaceCreationEventConsumerName = "iot_core_namespacecreation"
+const namespaceDeletionEventConsumerName = "iot_core_namespacedeletion"
 
 type EventHandler struct {
 	systemStub                       *system.SystemStub
+	js                               nats.JetStreamContext
 	namespaceCreateEventSubscription *nats.Subscription
+	namespaceDeleteEventSubscription *nats.Subscription
 
 	logger *logrus.Entry
 }
@@ -37,6 +41,8 @@ func NewEventHandler(logger *logrus.Entry, systemStub *system.SystemStub) (*Even
 	if err != nil {
 		return nil, errors.New("Error while opening jetsteram context. " + err.Error())
 	}
+	handler.js = js
+
 	_, err = js.AddConsumer("native_namespace_event", &nats.ConsumerConfig{
 		Durable:        namespaceCreationEventConsumerName,
 		Name:           namespaceCreationEventConsumerName,
@@ -56,6 +62,25 @@ func NewEventHandler(logger *logrus.Entry, systemStub *system.SystemStub) (*Even
 
 	handler.namespaceCreateEventSubscription = subscribtion
 
+	_, err = js.AddConsumer("native_namespace_deletion", &nats.ConsumerConfig{
+		Durable:        namespaceDeletionEventConsumerName,
+		Name:           namespaceDeletionEventConsumerName,
+		Description:    "Listens on native_namespace deletion requests for iot_core",
+		AckPolicy:      nats.AckExplicitPolicy,
+		FilterSubject:  "native.namespace.deletion.requested",
+		DeliverSubject: "iot.deliver.namespace.delete",
+		DeliverGroup:   "iot.deliver.namespace.delete",
+	})
+	if err != nil {
+		return nil, errors.New("Error while creating consumer. " + err.Error())
+	}
+	subscribtion, err = js.QueueSubscribe("native.namespace.deletion.requested", "iot.deliver.namespace.delete", handler.handleNamespaceDeletionEvent, nats.Bind("native_namespace_deletion", namespaceDeletionEventConsumerName))
+	if err != nil {
+		return nil, errors.New("Error while creating subscribtion. " + err.Error())
+	}
+
+	handler.namespaceDeleteEventSubscription = subscribtion
+
 	return handler, nil
 }
 
@@ -64,6 +89,10 @@ func (h *EventHandler) Close() error {
 	if err != nil {
 		return errors.New("Error while unsubscribing from namespace events. " + err.Error())
 	}
+	err = h.namespaceDeleteEventSubscription.Unsubscribe()
+	if err != nil {
+		return errors.New("Error while unsubscribing from namespace deletion requests. " + err.Error())
+	}
 	return nil
 }
 
@@ -123,3 +152,61 @@ func (h *EventHandler) handleNamespaceCreationEvent(msg *nats.Msg) {
 	span.SetStatus(codes.Ok, "")
 	logger.Info("Successfully handled namespace creation event.")
 }
+
+func (h *EventHandler) handleNamespaceDeletionEvent(msg *nats.Msg) {
+	ctx, span := systemNATS.StartTelemetrySpanFromMessage(context.Background(), msg, "Handle namespace deletion event")
+	defer span.End()
+
+	logger := h.logger.WithField("event", "namespace_deletion")
+
+	var namespace namespaceGRPC.Namespace
+	err := proto.Unmarshal(msg.Data, &namespace)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to unmarshal namespace from event: "+err.Error())
+		logger.Error("Failed to unmarshal namespace from event: " + err.Error())
+		span.RecordError(err)
+		// TODO: Dead leter queue
+		msg.Ack()
+		return
+	}
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "namespace",
+		Value: attribute.StringValue(namespace.Name),
+	})
+	logger = logger.WithField("namespace", namespace.Name)
+
+	// Devices, fleets and telemetry are stored in the database of the namespace. Only global data must be cleaned.
+	cleanupErr := balena.CleanupDeletedNamespace(ctx, h.systemStub, namespace.Name)
+	if cleanupErr != nil {
+		cleanupErr = errors.New("error while cleaning up balena integration: " + cleanupErr.Error())
+	}
+
+	acknowledgement := &namespaceGRPC.NamespaceDeletionAcknowledgement{
+		Namespace:   namespace.Name,
+		Participant: namespaceDeletionEventConsumerName,
+		Attempt:     namespace.DeletionAttempt,
+	}
+	if cleanupErr != nil {
+		acknowledgement.Error = cleanupErr.Error()
+	}
+	acknowledgementBytes, _ := proto.Marshal(acknowledgement)
+	_, err = h.js.Publish("native.namespace.deletion.acknowledged", acknowledgementBytes)
+	if err != nil {
+		err = errors.New("failed to publish acknowledgement: " + err.Error())
+		logger.Error(err.Error())
+		span.SetStatus(codes.Error, err.Error())
+		msg.NakWithDelay(time.Second * 5)
+		return
+	}
+
+	if cleanupErr != nil {
+		logger.Error(cleanupErr.Error())
+		span.SetStatus(codes.Error, cleanupErr.Error())
+		msg.NakWithDelay(time.Second * 5) //TODO: Dead letter queue
+		return
+	}
+
+	msg.Ack()
+	span.SetStatus(codes.Ok, "")
+	logger.Info("Successfully cleaned up data of the deleted namespace.")
+}
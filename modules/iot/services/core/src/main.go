@@ -21,7 +21,10 @@ import (
 	"github.com/slamy-solutions/openbp/modules/iot/services/core/src/services/integrations/balena"
 	"github.com/slamy-solutions/openbp/modules/iot/services/core/src/services/telemetry"
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	namespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
 )
 
 const (
@@ -49,8 +52,14 @@ func main() {
 	}
 	defer systemStub.Close(context.Background())
 
+	serviceAuthConfig := serviceauth.NewConfigFromEnv("iot_core")
+	serviceAuthDialOptions, err := serviceauth.DialOptions(serviceAuthConfig, native.NewServiceTokenIssuer(serviceAuthConfig.APIKey))
+	if err != nil {
+		panic("Failed to setup service authentication: " + err.Error())
+	}
+
 	// Connect to the "native" module services
-	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService().WithDialOptions(serviceAuthDialOptions...))
 	err = nativeStub.Connect()
 	if err != nil {
 		panic("Failed to connect to native services: " + err.Error())
@@ -95,6 +104,8 @@ func main() {
 	defer balenaIntegration.Close()
 	balenaIntegration.RegisterGRPCServices(grpcServer)
 
+	namespaceGRPC.RegisterNamespaceSectionExporterServiceServer(grpcServer, namespaceGRPC.NewSectionExporterServer("iot_core", db.NewNamespaceArchiver(systemStub.DB, namespaceArchiveCollections...)))
+
 	eventHandler, err := NewEventHandler(logger.WithField("service", "event_handler"), systemStub)
 	if err != nil {
 		panic("Failed to start event handler: " + err.Error())
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
+)
+
+// Collections of the namespace database that are included in the namespace archive.
+// Telemetry and integrations (they are bound to the external servers) are not exported.
+var namespaceArchiveCollections = []db.ArchiveCollection{
+	{Name: "iot_core_device", HexReferenceFields: []string{"identity"}, Quota: []db.ArchiveQuota{{Resource: quota.IOT_DEVICES}}},
+	{Name: "iot_core_fleet"},
+	{Name: "iot_core_fleetdevices"},
+}
@@ -12,6 +12,7 @@ import (
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -67,6 +68,16 @@ func (s *DeviceServer) randManagementId() string {
 func (s *DeviceServer) Create(ctx context.Context, in *deviceGRPC.CreateRequest) (*deviceGRPC.CreateResponse, error) {
 	collection := DeviceCollectionByNamespace(s.systemStub, in.Namespace)
 
+	err := quota.Reserve(ctx, s.systemStub.DB, in.Namespace, quota.IOT_DEVICES, 1)
+	if err != nil {
+		if err == quota.ErrQuotaExceeded {
+			return nil, status.Error(codes.ResourceExhausted, "Quota of the devices in the namespace is exceeded")
+		}
+		err = errors.New("error while reserving quota for new device: " + err.Error())
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	// Create identity for the device.
 	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
 		Namespace:       in.Namespace,
@@ -81,6 +92,11 @@ func (s *DeviceServer) Create(ctx context.Context, in *deviceGRPC.CreateRequest)
 		},
 	})
 	if err != nil {
+		quota.Release(ctx, s.systemStub.DB, in.Namespace, quota.IOT_DEVICES, 1)
+		if status.Code(err) == codes.ResourceExhausted {
+			return nil, status.Error(codes.ResourceExhausted, "Failed to create identity for the device. "+status.Convert(err).Message())
+		}
+
 		err = errors.New("error while creating identity for new device: " + err.Error())
 		s.logger.Error(err.Error())
 		return nil, status.Error(codes.Internal, err.Error())
@@ -98,6 +114,7 @@ func (s *DeviceServer) Create(ctx context.Context, in *deviceGRPC.CreateRequest)
 
 	insertResult, err := collection.UpdateOne(ctx, bson.M{"name": in.Name}, bson.M{"$setOnInsert": insertData}, options.Update().SetUpsert(true))
 	if err != nil {
+		quota.Release(ctx, s.systemStub.DB, in.Namespace, quota.IOT_DEVICES, 1)
 		_, deleteErr := s.nativeStub.Services.IAM.Identity.Delete(ctx, &identity.DeleteIdentityRequest{
 			Namespace: in.Namespace,
 			Uuid:      identityCreateResponse.Identity.Uuid,
@@ -114,6 +131,7 @@ func (s *DeviceServer) Create(ctx context.Context, in *deviceGRPC.CreateRequest)
 	}
 
 	if insertResult.UpsertedCount == 0 {
+		quota.Release(ctx, s.systemStub.DB, in.Namespace, quota.IOT_DEVICES, 1)
 		return nil, status.Error(codes.AlreadyExists, "Device with same name already exist")
 	}
 
@@ -369,6 +387,12 @@ func (s *DeviceServer) Delete(ctx context.Context, in *deviceGRPC.DeleteRequest)
 	}
 
 	if deviceDeleteResponse.DeletedCount > 0 {
+		if err := quota.Release(ctx, s.systemStub.DB, in.Namespace, quota.IOT_DEVICES, 1); err != nil {
+			s.logger.WithFields(logrus.Fields{
+				"iot_core_device_uuid": in.Uuid,
+				"namespace":            in.Namespace,
+			}).Warn("error while releasing quota of the deleted device: " + err.Error())
+		}
 		_, err := s.nativeStub.Services.IAM.Identity.Delete(ctx, &identity.DeleteIdentityRequest{
 			Namespace: in.Namespace,
 			Uuid:      foundedDevice.Identity,
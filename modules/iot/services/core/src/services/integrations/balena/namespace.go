@@ -0,0 +1,42 @@
+package balena
+
+import (
+	"context"
+	"errors"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Removes Balena servers of the deleted namespace together with their devices and unbinds devices of the other servers from the namespace.
+func CleanupDeletedNamespace(ctx context.Context, systemStub *system.SystemStub, namespace string) error {
+	deviceCollection := getBalenaDeviceCollection(systemStub)
+
+	_, err := deviceCollection.UpdateMany(
+		ctx,
+		bson.M{"bindedDeviceNamespace": namespace},
+		bson.M{
+			"$set": bson.M{
+				"bindedDeviceNamespace": nil,
+				"bindedDeviceUUID":      nil,
+			},
+			"$currentDate": bson.M{"updated": bson.M{"$type": "timestamp"}},
+			"$inc":         bson.M{"version": 1},
+		},
+	)
+	if err != nil {
+		return errors.Join(errors.New("error while unbinding devices of the namespace"), err)
+	}
+
+	_, err = deviceCollection.DeleteMany(ctx, bson.M{"balenaServerNamespace": namespace})
+	if err != nil {
+		return errors.Join(errors.New("error while deleting devices of the namespace balena servers"), err)
+	}
+
+	_, err = getBalenaServerCollection(systemStub).DeleteMany(ctx, bson.M{"namespace": namespace})
+	if err != nil {
+		return errors.Join(errors.New("error while deleting balena servers of the namespace"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Counts submitted entries in the ingest rate quota of their namespaces. Whole request is rejected if any namespace exceeds its rate.
+func (s *TelemetryServer) consumeIngestRate(ctx context.Context, namespaces []string) error {
+	counts := map[string]int64{}
+	for _, namespace := range namespaces {
+		counts[namespace]++
+	}
+
+	for namespace, count := range counts {
+		err := quota.Consume(ctx, s.systemStub.DB, namespace, quota.IOT_TELEMETRY_RATE, count)
+		if err != nil {
+			if err == quota.ErrQuotaExceeded {
+				return status.Error(codes.ResourceExhausted, "Telemetry ingest rate of the namespace ["+namespace+"] is exceeded")
+			}
+			err = errors.New("error while checking telemetry ingest rate: " + err.Error())
+			s.logger.Error(err.Error())
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	return nil
+}
@@ -44,6 +44,14 @@ func NewTelemetryServer(ctx context.Context, logger *logrus.Entry, systemStub *s
 }
 
 func (s *TelemetryServer) SubmitBasicMetrics(ctx context.Context, in *telemetryGRPC.SubmitBasicMetricsRequest) (*telemetryGRPC.SubmitBasicMetricsResponse, error) {
+	namespaces := make([]string, 0, len(in.Metrics))
+	for _, incommingMetric := range in.Metrics {
+		namespaces = append(namespaces, incommingMetric.DeviceNamespace)
+	}
+	if err := s.consumeIngestRate(ctx, namespaces); err != nil {
+		return nil, err
+	}
+
 	metrics := make(map[string]bson.A)
 	metricsIndexes := make(map[string][]int)
 
@@ -129,6 +137,14 @@ func (s *TelemetryServer) SubmitBasicMetrics(ctx context.Context, in *telemetryG
 	return &telemetryGRPC.SubmitBasicMetricsResponse{AssignedUUIDs: renderAssignedUUIDs()}, status.Error(codes.OK, "")
 }
 func (s *TelemetryServer) SubmitLog(ctx context.Context, in *telemetryGRPC.SubmitLogRequest) (*telemetryGRPC.SubmitLogResponse, error) {
+	namespaces := make([]string, 0, len(in.Entries))
+	for _, incommingEntry := range in.Entries {
+		namespaces = append(namespaces, incommingEntry.DeviceNamespace)
+	}
+	if err := s.consumeIngestRate(ctx, namespaces); err != nil {
+		return nil, err
+	}
+
 	logs := make(map[string]bson.A)
 	logsIndexes := make(map[string][]int)
 
@@ -196,6 +212,14 @@ func (s *TelemetryServer) SubmitLog(ctx context.Context, in *telemetryGRPC.Submi
 	return &telemetryGRPC.SubmitLogResponse{AssignedUUIDs: renderAssignedUUIDs()}, status.Error(codes.OK, "")
 }
 func (s *TelemetryServer) SubmitEvent(ctx context.Context, in *telemetryGRPC.SubmitEventRequest) (*telemetryGRPC.SubmitEventResponse, error) {
+	namespaces := make([]string, 0, len(in.Events))
+	for _, incommingEvent := range in.Events {
+		namespaces = append(namespaces, incommingEvent.DeviceNamespace)
+	}
+	if err := s.consumeIngestRate(ctx, namespaces); err != nil {
+		return nil, err
+	}
+
 	events := make(map[string]bson.A)
 	eventsIndexes := make(map[string][]int)
 
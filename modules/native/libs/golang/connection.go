@@ -6,13 +6,21 @@ import (
 
 	iamActorUser "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/actor/user"
 	iamAuth "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	iamAuthenticationAPIKey "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/apikey"
 	iamAuthenticationOAuth2 "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oauth2"
+	iamAuthenticationOIDC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oidc"
 	iamAuthenticationPassword "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/password"
+	iamAuthenticationSSO "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/sso"
+	iamAuthenticationTOTP "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/totp"
 	iamAuthenticationX509 "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/x509"
+	iamConfig "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/config"
+	iamGroup "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/group"
 	iamIdentity "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	iamOIDC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/oidc"
 	iamPolicy "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
 	iamRole "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
 	iamToken "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+	iamWorkload "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/workload"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	storageBucket "github.com/slamy-solutions/openbp/modules/native/libs/golang/storage/bucket"
@@ -76,20 +84,33 @@ func NewIAMConnection(address string, opts ...grpc.DialOption) (*grpc.ClientConn
 		},
 		Authentication: &IamAuthenticationServices{
 			Password: iamAuthenticationPassword.NewIAMAuthenticationPasswordServiceClient(dial),
+			TOTP:     iamAuthenticationTOTP.NewIAMAuthenticationTOTPServiceClient(dial),
 			X509:     iamAuthenticationX509.NewIAMAuthenticationX509ServiceClient(dial),
 			OAuth: IamAuthenticationOAuthServices{
 				Config: iamAuthenticationOAuth2.NewIAMAuthenticationOAuth2ConfigServiceClient(dial),
 				OAuth2: iamAuthenticationOAuth2.NewIAMAuthenticationOAuth2ServiceClient(dial),
 			},
+			SSO:    iamAuthenticationSSO.NewIAMAuthenticationSSOServiceClient(dial),
+			OIDC:   iamAuthenticationOIDC.NewIAMAuthenticationOIDCServiceClient(dial),
+			APIKey: iamAuthenticationAPIKey.NewIAMAuthenticationAPIKeyServiceClient(dial),
 		},
+		Config:   iamConfig.NewIAMConfigServiceClient(dial),
 		Identity: iamIdentity.NewIAMIdentityServiceClient(dial),
 		Auth:     iamAuth.NewIAMAuthServiceClient(dial),
 		Policy:   iamPolicy.NewIAMPolicyServiceClient(dial),
 		Role:     iamRole.NewIAMRoleServiceClient(dial),
+		Group:    iamGroup.NewIAMGroupServiceClient(dial),
 		Token:    iamToken.NewIAMTokenServiceClient(dial),
+		OIDC:     iamOIDC.NewIAMOIDCServiceClient(dial),
+		Workload: iamWorkload.NewIAMWorkloadServiceClient(dial),
 	}, nil
 }
 
+// Connect only to the Workload service of the IAM. Used to issue service tokens without service credentials.
+func NewIAMWorkloadConnection(address string, opts ...grpc.DialOption) (*grpc.ClientConn, iamWorkload.IAMWorkloadServiceClient, error) {
+	return makeGrpcClient(iamWorkload.NewIAMWorkloadServiceClient, address, opts...)
+}
+
 // Connect to Storage service
 func NewStorageConnection(address string, opts ...grpc.DialOption) (*grpc.ClientConn, *StorageService, error) {
 	dial, err := makeGrpcDial(address, opts...)
@@ -33,6 +33,14 @@ protoc --go_out=./iam/config --go_opt=paths=source_relative --go-grpc_out=./iam/
 echo "Generating proto for iam_identity service"
 mkdir -p ./iam/identity
 protoc --go_out=./iam/identity --go_opt=paths=source_relative --go-grpc_out=./iam/identity --go-grpc_opt=paths=source_relative -I ../../proto/iam identity.proto
+# iam_group
+echo "Generating proto for iam_group service"
+mkdir -p ./iam/group
+protoc --go_out=./iam/group --go_opt=paths=source_relative --go-grpc_out=./iam/group --go-grpc_opt=paths=source_relative -I ../../proto/iam group.proto
+# iam_oidc
+echo "Generating proto for iam_oidc service"
+mkdir -p ./iam/oidc
+protoc --go_out=./iam/oidc --go_opt=paths=source_relative --go-grpc_out=./iam/oidc --go-grpc_opt=paths=source_relative -I ../../proto/iam oidc.proto
 # iam_authentication_password
 echo "Generating proto for iam_authentication_password service"
 mkdir -p ./iam/authentication/password
@@ -45,6 +53,27 @@ protoc --go_out=./iam/authentication/x509 --go_opt=paths=source_relative --go-gr
 echo "Generating proto for iam_authentication_oauth2 service"
 mkdir -p ./iam/authentication/oauth2
 protoc --go_out=./iam/authentication/oauth2 --go_opt=paths=source_relative --go-grpc_out=./iam/authentication/oauth2 --go-grpc_opt=paths=source_relative -I ../../proto/iam/authentication oauth2.proto
+# iam_authentication_totp
+echo "Generating proto for iam_authentication_totp service"
+mkdir -p ./iam/authentication/totp
+protoc --go_out=./iam/authentication/totp --go_opt=paths=source_relative --go-grpc_out=./iam/authentication/totp --go-grpc_opt=paths=source_relative -I ../../proto/iam/authentication totp.proto
+# iam_authentication_sso
+echo "Generating proto for iam_authentication_sso service"
+mkdir -p ./iam/authentication/sso
+protoc --go_out=./iam/authentication/sso --go_opt=paths=source_relative --go-grpc_out=./iam/authentication/sso --go-grpc_opt=paths=source_relative -I ../../proto/iam/authentication sso.proto
+# iam_authentication_oidc
+echo "Generating proto for iam_authentication_oidc service"
+mkdir -p ./iam/authentication/oidc
+protoc --go_out=./iam/authentication/oidc --go_opt=paths=source_relative --go-grpc_out=./iam/authentication/oidc --go-grpc_opt=paths=source_relative -I ../../proto/iam/authentication oidc.proto
+# iam_workload
+echo "Generating proto for iam_workload service"
+mkdir -p ./iam/workload
+protoc --go_out=./iam/workload --go_opt=paths=source_relative --go-grpc_out=./iam/workload --go-grpc_opt=paths=source_relative -I ../../proto/iam workload.proto
+
+# iam_authentication_apikey
+echo "Generating proto for iam_authentication_apikey service"
+mkdir -p ./iam/authentication/apikey
+protoc --go_out=./iam/authentication/apikey --go_opt=paths=source_relative --go-grpc_out=./iam/authentication/apikey --go-grpc_opt=paths=source_relative -I ../../proto/iam/authentication apikey.proto
 # iam_actor_user
 echo "Generating proto for iam_actor_user service"
 mkdir -p ./iam/actor/user
@@ -31,6 +31,14 @@ const (
 	CreateTokenWithPasswordResponse_IDENTITY_NOT_ACTIVE CreateTokenWithPasswordResponse_Status = 2
 	// Not enough privileges to create token with specified scopes
 	CreateTokenWithPasswordResponse_UNAUTHORIZED CreateTokenWithPasswordResponse_Status = 3
+	// Password is valid, but identity has TOTP enabled and TOTP code wasnt provided
+	CreateTokenWithPasswordResponse_TOTP_REQUIRED CreateTokenWithPasswordResponse_Status = 4
+	// Provided TOTP code is invalid or was already used
+	CreateTokenWithPasswordResponse_TOTP_INVALID CreateTokenWithPasswordResponse_Status = 5
+	// Too many failed attempts. Identity or source is temporary locked
+	CreateTokenWithPasswordResponse_TOO_MANY_ATTEMPTS CreateTokenWithPasswordResponse_Status = 6
+	// Namespace of the identity is suspended or archived
+	CreateTokenWithPasswordResponse_NAMESPACE_SUSPENDED CreateTokenWithPasswordResponse_Status = 7
 )
 
 // Enum value maps for CreateTokenWithPasswordResponse_Status.
@@ -40,12 +48,20 @@ var (
 		1: "CREDENTIALS_INVALID",
 		2: "IDENTITY_NOT_ACTIVE",
 		3: "UNAUTHORIZED",
+		4: "TOTP_REQUIRED",
+		5: "TOTP_INVALID",
+		6: "TOO_MANY_ATTEMPTS",
+		7: "NAMESPACE_SUSPENDED",
 	}
 	CreateTokenWithPasswordResponse_Status_value = map[string]int32{
 		"OK":                  0,
 		"CREDENTIALS_INVALID": 1,
 		"IDENTITY_NOT_ACTIVE": 2,
 		"UNAUTHORIZED":        3,
+		"TOTP_REQUIRED":       4,
+		"TOTP_INVALID":        5,
+		"TOO_MANY_ATTEMPTS":   6,
+		"NAMESPACE_SUSPENDED": 7,
 	}
 )
 
@@ -73,7 +89,7 @@ func (x CreateTokenWithPasswordResponse_Status) Number() protoreflect.EnumNumber
 
 // Deprecated: Use CreateTokenWithPasswordResponse_Status.Descriptor instead.
 func (CreateTokenWithPasswordResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{2, 0}
+	return file_auth_proto_rawDescGZIP(), []int{3, 0}
 }
 
 type CreateTokenWithOAuth2Response_Status int32
@@ -87,6 +103,8 @@ const (
 	CreateTokenWithOAuth2Response_IDENTITY_NOT_ACTIVE CreateTokenWithOAuth2Response_Status = 2
 	// Not enough privileges to create token with specified scopes
 	CreateTokenWithOAuth2Response_UNAUTHORIZED CreateTokenWithOAuth2Response_Status = 3
+	// Namespace of the identity is suspended or archived
+	CreateTokenWithOAuth2Response_NAMESPACE_SUSPENDED CreateTokenWithOAuth2Response_Status = 4
 )
 
 // Enum value maps for CreateTokenWithOAuth2Response_Status.
@@ -96,12 +114,14 @@ var (
 		1: "UNAUTHENTICATED",
 		2: "IDENTITY_NOT_ACTIVE",
 		3: "UNAUTHORIZED",
+		4: "NAMESPACE_SUSPENDED",
 	}
 	CreateTokenWithOAuth2Response_Status_value = map[string]int32{
 		"OK":                  0,
 		"UNAUTHENTICATED":     1,
 		"IDENTITY_NOT_ACTIVE": 2,
 		"UNAUTHORIZED":        3,
+		"NAMESPACE_SUSPENDED": 4,
 	}
 )
 
@@ -129,7 +149,127 @@ func (x CreateTokenWithOAuth2Response_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CreateTokenWithOAuth2Response_Status.Descriptor instead.
 func (CreateTokenWithOAuth2Response_Status) EnumDescriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{4, 0}
+	return file_auth_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type CreateTokenWithSSOResponse_Status int32
+
+const (
+	// Everything is ok. Access and refresh tokens were successfully created
+	CreateTokenWithSSOResponse_OK CreateTokenWithSSOResponse_Status = 0
+	// SAML response is invalid or there is no identity linked to its subject
+	CreateTokenWithSSOResponse_UNAUTHENTICATED CreateTokenWithSSOResponse_Status = 1
+	// Identity was manually disabled
+	CreateTokenWithSSOResponse_IDENTITY_NOT_ACTIVE CreateTokenWithSSOResponse_Status = 2
+	// Not enough privileges to create token with specified scopes
+	CreateTokenWithSSOResponse_UNAUTHORIZED CreateTokenWithSSOResponse_Status = 3
+	// Namespace of the identity is suspended or archived
+	CreateTokenWithSSOResponse_NAMESPACE_SUSPENDED CreateTokenWithSSOResponse_Status = 4
+)
+
+// Enum value maps for CreateTokenWithSSOResponse_Status.
+var (
+	CreateTokenWithSSOResponse_Status_name = map[int32]string{
+		0: "OK",
+		1: "UNAUTHENTICATED",
+		2: "IDENTITY_NOT_ACTIVE",
+		3: "UNAUTHORIZED",
+		4: "NAMESPACE_SUSPENDED",
+	}
+	CreateTokenWithSSOResponse_Status_value = map[string]int32{
+		"OK":                  0,
+		"UNAUTHENTICATED":     1,
+		"IDENTITY_NOT_ACTIVE": 2,
+		"UNAUTHORIZED":        3,
+		"NAMESPACE_SUSPENDED": 4,
+	}
+)
+
+func (x CreateTokenWithSSOResponse_Status) Enum() *CreateTokenWithSSOResponse_Status {
+	p := new(CreateTokenWithSSOResponse_Status)
+	*p = x
+	return p
+}
+
+func (x CreateTokenWithSSOResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateTokenWithSSOResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_proto_enumTypes[2].Descriptor()
+}
+
+func (CreateTokenWithSSOResponse_Status) Type() protoreflect.EnumType {
+	return &file_auth_proto_enumTypes[2]
+}
+
+func (x CreateTokenWithSSOResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateTokenWithSSOResponse_Status.Descriptor instead.
+func (CreateTokenWithSSOResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type CreateTokenWithOIDCResponse_Status int32
+
+const (
+	// Everything is ok. Access and refresh tokens were successfully created
+	CreateTokenWithOIDCResponse_OK CreateTokenWithOIDCResponse_Status = 0
+	// Authorization code or ID token is invalid or there is no identity linked to its subject
+	CreateTokenWithOIDCResponse_UNAUTHENTICATED CreateTokenWithOIDCResponse_Status = 1
+	// Identity was manually disabled
+	CreateTokenWithOIDCResponse_IDENTITY_NOT_ACTIVE CreateTokenWithOIDCResponse_Status = 2
+	// Not enough privileges to create token with specified scopes
+	CreateTokenWithOIDCResponse_UNAUTHORIZED CreateTokenWithOIDCResponse_Status = 3
+	// Namespace of the identity is suspended or archived
+	CreateTokenWithOIDCResponse_NAMESPACE_SUSPENDED CreateTokenWithOIDCResponse_Status = 4
+)
+
+// Enum value maps for CreateTokenWithOIDCResponse_Status.
+var (
+	CreateTokenWithOIDCResponse_Status_name = map[int32]string{
+		0: "OK",
+		1: "UNAUTHENTICATED",
+		2: "IDENTITY_NOT_ACTIVE",
+		3: "UNAUTHORIZED",
+		4: "NAMESPACE_SUSPENDED",
+	}
+	CreateTokenWithOIDCResponse_Status_value = map[string]int32{
+		"OK":                  0,
+		"UNAUTHENTICATED":     1,
+		"IDENTITY_NOT_ACTIVE": 2,
+		"UNAUTHORIZED":        3,
+		"NAMESPACE_SUSPENDED": 4,
+	}
+)
+
+func (x CreateTokenWithOIDCResponse_Status) Enum() *CreateTokenWithOIDCResponse_Status {
+	p := new(CreateTokenWithOIDCResponse_Status)
+	*p = x
+	return p
+}
+
+func (x CreateTokenWithOIDCResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateTokenWithOIDCResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_proto_enumTypes[3].Descriptor()
+}
+
+func (CreateTokenWithOIDCResponse_Status) Type() protoreflect.EnumType {
+	return &file_auth_proto_enumTypes[3]
+}
+
+func (x CreateTokenWithOIDCResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateTokenWithOIDCResponse_Status.Descriptor instead.
+func (CreateTokenWithOIDCResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{9, 0}
 }
 
 type RefreshTokenResponse_Status int32
@@ -153,20 +293,26 @@ const (
 	RefreshTokenResponse_IDENTITY_NOT_ACTIVE RefreshTokenResponse_Status = 7
 	// Most probably indentity policies changed and now its not possible to create token with same scopes
 	RefreshTokenResponse_IDENTITY_UNAUTHENTICATED RefreshTokenResponse_Status = 8
+	// Refresh token was already used before. Token was disabled, because refresh token was most probably stolen.
+	RefreshTokenResponse_TOKEN_REUSED RefreshTokenResponse_Status = 9
+	// Namespace of the identity is suspended or archived
+	RefreshTokenResponse_NAMESPACE_SUSPENDED RefreshTokenResponse_Status = 10
 )
 
 // Enum value maps for RefreshTokenResponse_Status.
 var (
 	RefreshTokenResponse_Status_name = map[int32]string{
-		0: "OK",
-		1: "TOKEN_INVALID",
-		2: "TOKEN_NOT_FOUND",
-		3: "TOKEN_DISABLED",
-		4: "TOKEN_EXPIRED",
-		5: "TOKEN_IS_NOT_REFRESH_TOKEN",
-		6: "IDENTITY_NOT_FOUND",
-		7: "IDENTITY_NOT_ACTIVE",
-		8: "IDENTITY_UNAUTHENTICATED",
+		0:  "OK",
+		1:  "TOKEN_INVALID",
+		2:  "TOKEN_NOT_FOUND",
+		3:  "TOKEN_DISABLED",
+		4:  "TOKEN_EXPIRED",
+		5:  "TOKEN_IS_NOT_REFRESH_TOKEN",
+		6:  "IDENTITY_NOT_FOUND",
+		7:  "IDENTITY_NOT_ACTIVE",
+		8:  "IDENTITY_UNAUTHENTICATED",
+		9:  "TOKEN_REUSED",
+		10: "NAMESPACE_SUSPENDED",
 	}
 	RefreshTokenResponse_Status_value = map[string]int32{
 		"OK":                         0,
@@ -178,6 +324,8 @@ var (
 		"IDENTITY_NOT_FOUND":         6,
 		"IDENTITY_NOT_ACTIVE":        7,
 		"IDENTITY_UNAUTHENTICATED":   8,
+		"TOKEN_REUSED":               9,
+		"NAMESPACE_SUSPENDED":        10,
 	}
 )
 
@@ -192,11 +340,11 @@ func (x RefreshTokenResponse_Status) String() string {
 }
 
 func (RefreshTokenResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_auth_proto_enumTypes[2].Descriptor()
+	return file_auth_proto_enumTypes[4].Descriptor()
 }
 
 func (RefreshTokenResponse_Status) Type() protoreflect.EnumType {
-	return &file_auth_proto_enumTypes[2]
+	return &file_auth_proto_enumTypes[4]
 }
 
 func (x RefreshTokenResponse_Status) Number() protoreflect.EnumNumber {
@@ -205,7 +353,7 @@ func (x RefreshTokenResponse_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RefreshTokenResponse_Status.Descriptor instead.
 func (RefreshTokenResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{6, 0}
+	return file_auth_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type CheckAccessWithTokenResponse_Status int32
@@ -223,6 +371,8 @@ const (
 	CheckAccessWithTokenResponse_TOKEN_EXPIRED CheckAccessWithTokenResponse_Status = 4
 	// Token has not enought privileges to access specified scopes
 	CheckAccessWithTokenResponse_UNAUTHORIZED CheckAccessWithTokenResponse_Status = 5
+	// Namespace of the identity is suspended or archived
+	CheckAccessWithTokenResponse_NAMESPACE_SUSPENDED CheckAccessWithTokenResponse_Status = 6
 )
 
 // Enum value maps for CheckAccessWithTokenResponse_Status.
@@ -234,14 +384,16 @@ var (
 		3: "TOKEN_DISABLED",
 		4: "TOKEN_EXPIRED",
 		5: "UNAUTHORIZED",
+		6: "NAMESPACE_SUSPENDED",
 	}
 	CheckAccessWithTokenResponse_Status_value = map[string]int32{
-		"OK":              0,
-		"TOKEN_INVALID":   1,
-		"TOKEN_NOT_FOUND": 2,
-		"TOKEN_DISABLED":  3,
-		"TOKEN_EXPIRED":   4,
-		"UNAUTHORIZED":    5,
+		"OK":                  0,
+		"TOKEN_INVALID":       1,
+		"TOKEN_NOT_FOUND":     2,
+		"TOKEN_DISABLED":      3,
+		"TOKEN_EXPIRED":       4,
+		"UNAUTHORIZED":        5,
+		"NAMESPACE_SUSPENDED": 6,
 	}
 )
 
@@ -256,11 +408,11 @@ func (x CheckAccessWithTokenResponse_Status) String() string {
 }
 
 func (CheckAccessWithTokenResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_auth_proto_enumTypes[3].Descriptor()
+	return file_auth_proto_enumTypes[5].Descriptor()
 }
 
 func (CheckAccessWithTokenResponse_Status) Type() protoreflect.EnumType {
-	return &file_auth_proto_enumTypes[3]
+	return &file_auth_proto_enumTypes[5]
 }
 
 func (x CheckAccessWithTokenResponse_Status) Number() protoreflect.EnumNumber {
@@ -269,7 +421,7 @@ func (x CheckAccessWithTokenResponse_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CheckAccessWithTokenResponse_Status.Descriptor instead.
 func (CheckAccessWithTokenResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{8, 0}
+	return file_auth_proto_rawDescGZIP(), []int{13, 0}
 }
 
 type CheckAccessWithPasswordResponse_Status int32
@@ -281,6 +433,14 @@ const (
 	CheckAccessWithPasswordResponse_UNAUTHENTICATED CheckAccessWithPasswordResponse_Status = 1
 	// Identity dont have enought priviliges to perform actions from provided scopes
 	CheckAccessWithPasswordResponse_UNAUTHORIZED CheckAccessWithPasswordResponse_Status = 5
+	// Too many failed attempts. Identity or source is temporary locked
+	CheckAccessWithPasswordResponse_TOO_MANY_ATTEMPTS CheckAccessWithPasswordResponse_Status = 6
+	// Namespace of the identity is suspended or archived
+	CheckAccessWithPasswordResponse_NAMESPACE_SUSPENDED CheckAccessWithPasswordResponse_Status = 7
+	// Password is valid, but identity has TOTP enabled and TOTP code wasnt provided
+	CheckAccessWithPasswordResponse_TOTP_REQUIRED CheckAccessWithPasswordResponse_Status = 8
+	// Provided TOTP code is invalid or was already used
+	CheckAccessWithPasswordResponse_TOTP_INVALID CheckAccessWithPasswordResponse_Status = 9
 )
 
 // Enum value maps for CheckAccessWithPasswordResponse_Status.
@@ -289,11 +449,19 @@ var (
 		0: "OK",
 		1: "UNAUTHENTICATED",
 		5: "UNAUTHORIZED",
+		6: "TOO_MANY_ATTEMPTS",
+		7: "NAMESPACE_SUSPENDED",
+		8: "TOTP_REQUIRED",
+		9: "TOTP_INVALID",
 	}
 	CheckAccessWithPasswordResponse_Status_value = map[string]int32{
-		"OK":              0,
-		"UNAUTHENTICATED": 1,
-		"UNAUTHORIZED":    5,
+		"OK":                  0,
+		"UNAUTHENTICATED":     1,
+		"UNAUTHORIZED":        5,
+		"TOO_MANY_ATTEMPTS":   6,
+		"NAMESPACE_SUSPENDED": 7,
+		"TOTP_REQUIRED":       8,
+		"TOTP_INVALID":        9,
 	}
 )
 
@@ -308,11 +476,11 @@ func (x CheckAccessWithPasswordResponse_Status) String() string {
 }
 
 func (CheckAccessWithPasswordResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_auth_proto_enumTypes[4].Descriptor()
+	return file_auth_proto_enumTypes[6].Descriptor()
 }
 
 func (CheckAccessWithPasswordResponse_Status) Type() protoreflect.EnumType {
-	return &file_auth_proto_enumTypes[4]
+	return &file_auth_proto_enumTypes[6]
 }
 
 func (x CheckAccessWithPasswordResponse_Status) Number() protoreflect.EnumNumber {
@@ -321,7 +489,7 @@ func (x CheckAccessWithPasswordResponse_Status) Number() protoreflect.EnumNumber
 
 // Deprecated: Use CheckAccessWithPasswordResponse_Status.Descriptor instead.
 func (CheckAccessWithPasswordResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{10, 0}
+	return file_auth_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type CheckAccessWithX509Response_Status int32
@@ -343,29 +511,41 @@ const (
 	CheckAccessWithX509Response_IDENTITY_NOT_ACTIVE CheckAccessWithX509Response_Status = 6
 	// Certificate is valid, but identity dont have enought priviliges to perform actions from provided scopes
 	CheckAccessWithX509Response_UNAUTHORIZED CheckAccessWithX509Response_Status = 7
+	// Certificate expired or is not valid yet
+	CheckAccessWithX509Response_CERTIFICATE_EXPIRED CheckAccessWithX509Response_Status = 8
+	// Certificate was issued in other namespace than the one provided in the request
+	CheckAccessWithX509Response_CERTIFICATE_NAMESPACE_MISMATCH CheckAccessWithX509Response_Status = 9
+	// Namespace of the identity is suspended or archived
+	CheckAccessWithX509Response_NAMESPACE_SUSPENDED CheckAccessWithX509Response_Status = 10
 )
 
 // Enum value maps for CheckAccessWithX509Response_Status.
 var (
 	CheckAccessWithX509Response_Status_name = map[int32]string{
-		0: "OK",
-		1: "CERTIFICATE_INVALID_FORMAT",
-		2: "CERTIFICATE_INVALID",
-		3: "CERTIFICATE_NOT_FOUND",
-		4: "CERTIFICATE_DISABLED",
-		5: "IDENTITY_NOT_FOUND",
-		6: "IDENTITY_NOT_ACTIVE",
-		7: "UNAUTHORIZED",
+		0:  "OK",
+		1:  "CERTIFICATE_INVALID_FORMAT",
+		2:  "CERTIFICATE_INVALID",
+		3:  "CERTIFICATE_NOT_FOUND",
+		4:  "CERTIFICATE_DISABLED",
+		5:  "IDENTITY_NOT_FOUND",
+		6:  "IDENTITY_NOT_ACTIVE",
+		7:  "UNAUTHORIZED",
+		8:  "CERTIFICATE_EXPIRED",
+		9:  "CERTIFICATE_NAMESPACE_MISMATCH",
+		10: "NAMESPACE_SUSPENDED",
 	}
 	CheckAccessWithX509Response_Status_value = map[string]int32{
-		"OK":                         0,
-		"CERTIFICATE_INVALID_FORMAT": 1,
-		"CERTIFICATE_INVALID":        2,
-		"CERTIFICATE_NOT_FOUND":      3,
-		"CERTIFICATE_DISABLED":       4,
-		"IDENTITY_NOT_FOUND":         5,
-		"IDENTITY_NOT_ACTIVE":        6,
-		"UNAUTHORIZED":               7,
+		"OK":                             0,
+		"CERTIFICATE_INVALID_FORMAT":     1,
+		"CERTIFICATE_INVALID":            2,
+		"CERTIFICATE_NOT_FOUND":          3,
+		"CERTIFICATE_DISABLED":           4,
+		"IDENTITY_NOT_FOUND":             5,
+		"IDENTITY_NOT_ACTIVE":            6,
+		"UNAUTHORIZED":                   7,
+		"CERTIFICATE_EXPIRED":            8,
+		"CERTIFICATE_NAMESPACE_MISMATCH": 9,
+		"NAMESPACE_SUSPENDED":            10,
 	}
 )
 
@@ -380,11 +560,11 @@ func (x CheckAccessWithX509Response_Status) String() string {
 }
 
 func (CheckAccessWithX509Response_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_auth_proto_enumTypes[5].Descriptor()
+	return file_auth_proto_enumTypes[7].Descriptor()
 }
 
 func (CheckAccessWithX509Response_Status) Type() protoreflect.EnumType {
-	return &file_auth_proto_enumTypes[5]
+	return &file_auth_proto_enumTypes[7]
 }
 
 func (x CheckAccessWithX509Response_Status) Number() protoreflect.EnumNumber {
@@ -393,7 +573,79 @@ func (x CheckAccessWithX509Response_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CheckAccessWithX509Response_Status.Descriptor instead.
 func (CheckAccessWithX509Response_Status) EnumDescriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{12, 0}
+	return file_auth_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type CheckAccessWithAPIKeyResponse_Status int32
+
+const (
+	// Provided API key allows to access scopes
+	CheckAccessWithAPIKeyResponse_OK CheckAccessWithAPIKeyResponse_Status = 0
+	// API key has bad format or its secret doesnt match
+	CheckAccessWithAPIKeyResponse_KEY_INVALID CheckAccessWithAPIKeyResponse_Status = 1
+	// Most probably API key or its identity was deleted
+	CheckAccessWithAPIKeyResponse_KEY_NOT_FOUND CheckAccessWithAPIKeyResponse_Status = 2
+	// API key was revoked
+	CheckAccessWithAPIKeyResponse_KEY_REVOKED CheckAccessWithAPIKeyResponse_Status = 3
+	// API key expired
+	CheckAccessWithAPIKeyResponse_KEY_EXPIRED CheckAccessWithAPIKeyResponse_Status = 4
+	// Identity of the API key wasnt found or is not active
+	CheckAccessWithAPIKeyResponse_IDENTITY_NOT_ACTIVE CheckAccessWithAPIKeyResponse_Status = 5
+	// API key scopes are not allowed by the identity policies anymore or they dont allow to access specified scopes
+	CheckAccessWithAPIKeyResponse_UNAUTHORIZED CheckAccessWithAPIKeyResponse_Status = 6
+	// Namespace of the identity is suspended or archived
+	CheckAccessWithAPIKeyResponse_NAMESPACE_SUSPENDED CheckAccessWithAPIKeyResponse_Status = 7
+)
+
+// Enum value maps for CheckAccessWithAPIKeyResponse_Status.
+var (
+	CheckAccessWithAPIKeyResponse_Status_name = map[int32]string{
+		0: "OK",
+		1: "KEY_INVALID",
+		2: "KEY_NOT_FOUND",
+		3: "KEY_REVOKED",
+		4: "KEY_EXPIRED",
+		5: "IDENTITY_NOT_ACTIVE",
+		6: "UNAUTHORIZED",
+		7: "NAMESPACE_SUSPENDED",
+	}
+	CheckAccessWithAPIKeyResponse_Status_value = map[string]int32{
+		"OK":                  0,
+		"KEY_INVALID":         1,
+		"KEY_NOT_FOUND":       2,
+		"KEY_REVOKED":         3,
+		"KEY_EXPIRED":         4,
+		"IDENTITY_NOT_ACTIVE": 5,
+		"UNAUTHORIZED":        6,
+		"NAMESPACE_SUSPENDED": 7,
+	}
+)
+
+func (x CheckAccessWithAPIKeyResponse_Status) Enum() *CheckAccessWithAPIKeyResponse_Status {
+	p := new(CheckAccessWithAPIKeyResponse_Status)
+	*p = x
+	return p
+}
+
+func (x CheckAccessWithAPIKeyResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CheckAccessWithAPIKeyResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_proto_enumTypes[8].Descriptor()
+}
+
+func (CheckAccessWithAPIKeyResponse_Status) Type() protoreflect.EnumType {
+	return &file_auth_proto_enumTypes[8]
+}
+
+func (x CheckAccessWithAPIKeyResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CheckAccessWithAPIKeyResponse_Status.Descriptor instead.
+func (CheckAccessWithAPIKeyResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type CheckAccessResponse_Status int32
@@ -407,6 +659,8 @@ const (
 	CheckAccessResponse_IDENTITY_NOT_ACTIVE CheckAccessResponse_Status = 2
 	// Identity dont have enought priviliges to perform actions from provided scopes
 	CheckAccessResponse_UNAUTHORIZED CheckAccessResponse_Status = 3
+	// Namespace of the identity is suspended or archived
+	CheckAccessResponse_NAMESPACE_SUSPENDED CheckAccessResponse_Status = 4
 )
 
 // Enum value maps for CheckAccessResponse_Status.
@@ -416,12 +670,14 @@ var (
 		1: "IDENTITY_NOT_FOUND",
 		2: "IDENTITY_NOT_ACTIVE",
 		3: "UNAUTHORIZED",
+		4: "NAMESPACE_SUSPENDED",
 	}
 	CheckAccessResponse_Status_value = map[string]int32{
 		"OK":                  0,
 		"IDENTITY_NOT_FOUND":  1,
 		"IDENTITY_NOT_ACTIVE": 2,
 		"UNAUTHORIZED":        3,
+		"NAMESPACE_SUSPENDED": 4,
 	}
 )
 
@@ -436,11 +692,11 @@ func (x CheckAccessResponse_Status) String() string {
 }
 
 func (CheckAccessResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_auth_proto_enumTypes[6].Descriptor()
+	return file_auth_proto_enumTypes[9].Descriptor()
 }
 
 func (CheckAccessResponse_Status) Type() protoreflect.EnumType {
-	return &file_auth_proto_enumTypes[6]
+	return &file_auth_proto_enumTypes[9]
 }
 
 func (x CheckAccessResponse_Status) Number() protoreflect.EnumNumber {
@@ -449,7 +705,139 @@ func (x CheckAccessResponse_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CheckAccessResponse_Status.Descriptor instead.
 func (CheckAccessResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{14, 0}
+	return file_auth_proto_rawDescGZIP(), []int{21, 0}
+}
+
+type ScopeExplanation_Decision int32
+
+const (
+	// Access to the scope is granted
+	ScopeExplanation_GRANTED ScopeExplanation_Decision = 0
+	// None of the identity policies covers resources and actions of the scope
+	ScopeExplanation_NO_MATCHING_POLICY ScopeExplanation_Decision = 1
+	// Explicit DENY policy matched the scope
+	ScopeExplanation_DENIED_BY_POLICY ScopeExplanation_Decision = 2
+	// Some policies cover the scope, but their conditions (source IP, time window, authentication method, MFA) are not satisfied
+	ScopeExplanation_CONDITIONS_NOT_SATISFIED ScopeExplanation_Decision = 3
+	// Identity policies allow the scope, but the token was created with narrower list of scopes
+	ScopeExplanation_TOKEN_SCOPE_NARROWED ScopeExplanation_Decision = 4
+)
+
+// Enum value maps for ScopeExplanation_Decision.
+var (
+	ScopeExplanation_Decision_name = map[int32]string{
+		0: "GRANTED",
+		1: "NO_MATCHING_POLICY",
+		2: "DENIED_BY_POLICY",
+		3: "CONDITIONS_NOT_SATISFIED",
+		4: "TOKEN_SCOPE_NARROWED",
+	}
+	ScopeExplanation_Decision_value = map[string]int32{
+		"GRANTED":                  0,
+		"NO_MATCHING_POLICY":       1,
+		"DENIED_BY_POLICY":         2,
+		"CONDITIONS_NOT_SATISFIED": 3,
+		"TOKEN_SCOPE_NARROWED":     4,
+	}
+)
+
+func (x ScopeExplanation_Decision) Enum() *ScopeExplanation_Decision {
+	p := new(ScopeExplanation_Decision)
+	*p = x
+	return p
+}
+
+func (x ScopeExplanation_Decision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScopeExplanation_Decision) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_proto_enumTypes[10].Descriptor()
+}
+
+func (ScopeExplanation_Decision) Type() protoreflect.EnumType {
+	return &file_auth_proto_enumTypes[10]
+}
+
+func (x ScopeExplanation_Decision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScopeExplanation_Decision.Descriptor instead.
+func (ScopeExplanation_Decision) EnumDescriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{24, 0}
+}
+
+type ExplainAccessResponse_Status int32
+
+const (
+	// Access was explained. It doesnt mean that access is granted
+	ExplainAccessResponse_OK ExplainAccessResponse_Status = 0
+	// Identity wasnt founded
+	ExplainAccessResponse_IDENTITY_NOT_FOUND ExplainAccessResponse_Status = 1
+	// Identity was manually disabled
+	ExplainAccessResponse_IDENTITY_NOT_ACTIVE ExplainAccessResponse_Status = 2
+	// Received token has bad format or its signature doesnt match
+	ExplainAccessResponse_TOKEN_INVALID ExplainAccessResponse_Status = 3
+	// Most probably token was deleted after its creation
+	ExplainAccessResponse_TOKEN_NOT_FOUND ExplainAccessResponse_Status = 4
+	// Token was manually disabled
+	ExplainAccessResponse_TOKEN_DISABLED ExplainAccessResponse_Status = 5
+	// Token expired
+	ExplainAccessResponse_TOKEN_EXPIRED ExplainAccessResponse_Status = 6
+	// Namespace of the identity is suspended or archived
+	ExplainAccessResponse_NAMESPACE_SUSPENDED ExplainAccessResponse_Status = 7
+)
+
+// Enum value maps for ExplainAccessResponse_Status.
+var (
+	ExplainAccessResponse_Status_name = map[int32]string{
+		0: "OK",
+		1: "IDENTITY_NOT_FOUND",
+		2: "IDENTITY_NOT_ACTIVE",
+		3: "TOKEN_INVALID",
+		4: "TOKEN_NOT_FOUND",
+		5: "TOKEN_DISABLED",
+		6: "TOKEN_EXPIRED",
+		7: "NAMESPACE_SUSPENDED",
+	}
+	ExplainAccessResponse_Status_value = map[string]int32{
+		"OK":                  0,
+		"IDENTITY_NOT_FOUND":  1,
+		"IDENTITY_NOT_ACTIVE": 2,
+		"TOKEN_INVALID":       3,
+		"TOKEN_NOT_FOUND":     4,
+		"TOKEN_DISABLED":      5,
+		"TOKEN_EXPIRED":       6,
+		"NAMESPACE_SUSPENDED": 7,
+	}
+)
+
+func (x ExplainAccessResponse_Status) Enum() *ExplainAccessResponse_Status {
+	p := new(ExplainAccessResponse_Status)
+	*p = x
+	return p
+}
+
+func (x ExplainAccessResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExplainAccessResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_proto_enumTypes[11].Descriptor()
+}
+
+func (ExplainAccessResponse_Status) Type() protoreflect.EnumType {
+	return &file_auth_proto_enumTypes[11]
+}
+
+func (x ExplainAccessResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExplainAccessResponse_Status.Descriptor instead.
+func (ExplainAccessResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{25, 0}
 }
 
 // Scope of the requested access. Check native_iam_policy for more information.
@@ -528,6 +916,64 @@ func (x *Scope) GetNamespaceIndependent() bool {
 	return false
 }
 
+// Information about the request that is used to evaluate policy conditions
+type AccessContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IP address of the requester. Policies with source IP conditions will not grant access if it is empty.
+	SourceIP string `protobuf:"bytes,1,opt,name=sourceIP,proto3" json:"sourceIP,omitempty"`
+	// User agent of the requester. Used to track session activity.
+	UserAgent string `protobuf:"bytes,2,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+}
+
+func (x *AccessContext) Reset() {
+	*x = AccessContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessContext) ProtoMessage() {}
+
+func (x *AccessContext) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessContext.ProtoReflect.Descriptor instead.
+func (*AccessContext) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccessContext) GetSourceIP() string {
+	if x != nil {
+		return x.SourceIP
+	}
+	return ""
+}
+
+func (x *AccessContext) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
 type CreateTokenWithPasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -543,12 +989,16 @@ type CreateTokenWithPasswordRequest struct {
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// Scopes of the created token. Empty for creating token with all possible scopes for identity.
 	Scopes []*Scope `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// TOTP (Time-based one-time password) code or one of the recovery codes. Only required if identity has TOTP enabled.
+	Totp string `protobuf:"bytes,6,opt,name=totp,proto3" json:"totp,omitempty"`
+	// Information about the request. Source IP is used for brute-force protection
+	Context *AccessContext `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`
 }
 
 func (x *CreateTokenWithPasswordRequest) Reset() {
 	*x = CreateTokenWithPasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[1]
+		mi := &file_auth_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -561,7 +1011,7 @@ func (x *CreateTokenWithPasswordRequest) String() string {
 func (*CreateTokenWithPasswordRequest) ProtoMessage() {}
 
 func (x *CreateTokenWithPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[1]
+	mi := &file_auth_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +1024,7 @@ func (x *CreateTokenWithPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTokenWithPasswordRequest.ProtoReflect.Descriptor instead.
 func (*CreateTokenWithPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{1}
+	return file_auth_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateTokenWithPasswordRequest) GetNamespace() string {
@@ -612,6 +1062,20 @@ func (x *CreateTokenWithPasswordRequest) GetScopes() []*Scope {
 	return nil
 }
 
+func (x *CreateTokenWithPasswordRequest) GetTotp() string {
+	if x != nil {
+		return x.Totp
+	}
+	return ""
+}
+
+func (x *CreateTokenWithPasswordRequest) GetContext() *AccessContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
 type CreateTokenWithPasswordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -623,12 +1087,16 @@ type CreateTokenWithPasswordResponse struct {
 	AccessToken string `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
 	// Token used for refreshing accessToken. If status is not OK - empty string
 	RefreshToken string `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	// Password expired or administrator forced password change. Caller should ask identity to set new password.
+	PasswordChangeRequired bool `protobuf:"varint,4,opt,name=passwordChangeRequired,proto3" json:"passwordChangeRequired,omitempty"`
+	// If status is TOO_MANY_ATTEMPTS or TOTP_INVALID, number of seconds after which next attempt can be made. Invalid TOTP codes count as failed attempts of the password authentication.
+	RetryAfter uint32 `protobuf:"varint,5,opt,name=retryAfter,proto3" json:"retryAfter,omitempty"`
 }
 
 func (x *CreateTokenWithPasswordResponse) Reset() {
 	*x = CreateTokenWithPasswordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[2]
+		mi := &file_auth_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -641,7 +1109,7 @@ func (x *CreateTokenWithPasswordResponse) String() string {
 func (*CreateTokenWithPasswordResponse) ProtoMessage() {}
 
 func (x *CreateTokenWithPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[2]
+	mi := &file_auth_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +1122,7 @@ func (x *CreateTokenWithPasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTokenWithPasswordResponse.ProtoReflect.Descriptor instead.
 func (*CreateTokenWithPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{2}
+	return file_auth_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateTokenWithPasswordResponse) GetStatus() CreateTokenWithPasswordResponse_Status {
@@ -678,9 +1146,23 @@ func (x *CreateTokenWithPasswordResponse) GetRefreshToken() string {
 	return ""
 }
 
-type CreateTokenWithOAuth2Request struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *CreateTokenWithPasswordResponse) GetPasswordChangeRequired() bool {
+	if x != nil {
+		return x.PasswordChangeRequired
+	}
+	return false
+}
+
+func (x *CreateTokenWithPasswordResponse) GetRetryAfter() uint32 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type CreateTokenWithOAuth2Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Namespace where identity located. May be empty for global identity
@@ -702,7 +1184,7 @@ type CreateTokenWithOAuth2Request struct {
 func (x *CreateTokenWithOAuth2Request) Reset() {
 	*x = CreateTokenWithOAuth2Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[3]
+		mi := &file_auth_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -715,7 +1197,7 @@ func (x *CreateTokenWithOAuth2Request) String() string {
 func (*CreateTokenWithOAuth2Request) ProtoMessage() {}
 
 func (x *CreateTokenWithOAuth2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[3]
+	mi := &file_auth_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +1210,7 @@ func (x *CreateTokenWithOAuth2Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTokenWithOAuth2Request.ProtoReflect.Descriptor instead.
 func (*CreateTokenWithOAuth2Request) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{3}
+	return file_auth_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateTokenWithOAuth2Request) GetNamespace() string {
@@ -798,7 +1280,7 @@ type CreateTokenWithOAuth2Response struct {
 func (x *CreateTokenWithOAuth2Response) Reset() {
 	*x = CreateTokenWithOAuth2Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[4]
+		mi := &file_auth_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -811,7 +1293,7 @@ func (x *CreateTokenWithOAuth2Response) String() string {
 func (*CreateTokenWithOAuth2Response) ProtoMessage() {}
 
 func (x *CreateTokenWithOAuth2Response) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[4]
+	mi := &file_auth_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,7 +1306,7 @@ func (x *CreateTokenWithOAuth2Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTokenWithOAuth2Response.ProtoReflect.Descriptor instead.
 func (*CreateTokenWithOAuth2Response) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{4}
+	return file_auth_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateTokenWithOAuth2Response) GetStatus() CreateTokenWithOAuth2Response_Status {
@@ -855,31 +1337,40 @@ func (x *CreateTokenWithOAuth2Response) GetIdentity() string {
 	return ""
 }
 
-type RefreshTokenRequest struct {
+type CreateTokenWithSSORequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RefreshToken string `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	// Namespace where identity located. May be empty for global identity
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// SAML Identity Provider unique identifier
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Base64 encoded SAMLResponse received by Assertion Consumer Service
+	SamlResponse string `protobuf:"bytes,3,opt,name=samlResponse,proto3" json:"samlResponse,omitempty"`
+	// Arbitrary metadata. For example MAC/IP/information of the actor/application/browser/machine that created this token. The exact format of metadata is not defined, but JSON is suggested.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Scopes of the created token. Empty for creating token with all possible scopes for identity.
+	Scopes []*Scope `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
 }
 
-func (x *RefreshTokenRequest) Reset() {
-	*x = RefreshTokenRequest{}
+func (x *CreateTokenWithSSORequest) Reset() {
+	*x = CreateTokenWithSSORequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[5]
+		mi := &file_auth_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefreshTokenRequest) String() string {
+func (x *CreateTokenWithSSORequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenRequest) ProtoMessage() {}
+func (*CreateTokenWithSSORequest) ProtoMessage() {}
 
-func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[5]
+func (x *CreateTokenWithSSORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,88 +1381,65 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
-func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CreateTokenWithSSORequest.ProtoReflect.Descriptor instead.
+func (*CreateTokenWithSSORequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RefreshTokenRequest) GetRefreshToken() string {
+func (x *CreateTokenWithSSORequest) GetNamespace() string {
 	if x != nil {
-		return x.RefreshToken
+		return x.Namespace
 	}
 	return ""
 }
 
-type RefreshTokenResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Status of the refresh
-	Status RefreshTokenResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.RefreshTokenResponse_Status" json:"status,omitempty"`
-	// New access token
-	AccessToken string `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-}
-
-func (x *RefreshTokenResponse) Reset() {
-	*x = RefreshTokenResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateTokenWithSSORequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
 	}
+	return ""
 }
 
-func (x *RefreshTokenResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RefreshTokenResponse) ProtoMessage() {}
-
-func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateTokenWithSSORequest) GetSamlResponse() string {
+	if x != nil {
+		return x.SamlResponse
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
-func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{6}
+	return ""
 }
 
-func (x *RefreshTokenResponse) GetStatus() RefreshTokenResponse_Status {
+func (x *CreateTokenWithSSORequest) GetMetadata() string {
 	if x != nil {
-		return x.Status
+		return x.Metadata
 	}
-	return RefreshTokenResponse_OK
+	return ""
 }
 
-func (x *RefreshTokenResponse) GetAccessToken() string {
+func (x *CreateTokenWithSSORequest) GetScopes() []*Scope {
 	if x != nil {
-		return x.AccessToken
+		return x.Scopes
 	}
-	return ""
+	return nil
 }
 
-type CheckAccessWithTokenRequest struct {
+type CreateTokenWithSSOResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Token to verify
-	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	// Scopes for with to validate access
-	Scopes []*Scope `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Status of the token creation
+	Status CreateTokenWithSSOResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.CreateTokenWithSSOResponse_Status" json:"status,omitempty"`
+	// Details of the status, that can be safelly returned and displayed to the requester
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Token used for authentication and authorization
+	AccessToken string `protobuf:"bytes,3,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	// Token used for refreshing accessToken
+	RefreshToken string `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	// Identity UUID
+	Identity string `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (x *CheckAccessWithTokenRequest) Reset() {
-	*x = CheckAccessWithTokenRequest{}
+func (x *CreateTokenWithSSOResponse) Reset() {
+	*x = CreateTokenWithSSOResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_auth_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -979,13 +1447,13 @@ func (x *CheckAccessWithTokenRequest) Reset() {
 	}
 }
 
-func (x *CheckAccessWithTokenRequest) String() string {
+func (x *CreateTokenWithSSOResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAccessWithTokenRequest) ProtoMessage() {}
+func (*CreateTokenWithSSOResponse) ProtoMessage() {}
 
-func (x *CheckAccessWithTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateTokenWithSSOResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -997,44 +1465,67 @@ func (x *CheckAccessWithTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAccessWithTokenRequest.ProtoReflect.Descriptor instead.
-func (*CheckAccessWithTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTokenWithSSOResponse.ProtoReflect.Descriptor instead.
+func (*CreateTokenWithSSOResponse) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CheckAccessWithTokenRequest) GetAccessToken() string {
+func (x *CreateTokenWithSSOResponse) GetStatus() CreateTokenWithSSOResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CreateTokenWithSSOResponse_OK
+}
+
+func (x *CreateTokenWithSSOResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateTokenWithSSOResponse) GetAccessToken() string {
 	if x != nil {
 		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *CheckAccessWithTokenRequest) GetScopes() []*Scope {
+func (x *CreateTokenWithSSOResponse) GetRefreshToken() string {
 	if x != nil {
-		return x.Scopes
+		return x.RefreshToken
 	}
-	return nil
+	return ""
 }
 
-type CheckAccessWithTokenResponse struct {
+func (x *CreateTokenWithSSOResponse) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type CreateTokenWithOIDCRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Status of the verification
-	Status CheckAccessWithTokenResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.CheckAccessWithTokenResponse_Status" json:"status,omitempty"`
-	// Details of the status, that can be safelly returned and displayed to the requester
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Namespace where token and identity are located
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// Unique token id
-	TokenUUID string `protobuf:"bytes,4,opt,name=tokenUUID,proto3" json:"tokenUUID,omitempty"`
-	// Unique identity id
-	IdentityUUID string `protobuf:"bytes,5,opt,name=identityUUID,proto3" json:"identityUUID,omitempty"`
+	// Namespace where identity located. May be empty for global identity
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// OpenID Connect provider unique identifier
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Authorization code returned by the provider
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// State returned by the provider together with authorization code
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// Arbitrary metadata. For example MAC/IP/information of the actor/application/browser/machine that created this token. The exact format of metadata is not defined, but JSON is suggested.
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Scopes of the created token. Empty for creating token with all possible scopes for identity.
+	Scopes []*Scope `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
 }
 
-func (x *CheckAccessWithTokenResponse) Reset() {
-	*x = CheckAccessWithTokenResponse{}
+func (x *CreateTokenWithOIDCRequest) Reset() {
+	*x = CreateTokenWithOIDCRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_auth_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1042,13 +1533,13 @@ func (x *CheckAccessWithTokenResponse) Reset() {
 	}
 }
 
-func (x *CheckAccessWithTokenResponse) String() string {
+func (x *CreateTokenWithOIDCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAccessWithTokenResponse) ProtoMessage() {}
+func (*CreateTokenWithOIDCRequest) ProtoMessage() {}
 
-func (x *CheckAccessWithTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateTokenWithOIDCRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1060,65 +1551,72 @@ func (x *CheckAccessWithTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAccessWithTokenResponse.ProtoReflect.Descriptor instead.
-func (*CheckAccessWithTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTokenWithOIDCRequest.ProtoReflect.Descriptor instead.
+func (*CreateTokenWithOIDCRequest) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CheckAccessWithTokenResponse) GetStatus() CheckAccessWithTokenResponse_Status {
+func (x *CreateTokenWithOIDCRequest) GetNamespace() string {
 	if x != nil {
-		return x.Status
+		return x.Namespace
 	}
-	return CheckAccessWithTokenResponse_OK
+	return ""
 }
 
-func (x *CheckAccessWithTokenResponse) GetMessage() string {
+func (x *CreateTokenWithOIDCRequest) GetProvider() string {
 	if x != nil {
-		return x.Message
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *CheckAccessWithTokenResponse) GetNamespace() string {
+func (x *CreateTokenWithOIDCRequest) GetCode() string {
 	if x != nil {
-		return x.Namespace
+		return x.Code
 	}
 	return ""
 }
 
-func (x *CheckAccessWithTokenResponse) GetTokenUUID() string {
+func (x *CreateTokenWithOIDCRequest) GetState() string {
 	if x != nil {
-		return x.TokenUUID
+		return x.State
 	}
 	return ""
 }
 
-func (x *CheckAccessWithTokenResponse) GetIdentityUUID() string {
+func (x *CreateTokenWithOIDCRequest) GetMetadata() string {
 	if x != nil {
-		return x.IdentityUUID
+		return x.Metadata
 	}
 	return ""
 }
 
-type CheckAccessWithPasswordRequest struct {
+func (x *CreateTokenWithOIDCRequest) GetScopes() []*Scope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type CreateTokenWithOIDCResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Namespace where identity is located
-	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// Identity UUID inside namespace
-	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
-	// Identity secret key
-	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	// Arbitrary metadata. For example MAC/IP/information of the actor/application/browser/machine that provided this indentity and password. The exact format of metadata is not defined, but JSON is suggested.
-	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// Scopes to check
-	Scopes []*Scope `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Status of the token creation
+	Status CreateTokenWithOIDCResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.CreateTokenWithOIDCResponse_Status" json:"status,omitempty"`
+	// Details of the status, that can be safelly returned and displayed to the requester
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Token used for authentication and authorization
+	AccessToken string `protobuf:"bytes,3,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	// Token used for refreshing accessToken
+	RefreshToken string `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	// Identity UUID
+	Identity string `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (x *CheckAccessWithPasswordRequest) Reset() {
-	*x = CheckAccessWithPasswordRequest{}
+func (x *CreateTokenWithOIDCResponse) Reset() {
+	*x = CreateTokenWithOIDCResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_auth_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1126,13 +1624,13 @@ func (x *CheckAccessWithPasswordRequest) Reset() {
 	}
 }
 
-func (x *CheckAccessWithPasswordRequest) String() string {
+func (x *CreateTokenWithOIDCResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAccessWithPasswordRequest) ProtoMessage() {}
+func (*CreateTokenWithOIDCResponse) ProtoMessage() {}
 
-func (x *CheckAccessWithPasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateTokenWithOIDCResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1144,59 +1642,58 @@ func (x *CheckAccessWithPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAccessWithPasswordRequest.ProtoReflect.Descriptor instead.
-func (*CheckAccessWithPasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTokenWithOIDCResponse.ProtoReflect.Descriptor instead.
+func (*CreateTokenWithOIDCResponse) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CheckAccessWithPasswordRequest) GetNamespace() string {
+func (x *CreateTokenWithOIDCResponse) GetStatus() CreateTokenWithOIDCResponse_Status {
 	if x != nil {
-		return x.Namespace
+		return x.Status
 	}
-	return ""
+	return CreateTokenWithOIDCResponse_OK
 }
 
-func (x *CheckAccessWithPasswordRequest) GetIdentity() string {
+func (x *CreateTokenWithOIDCResponse) GetMessage() string {
 	if x != nil {
-		return x.Identity
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CheckAccessWithPasswordRequest) GetPassword() string {
+func (x *CreateTokenWithOIDCResponse) GetAccessToken() string {
 	if x != nil {
-		return x.Password
+		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *CheckAccessWithPasswordRequest) GetMetadata() string {
+func (x *CreateTokenWithOIDCResponse) GetRefreshToken() string {
 	if x != nil {
-		return x.Metadata
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *CheckAccessWithPasswordRequest) GetScopes() []*Scope {
+func (x *CreateTokenWithOIDCResponse) GetIdentity() string {
 	if x != nil {
-		return x.Scopes
+		return x.Identity
 	}
-	return nil
+	return ""
 }
 
-type CheckAccessWithPasswordResponse struct {
+type RefreshTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Status of the check
-	Status CheckAccessWithPasswordResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.CheckAccessWithPasswordResponse_Status" json:"status,omitempty"`
-	// Details of the status, that can be safelly returned and displayed to the requester
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RefreshToken string `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	// Information about the requester. Used to track session activity.
+	Context *AccessContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
 }
 
-func (x *CheckAccessWithPasswordResponse) Reset() {
-	*x = CheckAccessWithPasswordResponse{}
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_auth_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1204,13 +1701,13 @@ func (x *CheckAccessWithPasswordResponse) Reset() {
 	}
 }
 
-func (x *CheckAccessWithPasswordResponse) String() string {
+func (x *RefreshTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAccessWithPasswordResponse) ProtoMessage() {}
+func (*RefreshTokenRequest) ProtoMessage() {}
 
-func (x *CheckAccessWithPasswordResponse) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1222,38 +1719,40 @@ func (x *CheckAccessWithPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAccessWithPasswordResponse.ProtoReflect.Descriptor instead.
-func (*CheckAccessWithPasswordResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CheckAccessWithPasswordResponse) GetStatus() CheckAccessWithPasswordResponse_Status {
+func (x *RefreshTokenRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Status
+		return x.RefreshToken
 	}
-	return CheckAccessWithPasswordResponse_OK
+	return ""
 }
 
-func (x *CheckAccessWithPasswordResponse) GetMessage() string {
+func (x *RefreshTokenRequest) GetContext() *AccessContext {
 	if x != nil {
-		return x.Message
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-type CheckAccessWithX509Request struct {
+type RefreshTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// X509 certificate in DER format
-	Certificate []byte `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	// Scopes to check
-	Scopes []*Scope `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Status of the refresh
+	Status RefreshTokenResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.RefreshTokenResponse_Status" json:"status,omitempty"`
+	// New access token
+	AccessToken string `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	// New refresh token. Refresh token that was used for this request is not valid anymore.
+	RefreshToken string `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
 }
 
-func (x *CheckAccessWithX509Request) Reset() {
-	*x = CheckAccessWithX509Request{}
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_auth_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1261,13 +1760,13 @@ func (x *CheckAccessWithX509Request) Reset() {
 	}
 }
 
-func (x *CheckAccessWithX509Request) String() string {
+func (x *RefreshTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAccessWithX509Request) ProtoMessage() {}
+func (*RefreshTokenResponse) ProtoMessage() {}
 
-func (x *CheckAccessWithX509Request) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_auth_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1279,55 +1778,132 @@ func (x *CheckAccessWithX509Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAccessWithX509Request.ProtoReflect.Descriptor instead.
-func (*CheckAccessWithX509Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
 	return file_auth_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CheckAccessWithX509Request) GetCertificate() []byte {
+func (x *RefreshTokenResponse) GetStatus() RefreshTokenResponse_Status {
 	if x != nil {
-		return x.Certificate
+		return x.Status
 	}
-	return nil
+	return RefreshTokenResponse_OK
 }
 
-func (x *CheckAccessWithX509Request) GetScopes() []*Scope {
+func (x *RefreshTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type CheckAccessWithTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Token to verify
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	// Scopes for with to validate access
+	Scopes []*Scope `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Information about the request used to evaluate policy conditions
+	Context *AccessContext `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *CheckAccessWithTokenRequest) Reset() {
+	*x = CheckAccessWithTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAccessWithTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessWithTokenRequest) ProtoMessage() {}
+
+func (x *CheckAccessWithTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessWithTokenRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessWithTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckAccessWithTokenRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *CheckAccessWithTokenRequest) GetScopes() []*Scope {
 	if x != nil {
 		return x.Scopes
 	}
 	return nil
 }
 
-type CheckAccessWithX509Response struct {
+func (x *CheckAccessWithTokenRequest) GetContext() *AccessContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type CheckAccessWithTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Status of the check
-	Status CheckAccessWithX509Response_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.CheckAccessWithX509Response_Status" json:"status,omitempty"`
+	// Status of the verification
+	Status CheckAccessWithTokenResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.CheckAccessWithTokenResponse_Status" json:"status,omitempty"`
 	// Details of the status, that can be safelly returned and displayed to the requester
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Certificate information. Only available if status is one of the {OK; CERTIFICATE_DISABLED; IDENTITY_NOT_FOUND; IDENTITY_NOT_ACTIVE; UNAUTHORIZED}
-	CertificateInfo *CheckAccessWithX509Response_CertificateInfo `protobuf:"bytes,3,opt,name=certificateInfo,proto3" json:"certificateInfo,omitempty"`
+	// Namespace where token and identity are located
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Unique token id
+	TokenUUID string `protobuf:"bytes,4,opt,name=tokenUUID,proto3" json:"tokenUUID,omitempty"`
+	// Unique identity id
+	IdentityUUID string `protobuf:"bytes,5,opt,name=identityUUID,proto3" json:"identityUUID,omitempty"`
 }
 
-func (x *CheckAccessWithX509Response) Reset() {
-	*x = CheckAccessWithX509Response{}
+func (x *CheckAccessWithTokenResponse) Reset() {
+	*x = CheckAccessWithTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[12]
+		mi := &file_auth_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckAccessWithX509Response) String() string {
+func (x *CheckAccessWithTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAccessWithX509Response) ProtoMessage() {}
+func (*CheckAccessWithTokenResponse) ProtoMessage() {}
 
-func (x *CheckAccessWithX509Response) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[12]
+func (x *CheckAccessWithTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1338,62 +1914,84 @@ func (x *CheckAccessWithX509Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAccessWithX509Response.ProtoReflect.Descriptor instead.
-func (*CheckAccessWithX509Response) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CheckAccessWithTokenResponse.ProtoReflect.Descriptor instead.
+func (*CheckAccessWithTokenResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CheckAccessWithX509Response) GetStatus() CheckAccessWithX509Response_Status {
+func (x *CheckAccessWithTokenResponse) GetStatus() CheckAccessWithTokenResponse_Status {
 	if x != nil {
 		return x.Status
 	}
-	return CheckAccessWithX509Response_OK
+	return CheckAccessWithTokenResponse_OK
 }
 
-func (x *CheckAccessWithX509Response) GetMessage() string {
+func (x *CheckAccessWithTokenResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *CheckAccessWithX509Response) GetCertificateInfo() *CheckAccessWithX509Response_CertificateInfo {
+func (x *CheckAccessWithTokenResponse) GetNamespace() string {
 	if x != nil {
-		return x.CertificateInfo
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-type CheckAccessRequest struct {
+func (x *CheckAccessWithTokenResponse) GetTokenUUID() string {
+	if x != nil {
+		return x.TokenUUID
+	}
+	return ""
+}
+
+func (x *CheckAccessWithTokenResponse) GetIdentityUUID() string {
+	if x != nil {
+		return x.IdentityUUID
+	}
+	return ""
+}
+
+type CheckAccessWithPasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Namespace where identity is located
 	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// Unique identifier of the identity
+	// Identity UUID inside namespace
 	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Identity secret key
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// Arbitrary metadata. For example MAC/IP/information of the actor/application/browser/machine that provided this indentity and password. The exact format of metadata is not defined, but JSON is suggested.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// Scopes to check
-	Scopes []*Scope `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Scopes []*Scope `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Information about the request used to evaluate policy conditions
+	Context *AccessContext `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	// TOTP (Time-based one-time password) code or one of the recovery codes. Only required if identity has TOTP enabled.
+	Totp string `protobuf:"bytes,7,opt,name=totp,proto3" json:"totp,omitempty"`
 }
 
-func (x *CheckAccessRequest) Reset() {
-	*x = CheckAccessRequest{}
+func (x *CheckAccessWithPasswordRequest) Reset() {
+	*x = CheckAccessWithPasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[13]
+		mi := &file_auth_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckAccessRequest) String() string {
+func (x *CheckAccessWithPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAccessRequest) ProtoMessage() {}
+func (*CheckAccessWithPasswordRequest) ProtoMessage() {}
 
-func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[13]
+func (x *CheckAccessWithPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,60 +2002,90 @@ func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
-func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CheckAccessWithPasswordRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessWithPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CheckAccessRequest) GetNamespace() string {
+func (x *CheckAccessWithPasswordRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *CheckAccessRequest) GetIdentity() string {
+func (x *CheckAccessWithPasswordRequest) GetIdentity() string {
 	if x != nil {
 		return x.Identity
 	}
 	return ""
 }
 
-func (x *CheckAccessRequest) GetScopes() []*Scope {
+func (x *CheckAccessWithPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CheckAccessWithPasswordRequest) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *CheckAccessWithPasswordRequest) GetScopes() []*Scope {
 	if x != nil {
 		return x.Scopes
 	}
 	return nil
 }
 
-type CheckAccessResponse struct {
+func (x *CheckAccessWithPasswordRequest) GetContext() *AccessContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *CheckAccessWithPasswordRequest) GetTotp() string {
+	if x != nil {
+		return x.Totp
+	}
+	return ""
+}
+
+type CheckAccessWithPasswordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Status of the check
-	Status CheckAccessResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.CheckAccessResponse_Status" json:"status,omitempty"`
+	Status CheckAccessWithPasswordResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.CheckAccessWithPasswordResponse_Status" json:"status,omitempty"`
 	// Details of the status, that can be safelly returned and displayed to the requester
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// If status is TOO_MANY_ATTEMPTS or TOTP_INVALID, number of seconds after which next attempt can be made. Invalid TOTP codes count as failed attempts of the password authentication.
+	RetryAfter uint32 `protobuf:"varint,3,opt,name=retryAfter,proto3" json:"retryAfter,omitempty"`
 }
 
-func (x *CheckAccessResponse) Reset() {
-	*x = CheckAccessResponse{}
+func (x *CheckAccessWithPasswordResponse) Reset() {
+	*x = CheckAccessWithPasswordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[14]
+		mi := &file_auth_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckAccessResponse) String() string {
+func (x *CheckAccessWithPasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAccessResponse) ProtoMessage() {}
+func (*CheckAccessWithPasswordResponse) ProtoMessage() {}
 
-func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[14]
+func (x *CheckAccessWithPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1465,26 +2093,874 @@ func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessWithPasswordResponse.ProtoReflect.Descriptor instead.
+func (*CheckAccessWithPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CheckAccessWithPasswordResponse) GetStatus() CheckAccessWithPasswordResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CheckAccessWithPasswordResponse_OK
+}
+
+func (x *CheckAccessWithPasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckAccessWithPasswordResponse) GetRetryAfter() uint32 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type CheckAccessWithX509Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// X509 certificate in DER format
+	Certificate []byte `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// Scopes to check
+	Scopes []*Scope `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Information about the request used to evaluate policy conditions
+	Context *AccessContext `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	// Namespace where certificate is expected to be issued. Only checked if `verifyNamespace` is set.
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Reject certificates issued by the intermediate CA of other namespace
+	VerifyNamespace bool `protobuf:"varint,5,opt,name=verifyNamespace,proto3" json:"verifyNamespace,omitempty"`
+}
+
+func (x *CheckAccessWithX509Request) Reset() {
+	*x = CheckAccessWithX509Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAccessWithX509Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessWithX509Request) ProtoMessage() {}
+
+func (x *CheckAccessWithX509Request) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessWithX509Request.ProtoReflect.Descriptor instead.
+func (*CheckAccessWithX509Request) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CheckAccessWithX509Request) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *CheckAccessWithX509Request) GetScopes() []*Scope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CheckAccessWithX509Request) GetContext() *AccessContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *CheckAccessWithX509Request) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CheckAccessWithX509Request) GetVerifyNamespace() bool {
+	if x != nil {
+		return x.VerifyNamespace
+	}
+	return false
+}
+
+type CheckAccessWithX509Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status of the check
+	Status CheckAccessWithX509Response_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.CheckAccessWithX509Response_Status" json:"status,omitempty"`
+	// Details of the status, that can be safelly returned and displayed to the requester
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Certificate information. Only available if status is one of the {OK; CERTIFICATE_DISABLED; IDENTITY_NOT_FOUND; IDENTITY_NOT_ACTIVE; UNAUTHORIZED}
+	CertificateInfo *CheckAccessWithX509Response_CertificateInfo `protobuf:"bytes,3,opt,name=certificateInfo,proto3" json:"certificateInfo,omitempty"`
+}
+
+func (x *CheckAccessWithX509Response) Reset() {
+	*x = CheckAccessWithX509Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAccessWithX509Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessWithX509Response) ProtoMessage() {}
+
+func (x *CheckAccessWithX509Response) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessWithX509Response.ProtoReflect.Descriptor instead.
+func (*CheckAccessWithX509Response) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CheckAccessWithX509Response) GetStatus() CheckAccessWithX509Response_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CheckAccessWithX509Response_OK
+}
+
+func (x *CheckAccessWithX509Response) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckAccessWithX509Response) GetCertificateInfo() *CheckAccessWithX509Response_CertificateInfo {
+	if x != nil {
+		return x.CertificateInfo
+	}
+	return nil
+}
+
+type CheckAccessWithAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// API key to verify
+	ApiKey string `protobuf:"bytes,1,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
+	// Scopes for with to validate access
+	Scopes []*Scope `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Information about the request used to evaluate policy conditions
+	Context *AccessContext `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *CheckAccessWithAPIKeyRequest) Reset() {
+	*x = CheckAccessWithAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAccessWithAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessWithAPIKeyRequest) ProtoMessage() {}
+
+func (x *CheckAccessWithAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessWithAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessWithAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CheckAccessWithAPIKeyRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *CheckAccessWithAPIKeyRequest) GetScopes() []*Scope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CheckAccessWithAPIKeyRequest) GetContext() *AccessContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type CheckAccessWithAPIKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status of the verification
+	Status CheckAccessWithAPIKeyResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.CheckAccessWithAPIKeyResponse_Status" json:"status,omitempty"`
+	// Details of the status, that can be safelly returned and displayed to the requester
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Namespace where API key and identity are located
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Unique API key id
+	ApiKeyUUID string `protobuf:"bytes,4,opt,name=apiKeyUUID,proto3" json:"apiKeyUUID,omitempty"`
+	// Unique identity id
+	IdentityUUID string `protobuf:"bytes,5,opt,name=identityUUID,proto3" json:"identityUUID,omitempty"`
+}
+
+func (x *CheckAccessWithAPIKeyResponse) Reset() {
+	*x = CheckAccessWithAPIKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAccessWithAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessWithAPIKeyResponse) ProtoMessage() {}
+
+func (x *CheckAccessWithAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessWithAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CheckAccessWithAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckAccessWithAPIKeyResponse) GetStatus() CheckAccessWithAPIKeyResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CheckAccessWithAPIKeyResponse_OK
+}
+
+func (x *CheckAccessWithAPIKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckAccessWithAPIKeyResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CheckAccessWithAPIKeyResponse) GetApiKeyUUID() string {
+	if x != nil {
+		return x.ApiKeyUUID
+	}
+	return ""
+}
+
+func (x *CheckAccessWithAPIKeyResponse) GetIdentityUUID() string {
+	if x != nil {
+		return x.IdentityUUID
+	}
+	return ""
+}
+
+type CheckAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Namespace where identity is located
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Unique identifier of the identity
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Scopes to check
+	Scopes []*Scope `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Information about the request used to evaluate policy conditions. Authentication method is unknown for this check, so policies with authentication method or MFA conditions will not grant access.
+	Context *AccessContext `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *CheckAccessRequest) Reset() {
+	*x = CheckAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessRequest) ProtoMessage() {}
+
+func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckAccessRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetScopes() []*Scope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CheckAccessRequest) GetContext() *AccessContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type CheckAccessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status of the check
+	Status CheckAccessResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.CheckAccessResponse_Status" json:"status,omitempty"`
+	// Details of the status, that can be safelly returned and displayed to the requester
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CheckAccessResponse) Reset() {
+	*x = CheckAccessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessResponse) ProtoMessage() {}
+
+func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckAccessResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckAccessResponse) GetStatus() CheckAccessResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CheckAccessResponse_OK
+}
+
+func (x *CheckAccessResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExplainAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whose access has to be explained
+	//
+	// Types that are assignable to Subject:
+	//	*ExplainAccessRequest_Identity
+	//	*ExplainAccessRequest_AccessToken
+	Subject isExplainAccessRequest_Subject `protobuf_oneof:"subject"`
+	// Scopes to explain
+	Scopes []*Scope `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Information about the request used to evaluate policy conditions
+	Context *AccessContext `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *ExplainAccessRequest) Reset() {
+	*x = ExplainAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainAccessRequest) ProtoMessage() {}
+
+func (x *ExplainAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainAccessRequest.ProtoReflect.Descriptor instead.
+func (*ExplainAccessRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (m *ExplainAccessRequest) GetSubject() isExplainAccessRequest_Subject {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (x *ExplainAccessRequest) GetIdentity() *ExplainAccessRequest_IdentitySubject {
+	if x, ok := x.GetSubject().(*ExplainAccessRequest_Identity); ok {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *ExplainAccessRequest) GetAccessToken() string {
+	if x, ok := x.GetSubject().(*ExplainAccessRequest_AccessToken); ok {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ExplainAccessRequest) GetScopes() []*Scope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ExplainAccessRequest) GetContext() *AccessContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type isExplainAccessRequest_Subject interface {
+	isExplainAccessRequest_Subject()
+}
+
+type ExplainAccessRequest_Identity struct {
+	// Explain access of the identity (the same way as CheckAccess does)
+	Identity *ExplainAccessRequest_IdentitySubject `protobuf:"bytes,1,opt,name=identity,proto3,oneof"`
+}
+
+type ExplainAccessRequest_AccessToken struct {
+	// Explain access of the token (the same way as CheckAccessWithToken does). Policies of the token identity are also checked to find out if access was lost because of token scope narrowing.
+	AccessToken string `protobuf:"bytes,2,opt,name=accessToken,proto3,oneof"`
+}
+
+func (*ExplainAccessRequest_Identity) isExplainAccessRequest_Subject() {}
+
+func (*ExplainAccessRequest_AccessToken) isExplainAccessRequest_Subject() {}
+
+// Policy that participated in the access decision
+type ExplainedPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Namespace of the policy
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Unique identifier of the policy
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Name of the policy
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Namespace of the role through which policy is assigned to the identity. Empty if policy is assigned directly.
+	RoleNamespace string `protobuf:"bytes,4,opt,name=roleNamespace,proto3" json:"roleNamespace,omitempty"`
+	// Unique identifier of the role through which policy is assigned to the identity. Empty if policy is assigned directly.
+	RoleUUID string `protobuf:"bytes,5,opt,name=roleUUID,proto3" json:"roleUUID,omitempty"`
+	// Namespace of the group through which policy is assigned to the identity. Empty if policy is not assigned through the group.
+	GroupNamespace string `protobuf:"bytes,6,opt,name=groupNamespace,proto3" json:"groupNamespace,omitempty"`
+	// Unique identifier of the group through which policy is assigned to the identity. Empty if policy is not assigned through the group.
+	GroupUUID string `protobuf:"bytes,7,opt,name=groupUUID,proto3" json:"groupUUID,omitempty"`
+}
+
+func (x *ExplainedPolicy) Reset() {
+	*x = ExplainedPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainedPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainedPolicy) ProtoMessage() {}
+
+func (x *ExplainedPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainedPolicy.ProtoReflect.Descriptor instead.
+func (*ExplainedPolicy) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExplainedPolicy) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExplainedPolicy) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ExplainedPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExplainedPolicy) GetRoleNamespace() string {
+	if x != nil {
+		return x.RoleNamespace
+	}
+	return ""
+}
+
+func (x *ExplainedPolicy) GetRoleUUID() string {
+	if x != nil {
+		return x.RoleUUID
+	}
+	return ""
+}
+
+func (x *ExplainedPolicy) GetGroupNamespace() string {
+	if x != nil {
+		return x.GroupNamespace
+	}
+	return ""
+}
+
+func (x *ExplainedPolicy) GetGroupUUID() string {
+	if x != nil {
+		return x.GroupUUID
+	}
+	return ""
+}
+
+type ScopeExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Explained scope
+	Scope *Scope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	// True if access to the scope is granted
+	Granted bool `protobuf:"varint,2,opt,name=granted,proto3" json:"granted,omitempty"`
+	// Reason of the decision
+	Decision ScopeExplanation_Decision `protobuf:"varint,3,opt,name=decision,proto3,enum=native_iam_auth.ScopeExplanation_Decision" json:"decision,omitempty"`
+	// Policies that allow access to the scope
+	MatchedPolicies []*ExplainedPolicy `protobuf:"bytes,4,rep,name=matchedPolicies,proto3" json:"matchedPolicies,omitempty"`
+	// DENY policies that match the scope
+	DenyingPolicies []*ExplainedPolicy `protobuf:"bytes,5,rep,name=denyingPolicies,proto3" json:"denyingPolicies,omitempty"`
+	// Policies that cover the scope, but their conditions are not satisfied
+	UnsatisfiedConditionPolicies []*ExplainedPolicy `protobuf:"bytes,6,rep,name=unsatisfiedConditionPolicies,proto3" json:"unsatisfiedConditionPolicies,omitempty"`
+	// Resources of the scope that are not covered by any applicable policy
+	UnsatisfiedResources []string `protobuf:"bytes,7,rep,name=unsatisfiedResources,proto3" json:"unsatisfiedResources,omitempty"`
+	// Actions of the scope that are not covered by any applicable policy
+	UnsatisfiedActions []string `protobuf:"bytes,8,rep,name=unsatisfiedActions,proto3" json:"unsatisfiedActions,omitempty"`
+}
+
+func (x *ScopeExplanation) Reset() {
+	*x = ScopeExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScopeExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScopeExplanation) ProtoMessage() {}
+
+func (x *ScopeExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScopeExplanation.ProtoReflect.Descriptor instead.
+func (*ScopeExplanation) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ScopeExplanation) GetScope() *Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *ScopeExplanation) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+func (x *ScopeExplanation) GetDecision() ScopeExplanation_Decision {
+	if x != nil {
+		return x.Decision
+	}
+	return ScopeExplanation_GRANTED
+}
+
+func (x *ScopeExplanation) GetMatchedPolicies() []*ExplainedPolicy {
+	if x != nil {
+		return x.MatchedPolicies
+	}
+	return nil
+}
+
+func (x *ScopeExplanation) GetDenyingPolicies() []*ExplainedPolicy {
+	if x != nil {
+		return x.DenyingPolicies
+	}
+	return nil
+}
+
+func (x *ScopeExplanation) GetUnsatisfiedConditionPolicies() []*ExplainedPolicy {
+	if x != nil {
+		return x.UnsatisfiedConditionPolicies
+	}
+	return nil
+}
+
+func (x *ScopeExplanation) GetUnsatisfiedResources() []string {
+	if x != nil {
+		return x.UnsatisfiedResources
+	}
+	return nil
+}
+
+func (x *ScopeExplanation) GetUnsatisfiedActions() []string {
+	if x != nil {
+		return x.UnsatisfiedActions
+	}
+	return nil
+}
+
+type ExplainAccessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status of the explanation
+	Status ExplainAccessResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=native_iam_auth.ExplainAccessResponse_Status" json:"status,omitempty"`
+	// Details of the status, that can be safelly returned and displayed to the requester
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Namespace of the identity
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Unique identifier of the identity
+	Identity string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	// True if access to all the scopes is granted
+	Granted bool `protobuf:"varint,5,opt,name=granted,proto3" json:"granted,omitempty"`
+	// Explanation for every requested scope in the same order
+	Scopes []*ScopeExplanation `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+}
+
+func (x *ExplainAccessResponse) Reset() {
+	*x = ExplainAccessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainAccessResponse) ProtoMessage() {}
+
+func (x *ExplainAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainAccessResponse.ProtoReflect.Descriptor instead.
+func (*ExplainAccessResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExplainAccessResponse) GetStatus() ExplainAccessResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ExplainAccessResponse_OK
+}
+
+func (x *ExplainAccessResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExplainAccessResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
 }
 
-// Deprecated: Use CheckAccessResponse.ProtoReflect.Descriptor instead.
-func (*CheckAccessResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{14}
+func (x *ExplainAccessResponse) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
 }
 
-func (x *CheckAccessResponse) GetStatus() CheckAccessResponse_Status {
+func (x *ExplainAccessResponse) GetGranted() bool {
 	if x != nil {
-		return x.Status
+		return x.Granted
 	}
-	return CheckAccessResponse_OK
+	return false
 }
 
-func (x *CheckAccessResponse) GetMessage() string {
+func (x *ExplainAccessResponse) GetScopes() []*ScopeExplanation {
 	if x != nil {
-		return x.Message
+		return x.Scopes
 	}
-	return ""
+	return nil
 }
 
 // Detailed information about certificate
@@ -1504,7 +2980,7 @@ type CheckAccessWithX509Response_CertificateInfo struct {
 func (x *CheckAccessWithX509Response_CertificateInfo) Reset() {
 	*x = CheckAccessWithX509Response_CertificateInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[15]
+		mi := &file_auth_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1517,7 +2993,7 @@ func (x *CheckAccessWithX509Response_CertificateInfo) String() string {
 func (*CheckAccessWithX509Response_CertificateInfo) ProtoMessage() {}
 
 func (x *CheckAccessWithX509Response_CertificateInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[15]
+	mi := &file_auth_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1530,7 +3006,7 @@ func (x *CheckAccessWithX509Response_CertificateInfo) ProtoReflect() protoreflec
 
 // Deprecated: Use CheckAccessWithX509Response_CertificateInfo.ProtoReflect.Descriptor instead.
 func (*CheckAccessWithX509Response_CertificateInfo) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{12, 0}
+	return file_auth_proto_rawDescGZIP(), []int{17, 0}
 }
 
 func (x *CheckAccessWithX509Response_CertificateInfo) GetNamespace() string {
@@ -1554,6 +3030,63 @@ func (x *CheckAccessWithX509Response_CertificateInfo) GetIdentity() string {
 	return ""
 }
 
+type ExplainAccessRequest_IdentitySubject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Namespace where identity is located
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Unique identifier of the identity
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *ExplainAccessRequest_IdentitySubject) Reset() {
+	*x = ExplainAccessRequest_IdentitySubject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainAccessRequest_IdentitySubject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainAccessRequest_IdentitySubject) ProtoMessage() {}
+
+func (x *ExplainAccessRequest_IdentitySubject) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainAccessRequest_IdentitySubject.ProtoReflect.Descriptor instead.
+func (*ExplainAccessRequest_IdentitySubject) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *ExplainAccessRequest_IdentitySubject) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExplainAccessRequest_IdentitySubject) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
 var File_auth_proto protoreflect.FileDescriptor
 
 var file_auth_proto_rawDesc = []byte{
@@ -1568,197 +3101,365 @@ var file_auth_proto_rawDesc = []byte{
 	0x14, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x70, 0x65,
 	0x6e, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6e, 0x61, 0x6d,
 	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x74, 0x22, 0xc2, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x22, 0x49, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x50, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x50, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x22, 0x90, 0x02, 0x0a,
+	0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x6f, 0x74, 0x70, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0xbc, 0x03, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x16, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x22, 0xa9, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54,
+	0x49, 0x41, 0x4c, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54,
+	0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x54,
+	0x50, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c,
+	0x54, 0x4f, 0x54, 0x50, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x05, 0x12, 0x15,
+	0x0a, 0x11, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d,
+	0x50, 0x54, 0x53, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41,
+	0x43, 0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x07, 0x22, 0xfe,
+	0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x4c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x2e, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22,
+	0xbb, 0x02, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x35, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x22, 0x69, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e,
+	0x54, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x45,
+	0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43,
+	0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x22, 0xc5, 0x01,
+	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x53, 0x53, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x61, 0x6d, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x61, 0x6d,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69,
+	0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xcf, 0x02, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61,
+	0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x69, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13,
+	0x0a, 0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17,
+	0x0a, 0x13, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x55, 0x53, 0x50,
+	0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x22, 0xcc, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x49, 0x44, 0x43, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
 	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
 	0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x8e, 0x02, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x6e, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a,
-	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x54, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
-	0x4b, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41,
-	0x4c, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
-	0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54,
-	0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f,
-	0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x03, 0x22, 0xfe, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
-	0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
-	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76,
-	0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x1d, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x41, 0x75, 0x74,
-	0x68, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6e, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x41, 0x75, 0x74,
-	0x68, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x50, 0x0a, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a,
-	0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x55,
-	0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x03, 0x22, 0x39, 0x0a,
-	0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xcf, 0x02, 0x0a, 0x14, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xce, 0x01, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
-	0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12,
-	0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
-	0x4e, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x44, 0x49,
-	0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45,
-	0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x54,
-	0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x49, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x46, 0x52,
-	0x45, 0x53, 0x48, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x49,
-	0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x07, 0x12, 0x1c, 0x0a, 0x18,
-	0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45,
-	0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x08, 0x22, 0x6f, 0x0a, 0x1b, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x73,
-	0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x63,
-	0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xd9, 0x02, 0x0a, 0x1c,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xd1, 0x02, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x49, 0x44, 0x43, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x49, 0x44, 0x43, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22,
+	0x69, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49,
+	0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12,
+	0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53,
+	0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x22, 0x73, 0x0a, 0x13, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0x9e, 0x03, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf9, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f,
+	0x4b, 0x45, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f,
+	0x49, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x54,
+	0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49,
+	0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x17,
+	0x0a, 0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x07, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x44, 0x45, 0x4e, 0x54,
+	0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x52,
+	0x45, 0x55, 0x53, 0x45, 0x44, 0x10, 0x09, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x41, 0x4d, 0x45, 0x53,
+	0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x0a,
+	0x22, 0xa9, 0x01, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xf3, 0x02, 0x0a,
+	0x1c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e,
 	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6e,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x55, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x55, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x55, 0x49,
+	0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x55, 0x55, 0x49, 0x44, 0x22, 0x8a, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45,
+	0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x54,
+	0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02,
+	0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54,
+	0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x41, 0x4d,
+	0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44,
+	0x10, 0x06, 0x22, 0x90, 0x02, 0x0a, 0x1e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52,
+	0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x6f, 0x74, 0x70, 0x22, 0xbb, 0x02, 0x0a, 0x1f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x6e, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
 	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x55, 0x49, 0x44,
-	0x12, 0x22, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x55, 0x49, 0x44,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x55, 0x55, 0x49, 0x44, 0x22, 0x71, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
-	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f,
-	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x4b,
-	0x45, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x12,
-	0x0a, 0x0e, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44,
-	0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49,
-	0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f,
-	0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x05, 0x22, 0xc2, 0x01, 0x0a, 0x1e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x06,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53,
-	0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xc5, 0x01, 0x0a,
-	0x1f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x37, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69,
-	0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x37, 0x0a, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a,
-	0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
-	0x45, 0x44, 0x10, 0x05, 0x22, 0x6e, 0x0a, 0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x22, 0x8c, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54,
+	0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x05, 0x12, 0x15,
+	0x0a, 0x11, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4d,
+	0x50, 0x54, 0x53, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41,
+	0x43, 0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x07, 0x12, 0x11,
+	0x0a, 0x0d, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x08, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x10, 0x09, 0x22, 0xf0, 0x01, 0x0a, 0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x58, 0x35, 0x30, 0x39, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
 	0x63, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61,
 	0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63,
-	0x6f, 0x70, 0x65, 0x73, 0x22, 0x91, 0x04, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69,
+	0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xe7, 0x04, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x58, 0x35, 0x30, 0x39, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63,
 	0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x58, 0x35, 0x30, 0x39, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61,
-	0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x58, 0x35, 0x30, 0x39, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x66, 0x0a, 0x0f, 0x63,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61,
-	0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x58, 0x35, 0x30, 0x39, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x1a, 0x5f, 0x0a, 0x0f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x22, 0xc1, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x45, 0x52, 0x54, 0x49,
-	0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x46,
-	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x45, 0x52, 0x54, 0x49,
-	0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x02,
-	0x12, 0x19, 0x0a, 0x15, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x43,
-	0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42,
-	0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54,
-	0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a,
-	0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43,
-	0x54, 0x49, 0x56, 0x45, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48,
-	0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x07, 0x22, 0x7e, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76,
-	0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x65,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x66, 0x0a,
+	0x0f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x58, 0x35, 0x30, 0x39, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x5f, 0x0a, 0x0f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x97, 0x02, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x45, 0x52,
+	0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x45, 0x52,
+	0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x18, 0x0a,
+	0x14, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53,
+	0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x44, 0x45, 0x4e, 0x54,
+	0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12,
+	0x17, 0x0a, 0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55,
+	0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x45,
+	0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x08, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41,
+	0x54, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x4d, 0x49, 0x53,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x09, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x41, 0x4d, 0x45, 0x53,
+	0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x0a,
+	0x22, 0xa0, 0x01, 0x0a, 0x1c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x22, 0x87, 0x03, 0x0a, 0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69,
+	0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x55, 0x55, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x55, 0x55, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x55, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x55, 0x49, 0x44,
+	0x22, 0x9a, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x45, 0x59, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4b, 0x45, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x45, 0x59, 0x5f, 0x52,
+	0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x45, 0x59, 0x5f,
+	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x45,
+	0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
+	0x45, 0x44, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43,
+	0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x07, 0x22, 0xb8, 0x01,
+	0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e,
+	0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x38,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe2, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x65,
 	0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x2b, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75,
@@ -1766,65 +3467,197 @@ var file_auth_proto_rawDesc = []byte{
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x53, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x6c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
 	0x00, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f,
 	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x45,
 	0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
 	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
-	0x45, 0x44, 0x10, 0x03, 0x32, 0xa2, 0x06, 0x0a, 0x0e, 0x49, 0x41, 0x4d, 0x41, 0x75, 0x74, 0x68,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7c, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x2f, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43,
+	0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x22, 0xc9, 0x02,
+	0x0a, 0x14, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x48,
+	0x00, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2e, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12,
+	0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x1a, 0x43, 0x0a, 0x0f, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x42, 0x09,
+	0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x0f, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c,
+	0x65, 0x55, 0x55, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c,
+	0x65, 0x55, 0x55, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x55, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x55, 0x49, 0x44, 0x22, 0x83, 0x05, 0x0a, 0x10,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2c, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x4a, 0x0a, 0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0f,
+	0x64, 0x65, 0x6e, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69,
+	0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65,
+	0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x64, 0x65, 0x6e, 0x79, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x1c, 0x75, 0x6e, 0x73, 0x61,
+	0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x1c, 0x75, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x32,
+	0x0a, 0x14, 0x75, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x75, 0x6e,
+	0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x75, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65,
+	0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12,
+	0x75, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x7d, 0x0a, 0x08, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0b,
+	0x0a, 0x07, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4e,
+	0x4f, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x5f, 0x42, 0x59,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e,
+	0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x41, 0x54, 0x49,
+	0x53, 0x46, 0x49, 0x45, 0x44, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4e, 0x41, 0x52, 0x52, 0x4f, 0x57, 0x45, 0x44, 0x10,
+	0x04, 0x22, 0xb3, 0x03, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64,
+	0x12, 0x39, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49,
+	0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12,
+	0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x54,
+	0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x17,
+	0x0a, 0x13, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x55, 0x53, 0x50,
+	0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x07, 0x32, 0xdb, 0x09, 0x0a, 0x0e, 0x49, 0x41, 0x4d, 0x41,
+	0x75, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7c, 0x0a, 0x17, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2f, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69,
+	0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x41, 0x75, 0x74, 0x68,
+	0x32, 0x12, 0x2d, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x53, 0x53, 0x4f, 0x12, 0x2a, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f,
 	0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d,
+	0x57, 0x69, 0x74, 0x68, 0x53, 0x53, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x70, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x4f, 0x49, 0x44, 0x43, 0x12, 0x2b, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x49, 0x44, 0x43, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d,
 	0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x12, 0x2d,
-	0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68,
-	0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
-	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f,
-	0x41, 0x75, 0x74, 0x68, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a,
-	0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x2e,
-	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x14, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x2c, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f,
-	0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2d, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69,
-	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x7c, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69,
-	0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2f, 0x2e, 0x6e, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a,
-	0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68,
-	0x58, 0x35, 0x30, 0x39, 0x12, 0x2b, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x49, 0x44, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x24, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73,
+	0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61,
 	0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x58, 0x35, 0x30, 0x39, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57,
-	0x69, 0x74, 0x68, 0x58, 0x35, 0x30, 0x39, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x58, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x23,
+	0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2f,
 	0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x23, 0x5a, 0x21, 0x73, 0x6c, 0x61,
-	0x6d, 0x79, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x42, 0x50, 0x2f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x2f, 0x69, 0x61, 0x6d, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x30, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x70, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x58, 0x35, 0x30, 0x39, 0x12, 0x2b, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x58, 0x35, 0x30, 0x39, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69,
+	0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x58, 0x35, 0x30, 0x39, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x2e, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x23, 0x2e, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x25, 0x2e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x61, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x23, 0x5a, 0x21, 0x73, 0x6c, 0x61, 0x6d, 0x79, 0x2f, 0x6f,
+	0x70, 0x65, 0x6e, 0x42, 0x50, 0x2f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x2f, 0x69, 0x61, 0x6d,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1839,67 +3672,115 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_auth_proto_goTypes = []interface{}{
 	(CreateTokenWithPasswordResponse_Status)(0),         // 0: native_iam_auth.CreateTokenWithPasswordResponse.Status
 	(CreateTokenWithOAuth2Response_Status)(0),           // 1: native_iam_auth.CreateTokenWithOAuth2Response.Status
-	(RefreshTokenResponse_Status)(0),                    // 2: native_iam_auth.RefreshTokenResponse.Status
-	(CheckAccessWithTokenResponse_Status)(0),            // 3: native_iam_auth.CheckAccessWithTokenResponse.Status
-	(CheckAccessWithPasswordResponse_Status)(0),         // 4: native_iam_auth.CheckAccessWithPasswordResponse.Status
-	(CheckAccessWithX509Response_Status)(0),             // 5: native_iam_auth.CheckAccessWithX509Response.Status
-	(CheckAccessResponse_Status)(0),                     // 6: native_iam_auth.CheckAccessResponse.Status
-	(*Scope)(nil),                                       // 7: native_iam_auth.Scope
-	(*CreateTokenWithPasswordRequest)(nil),              // 8: native_iam_auth.CreateTokenWithPasswordRequest
-	(*CreateTokenWithPasswordResponse)(nil),             // 9: native_iam_auth.CreateTokenWithPasswordResponse
-	(*CreateTokenWithOAuth2Request)(nil),                // 10: native_iam_auth.CreateTokenWithOAuth2Request
-	(*CreateTokenWithOAuth2Response)(nil),               // 11: native_iam_auth.CreateTokenWithOAuth2Response
-	(*RefreshTokenRequest)(nil),                         // 12: native_iam_auth.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),                        // 13: native_iam_auth.RefreshTokenResponse
-	(*CheckAccessWithTokenRequest)(nil),                 // 14: native_iam_auth.CheckAccessWithTokenRequest
-	(*CheckAccessWithTokenResponse)(nil),                // 15: native_iam_auth.CheckAccessWithTokenResponse
-	(*CheckAccessWithPasswordRequest)(nil),              // 16: native_iam_auth.CheckAccessWithPasswordRequest
-	(*CheckAccessWithPasswordResponse)(nil),             // 17: native_iam_auth.CheckAccessWithPasswordResponse
-	(*CheckAccessWithX509Request)(nil),                  // 18: native_iam_auth.CheckAccessWithX509Request
-	(*CheckAccessWithX509Response)(nil),                 // 19: native_iam_auth.CheckAccessWithX509Response
-	(*CheckAccessRequest)(nil),                          // 20: native_iam_auth.CheckAccessRequest
-	(*CheckAccessResponse)(nil),                         // 21: native_iam_auth.CheckAccessResponse
-	(*CheckAccessWithX509Response_CertificateInfo)(nil), // 22: native_iam_auth.CheckAccessWithX509Response.CertificateInfo
+	(CreateTokenWithSSOResponse_Status)(0),              // 2: native_iam_auth.CreateTokenWithSSOResponse.Status
+	(CreateTokenWithOIDCResponse_Status)(0),             // 3: native_iam_auth.CreateTokenWithOIDCResponse.Status
+	(RefreshTokenResponse_Status)(0),                    // 4: native_iam_auth.RefreshTokenResponse.Status
+	(CheckAccessWithTokenResponse_Status)(0),            // 5: native_iam_auth.CheckAccessWithTokenResponse.Status
+	(CheckAccessWithPasswordResponse_Status)(0),         // 6: native_iam_auth.CheckAccessWithPasswordResponse.Status
+	(CheckAccessWithX509Response_Status)(0),             // 7: native_iam_auth.CheckAccessWithX509Response.Status
+	(CheckAccessWithAPIKeyResponse_Status)(0),           // 8: native_iam_auth.CheckAccessWithAPIKeyResponse.Status
+	(CheckAccessResponse_Status)(0),                     // 9: native_iam_auth.CheckAccessResponse.Status
+	(ScopeExplanation_Decision)(0),                      // 10: native_iam_auth.ScopeExplanation.Decision
+	(ExplainAccessResponse_Status)(0),                   // 11: native_iam_auth.ExplainAccessResponse.Status
+	(*Scope)(nil),                                       // 12: native_iam_auth.Scope
+	(*AccessContext)(nil),                               // 13: native_iam_auth.AccessContext
+	(*CreateTokenWithPasswordRequest)(nil),              // 14: native_iam_auth.CreateTokenWithPasswordRequest
+	(*CreateTokenWithPasswordResponse)(nil),             // 15: native_iam_auth.CreateTokenWithPasswordResponse
+	(*CreateTokenWithOAuth2Request)(nil),                // 16: native_iam_auth.CreateTokenWithOAuth2Request
+	(*CreateTokenWithOAuth2Response)(nil),               // 17: native_iam_auth.CreateTokenWithOAuth2Response
+	(*CreateTokenWithSSORequest)(nil),                   // 18: native_iam_auth.CreateTokenWithSSORequest
+	(*CreateTokenWithSSOResponse)(nil),                  // 19: native_iam_auth.CreateTokenWithSSOResponse
+	(*CreateTokenWithOIDCRequest)(nil),                  // 20: native_iam_auth.CreateTokenWithOIDCRequest
+	(*CreateTokenWithOIDCResponse)(nil),                 // 21: native_iam_auth.CreateTokenWithOIDCResponse
+	(*RefreshTokenRequest)(nil),                         // 22: native_iam_auth.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),                        // 23: native_iam_auth.RefreshTokenResponse
+	(*CheckAccessWithTokenRequest)(nil),                 // 24: native_iam_auth.CheckAccessWithTokenRequest
+	(*CheckAccessWithTokenResponse)(nil),                // 25: native_iam_auth.CheckAccessWithTokenResponse
+	(*CheckAccessWithPasswordRequest)(nil),              // 26: native_iam_auth.CheckAccessWithPasswordRequest
+	(*CheckAccessWithPasswordResponse)(nil),             // 27: native_iam_auth.CheckAccessWithPasswordResponse
+	(*CheckAccessWithX509Request)(nil),                  // 28: native_iam_auth.CheckAccessWithX509Request
+	(*CheckAccessWithX509Response)(nil),                 // 29: native_iam_auth.CheckAccessWithX509Response
+	(*CheckAccessWithAPIKeyRequest)(nil),                // 30: native_iam_auth.CheckAccessWithAPIKeyRequest
+	(*CheckAccessWithAPIKeyResponse)(nil),               // 31: native_iam_auth.CheckAccessWithAPIKeyResponse
+	(*CheckAccessRequest)(nil),                          // 32: native_iam_auth.CheckAccessRequest
+	(*CheckAccessResponse)(nil),                         // 33: native_iam_auth.CheckAccessResponse
+	(*ExplainAccessRequest)(nil),                        // 34: native_iam_auth.ExplainAccessRequest
+	(*ExplainedPolicy)(nil),                             // 35: native_iam_auth.ExplainedPolicy
+	(*ScopeExplanation)(nil),                            // 36: native_iam_auth.ScopeExplanation
+	(*ExplainAccessResponse)(nil),                       // 37: native_iam_auth.ExplainAccessResponse
+	(*CheckAccessWithX509Response_CertificateInfo)(nil), // 38: native_iam_auth.CheckAccessWithX509Response.CertificateInfo
+	(*ExplainAccessRequest_IdentitySubject)(nil),        // 39: native_iam_auth.ExplainAccessRequest.IdentitySubject
 }
 var file_auth_proto_depIdxs = []int32{
-	7,  // 0: native_iam_auth.CreateTokenWithPasswordRequest.scopes:type_name -> native_iam_auth.Scope
-	0,  // 1: native_iam_auth.CreateTokenWithPasswordResponse.status:type_name -> native_iam_auth.CreateTokenWithPasswordResponse.Status
-	7,  // 2: native_iam_auth.CreateTokenWithOAuth2Request.scopes:type_name -> native_iam_auth.Scope
-	1,  // 3: native_iam_auth.CreateTokenWithOAuth2Response.status:type_name -> native_iam_auth.CreateTokenWithOAuth2Response.Status
-	2,  // 4: native_iam_auth.RefreshTokenResponse.status:type_name -> native_iam_auth.RefreshTokenResponse.Status
-	7,  // 5: native_iam_auth.CheckAccessWithTokenRequest.scopes:type_name -> native_iam_auth.Scope
-	3,  // 6: native_iam_auth.CheckAccessWithTokenResponse.status:type_name -> native_iam_auth.CheckAccessWithTokenResponse.Status
-	7,  // 7: native_iam_auth.CheckAccessWithPasswordRequest.scopes:type_name -> native_iam_auth.Scope
-	4,  // 8: native_iam_auth.CheckAccessWithPasswordResponse.status:type_name -> native_iam_auth.CheckAccessWithPasswordResponse.Status
-	7,  // 9: native_iam_auth.CheckAccessWithX509Request.scopes:type_name -> native_iam_auth.Scope
-	5,  // 10: native_iam_auth.CheckAccessWithX509Response.status:type_name -> native_iam_auth.CheckAccessWithX509Response.Status
-	22, // 11: native_iam_auth.CheckAccessWithX509Response.certificateInfo:type_name -> native_iam_auth.CheckAccessWithX509Response.CertificateInfo
-	7,  // 12: native_iam_auth.CheckAccessRequest.scopes:type_name -> native_iam_auth.Scope
-	6,  // 13: native_iam_auth.CheckAccessResponse.status:type_name -> native_iam_auth.CheckAccessResponse.Status
-	8,  // 14: native_iam_auth.IAMAuthService.CreateTokenWithPassword:input_type -> native_iam_auth.CreateTokenWithPasswordRequest
-	10, // 15: native_iam_auth.IAMAuthService.CreateTokenWithOAuth2:input_type -> native_iam_auth.CreateTokenWithOAuth2Request
-	12, // 16: native_iam_auth.IAMAuthService.RefreshToken:input_type -> native_iam_auth.RefreshTokenRequest
-	14, // 17: native_iam_auth.IAMAuthService.CheckAccessWithToken:input_type -> native_iam_auth.CheckAccessWithTokenRequest
-	16, // 18: native_iam_auth.IAMAuthService.CheckAccessWithPassword:input_type -> native_iam_auth.CheckAccessWithPasswordRequest
-	18, // 19: native_iam_auth.IAMAuthService.CheckAccessWithX509:input_type -> native_iam_auth.CheckAccessWithX509Request
-	20, // 20: native_iam_auth.IAMAuthService.CheckAccess:input_type -> native_iam_auth.CheckAccessRequest
-	9,  // 21: native_iam_auth.IAMAuthService.CreateTokenWithPassword:output_type -> native_iam_auth.CreateTokenWithPasswordResponse
-	11, // 22: native_iam_auth.IAMAuthService.CreateTokenWithOAuth2:output_type -> native_iam_auth.CreateTokenWithOAuth2Response
-	13, // 23: native_iam_auth.IAMAuthService.RefreshToken:output_type -> native_iam_auth.RefreshTokenResponse
-	15, // 24: native_iam_auth.IAMAuthService.CheckAccessWithToken:output_type -> native_iam_auth.CheckAccessWithTokenResponse
-	17, // 25: native_iam_auth.IAMAuthService.CheckAccessWithPassword:output_type -> native_iam_auth.CheckAccessWithPasswordResponse
-	19, // 26: native_iam_auth.IAMAuthService.CheckAccessWithX509:output_type -> native_iam_auth.CheckAccessWithX509Response
-	21, // 27: native_iam_auth.IAMAuthService.CheckAccess:output_type -> native_iam_auth.CheckAccessResponse
-	21, // [21:28] is the sub-list for method output_type
-	14, // [14:21] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	12, // 0: native_iam_auth.CreateTokenWithPasswordRequest.scopes:type_name -> native_iam_auth.Scope
+	13, // 1: native_iam_auth.CreateTokenWithPasswordRequest.context:type_name -> native_iam_auth.AccessContext
+	0,  // 2: native_iam_auth.CreateTokenWithPasswordResponse.status:type_name -> native_iam_auth.CreateTokenWithPasswordResponse.Status
+	12, // 3: native_iam_auth.CreateTokenWithOAuth2Request.scopes:type_name -> native_iam_auth.Scope
+	1,  // 4: native_iam_auth.CreateTokenWithOAuth2Response.status:type_name -> native_iam_auth.CreateTokenWithOAuth2Response.Status
+	12, // 5: native_iam_auth.CreateTokenWithSSORequest.scopes:type_name -> native_iam_auth.Scope
+	2,  // 6: native_iam_auth.CreateTokenWithSSOResponse.status:type_name -> native_iam_auth.CreateTokenWithSSOResponse.Status
+	12, // 7: native_iam_auth.CreateTokenWithOIDCRequest.scopes:type_name -> native_iam_auth.Scope
+	3,  // 8: native_iam_auth.CreateTokenWithOIDCResponse.status:type_name -> native_iam_auth.CreateTokenWithOIDCResponse.Status
+	13, // 9: native_iam_auth.RefreshTokenRequest.context:type_name -> native_iam_auth.AccessContext
+	4,  // 10: native_iam_auth.RefreshTokenResponse.status:type_name -> native_iam_auth.RefreshTokenResponse.Status
+	12, // 11: native_iam_auth.CheckAccessWithTokenRequest.scopes:type_name -> native_iam_auth.Scope
+	13, // 12: native_iam_auth.CheckAccessWithTokenRequest.context:type_name -> native_iam_auth.AccessContext
+	5,  // 13: native_iam_auth.CheckAccessWithTokenResponse.status:type_name -> native_iam_auth.CheckAccessWithTokenResponse.Status
+	12, // 14: native_iam_auth.CheckAccessWithPasswordRequest.scopes:type_name -> native_iam_auth.Scope
+	13, // 15: native_iam_auth.CheckAccessWithPasswordRequest.context:type_name -> native_iam_auth.AccessContext
+	6,  // 16: native_iam_auth.CheckAccessWithPasswordResponse.status:type_name -> native_iam_auth.CheckAccessWithPasswordResponse.Status
+	12, // 17: native_iam_auth.CheckAccessWithX509Request.scopes:type_name -> native_iam_auth.Scope
+	13, // 18: native_iam_auth.CheckAccessWithX509Request.context:type_name -> native_iam_auth.AccessContext
+	7,  // 19: native_iam_auth.CheckAccessWithX509Response.status:type_name -> native_iam_auth.CheckAccessWithX509Response.Status
+	38, // 20: native_iam_auth.CheckAccessWithX509Response.certificateInfo:type_name -> native_iam_auth.CheckAccessWithX509Response.CertificateInfo
+	12, // 21: native_iam_auth.CheckAccessWithAPIKeyRequest.scopes:type_name -> native_iam_auth.Scope
+	13, // 22: native_iam_auth.CheckAccessWithAPIKeyRequest.context:type_name -> native_iam_auth.AccessContext
+	8,  // 23: native_iam_auth.CheckAccessWithAPIKeyResponse.status:type_name -> native_iam_auth.CheckAccessWithAPIKeyResponse.Status
+	12, // 24: native_iam_auth.CheckAccessRequest.scopes:type_name -> native_iam_auth.Scope
+	13, // 25: native_iam_auth.CheckAccessRequest.context:type_name -> native_iam_auth.AccessContext
+	9,  // 26: native_iam_auth.CheckAccessResponse.status:type_name -> native_iam_auth.CheckAccessResponse.Status
+	39, // 27: native_iam_auth.ExplainAccessRequest.identity:type_name -> native_iam_auth.ExplainAccessRequest.IdentitySubject
+	12, // 28: native_iam_auth.ExplainAccessRequest.scopes:type_name -> native_iam_auth.Scope
+	13, // 29: native_iam_auth.ExplainAccessRequest.context:type_name -> native_iam_auth.AccessContext
+	12, // 30: native_iam_auth.ScopeExplanation.scope:type_name -> native_iam_auth.Scope
+	10, // 31: native_iam_auth.ScopeExplanation.decision:type_name -> native_iam_auth.ScopeExplanation.Decision
+	35, // 32: native_iam_auth.ScopeExplanation.matchedPolicies:type_name -> native_iam_auth.ExplainedPolicy
+	35, // 33: native_iam_auth.ScopeExplanation.denyingPolicies:type_name -> native_iam_auth.ExplainedPolicy
+	35, // 34: native_iam_auth.ScopeExplanation.unsatisfiedConditionPolicies:type_name -> native_iam_auth.ExplainedPolicy
+	11, // 35: native_iam_auth.ExplainAccessResponse.status:type_name -> native_iam_auth.ExplainAccessResponse.Status
+	36, // 36: native_iam_auth.ExplainAccessResponse.scopes:type_name -> native_iam_auth.ScopeExplanation
+	14, // 37: native_iam_auth.IAMAuthService.CreateTokenWithPassword:input_type -> native_iam_auth.CreateTokenWithPasswordRequest
+	16, // 38: native_iam_auth.IAMAuthService.CreateTokenWithOAuth2:input_type -> native_iam_auth.CreateTokenWithOAuth2Request
+	18, // 39: native_iam_auth.IAMAuthService.CreateTokenWithSSO:input_type -> native_iam_auth.CreateTokenWithSSORequest
+	20, // 40: native_iam_auth.IAMAuthService.CreateTokenWithOIDC:input_type -> native_iam_auth.CreateTokenWithOIDCRequest
+	22, // 41: native_iam_auth.IAMAuthService.RefreshToken:input_type -> native_iam_auth.RefreshTokenRequest
+	24, // 42: native_iam_auth.IAMAuthService.CheckAccessWithToken:input_type -> native_iam_auth.CheckAccessWithTokenRequest
+	26, // 43: native_iam_auth.IAMAuthService.CheckAccessWithPassword:input_type -> native_iam_auth.CheckAccessWithPasswordRequest
+	28, // 44: native_iam_auth.IAMAuthService.CheckAccessWithX509:input_type -> native_iam_auth.CheckAccessWithX509Request
+	30, // 45: native_iam_auth.IAMAuthService.CheckAccessWithAPIKey:input_type -> native_iam_auth.CheckAccessWithAPIKeyRequest
+	32, // 46: native_iam_auth.IAMAuthService.CheckAccess:input_type -> native_iam_auth.CheckAccessRequest
+	34, // 47: native_iam_auth.IAMAuthService.ExplainAccess:input_type -> native_iam_auth.ExplainAccessRequest
+	15, // 48: native_iam_auth.IAMAuthService.CreateTokenWithPassword:output_type -> native_iam_auth.CreateTokenWithPasswordResponse
+	17, // 49: native_iam_auth.IAMAuthService.CreateTokenWithOAuth2:output_type -> native_iam_auth.CreateTokenWithOAuth2Response
+	19, // 50: native_iam_auth.IAMAuthService.CreateTokenWithSSO:output_type -> native_iam_auth.CreateTokenWithSSOResponse
+	21, // 51: native_iam_auth.IAMAuthService.CreateTokenWithOIDC:output_type -> native_iam_auth.CreateTokenWithOIDCResponse
+	23, // 52: native_iam_auth.IAMAuthService.RefreshToken:output_type -> native_iam_auth.RefreshTokenResponse
+	25, // 53: native_iam_auth.IAMAuthService.CheckAccessWithToken:output_type -> native_iam_auth.CheckAccessWithTokenResponse
+	27, // 54: native_iam_auth.IAMAuthService.CheckAccessWithPassword:output_type -> native_iam_auth.CheckAccessWithPasswordResponse
+	29, // 55: native_iam_auth.IAMAuthService.CheckAccessWithX509:output_type -> native_iam_auth.CheckAccessWithX509Response
+	31, // 56: native_iam_auth.IAMAuthService.CheckAccessWithAPIKey:output_type -> native_iam_auth.CheckAccessWithAPIKeyResponse
+	33, // 57: native_iam_auth.IAMAuthService.CheckAccess:output_type -> native_iam_auth.CheckAccessResponse
+	37, // 58: native_iam_auth.IAMAuthService.ExplainAccess:output_type -> native_iam_auth.ExplainAccessResponse
+	48, // [48:59] is the sub-list for method output_type
+	37, // [37:48] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -1921,7 +3802,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTokenWithPasswordRequest); i {
+			switch v := v.(*AccessContext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1933,7 +3814,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTokenWithPasswordResponse); i {
+			switch v := v.(*CreateTokenWithPasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1945,7 +3826,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTokenWithOAuth2Request); i {
+			switch v := v.(*CreateTokenWithPasswordResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1957,7 +3838,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTokenWithOAuth2Response); i {
+			switch v := v.(*CreateTokenWithOAuth2Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1969,7 +3850,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshTokenRequest); i {
+			switch v := v.(*CreateTokenWithOAuth2Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1981,7 +3862,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshTokenResponse); i {
+			switch v := v.(*CreateTokenWithSSORequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1993,7 +3874,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAccessWithTokenRequest); i {
+			switch v := v.(*CreateTokenWithSSOResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2005,7 +3886,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAccessWithTokenResponse); i {
+			switch v := v.(*CreateTokenWithOIDCRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2017,7 +3898,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAccessWithPasswordRequest); i {
+			switch v := v.(*CreateTokenWithOIDCResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2029,7 +3910,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAccessWithPasswordResponse); i {
+			switch v := v.(*RefreshTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2041,7 +3922,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAccessWithX509Request); i {
+			switch v := v.(*RefreshTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2053,7 +3934,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAccessWithX509Response); i {
+			switch v := v.(*CheckAccessWithTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2065,7 +3946,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAccessRequest); i {
+			switch v := v.(*CheckAccessWithTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2077,7 +3958,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAccessResponse); i {
+			switch v := v.(*CheckAccessWithPasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2089,6 +3970,138 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAccessWithPasswordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAccessWithX509Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAccessWithX509Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAccessWithAPIKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAccessWithAPIKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAccessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAccessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainAccessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainedPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScopeExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainAccessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckAccessWithX509Response_CertificateInfo); i {
 			case 0:
 				return &v.state
@@ -2100,14 +4113,30 @@ func file_auth_proto_init() {
 				return nil
 			}
 		}
+		file_auth_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainAccessRequest_IdentitySubject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_auth_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*ExplainAccessRequest_Identity)(nil),
+		(*ExplainAccessRequest_AccessToken)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_auth_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   16,
+			NumEnums:      12,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
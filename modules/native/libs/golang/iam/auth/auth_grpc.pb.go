@@ -26,6 +26,10 @@ type IAMAuthServiceClient interface {
 	CreateTokenWithPassword(ctx context.Context, in *CreateTokenWithPasswordRequest, opts ...grpc.CallOption) (*CreateTokenWithPasswordResponse, error)
 	// Create access token and refresh token using thrid party OAuth2 provider
 	CreateTokenWithOAuth2(ctx context.Context, in *CreateTokenWithOAuth2Request, opts ...grpc.CallOption) (*CreateTokenWithOAuth2Response, error)
+	// Create access token and refresh token using SSO (Single Sign On) with SAML 2.0 Identity Provider
+	CreateTokenWithSSO(ctx context.Context, in *CreateTokenWithSSORequest, opts ...grpc.CallOption) (*CreateTokenWithSSOResponse, error)
+	// Create access token and refresh token using OpenID Connect provider configured for the namespace
+	CreateTokenWithOIDC(ctx context.Context, in *CreateTokenWithOIDCRequest, opts ...grpc.CallOption) (*CreateTokenWithOIDCResponse, error)
 	// OAuth. Creates new access token using refresh tokenna
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
 	// rpc VerifyResoureAccess(VerifyResourceAccessRequest) returns (VerifyResourceAccessResponse);
@@ -35,8 +39,12 @@ type IAMAuthServiceClient interface {
 	CheckAccessWithPassword(ctx context.Context, in *CheckAccessWithPasswordRequest, opts ...grpc.CallOption) (*CheckAccessWithPasswordResponse, error)
 	// Authorization with X509 certificates. Check if provided identity identified by proposed certificate is allowed to perform actions from the provided scopes
 	CheckAccessWithX509(ctx context.Context, in *CheckAccessWithX509Request, opts ...grpc.CallOption) (*CheckAccessWithX509Response, error)
+	// Authorization with long-lived API keys. Check if API key is allowed to perform actions from the provided scopes
+	CheckAccessWithAPIKey(ctx context.Context, in *CheckAccessWithAPIKeyRequest, opts ...grpc.CallOption) (*CheckAccessWithAPIKeyResponse, error)
 	// Check if provided identity is allowed to perform actions from the provided scopes
 	CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error)
+	// Explains access decision for the identity or token. Returns for each scope if access is granted and which policies and roles caused the decision.
+	ExplainAccess(ctx context.Context, in *ExplainAccessRequest, opts ...grpc.CallOption) (*ExplainAccessResponse, error)
 }
 
 type iAMAuthServiceClient struct {
@@ -65,6 +73,24 @@ func (c *iAMAuthServiceClient) CreateTokenWithOAuth2(ctx context.Context, in *Cr
 	return out, nil
 }
 
+func (c *iAMAuthServiceClient) CreateTokenWithSSO(ctx context.Context, in *CreateTokenWithSSORequest, opts ...grpc.CallOption) (*CreateTokenWithSSOResponse, error) {
+	out := new(CreateTokenWithSSOResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_auth.IAMAuthService/CreateTokenWithSSO", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMAuthServiceClient) CreateTokenWithOIDC(ctx context.Context, in *CreateTokenWithOIDCRequest, opts ...grpc.CallOption) (*CreateTokenWithOIDCResponse, error) {
+	out := new(CreateTokenWithOIDCResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_auth.IAMAuthService/CreateTokenWithOIDC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMAuthServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
 	out := new(RefreshTokenResponse)
 	err := c.cc.Invoke(ctx, "/native_iam_auth.IAMAuthService/RefreshToken", in, out, opts...)
@@ -101,6 +127,15 @@ func (c *iAMAuthServiceClient) CheckAccessWithX509(ctx context.Context, in *Chec
 	return out, nil
 }
 
+func (c *iAMAuthServiceClient) CheckAccessWithAPIKey(ctx context.Context, in *CheckAccessWithAPIKeyRequest, opts ...grpc.CallOption) (*CheckAccessWithAPIKeyResponse, error) {
+	out := new(CheckAccessWithAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_auth.IAMAuthService/CheckAccessWithAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMAuthServiceClient) CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error) {
 	out := new(CheckAccessResponse)
 	err := c.cc.Invoke(ctx, "/native_iam_auth.IAMAuthService/CheckAccess", in, out, opts...)
@@ -110,6 +145,15 @@ func (c *iAMAuthServiceClient) CheckAccess(ctx context.Context, in *CheckAccessR
 	return out, nil
 }
 
+func (c *iAMAuthServiceClient) ExplainAccess(ctx context.Context, in *ExplainAccessRequest, opts ...grpc.CallOption) (*ExplainAccessResponse, error) {
+	out := new(ExplainAccessResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_auth.IAMAuthService/ExplainAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IAMAuthServiceServer is the server API for IAMAuthService service.
 // All implementations must embed UnimplementedIAMAuthServiceServer
 // for forward compatibility
@@ -118,6 +162,10 @@ type IAMAuthServiceServer interface {
 	CreateTokenWithPassword(context.Context, *CreateTokenWithPasswordRequest) (*CreateTokenWithPasswordResponse, error)
 	// Create access token and refresh token using thrid party OAuth2 provider
 	CreateTokenWithOAuth2(context.Context, *CreateTokenWithOAuth2Request) (*CreateTokenWithOAuth2Response, error)
+	// Create access token and refresh token using SSO (Single Sign On) with SAML 2.0 Identity Provider
+	CreateTokenWithSSO(context.Context, *CreateTokenWithSSORequest) (*CreateTokenWithSSOResponse, error)
+	// Create access token and refresh token using OpenID Connect provider configured for the namespace
+	CreateTokenWithOIDC(context.Context, *CreateTokenWithOIDCRequest) (*CreateTokenWithOIDCResponse, error)
 	// OAuth. Creates new access token using refresh tokenna
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
 	// rpc VerifyResoureAccess(VerifyResourceAccessRequest) returns (VerifyResourceAccessResponse);
@@ -127,8 +175,12 @@ type IAMAuthServiceServer interface {
 	CheckAccessWithPassword(context.Context, *CheckAccessWithPasswordRequest) (*CheckAccessWithPasswordResponse, error)
 	// Authorization with X509 certificates. Check if provided identity identified by proposed certificate is allowed to perform actions from the provided scopes
 	CheckAccessWithX509(context.Context, *CheckAccessWithX509Request) (*CheckAccessWithX509Response, error)
+	// Authorization with long-lived API keys. Check if API key is allowed to perform actions from the provided scopes
+	CheckAccessWithAPIKey(context.Context, *CheckAccessWithAPIKeyRequest) (*CheckAccessWithAPIKeyResponse, error)
 	// Check if provided identity is allowed to perform actions from the provided scopes
 	CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error)
+	// Explains access decision for the identity or token. Returns for each scope if access is granted and which policies and roles caused the decision.
+	ExplainAccess(context.Context, *ExplainAccessRequest) (*ExplainAccessResponse, error)
 	mustEmbedUnimplementedIAMAuthServiceServer()
 }
 
@@ -142,6 +194,12 @@ func (UnimplementedIAMAuthServiceServer) CreateTokenWithPassword(context.Context
 func (UnimplementedIAMAuthServiceServer) CreateTokenWithOAuth2(context.Context, *CreateTokenWithOAuth2Request) (*CreateTokenWithOAuth2Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTokenWithOAuth2 not implemented")
 }
+func (UnimplementedIAMAuthServiceServer) CreateTokenWithSSO(context.Context, *CreateTokenWithSSORequest) (*CreateTokenWithSSOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTokenWithSSO not implemented")
+}
+func (UnimplementedIAMAuthServiceServer) CreateTokenWithOIDC(context.Context, *CreateTokenWithOIDCRequest) (*CreateTokenWithOIDCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTokenWithOIDC not implemented")
+}
 func (UnimplementedIAMAuthServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
 }
@@ -154,9 +212,15 @@ func (UnimplementedIAMAuthServiceServer) CheckAccessWithPassword(context.Context
 func (UnimplementedIAMAuthServiceServer) CheckAccessWithX509(context.Context, *CheckAccessWithX509Request) (*CheckAccessWithX509Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAccessWithX509 not implemented")
 }
+func (UnimplementedIAMAuthServiceServer) CheckAccessWithAPIKey(context.Context, *CheckAccessWithAPIKeyRequest) (*CheckAccessWithAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccessWithAPIKey not implemented")
+}
 func (UnimplementedIAMAuthServiceServer) CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
 }
+func (UnimplementedIAMAuthServiceServer) ExplainAccess(context.Context, *ExplainAccessRequest) (*ExplainAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainAccess not implemented")
+}
 func (UnimplementedIAMAuthServiceServer) mustEmbedUnimplementedIAMAuthServiceServer() {}
 
 // UnsafeIAMAuthServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -206,6 +270,42 @@ func _IAMAuthService_CreateTokenWithOAuth2_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMAuthService_CreateTokenWithSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTokenWithSSORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMAuthServiceServer).CreateTokenWithSSO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_auth.IAMAuthService/CreateTokenWithSSO",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMAuthServiceServer).CreateTokenWithSSO(ctx, req.(*CreateTokenWithSSORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMAuthService_CreateTokenWithOIDC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTokenWithOIDCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMAuthServiceServer).CreateTokenWithOIDC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_auth.IAMAuthService/CreateTokenWithOIDC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMAuthServiceServer).CreateTokenWithOIDC(ctx, req.(*CreateTokenWithOIDCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IAMAuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefreshTokenRequest)
 	if err := dec(in); err != nil {
@@ -278,6 +378,24 @@ func _IAMAuthService_CheckAccessWithX509_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMAuthService_CheckAccessWithAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccessWithAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMAuthServiceServer).CheckAccessWithAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_auth.IAMAuthService/CheckAccessWithAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMAuthServiceServer).CheckAccessWithAPIKey(ctx, req.(*CheckAccessWithAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IAMAuthService_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckAccessRequest)
 	if err := dec(in); err != nil {
@@ -296,6 +414,24 @@ func _IAMAuthService_CheckAccess_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMAuthService_ExplainAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMAuthServiceServer).ExplainAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_auth.IAMAuthService/ExplainAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMAuthServiceServer).ExplainAccess(ctx, req.(*ExplainAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IAMAuthService_ServiceDesc is the grpc.ServiceDesc for IAMAuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -311,6 +447,14 @@ var IAMAuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTokenWithOAuth2",
 			Handler:    _IAMAuthService_CreateTokenWithOAuth2_Handler,
 		},
+		{
+			MethodName: "CreateTokenWithSSO",
+			Handler:    _IAMAuthService_CreateTokenWithSSO_Handler,
+		},
+		{
+			MethodName: "CreateTokenWithOIDC",
+			Handler:    _IAMAuthService_CreateTokenWithOIDC_Handler,
+		},
 		{
 			MethodName: "RefreshToken",
 			Handler:    _IAMAuthService_RefreshToken_Handler,
@@ -327,10 +471,18 @@ var IAMAuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckAccessWithX509",
 			Handler:    _IAMAuthService_CheckAccessWithX509_Handler,
 		},
+		{
+			MethodName: "CheckAccessWithAPIKey",
+			Handler:    _IAMAuthService_CheckAccessWithAPIKey_Handler,
+		},
 		{
 			MethodName: "CheckAccess",
 			Handler:    _IAMAuthService_CheckAccess_Handler,
 		},
+		{
+			MethodName: "ExplainAccess",
+			Handler:    _IAMAuthService_ExplainAccess_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
@@ -25,11 +25,16 @@ type IAMAuthenticationPasswordServiceClient interface {
 	// Tries to find identity and compare its password.
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
 	// Creates or updates identity password for authentification.
+	// Password is validated against password policy from the IAM configuration. Returns INVALID_ARGUMENT if password is too weak or was recently used.
 	CreateOrUpdate(ctx context.Context, in *CreateOrUpdateRequest, opts ...grpc.CallOption) (*CreateOrUpdateResponse, error)
 	// Deletes idenity password. After this action, action can not be authentificated using password.
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 	// Checks if password authentification method is defined for specified entity
 	Exists(ctx context.Context, in *ExistsRequest, opts ...grpc.CallOption) (*ExistsResponse, error)
+	// Lists all the active lockouts created after too many failed authentication attempts
+	ListLockouts(ctx context.Context, in *ListLockoutsRequest, opts ...grpc.CallOption) (*ListLockoutsResponse, error)
+	// Removes lockout and resets failed attempts counter for the identity or source IP
+	ClearLockout(ctx context.Context, in *ClearLockoutRequest, opts ...grpc.CallOption) (*ClearLockoutResponse, error)
 }
 
 type iAMAuthenticationPasswordServiceClient struct {
@@ -76,6 +81,24 @@ func (c *iAMAuthenticationPasswordServiceClient) Exists(ctx context.Context, in
 	return out, nil
 }
 
+func (c *iAMAuthenticationPasswordServiceClient) ListLockouts(ctx context.Context, in *ListLockoutsRequest, opts ...grpc.CallOption) (*ListLockoutsResponse, error) {
+	out := new(ListLockoutsResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_authentication_password.IAMAuthenticationPasswordService/ListLockouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMAuthenticationPasswordServiceClient) ClearLockout(ctx context.Context, in *ClearLockoutRequest, opts ...grpc.CallOption) (*ClearLockoutResponse, error) {
+	out := new(ClearLockoutResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_authentication_password.IAMAuthenticationPasswordService/ClearLockout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IAMAuthenticationPasswordServiceServer is the server API for IAMAuthenticationPasswordService service.
 // All implementations must embed UnimplementedIAMAuthenticationPasswordServiceServer
 // for forward compatibility
@@ -83,11 +106,16 @@ type IAMAuthenticationPasswordServiceServer interface {
 	// Tries to find identity and compare its password.
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
 	// Creates or updates identity password for authentification.
+	// Password is validated against password policy from the IAM configuration. Returns INVALID_ARGUMENT if password is too weak or was recently used.
 	CreateOrUpdate(context.Context, *CreateOrUpdateRequest) (*CreateOrUpdateResponse, error)
 	// Deletes idenity password. After this action, action can not be authentificated using password.
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	// Checks if password authentification method is defined for specified entity
 	Exists(context.Context, *ExistsRequest) (*ExistsResponse, error)
+	// Lists all the active lockouts created after too many failed authentication attempts
+	ListLockouts(context.Context, *ListLockoutsRequest) (*ListLockoutsResponse, error)
+	// Removes lockout and resets failed attempts counter for the identity or source IP
+	ClearLockout(context.Context, *ClearLockoutRequest) (*ClearLockoutResponse, error)
 	mustEmbedUnimplementedIAMAuthenticationPasswordServiceServer()
 }
 
@@ -107,6 +135,12 @@ func (UnimplementedIAMAuthenticationPasswordServiceServer) Delete(context.Contex
 func (UnimplementedIAMAuthenticationPasswordServiceServer) Exists(context.Context, *ExistsRequest) (*ExistsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exists not implemented")
 }
+func (UnimplementedIAMAuthenticationPasswordServiceServer) ListLockouts(context.Context, *ListLockoutsRequest) (*ListLockoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLockouts not implemented")
+}
+func (UnimplementedIAMAuthenticationPasswordServiceServer) ClearLockout(context.Context, *ClearLockoutRequest) (*ClearLockoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearLockout not implemented")
+}
 func (UnimplementedIAMAuthenticationPasswordServiceServer) mustEmbedUnimplementedIAMAuthenticationPasswordServiceServer() {
 }
 
@@ -193,6 +227,42 @@ func _IAMAuthenticationPasswordService_Exists_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMAuthenticationPasswordService_ListLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLockoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMAuthenticationPasswordServiceServer).ListLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_authentication_password.IAMAuthenticationPasswordService/ListLockouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMAuthenticationPasswordServiceServer).ListLockouts(ctx, req.(*ListLockoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMAuthenticationPasswordService_ClearLockout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearLockoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMAuthenticationPasswordServiceServer).ClearLockout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_authentication_password.IAMAuthenticationPasswordService/ClearLockout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMAuthenticationPasswordServiceServer).ClearLockout(ctx, req.(*ClearLockoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IAMAuthenticationPasswordService_ServiceDesc is the grpc.ServiceDesc for IAMAuthenticationPasswordService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -216,6 +286,14 @@ var IAMAuthenticationPasswordService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Exists",
 			Handler:    _IAMAuthenticationPasswordService_Exists_Handler,
 		},
+		{
+			MethodName: "ListLockouts",
+			Handler:    _IAMAuthenticationPasswordService_ListLockouts_Handler,
+		},
+		{
+			MethodName: "ClearLockout",
+			Handler:    _IAMAuthenticationPasswordService_ClearLockout_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "password.proto",
@@ -24,7 +24,9 @@ const _ = grpc.SupportPackageIsVersion7
 type IAMAuthenticationX509ServiceClient interface {
 	// Get root CA certificate and public key in DER format. It can be used to validate all the certificates created by this service
 	GetRootCAInfo(ctx context.Context, in *GetRootCAInfoRequest, opts ...grpc.CallOption) (*GetRootCAInfoResponse, error)
-	// Register public key for identity and generate x509 certificate for it. Sign certificate using internal CA.
+	// Get certificate chain of the namespace intermediate CA. All the certificates of the namespace are signed by it.
+	GetNamespaceCAChain(ctx context.Context, in *GetNamespaceCAChainRequest, opts ...grpc.CallOption) (*GetNamespaceCAChainResponse, error)
+	// Register public key for identity and generate x509 certificate for it. Sign certificate using intermediate CA of the namespace.
 	RegisterAndGenerate(ctx context.Context, in *RegisterAndGenerateRequest, opts ...grpc.CallOption) (*RegisterAndGenerateResponse, error)
 	// Regenerate x509 certificate. Return new x509 certificate signed with CA.
 	Regenerate(ctx context.Context, in *RegenerateRequest, opts ...grpc.CallOption) (*RegenerateResponse, error)
@@ -40,12 +42,20 @@ type IAMAuthenticationX509ServiceClient interface {
 	CountForIdentity(ctx context.Context, in *CountForIdentityRequest, opts ...grpc.CallOption) (*CountForIdentityResponse, error)
 	// List all the registered certificates for specified identity
 	ListForIdentity(ctx context.Context, in *ListForIdentityRequest, opts ...grpc.CallOption) (IAMAuthenticationX509Service_ListForIdentityClient, error)
+	// List not disabled certificates that expire soon. The soonest first.
+	ListExpiring(ctx context.Context, in *ListExpiringRequest, opts ...grpc.CallOption) (IAMAuthenticationX509Service_ListExpiringClient, error)
 	// Update certificate information
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
-	// Delete certificate. Note, that previously generated X509 certificate is still valid. Thats why you have to check if they still exists and wasnt disabled.
+	// Delete certificate. Previously generated X509 certificate still has valid signature, but it is added to the certificate revocation list. Thats why you have to check if they still exists and wasnt disabled.
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 	// Mark certificate as manually disabled. Disabled certificated cant be used.
 	Disable(ctx context.Context, in *DisableRequest, opts ...grpc.CallOption) (*DisableResponse, error)
+	// Get certificate revocation list with all the disabled and deleted certificates that are not expired yet. It can be used by TLS terminators to reject revoked certificates without calling this service.
+	GetCRL(ctx context.Context, in *GetCRLRequest, opts ...grpc.CallOption) (*GetCRLResponse, error)
+	// Same as GetCRL, but for certificates issued by the intermediate CA of the namespace. Signed by the intermediate CA.
+	GetNamespaceCRL(ctx context.Context, in *GetNamespaceCRLRequest, opts ...grpc.CallOption) (*GetNamespaceCRLResponse, error)
+	// Handle OCSP request and return signed OCSP response
+	OCSP(ctx context.Context, in *OCSPRequest, opts ...grpc.CallOption) (*OCSPResponse, error)
 }
 
 type iAMAuthenticationX509ServiceClient struct {
@@ -65,6 +75,15 @@ func (c *iAMAuthenticationX509ServiceClient) GetRootCAInfo(ctx context.Context,
 	return out, nil
 }
 
+func (c *iAMAuthenticationX509ServiceClient) GetNamespaceCAChain(ctx context.Context, in *GetNamespaceCAChainRequest, opts ...grpc.CallOption) (*GetNamespaceCAChainResponse, error) {
+	out := new(GetNamespaceCAChainResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_authentication_x509.IAMAuthenticationX509Service/GetNamespaceCAChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *iAMAuthenticationX509ServiceClient) RegisterAndGenerate(ctx context.Context, in *RegisterAndGenerateRequest, opts ...grpc.CallOption) (*RegisterAndGenerateResponse, error) {
 	out := new(RegisterAndGenerateResponse)
 	err := c.cc.Invoke(ctx, "/native_iam_authentication_x509.IAMAuthenticationX509Service/RegisterAndGenerate", in, out, opts...)
@@ -183,6 +202,38 @@ func (x *iAMAuthenticationX509ServiceListForIdentityClient) Recv() (*ListForIden
 	return m, nil
 }
 
+func (c *iAMAuthenticationX509ServiceClient) ListExpiring(ctx context.Context, in *ListExpiringRequest, opts ...grpc.CallOption) (IAMAuthenticationX509Service_ListExpiringClient, error) {
+	stream, err := c.cc.NewStream(ctx, &IAMAuthenticationX509Service_ServiceDesc.Streams[2], "/native_iam_authentication_x509.IAMAuthenticationX509Service/ListExpiring", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &iAMAuthenticationX509ServiceListExpiringClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IAMAuthenticationX509Service_ListExpiringClient interface {
+	Recv() (*ListExpiringResponse, error)
+	grpc.ClientStream
+}
+
+type iAMAuthenticationX509ServiceListExpiringClient struct {
+	grpc.ClientStream
+}
+
+func (x *iAMAuthenticationX509ServiceListExpiringClient) Recv() (*ListExpiringResponse, error) {
+	m := new(ListExpiringResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *iAMAuthenticationX509ServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
 	out := new(UpdateResponse)
 	err := c.cc.Invoke(ctx, "/native_iam_authentication_x509.IAMAuthenticationX509Service/Update", in, out, opts...)
@@ -210,13 +261,42 @@ func (c *iAMAuthenticationX509ServiceClient) Disable(ctx context.Context, in *Di
 	return out, nil
 }
 
+func (c *iAMAuthenticationX509ServiceClient) GetCRL(ctx context.Context, in *GetCRLRequest, opts ...grpc.CallOption) (*GetCRLResponse, error) {
+	out := new(GetCRLResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_authentication_x509.IAMAuthenticationX509Service/GetCRL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMAuthenticationX509ServiceClient) GetNamespaceCRL(ctx context.Context, in *GetNamespaceCRLRequest, opts ...grpc.CallOption) (*GetNamespaceCRLResponse, error) {
+	out := new(GetNamespaceCRLResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_authentication_x509.IAMAuthenticationX509Service/GetNamespaceCRL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMAuthenticationX509ServiceClient) OCSP(ctx context.Context, in *OCSPRequest, opts ...grpc.CallOption) (*OCSPResponse, error) {
+	out := new(OCSPResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_authentication_x509.IAMAuthenticationX509Service/OCSP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IAMAuthenticationX509ServiceServer is the server API for IAMAuthenticationX509Service service.
 // All implementations must embed UnimplementedIAMAuthenticationX509ServiceServer
 // for forward compatibility
 type IAMAuthenticationX509ServiceServer interface {
 	// Get root CA certificate and public key in DER format. It can be used to validate all the certificates created by this service
 	GetRootCAInfo(context.Context, *GetRootCAInfoRequest) (*GetRootCAInfoResponse, error)
-	// Register public key for identity and generate x509 certificate for it. Sign certificate using internal CA.
+	// Get certificate chain of the namespace intermediate CA. All the certificates of the namespace are signed by it.
+	GetNamespaceCAChain(context.Context, *GetNamespaceCAChainRequest) (*GetNamespaceCAChainResponse, error)
+	// Register public key for identity and generate x509 certificate for it. Sign certificate using intermediate CA of the namespace.
 	RegisterAndGenerate(context.Context, *RegisterAndGenerateRequest) (*RegisterAndGenerateResponse, error)
 	// Regenerate x509 certificate. Return new x509 certificate signed with CA.
 	Regenerate(context.Context, *RegenerateRequest) (*RegenerateResponse, error)
@@ -232,12 +312,20 @@ type IAMAuthenticationX509ServiceServer interface {
 	CountForIdentity(context.Context, *CountForIdentityRequest) (*CountForIdentityResponse, error)
 	// List all the registered certificates for specified identity
 	ListForIdentity(*ListForIdentityRequest, IAMAuthenticationX509Service_ListForIdentityServer) error
+	// List not disabled certificates that expire soon. The soonest first.
+	ListExpiring(*ListExpiringRequest, IAMAuthenticationX509Service_ListExpiringServer) error
 	// Update certificate information
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
-	// Delete certificate. Note, that previously generated X509 certificate is still valid. Thats why you have to check if they still exists and wasnt disabled.
+	// Delete certificate. Previously generated X509 certificate still has valid signature, but it is added to the certificate revocation list. Thats why you have to check if they still exists and wasnt disabled.
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	// Mark certificate as manually disabled. Disabled certificated cant be used.
 	Disable(context.Context, *DisableRequest) (*DisableResponse, error)
+	// Get certificate revocation list with all the disabled and deleted certificates that are not expired yet. It can be used by TLS terminators to reject revoked certificates without calling this service.
+	GetCRL(context.Context, *GetCRLRequest) (*GetCRLResponse, error)
+	// Same as GetCRL, but for certificates issued by the intermediate CA of the namespace. Signed by the intermediate CA.
+	GetNamespaceCRL(context.Context, *GetNamespaceCRLRequest) (*GetNamespaceCRLResponse, error)
+	// Handle OCSP request and return signed OCSP response
+	OCSP(context.Context, *OCSPRequest) (*OCSPResponse, error)
 	mustEmbedUnimplementedIAMAuthenticationX509ServiceServer()
 }
 
@@ -248,6 +336,9 @@ type UnimplementedIAMAuthenticationX509ServiceServer struct {
 func (UnimplementedIAMAuthenticationX509ServiceServer) GetRootCAInfo(context.Context, *GetRootCAInfoRequest) (*GetRootCAInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRootCAInfo not implemented")
 }
+func (UnimplementedIAMAuthenticationX509ServiceServer) GetNamespaceCAChain(context.Context, *GetNamespaceCAChainRequest) (*GetNamespaceCAChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceCAChain not implemented")
+}
 func (UnimplementedIAMAuthenticationX509ServiceServer) RegisterAndGenerate(context.Context, *RegisterAndGenerateRequest) (*RegisterAndGenerateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterAndGenerate not implemented")
 }
@@ -272,6 +363,9 @@ func (UnimplementedIAMAuthenticationX509ServiceServer) CountForIdentity(context.
 func (UnimplementedIAMAuthenticationX509ServiceServer) ListForIdentity(*ListForIdentityRequest, IAMAuthenticationX509Service_ListForIdentityServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListForIdentity not implemented")
 }
+func (UnimplementedIAMAuthenticationX509ServiceServer) ListExpiring(*ListExpiringRequest, IAMAuthenticationX509Service_ListExpiringServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListExpiring not implemented")
+}
 func (UnimplementedIAMAuthenticationX509ServiceServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
@@ -281,6 +375,15 @@ func (UnimplementedIAMAuthenticationX509ServiceServer) Delete(context.Context, *
 func (UnimplementedIAMAuthenticationX509ServiceServer) Disable(context.Context, *DisableRequest) (*DisableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Disable not implemented")
 }
+func (UnimplementedIAMAuthenticationX509ServiceServer) GetCRL(context.Context, *GetCRLRequest) (*GetCRLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCRL not implemented")
+}
+func (UnimplementedIAMAuthenticationX509ServiceServer) GetNamespaceCRL(context.Context, *GetNamespaceCRLRequest) (*GetNamespaceCRLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceCRL not implemented")
+}
+func (UnimplementedIAMAuthenticationX509ServiceServer) OCSP(context.Context, *OCSPRequest) (*OCSPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OCSP not implemented")
+}
 func (UnimplementedIAMAuthenticationX509ServiceServer) mustEmbedUnimplementedIAMAuthenticationX509ServiceServer() {
 }
 
@@ -313,6 +416,24 @@ func _IAMAuthenticationX509Service_GetRootCAInfo_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMAuthenticationX509Service_GetNamespaceCAChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceCAChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMAuthenticationX509ServiceServer).GetNamespaceCAChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_authentication_x509.IAMAuthenticationX509Service/GetNamespaceCAChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMAuthenticationX509ServiceServer).GetNamespaceCAChain(ctx, req.(*GetNamespaceCAChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IAMAuthenticationX509Service_RegisterAndGenerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterAndGenerateRequest)
 	if err := dec(in); err != nil {
@@ -463,6 +584,27 @@ func (x *iAMAuthenticationX509ServiceListForIdentityServer) Send(m *ListForIdent
 	return x.ServerStream.SendMsg(m)
 }
 
+func _IAMAuthenticationX509Service_ListExpiring_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListExpiringRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IAMAuthenticationX509ServiceServer).ListExpiring(m, &iAMAuthenticationX509ServiceListExpiringServer{stream})
+}
+
+type IAMAuthenticationX509Service_ListExpiringServer interface {
+	Send(*ListExpiringResponse) error
+	grpc.ServerStream
+}
+
+type iAMAuthenticationX509ServiceListExpiringServer struct {
+	grpc.ServerStream
+}
+
+func (x *iAMAuthenticationX509ServiceListExpiringServer) Send(m *ListExpiringResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _IAMAuthenticationX509Service_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateRequest)
 	if err := dec(in); err != nil {
@@ -517,6 +659,60 @@ func _IAMAuthenticationX509Service_Disable_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMAuthenticationX509Service_GetCRL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCRLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMAuthenticationX509ServiceServer).GetCRL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_authentication_x509.IAMAuthenticationX509Service/GetCRL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMAuthenticationX509ServiceServer).GetCRL(ctx, req.(*GetCRLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMAuthenticationX509Service_GetNamespaceCRL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceCRLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMAuthenticationX509ServiceServer).GetNamespaceCRL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_authentication_x509.IAMAuthenticationX509Service/GetNamespaceCRL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMAuthenticationX509ServiceServer).GetNamespaceCRL(ctx, req.(*GetNamespaceCRLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMAuthenticationX509Service_OCSP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OCSPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMAuthenticationX509ServiceServer).OCSP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_authentication_x509.IAMAuthenticationX509Service/OCSP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMAuthenticationX509ServiceServer).OCSP(ctx, req.(*OCSPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IAMAuthenticationX509Service_ServiceDesc is the grpc.ServiceDesc for IAMAuthenticationX509Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -528,6 +724,10 @@ var IAMAuthenticationX509Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRootCAInfo",
 			Handler:    _IAMAuthenticationX509Service_GetRootCAInfo_Handler,
 		},
+		{
+			MethodName: "GetNamespaceCAChain",
+			Handler:    _IAMAuthenticationX509Service_GetNamespaceCAChain_Handler,
+		},
 		{
 			MethodName: "RegisterAndGenerate",
 			Handler:    _IAMAuthenticationX509Service_RegisterAndGenerate_Handler,
@@ -564,6 +764,18 @@ var IAMAuthenticationX509Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Disable",
 			Handler:    _IAMAuthenticationX509Service_Disable_Handler,
 		},
+		{
+			MethodName: "GetCRL",
+			Handler:    _IAMAuthenticationX509Service_GetCRL_Handler,
+		},
+		{
+			MethodName: "GetNamespaceCRL",
+			Handler:    _IAMAuthenticationX509Service_GetNamespaceCRL_Handler,
+		},
+		{
+			MethodName: "OCSP",
+			Handler:    _IAMAuthenticationX509Service_OCSP_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -576,6 +788,11 @@ var IAMAuthenticationX509Service_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _IAMAuthenticationX509Service_ListForIdentity_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListExpiring",
+			Handler:       _IAMAuthenticationX509Service_ListExpiring_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "x509.proto",
 }
@@ -38,16 +38,20 @@ type IAMIdentityServiceClient interface {
 	GetServiceManagedIdentity(ctx context.Context, in *GetServiceManagedIdentityRequest, opts ...grpc.CallOption) (*GetServiceManagedIdentityResponse, error)
 	// Update identity information
 	Update(ctx context.Context, in *UpdateIdentityRequest, opts ...grpc.CallOption) (*UpdateIdentityResponse, error)
-	// Add policy to the identity. If policy was already added - does nothing.
+	// Add policy to the identity. If policy was already added - replaces validity period and justification of the assignment.
 	AddPolicy(ctx context.Context, in *AddPolicyRequest, opts ...grpc.CallOption) (*AddPolicyResponse, error)
 	// Remove policy from the identity. If policy was already removed - does nothing.
 	RemovePolicy(ctx context.Context, in *RemovePolicyRequest, opts ...grpc.CallOption) (*RemovePolicyResponse, error)
-	// Add role to the identity. If role was already added = does nothing
+	// Add role to the identity. If role was already added - replaces validity period and justification of the assignment.
 	AddRole(ctx context.Context, in *AddRoleRequest, opts ...grpc.CallOption) (*AddRoleResponse, error)
 	// Remove role from the identity. If role was already removed - does nothing.
 	RemoveRole(ctx context.Context, in *RemoveRoleRequest, opts ...grpc.CallOption) (*RemoveRoleResponse, error)
 	// Set identity active or not.
 	SetActive(ctx context.Context, in *SetIdentityActiveRequest, opts ...grpc.CallOption) (*SetIdentityActiveResponse, error)
+	// Simulates policy or role change without persisting it. Returns how effective permissions of the identities in the namespace will change.
+	SimulatePolicyChange(ctx context.Context, in *SimulatePolicyChangeRequest, opts ...grpc.CallOption) (*SimulatePolicyChangeResponse, error)
+	// Get all the policies that are in effect for the identity (directly assigned and inherited from the roles) with information from where they were assigned
+	GetEffectivePolicies(ctx context.Context, in *GetEffectivePoliciesRequest, opts ...grpc.CallOption) (*GetEffectivePoliciesResponse, error)
 }
 
 type iAMIdentityServiceClient struct {
@@ -198,6 +202,24 @@ func (c *iAMIdentityServiceClient) SetActive(ctx context.Context, in *SetIdentit
 	return out, nil
 }
 
+func (c *iAMIdentityServiceClient) SimulatePolicyChange(ctx context.Context, in *SimulatePolicyChangeRequest, opts ...grpc.CallOption) (*SimulatePolicyChangeResponse, error) {
+	out := new(SimulatePolicyChangeResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_identity.IAMIdentityService/SimulatePolicyChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iAMIdentityServiceClient) GetEffectivePolicies(ctx context.Context, in *GetEffectivePoliciesRequest, opts ...grpc.CallOption) (*GetEffectivePoliciesResponse, error) {
+	out := new(GetEffectivePoliciesResponse)
+	err := c.cc.Invoke(ctx, "/native_iam_identity.IAMIdentityService/GetEffectivePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IAMIdentityServiceServer is the server API for IAMIdentityService service.
 // All implementations must embed UnimplementedIAMIdentityServiceServer
 // for forward compatibility
@@ -218,16 +240,20 @@ type IAMIdentityServiceServer interface {
 	GetServiceManagedIdentity(context.Context, *GetServiceManagedIdentityRequest) (*GetServiceManagedIdentityResponse, error)
 	// Update identity information
 	Update(context.Context, *UpdateIdentityRequest) (*UpdateIdentityResponse, error)
-	// Add policy to the identity. If policy was already added - does nothing.
+	// Add policy to the identity. If policy was already added - replaces validity period and justification of the assignment.
 	AddPolicy(context.Context, *AddPolicyRequest) (*AddPolicyResponse, error)
 	// Remove policy from the identity. If policy was already removed - does nothing.
 	RemovePolicy(context.Context, *RemovePolicyRequest) (*RemovePolicyResponse, error)
-	// Add role to the identity. If role was already added = does nothing
+	// Add role to the identity. If role was already added - replaces validity period and justification of the assignment.
 	AddRole(context.Context, *AddRoleRequest) (*AddRoleResponse, error)
 	// Remove role from the identity. If role was already removed - does nothing.
 	RemoveRole(context.Context, *RemoveRoleRequest) (*RemoveRoleResponse, error)
 	// Set identity active or not.
 	SetActive(context.Context, *SetIdentityActiveRequest) (*SetIdentityActiveResponse, error)
+	// Simulates policy or role change without persisting it. Returns how effective permissions of the identities in the namespace will change.
+	SimulatePolicyChange(context.Context, *SimulatePolicyChangeRequest) (*SimulatePolicyChangeResponse, error)
+	// Get all the policies that are in effect for the identity (directly assigned and inherited from the roles) with information from where they were assigned
+	GetEffectivePolicies(context.Context, *GetEffectivePoliciesRequest) (*GetEffectivePoliciesResponse, error)
 	mustEmbedUnimplementedIAMIdentityServiceServer()
 }
 
@@ -274,6 +300,12 @@ func (UnimplementedIAMIdentityServiceServer) RemoveRole(context.Context, *Remove
 func (UnimplementedIAMIdentityServiceServer) SetActive(context.Context, *SetIdentityActiveRequest) (*SetIdentityActiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetActive not implemented")
 }
+func (UnimplementedIAMIdentityServiceServer) SimulatePolicyChange(context.Context, *SimulatePolicyChangeRequest) (*SimulatePolicyChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePolicyChange not implemented")
+}
+func (UnimplementedIAMIdentityServiceServer) GetEffectivePolicies(context.Context, *GetEffectivePoliciesRequest) (*GetEffectivePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectivePolicies not implemented")
+}
 func (UnimplementedIAMIdentityServiceServer) mustEmbedUnimplementedIAMIdentityServiceServer() {}
 
 // UnsafeIAMIdentityServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -524,6 +556,42 @@ func _IAMIdentityService_SetActive_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IAMIdentityService_SimulatePolicyChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulatePolicyChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMIdentityServiceServer).SimulatePolicyChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_identity.IAMIdentityService/SimulatePolicyChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMIdentityServiceServer).SimulatePolicyChange(ctx, req.(*SimulatePolicyChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IAMIdentityService_GetEffectivePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectivePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IAMIdentityServiceServer).GetEffectivePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_iam_identity.IAMIdentityService/GetEffectivePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IAMIdentityServiceServer).GetEffectivePolicies(ctx, req.(*GetEffectivePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IAMIdentityService_ServiceDesc is the grpc.ServiceDesc for IAMIdentityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -579,6 +647,14 @@ var IAMIdentityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetActive",
 			Handler:    _IAMIdentityService_SetActive_Handler,
 		},
+		{
+			MethodName: "SimulatePolicyChange",
+			Handler:    _IAMIdentityService_SimulatePolicyChange_Handler,
+		},
+		{
+			MethodName: "GetEffectivePolicies",
+			Handler:    _IAMIdentityService_GetEffectivePolicies_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -32,6 +32,14 @@ type KeyValueStorageServiceClient interface {
 	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
 	// Checks if key exists in specified namespace
 	Exist(ctx context.Context, in *ExistRequest, opts ...grpc.CallOption) (*ExistResponse, error)
+	// Lists keys that start with the prefix. Keys are sorted and returned page by page.
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Gets multiple keys at once
+	BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error)
+	// Sets multiple keys at once. Operation is not atomic: if it fails, part of the keys may be already set.
+	BatchSet(ctx context.Context, in *BatchSetRequest, opts ...grpc.CallOption) (*BatchSetResponse, error)
+	// Sets value only if current version of the key equals to the expected one. Can be used for locks and counters.
+	CompareAndSwap(ctx context.Context, in *CompareAndSwapRequest, opts ...grpc.CallOption) (*CompareAndSwapResponse, error)
 }
 
 type keyValueStorageServiceClient struct {
@@ -87,6 +95,42 @@ func (c *keyValueStorageServiceClient) Exist(ctx context.Context, in *ExistReque
 	return out, nil
 }
 
+func (c *keyValueStorageServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/native_keyvalueprstorage.KeyValueStorageService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyValueStorageServiceClient) BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error) {
+	out := new(BatchGetResponse)
+	err := c.cc.Invoke(ctx, "/native_keyvalueprstorage.KeyValueStorageService/BatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyValueStorageServiceClient) BatchSet(ctx context.Context, in *BatchSetRequest, opts ...grpc.CallOption) (*BatchSetResponse, error) {
+	out := new(BatchSetResponse)
+	err := c.cc.Invoke(ctx, "/native_keyvalueprstorage.KeyValueStorageService/BatchSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyValueStorageServiceClient) CompareAndSwap(ctx context.Context, in *CompareAndSwapRequest, opts ...grpc.CallOption) (*CompareAndSwapResponse, error) {
+	out := new(CompareAndSwapResponse)
+	err := c.cc.Invoke(ctx, "/native_keyvalueprstorage.KeyValueStorageService/CompareAndSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KeyValueStorageServiceServer is the server API for KeyValueStorageService service.
 // All implementations must embed UnimplementedKeyValueStorageServiceServer
 // for forward compatibility
@@ -101,6 +145,14 @@ type KeyValueStorageServiceServer interface {
 	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
 	// Checks if key exists in specified namespace
 	Exist(context.Context, *ExistRequest) (*ExistResponse, error)
+	// Lists keys that start with the prefix. Keys are sorted and returned page by page.
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Gets multiple keys at once
+	BatchGet(context.Context, *BatchGetRequest) (*BatchGetResponse, error)
+	// Sets multiple keys at once. Operation is not atomic: if it fails, part of the keys may be already set.
+	BatchSet(context.Context, *BatchSetRequest) (*BatchSetResponse, error)
+	// Sets value only if current version of the key equals to the expected one. Can be used for locks and counters.
+	CompareAndSwap(context.Context, *CompareAndSwapRequest) (*CompareAndSwapResponse, error)
 	mustEmbedUnimplementedKeyValueStorageServiceServer()
 }
 
@@ -123,6 +175,18 @@ func (UnimplementedKeyValueStorageServiceServer) Remove(context.Context, *Remove
 func (UnimplementedKeyValueStorageServiceServer) Exist(context.Context, *ExistRequest) (*ExistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exist not implemented")
 }
+func (UnimplementedKeyValueStorageServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedKeyValueStorageServiceServer) BatchGet(context.Context, *BatchGetRequest) (*BatchGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (UnimplementedKeyValueStorageServiceServer) BatchSet(context.Context, *BatchSetRequest) (*BatchSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSet not implemented")
+}
+func (UnimplementedKeyValueStorageServiceServer) CompareAndSwap(context.Context, *CompareAndSwapRequest) (*CompareAndSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareAndSwap not implemented")
+}
 func (UnimplementedKeyValueStorageServiceServer) mustEmbedUnimplementedKeyValueStorageServiceServer() {
 }
 
@@ -227,6 +291,78 @@ func _KeyValueStorageService_Exist_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KeyValueStorageService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueStorageServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_keyvalueprstorage.KeyValueStorageService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueStorageServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValueStorageService_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueStorageServiceServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_keyvalueprstorage.KeyValueStorageService/BatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueStorageServiceServer).BatchGet(ctx, req.(*BatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValueStorageService_BatchSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueStorageServiceServer).BatchSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_keyvalueprstorage.KeyValueStorageService/BatchSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueStorageServiceServer).BatchSet(ctx, req.(*BatchSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyValueStorageService_CompareAndSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueStorageServiceServer).CompareAndSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_keyvalueprstorage.KeyValueStorageService/CompareAndSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueStorageServiceServer).CompareAndSwap(ctx, req.(*CompareAndSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KeyValueStorageService_ServiceDesc is the grpc.ServiceDesc for KeyValueStorageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -254,6 +390,22 @@ var KeyValueStorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Exist",
 			Handler:    _KeyValueStorageService_Exist_Handler,
 		},
+		{
+			MethodName: "List",
+			Handler:    _KeyValueStorageService_List_Handler,
+		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _KeyValueStorageService_BatchGet_Handler,
+		},
+		{
+			MethodName: "BatchSet",
+			Handler:    _KeyValueStorageService_BatchSet_Handler,
+		},
+		{
+			MethodName: "CompareAndSwap",
+			Handler:    _KeyValueStorageService_CompareAndSwap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "keyvaluestorage.proto",
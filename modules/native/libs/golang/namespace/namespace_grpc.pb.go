@@ -28,8 +28,16 @@ type NamespaceServiceClient interface {
 	Create(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error)
 	// Updates namespace information. If namespace doesnt exist, will return error.
 	Update(ctx context.Context, in *UpdateNamespaceRequest, opts ...grpc.CallOption) (*UpdateNamespaceResponse, error)
-	// Deletes namespace and all its data
+	// Replaces labels of the namespace
+	SetLabels(ctx context.Context, in *SetNamespaceLabelsRequest, opts ...grpc.CallOption) (*SetNamespaceLabelsResponse, error)
+	// Changes lifecycle state of the namespace (active, suspended or archived). Data of the namespace is not changed.
+	SetState(ctx context.Context, in *SetNamespaceStateRequest, opts ...grpc.CallOption) (*SetNamespaceStateResponse, error)
+	// Starts deletion of the namespace and all its data. Namespace is deleted after all the services confirm cleanup of its data.
 	Delete(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
+	// Returns progress of the namespace deletion. Fails with FailedPrecondition if namespace is not being deleted.
+	GetDeletionStatus(ctx context.Context, in *GetNamespaceDeletionStatusRequest, opts ...grpc.CallOption) (*GetNamespaceDeletionStatusResponse, error)
+	// Sends deletion request again to the services that didnt confirm cleanup. Services that no longer listen for deletion requests are removed from the participants.
+	RetryDeletion(ctx context.Context, in *RetryNamespaceDeletionRequest, opts ...grpc.CallOption) (*RetryNamespaceDeletionResponse, error)
 	// Returns namespace information by its name
 	Get(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*GetNamespaceResponse, error)
 	// Streams list of all namespaces
@@ -38,6 +46,14 @@ type NamespaceServiceClient interface {
 	Exists(ctx context.Context, in *IsNamespaceExistRequest, opts ...grpc.CallOption) (*IsNamespaceExistResponse, error)
 	// Gets namespace statistics. If namespace doesnt exist, will return error.
 	Stat(ctx context.Context, in *GetNamespaceStatisticsRequest, opts ...grpc.CallOption) (*GetNamespaceStatisticsResponse, error)
+	// Sets limits of the namespace resources. Resources are checked by the services on creation and fail with ResourceExhausted if limit is reached.
+	SetQuota(ctx context.Context, in *SetNamespaceQuotaRequest, opts ...grpc.CallOption) (*SetNamespaceQuotaResponse, error)
+	// Gets limits and current usage of the namespace resources
+	GetQuota(ctx context.Context, in *GetNamespaceQuotaRequest, opts ...grpc.CallOption) (*GetNamespaceQuotaResponse, error)
+	// Streams archive with all the data of the namespace. First message is the header of the archive.
+	Export(ctx context.Context, in *ExportNamespaceRequest, opts ...grpc.CallOption) (NamespaceService_ExportClient, error)
+	// Creates new namespace from the archive. UUIDs are remapped if namespace is imported under the different name.
+	Import(ctx context.Context, opts ...grpc.CallOption) (NamespaceService_ImportClient, error)
 }
 
 type namespaceServiceClient struct {
@@ -75,6 +91,24 @@ func (c *namespaceServiceClient) Update(ctx context.Context, in *UpdateNamespace
 	return out, nil
 }
 
+func (c *namespaceServiceClient) SetLabels(ctx context.Context, in *SetNamespaceLabelsRequest, opts ...grpc.CallOption) (*SetNamespaceLabelsResponse, error) {
+	out := new(SetNamespaceLabelsResponse)
+	err := c.cc.Invoke(ctx, "/native_namespace.NamespaceService/SetLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) SetState(ctx context.Context, in *SetNamespaceStateRequest, opts ...grpc.CallOption) (*SetNamespaceStateResponse, error) {
+	out := new(SetNamespaceStateResponse)
+	err := c.cc.Invoke(ctx, "/native_namespace.NamespaceService/SetState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *namespaceServiceClient) Delete(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
 	out := new(DeleteNamespaceResponse)
 	err := c.cc.Invoke(ctx, "/native_namespace.NamespaceService/Delete", in, out, opts...)
@@ -84,6 +118,24 @@ func (c *namespaceServiceClient) Delete(ctx context.Context, in *DeleteNamespace
 	return out, nil
 }
 
+func (c *namespaceServiceClient) GetDeletionStatus(ctx context.Context, in *GetNamespaceDeletionStatusRequest, opts ...grpc.CallOption) (*GetNamespaceDeletionStatusResponse, error) {
+	out := new(GetNamespaceDeletionStatusResponse)
+	err := c.cc.Invoke(ctx, "/native_namespace.NamespaceService/GetDeletionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) RetryDeletion(ctx context.Context, in *RetryNamespaceDeletionRequest, opts ...grpc.CallOption) (*RetryNamespaceDeletionResponse, error) {
+	out := new(RetryNamespaceDeletionResponse)
+	err := c.cc.Invoke(ctx, "/native_namespace.NamespaceService/RetryDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *namespaceServiceClient) Get(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*GetNamespaceResponse, error) {
 	out := new(GetNamespaceResponse)
 	err := c.cc.Invoke(ctx, "/native_namespace.NamespaceService/Get", in, out, opts...)
@@ -143,6 +195,90 @@ func (c *namespaceServiceClient) Stat(ctx context.Context, in *GetNamespaceStati
 	return out, nil
 }
 
+func (c *namespaceServiceClient) SetQuota(ctx context.Context, in *SetNamespaceQuotaRequest, opts ...grpc.CallOption) (*SetNamespaceQuotaResponse, error) {
+	out := new(SetNamespaceQuotaResponse)
+	err := c.cc.Invoke(ctx, "/native_namespace.NamespaceService/SetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) GetQuota(ctx context.Context, in *GetNamespaceQuotaRequest, opts ...grpc.CallOption) (*GetNamespaceQuotaResponse, error) {
+	out := new(GetNamespaceQuotaResponse)
+	err := c.cc.Invoke(ctx, "/native_namespace.NamespaceService/GetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) Export(ctx context.Context, in *ExportNamespaceRequest, opts ...grpc.CallOption) (NamespaceService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NamespaceService_ServiceDesc.Streams[1], "/native_namespace.NamespaceService/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &namespaceServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NamespaceService_ExportClient interface {
+	Recv() (*ExportNamespaceResponse, error)
+	grpc.ClientStream
+}
+
+type namespaceServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *namespaceServiceExportClient) Recv() (*ExportNamespaceResponse, error) {
+	m := new(ExportNamespaceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *namespaceServiceClient) Import(ctx context.Context, opts ...grpc.CallOption) (NamespaceService_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NamespaceService_ServiceDesc.Streams[2], "/native_namespace.NamespaceService/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &namespaceServiceImportClient{stream}
+	return x, nil
+}
+
+type NamespaceService_ImportClient interface {
+	Send(*ImportNamespaceRequest) error
+	CloseAndRecv() (*ImportNamespaceResponse, error)
+	grpc.ClientStream
+}
+
+type namespaceServiceImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *namespaceServiceImportClient) Send(m *ImportNamespaceRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *namespaceServiceImportClient) CloseAndRecv() (*ImportNamespaceResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportNamespaceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // NamespaceServiceServer is the server API for NamespaceService service.
 // All implementations must embed UnimplementedNamespaceServiceServer
 // for forward compatibility
@@ -153,8 +289,16 @@ type NamespaceServiceServer interface {
 	Create(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
 	// Updates namespace information. If namespace doesnt exist, will return error.
 	Update(context.Context, *UpdateNamespaceRequest) (*UpdateNamespaceResponse, error)
-	// Deletes namespace and all its data
+	// Replaces labels of the namespace
+	SetLabels(context.Context, *SetNamespaceLabelsRequest) (*SetNamespaceLabelsResponse, error)
+	// Changes lifecycle state of the namespace (active, suspended or archived). Data of the namespace is not changed.
+	SetState(context.Context, *SetNamespaceStateRequest) (*SetNamespaceStateResponse, error)
+	// Starts deletion of the namespace and all its data. Namespace is deleted after all the services confirm cleanup of its data.
 	Delete(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	// Returns progress of the namespace deletion. Fails with FailedPrecondition if namespace is not being deleted.
+	GetDeletionStatus(context.Context, *GetNamespaceDeletionStatusRequest) (*GetNamespaceDeletionStatusResponse, error)
+	// Sends deletion request again to the services that didnt confirm cleanup. Services that no longer listen for deletion requests are removed from the participants.
+	RetryDeletion(context.Context, *RetryNamespaceDeletionRequest) (*RetryNamespaceDeletionResponse, error)
 	// Returns namespace information by its name
 	Get(context.Context, *GetNamespaceRequest) (*GetNamespaceResponse, error)
 	// Streams list of all namespaces
@@ -163,6 +307,14 @@ type NamespaceServiceServer interface {
 	Exists(context.Context, *IsNamespaceExistRequest) (*IsNamespaceExistResponse, error)
 	// Gets namespace statistics. If namespace doesnt exist, will return error.
 	Stat(context.Context, *GetNamespaceStatisticsRequest) (*GetNamespaceStatisticsResponse, error)
+	// Sets limits of the namespace resources. Resources are checked by the services on creation and fail with ResourceExhausted if limit is reached.
+	SetQuota(context.Context, *SetNamespaceQuotaRequest) (*SetNamespaceQuotaResponse, error)
+	// Gets limits and current usage of the namespace resources
+	GetQuota(context.Context, *GetNamespaceQuotaRequest) (*GetNamespaceQuotaResponse, error)
+	// Streams archive with all the data of the namespace. First message is the header of the archive.
+	Export(*ExportNamespaceRequest, NamespaceService_ExportServer) error
+	// Creates new namespace from the archive. UUIDs are remapped if namespace is imported under the different name.
+	Import(NamespaceService_ImportServer) error
 	mustEmbedUnimplementedNamespaceServiceServer()
 }
 
@@ -179,9 +331,21 @@ func (UnimplementedNamespaceServiceServer) Create(context.Context, *CreateNamesp
 func (UnimplementedNamespaceServiceServer) Update(context.Context, *UpdateNamespaceRequest) (*UpdateNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedNamespaceServiceServer) SetLabels(context.Context, *SetNamespaceLabelsRequest) (*SetNamespaceLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLabels not implemented")
+}
+func (UnimplementedNamespaceServiceServer) SetState(context.Context, *SetNamespaceStateRequest) (*SetNamespaceStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetState not implemented")
+}
 func (UnimplementedNamespaceServiceServer) Delete(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedNamespaceServiceServer) GetDeletionStatus(context.Context, *GetNamespaceDeletionStatusRequest) (*GetNamespaceDeletionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletionStatus not implemented")
+}
+func (UnimplementedNamespaceServiceServer) RetryDeletion(context.Context, *RetryNamespaceDeletionRequest) (*RetryNamespaceDeletionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDeletion not implemented")
+}
 func (UnimplementedNamespaceServiceServer) Get(context.Context, *GetNamespaceRequest) (*GetNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
@@ -194,6 +358,18 @@ func (UnimplementedNamespaceServiceServer) Exists(context.Context, *IsNamespaceE
 func (UnimplementedNamespaceServiceServer) Stat(context.Context, *GetNamespaceStatisticsRequest) (*GetNamespaceStatisticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stat not implemented")
 }
+func (UnimplementedNamespaceServiceServer) SetQuota(context.Context, *SetNamespaceQuotaRequest) (*SetNamespaceQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuota not implemented")
+}
+func (UnimplementedNamespaceServiceServer) GetQuota(context.Context, *GetNamespaceQuotaRequest) (*GetNamespaceQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (UnimplementedNamespaceServiceServer) Export(*ExportNamespaceRequest, NamespaceService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedNamespaceServiceServer) Import(NamespaceService_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 func (UnimplementedNamespaceServiceServer) mustEmbedUnimplementedNamespaceServiceServer() {}
 
 // UnsafeNamespaceServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -261,6 +437,42 @@ func _NamespaceService_Update_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NamespaceService_SetLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).SetLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_namespace.NamespaceService/SetLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).SetLabels(ctx, req.(*SetNamespaceLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_SetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).SetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_namespace.NamespaceService/SetState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).SetState(ctx, req.(*SetNamespaceStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NamespaceService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteNamespaceRequest)
 	if err := dec(in); err != nil {
@@ -279,6 +491,42 @@ func _NamespaceService_Delete_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NamespaceService_GetDeletionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceDeletionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).GetDeletionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_namespace.NamespaceService/GetDeletionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).GetDeletionStatus(ctx, req.(*GetNamespaceDeletionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_RetryDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryNamespaceDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).RetryDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_namespace.NamespaceService/RetryDeletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).RetryDeletion(ctx, req.(*RetryNamespaceDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NamespaceService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNamespaceRequest)
 	if err := dec(in); err != nil {
@@ -354,6 +602,89 @@ func _NamespaceService_Stat_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NamespaceService_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_namespace.NamespaceService/SetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).SetQuota(ctx, req.(*SetNamespaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).GetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_namespace.NamespaceService/GetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).GetQuota(ctx, req.(*GetNamespaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportNamespaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NamespaceServiceServer).Export(m, &namespaceServiceExportServer{stream})
+}
+
+type NamespaceService_ExportServer interface {
+	Send(*ExportNamespaceResponse) error
+	grpc.ServerStream
+}
+
+type namespaceServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *namespaceServiceExportServer) Send(m *ExportNamespaceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NamespaceService_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NamespaceServiceServer).Import(&namespaceServiceImportServer{stream})
+}
+
+type NamespaceService_ImportServer interface {
+	SendAndClose(*ImportNamespaceResponse) error
+	Recv() (*ImportNamespaceRequest, error)
+	grpc.ServerStream
+}
+
+type namespaceServiceImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *namespaceServiceImportServer) SendAndClose(m *ImportNamespaceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *namespaceServiceImportServer) Recv() (*ImportNamespaceRequest, error) {
+	m := new(ImportNamespaceRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // NamespaceService_ServiceDesc is the grpc.ServiceDesc for NamespaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -373,10 +704,26 @@ var NamespaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _NamespaceService_Update_Handler,
 		},
+		{
+			MethodName: "SetLabels",
+			Handler:    _NamespaceService_SetLabels_Handler,
+		},
+		{
+			MethodName: "SetState",
+			Handler:    _NamespaceService_SetState_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _NamespaceService_Delete_Handler,
 		},
+		{
+			MethodName: "GetDeletionStatus",
+			Handler:    _NamespaceService_GetDeletionStatus_Handler,
+		},
+		{
+			MethodName: "RetryDeletion",
+			Handler:    _NamespaceService_RetryDeletion_Handler,
+		},
 		{
 			MethodName: "Get",
 			Handler:    _NamespaceService_Get_Handler,
@@ -389,6 +736,14 @@ var NamespaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Stat",
 			Handler:    _NamespaceService_Stat_Handler,
 		},
+		{
+			MethodName: "SetQuota",
+			Handler:    _NamespaceService_SetQuota_Handler,
+		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _NamespaceService_GetQuota_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -396,6 +751,243 @@ var NamespaceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _NamespaceService_GetAll_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Export",
+			Handler:       _NamespaceService_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _NamespaceService_Import_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "namespace.proto",
+}
+
+// NamespaceSectionExporterServiceClient is the client API for NamespaceSectionExporterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NamespaceSectionExporterServiceClient interface {
+	// Streams records with the data of the module that belongs to the namespace
+	ExportSection(ctx context.Context, in *ExportNamespaceSectionRequest, opts ...grpc.CallOption) (NamespaceSectionExporterService_ExportSectionClient, error)
+	// Imports records of the module to the namespace
+	ImportSection(ctx context.Context, opts ...grpc.CallOption) (NamespaceSectionExporterService_ImportSectionClient, error)
+	// Counts usage of the namespace quotas by the existing data of the module. Used to count resources that were created before the limits were set.
+	CountQuotaUsage(ctx context.Context, in *CountNamespaceSectionQuotaUsageRequest, opts ...grpc.CallOption) (*CountNamespaceSectionQuotaUsageResponse, error)
+}
+
+type namespaceSectionExporterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNamespaceSectionExporterServiceClient(cc grpc.ClientConnInterface) NamespaceSectionExporterServiceClient {
+	return &namespaceSectionExporterServiceClient{cc}
+}
+
+func (c *namespaceSectionExporterServiceClient) ExportSection(ctx context.Context, in *ExportNamespaceSectionRequest, opts ...grpc.CallOption) (NamespaceSectionExporterService_ExportSectionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NamespaceSectionExporterService_ServiceDesc.Streams[0], "/native_namespace.NamespaceSectionExporterService/ExportSection", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &namespaceSectionExporterServiceExportSectionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NamespaceSectionExporterService_ExportSectionClient interface {
+	Recv() (*ExportNamespaceSectionResponse, error)
+	grpc.ClientStream
+}
+
+type namespaceSectionExporterServiceExportSectionClient struct {
+	grpc.ClientStream
+}
+
+func (x *namespaceSectionExporterServiceExportSectionClient) Recv() (*ExportNamespaceSectionResponse, error) {
+	m := new(ExportNamespaceSectionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *namespaceSectionExporterServiceClient) ImportSection(ctx context.Context, opts ...grpc.CallOption) (NamespaceSectionExporterService_ImportSectionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NamespaceSectionExporterService_ServiceDesc.Streams[1], "/native_namespace.NamespaceSectionExporterService/ImportSection", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &namespaceSectionExporterServiceImportSectionClient{stream}
+	return x, nil
+}
+
+type NamespaceSectionExporterService_ImportSectionClient interface {
+	Send(*ImportNamespaceSectionRequest) error
+	CloseAndRecv() (*ImportNamespaceSectionResponse, error)
+	grpc.ClientStream
+}
+
+type namespaceSectionExporterServiceImportSectionClient struct {
+	grpc.ClientStream
+}
+
+func (x *namespaceSectionExporterServiceImportSectionClient) Send(m *ImportNamespaceSectionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *namespaceSectionExporterServiceImportSectionClient) CloseAndRecv() (*ImportNamespaceSectionResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportNamespaceSectionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *namespaceSectionExporterServiceClient) CountQuotaUsage(ctx context.Context, in *CountNamespaceSectionQuotaUsageRequest, opts ...grpc.CallOption) (*CountNamespaceSectionQuotaUsageResponse, error) {
+	out := new(CountNamespaceSectionQuotaUsageResponse)
+	err := c.cc.Invoke(ctx, "/native_namespace.NamespaceSectionExporterService/CountQuotaUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NamespaceSectionExporterServiceServer is the server API for NamespaceSectionExporterService service.
+// All implementations must embed UnimplementedNamespaceSectionExporterServiceServer
+// for forward compatibility
+type NamespaceSectionExporterServiceServer interface {
+	// Streams records with the data of the module that belongs to the namespace
+	ExportSection(*ExportNamespaceSectionRequest, NamespaceSectionExporterService_ExportSectionServer) error
+	// Imports records of the module to the namespace
+	ImportSection(NamespaceSectionExporterService_ImportSectionServer) error
+	// Counts usage of the namespace quotas by the existing data of the module. Used to count resources that were created before the limits were set.
+	CountQuotaUsage(context.Context, *CountNamespaceSectionQuotaUsageRequest) (*CountNamespaceSectionQuotaUsageResponse, error)
+	mustEmbedUnimplementedNamespaceSectionExporterServiceServer()
+}
+
+// UnimplementedNamespaceSectionExporterServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNamespaceSectionExporterServiceServer struct {
+}
+
+func (UnimplementedNamespaceSectionExporterServiceServer) ExportSection(*ExportNamespaceSectionRequest, NamespaceSectionExporterService_ExportSectionServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSection not implemented")
+}
+func (UnimplementedNamespaceSectionExporterServiceServer) ImportSection(NamespaceSectionExporterService_ImportSectionServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSection not implemented")
+}
+func (UnimplementedNamespaceSectionExporterServiceServer) CountQuotaUsage(context.Context, *CountNamespaceSectionQuotaUsageRequest) (*CountNamespaceSectionQuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountQuotaUsage not implemented")
+}
+func (UnimplementedNamespaceSectionExporterServiceServer) mustEmbedUnimplementedNamespaceSectionExporterServiceServer() {
+}
+
+// UnsafeNamespaceSectionExporterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NamespaceSectionExporterServiceServer will
+// result in compilation errors.
+type UnsafeNamespaceSectionExporterServiceServer interface {
+	mustEmbedUnimplementedNamespaceSectionExporterServiceServer()
+}
+
+func RegisterNamespaceSectionExporterServiceServer(s grpc.ServiceRegistrar, srv NamespaceSectionExporterServiceServer) {
+	s.RegisterService(&NamespaceSectionExporterService_ServiceDesc, srv)
+}
+
+func _NamespaceSectionExporterService_ExportSection_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportNamespaceSectionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NamespaceSectionExporterServiceServer).ExportSection(m, &namespaceSectionExporterServiceExportSectionServer{stream})
+}
+
+type NamespaceSectionExporterService_ExportSectionServer interface {
+	Send(*ExportNamespaceSectionResponse) error
+	grpc.ServerStream
+}
+
+type namespaceSectionExporterServiceExportSectionServer struct {
+	grpc.ServerStream
+}
+
+func (x *namespaceSectionExporterServiceExportSectionServer) Send(m *ExportNamespaceSectionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NamespaceSectionExporterService_ImportSection_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NamespaceSectionExporterServiceServer).ImportSection(&namespaceSectionExporterServiceImportSectionServer{stream})
+}
+
+type NamespaceSectionExporterService_ImportSectionServer interface {
+	SendAndClose(*ImportNamespaceSectionResponse) error
+	Recv() (*ImportNamespaceSectionRequest, error)
+	grpc.ServerStream
+}
+
+type namespaceSectionExporterServiceImportSectionServer struct {
+	grpc.ServerStream
+}
+
+func (x *namespaceSectionExporterServiceImportSectionServer) SendAndClose(m *ImportNamespaceSectionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *namespaceSectionExporterServiceImportSectionServer) Recv() (*ImportNamespaceSectionRequest, error) {
+	m := new(ImportNamespaceSectionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NamespaceSectionExporterService_CountQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountNamespaceSectionQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceSectionExporterServiceServer).CountQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/native_namespace.NamespaceSectionExporterService/CountQuotaUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceSectionExporterServiceServer).CountQuotaUsage(ctx, req.(*CountNamespaceSectionQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NamespaceSectionExporterService_ServiceDesc is the grpc.ServiceDesc for NamespaceSectionExporterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NamespaceSectionExporterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "native_namespace.NamespaceSectionExporterService",
+	HandlerType: (*NamespaceSectionExporterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CountQuotaUsage",
+			Handler:    _NamespaceSectionExporterService_CountQuotaUsage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportSection",
+			Handler:       _NamespaceSectionExporterService_ExportSection_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportSection",
+			Handler:       _NamespaceSectionExporterService_ImportSection_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "namespace.proto",
 }
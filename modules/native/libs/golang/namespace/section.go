@@ -0,0 +1,89 @@
+package namespace
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Stores and restores data of the module for the namespace archives
+type SectionArchiver interface {
+	// Calls write for every index and document of the namespace. Data is BSON encoded.
+	Export(ctx context.Context, namespace string, write func(collection string, index bool, data []byte) error) error
+	// Imports index or document that was exported from the source namespace
+	Import(ctx context.Context, sourceNamespace string, namespace string, collection string, index bool, data []byte) error
+	// Counts usage of the quota resources by the existing data of the namespace. Key is the name of the resource.
+	CountQuotaUsage(ctx context.Context, namespace string) (map[string]int64, error)
+}
+
+type sectionExporterServer struct {
+	UnimplementedNamespaceSectionExporterServiceServer
+
+	section  string
+	archiver SectionArchiver
+}
+
+// Creates NamespaceSectionExporterService server that exports the module data as the section with provided name
+func NewSectionExporterServer(section string, archiver SectionArchiver) NamespaceSectionExporterServiceServer {
+	return &sectionExporterServer{
+		section:  section,
+		archiver: archiver,
+	}
+}
+
+func (s *sectionExporterServer) ExportSection(in *ExportNamespaceSectionRequest, out NamespaceSectionExporterService_ExportSectionServer) error {
+	err := s.archiver.Export(out.Context(), in.Namespace, func(collection string, index bool, data []byte) error {
+		kind := NamespaceArchiveRecord_DOCUMENT
+		if index {
+			kind = NamespaceArchiveRecord_INDEX
+		}
+		return out.Send(&ExportNamespaceSectionResponse{
+			Record: &NamespaceArchiveRecord{
+				Section:    s.section,
+				Collection: collection,
+				Kind:       kind,
+				Data:       data,
+			},
+		})
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func (s *sectionExporterServer) ImportSection(in NamespaceSectionExporterService_ImportSectionServer) error {
+	var imported uint64 = 0
+	for {
+		request, err := in.Recv()
+		if err == io.EOF {
+			return in.SendAndClose(&ImportNamespaceSectionResponse{Imported: imported})
+		}
+		if err != nil {
+			return err
+		}
+
+		if request.Record == nil || request.Record.Section != s.section {
+			return status.Error(codes.InvalidArgument, "Record doesnt belong to the ["+s.section+"] section")
+		}
+
+		err = s.archiver.Import(in.Context(), request.SourceNamespace, request.Namespace, request.Record.Collection, request.Record.Kind == NamespaceArchiveRecord_INDEX, request.Record.Data)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to import record of the ["+request.Record.Collection+"] collection: "+err.Error())
+		}
+		imported++
+	}
+}
+
+func (s *sectionExporterServer) CountQuotaUsage(ctx context.Context, in *CountNamespaceSectionQuotaUsageRequest) (*CountNamespaceSectionQuotaUsageResponse, error) {
+	usage, err := s.archiver.CountQuotaUsage(ctx, in.Namespace)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to count quota usage: "+err.Error())
+	}
+	return &CountNamespaceSectionQuotaUsageResponse{Usage: usage}, status.Error(codes.OK, "")
+}
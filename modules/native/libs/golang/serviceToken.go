@@ -0,0 +1,44 @@
+package native
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	iamWorkload "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/workload"
+)
+
+// Issues short-lived service tokens using the API key of the service identity. Tokens are issued by the native_iam.
+// Connection to the native_iam is established on the first call and doesnt use service credentials itself.
+// Returns nil if API key is empty, so the result can be passed directly to the serviceauth.DialOptions.
+func NewServiceTokenIssuer(apiKey string) func(ctx context.Context) (string, time.Time, error) {
+	if apiKey == "" {
+		return nil
+	}
+
+	var client iamWorkload.IAMWorkloadServiceClient
+	mu := sync.Mutex{}
+
+	return func(ctx context.Context) (string, time.Time, error) {
+		mu.Lock()
+		if client == nil {
+			var err error
+			_, client, err = NewIAMWorkloadConnection(getConfigEnv("NATIVE_IAM_URL", "native_iam:80"))
+			if err != nil {
+				mu.Unlock()
+				return "", time.Time{}, err
+			}
+		}
+		mu.Unlock()
+
+		response, err := client.IssueToken(ctx, &iamWorkload.IssueTokenRequest{ApiKey: apiKey})
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		if response.Status != iamWorkload.IssueTokenResponse_OK {
+			return "", time.Time{}, errors.New("native_iam refused to issue service token: " + response.Status.String())
+		}
+		return response.Token, response.ExpiresAt.AsTime(), nil
+	}
+}
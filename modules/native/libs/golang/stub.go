@@ -9,13 +9,21 @@ import (
 
 	iamActorUserGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/actor/user"
 	iamAuthGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	iamAuthenticationAPIKeyGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/apikey"
 	iamAuthenticationOAuth2Grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oauth2"
+	iamAuthenticationOIDCGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oidc"
 	iamAuthenticationPasswordGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/password"
+	iamAuthenticationSSOGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/sso"
+	iamAuthenticationTOTPGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/totp"
 	iamAuthenticationX509Grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/x509"
+	iamConfigGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/config"
+	iamGroupGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/group"
 	iamIdentityGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	iamOIDCGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/oidc"
 	iamPolicyGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
 	iamRoleGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
 	iamTokenGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+	iamWorkloadGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/workload"
 	keyvaluestorageGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
 	namespaceGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	storageBucketGrpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/storage/bucket"
@@ -35,8 +43,12 @@ type IamActorServices struct {
 
 type IamAuthenticationServices struct {
 	Password iamAuthenticationPasswordGrpc.IAMAuthenticationPasswordServiceClient
+	TOTP     iamAuthenticationTOTPGrpc.IAMAuthenticationTOTPServiceClient
 	X509     iamAuthenticationX509Grpc.IAMAuthenticationX509ServiceClient
 	OAuth    IamAuthenticationOAuthServices
+	SSO      iamAuthenticationSSOGrpc.IAMAuthenticationSSOServiceClient
+	OIDC     iamAuthenticationOIDCGrpc.IAMAuthenticationOIDCServiceClient
+	APIKey   iamAuthenticationAPIKeyGrpc.IAMAuthenticationAPIKeyServiceClient
 }
 
 type IamAuthenticationOAuthServices struct {
@@ -47,11 +59,15 @@ type IamAuthenticationOAuthServices struct {
 type IAMService struct {
 	Actor          *IamActorServices
 	Authentication *IamAuthenticationServices
+	Config         iamConfigGrpc.IAMConfigServiceClient
 	Identity       iamIdentityGrpc.IAMIdentityServiceClient
 	Auth           iamAuthGrpc.IAMAuthServiceClient
 	Policy         iamPolicyGrpc.IAMPolicyServiceClient
 	Role           iamRoleGrpc.IAMRoleServiceClient
+	Group          iamGroupGrpc.IAMGroupServiceClient
 	Token          iamTokenGrpc.IAMTokenServiceClient
+	OIDC           iamOIDCGrpc.IAMOIDCServiceClient
+	Workload       iamWorkloadGrpc.IAMWorkloadServiceClient
 }
 
 type StorageService struct {
@@ -80,6 +96,9 @@ type StubConfig struct {
 	iam GrpcServiceConfig
 
 	storage GrpcServiceConfig
+
+	// Additional options for all the connections. For example, service credentials.
+	dialOptions []grpc.DialOption
 }
 
 func NewStubConfig() *StubConfig {
@@ -109,6 +128,12 @@ func (sc *StubConfig) WithLogger(logger *log.Logger) *StubConfig {
 	return sc
 }
 
+// Adds options to all the connections of the stub
+func (sc *StubConfig) WithDialOptions(opts ...grpc.DialOption) *StubConfig {
+	sc.dialOptions = append(sc.dialOptions, opts...)
+	return sc
+}
+
 func (sc *StubConfig) WithNamespaceService(conf ...GrpcServiceConfig) *StubConfig {
 	if len(conf) != 0 {
 		sc.namespace = conf[0]
@@ -186,7 +211,7 @@ func (n *NativeStub) Connect() error {
 	}
 
 	if n.config.namespace.enabled {
-		conn, service, err := NewNamespaceConnection(n.config.namespace.url)
+		conn, service, err := NewNamespaceConnection(n.config.namespace.url, n.config.dialOptions...)
 		if err != nil {
 			n.log.Error("Error while connecting to the native_namespace service: " + err.Error())
 			n.closeConnections()
@@ -198,7 +223,7 @@ func (n *NativeStub) Connect() error {
 	}
 
 	if n.config.keyValueStorage.enabled {
-		conn, service, err := NewKeyValueStorageConnection(n.config.keyValueStorage.url)
+		conn, service, err := NewKeyValueStorageConnection(n.config.keyValueStorage.url, n.config.dialOptions...)
 		if err != nil {
 			n.log.Error("Error while connecting to the native_iam_keyValueStorage service: " + err.Error())
 			n.closeConnections()
@@ -210,7 +235,7 @@ func (n *NativeStub) Connect() error {
 	}
 
 	if n.config.iam.enabled {
-		conn, services, err := NewIAMConnection(n.config.iam.url)
+		conn, services, err := NewIAMConnection(n.config.iam.url, n.config.dialOptions...)
 		if err != nil {
 			n.log.Error("Error while connecting to the native_iam service: " + err.Error())
 			n.closeConnections()
@@ -222,7 +247,7 @@ func (n *NativeStub) Connect() error {
 	}
 
 	if n.config.storage.enabled {
-		conn, service, err := NewStorageConnection(n.config.storage.url)
+		conn, service, err := NewStorageConnection(n.config.storage.url, n.config.dialOptions...)
 		if err != nil {
 			n.log.Error("Error while connecting to the native_storage service: " + err.Error())
 			n.closeConnections()
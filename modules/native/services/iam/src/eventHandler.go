@@ -15,12 +15,19 @@ import (
 	system_nats "github.com/slamy-solutions/openbp/modules/system/libs/golang/nats"
 
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/actor/user"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/apikey"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/oauth"
+	authenticationOIDC "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/oidc"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/password"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/sso"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/totp"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/x509"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/group"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/identity"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/oidc"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/policy"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/role"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/token"
 
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	namespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
@@ -28,12 +35,15 @@ import (
 
 const (
 	NAMESPACE_CREATION_EVENT_CONSUMER_NAME = "native_iam_namespacecreation"
+	NAMESPACE_DELETION_EVENT_CONSUMER_NAME = "native_iam_namespacedeletion"
 )
 
 type eventHandlerService struct {
 	systemStub                       *system.SystemStub
 	nativeStub                       *native.NativeStub
+	js                               nats.JetStreamContext
 	namespaceCreateEventSubscription *nats.Subscription
+	namespaceDeleteEventSubscription *nats.Subscription
 
 	policyServer *policy.IAMPolicyServer
 	roleServer   *role.IAMRoleServer
@@ -46,12 +56,15 @@ func NewEventHandlerService(systemStub *system.SystemStub, nativeStub *native.Na
 		policyServer:                     policyServer,
 		roleServer:                       roleServer,
 		namespaceCreateEventSubscription: nil,
+		namespaceDeleteEventSubscription: nil,
 	}
 
 	js, err := systemStub.Nats.JetStream()
 	if err != nil {
 		return nil, errors.New("Error while opening jetsteram context. " + err.Error())
 	}
+	service.js = js
+
 	_, err = js.AddConsumer("native_namespace_event", &nats.ConsumerConfig{
 		Durable:        NAMESPACE_CREATION_EVENT_CONSUMER_NAME,
 		Name:           NAMESPACE_CREATION_EVENT_CONSUMER_NAME,
@@ -71,6 +84,25 @@ func NewEventHandlerService(systemStub *system.SystemStub, nativeStub *native.Na
 
 	service.namespaceCreateEventSubscription = subscribtion
 
+	_, err = js.AddConsumer("native_namespace_deletion", &nats.ConsumerConfig{
+		Durable:        NAMESPACE_DELETION_EVENT_CONSUMER_NAME,
+		Name:           NAMESPACE_DELETION_EVENT_CONSUMER_NAME,
+		Description:    "Listens on native_namespace deletion requests for native_iam",
+		AckPolicy:      nats.AckExplicitPolicy,
+		FilterSubject:  "native.namespace.deletion.requested",
+		DeliverSubject: "native.iam.deliver.namespace.delete",
+		DeliverGroup:   "native.iam.deliver.namespace.delete",
+	})
+	if err != nil {
+		return nil, errors.New("Error while creating consumer. " + err.Error())
+	}
+	subscribtion, err = js.QueueSubscribe("native.namespace.deletion.requested", "native.iam.deliver.namespace.delete", service.handleNamespaceDeletionEvent, nats.Bind("native_namespace_deletion", NAMESPACE_DELETION_EVENT_CONSUMER_NAME))
+	if err != nil {
+		return nil, errors.New("Error while creating subscribtion. " + err.Error())
+	}
+
+	service.namespaceDeleteEventSubscription = subscribtion
+
 	return service, nil
 }
 
@@ -79,6 +111,10 @@ func (s *eventHandlerService) Close() error {
 	if err != nil {
 		return errors.New("Error while unsubscribing from namespace events. " + err.Error())
 	}
+	err = s.namespaceDeleteEventSubscription.Unsubscribe()
+	if err != nil {
+		return errors.New("Error while unsubscribing from namespace deletion requests. " + err.Error())
+	}
 	return nil
 }
 
@@ -124,11 +160,21 @@ func (s *eventHandlerService) handleNamespaceCreationEvent(msg *nats.Msg) {
 		badAnswer(errors.New("failed to handle creation event for identity service: " + err.Error()))
 		return
 	}
+	err = group.HandleNamespaceCreationEvent(ctx, logger.WithField("service", "group"), &namespace, s.systemStub)
+	if err != nil {
+		badAnswer(errors.New("failed to handle creation event for group service: " + err.Error()))
+		return
+	}
 	err = password.HandleNamespaceCreationEvent(ctx, logger.WithField("service", "authentication_password"), &namespace, s.systemStub)
 	if err != nil {
 		badAnswer(errors.New("failed to handle creation event for authentication_password service: " + err.Error()))
 		return
 	}
+	err = totp.HandleNamespaceCreationEvent(ctx, logger.WithField("service", "authentication_totp"), &namespace, s.systemStub)
+	if err != nil {
+		badAnswer(errors.New("failed to handle creation event for authentication_totp service: " + err.Error()))
+		return
+	}
 	err = x509.HandleNamespaceCreationEvent(ctx, logger.WithField("service", "authentication_x509"), &namespace, s.systemStub)
 	if err != nil {
 		badAnswer(errors.New("failed to handle creation event for authentication_x509 service: " + err.Error()))
@@ -139,6 +185,26 @@ func (s *eventHandlerService) handleNamespaceCreationEvent(msg *nats.Msg) {
 		badAnswer(errors.New("failed to handle creation event for authentication_oauth service: " + err.Error()))
 		return
 	}
+	err = sso.HandleNamespaceCreationEvent(ctx, logger.WithField("service", "authentication_sso"), &namespace, s.systemStub)
+	if err != nil {
+		badAnswer(errors.New("failed to handle creation event for authentication_sso service: " + err.Error()))
+		return
+	}
+	err = authenticationOIDC.HandleNamespaceCreationEvent(ctx, logger.WithField("service", "authentication_oidc"), &namespace, s.systemStub)
+	if err != nil {
+		badAnswer(errors.New("failed to handle creation event for authentication_oidc service: " + err.Error()))
+		return
+	}
+	err = apikey.HandleNamespaceCreationEvent(ctx, logger.WithField("service", "authentication_apikey"), &namespace, s.systemStub)
+	if err != nil {
+		badAnswer(errors.New("failed to handle creation event for authentication_apikey service: " + err.Error()))
+		return
+	}
+	err = oidc.HandleNamespaceCreationEvent(ctx, logger.WithField("service", "oidc"), &namespace, s.systemStub)
+	if err != nil {
+		badAnswer(errors.New("failed to handle creation event for oidc service: " + err.Error()))
+		return
+	}
 	err = user.HandleNamespaceCreationEvent(ctx, logger.WithField("service", "actor_user"), &namespace, s.systemStub)
 	if err != nil {
 		badAnswer(errors.New("failed to handle creation event for actor_user service: " + err.Error()))
@@ -148,3 +214,75 @@ func (s *eventHandlerService) handleNamespaceCreationEvent(msg *nats.Msg) {
 	span.SetStatus(codes.Ok, "")
 	msg.Ack()
 }
+
+func (s *eventHandlerService) handleNamespaceDeletionEvent(msg *nats.Msg) {
+	ctx, span := system_nats.StartTelemetrySpanFromMessage(context.Background(), msg, "Handle namespace deletion event")
+	defer span.End()
+
+	var namespace namespaceGRPC.Namespace
+	err := proto.Unmarshal(msg.Data, &namespace)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to unmarshal namespace from event: "+err.Error())
+		span.RecordError(err)
+		// TODO: Dead leter queue
+		msg.Ack()
+		return
+	}
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "namespace",
+		Value: attribute.StringValue(namespace.Name),
+	})
+
+	// Handle event in the services
+	logger := logrus.StandardLogger()
+
+	// Reports result of the cleanup to the native_namespace
+	acknowledge := func(cleanupErr error) {
+		acknowledgement := &namespaceGRPC.NamespaceDeletionAcknowledgement{
+			Namespace:   namespace.Name,
+			Participant: NAMESPACE_DELETION_EVENT_CONSUMER_NAME,
+			Attempt:     namespace.DeletionAttempt,
+		}
+		if cleanupErr != nil {
+			acknowledgement.Error = cleanupErr.Error()
+		}
+		acknowledgementBytes, _ := proto.Marshal(acknowledgement)
+		_, err := s.js.Publish("native.namespace.deletion.acknowledged", acknowledgementBytes)
+		if err != nil {
+			span.SetStatus(codes.Error, "failed to publish acknowledgement: "+err.Error())
+			msg.NakWithDelay(time.Second * 5)
+			return
+		}
+
+		if cleanupErr != nil {
+			span.SetStatus(codes.Error, cleanupErr.Error())
+			//TODO: Dead letter queue
+			msg.NakWithDelay(time.Second * 5)
+			return
+		}
+		span.SetStatus(codes.Ok, "")
+		msg.Ack()
+	}
+
+	handlers := []struct {
+		service string
+		handle  func(ctx context.Context, logger *logrus.Entry, namespace *namespaceGRPC.Namespace, systemStub *system.SystemStub) error
+	}{
+		{"identity", identity.HandleNamespaceDeletionEvent},
+		{"policy", policy.HandleNamespaceDeletionEvent},
+		{"role", role.HandleNamespaceDeletionEvent},
+		{"token", token.HandleNamespaceDeletionEvent},
+		{"authentication_password", password.HandleNamespaceDeletionEvent},
+		{"authentication_x509", x509.HandleNamespaceDeletionEvent},
+		{"actor_user", user.HandleNamespaceDeletionEvent},
+	}
+	for _, handler := range handlers {
+		err = handler.handle(ctx, logger.WithField("service", handler.service), &namespace, s.systemStub)
+		if err != nil {
+			acknowledge(errors.New("failed to handle deletion event for " + handler.service + " service: " + err.Error()))
+			return
+		}
+	}
+
+	acknowledge(nil)
+}
@@ -15,23 +15,42 @@ import (
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	native_iam_actor_user_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/actor/user"
 	native_iam_auth_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	native_iam_authentication_apikey_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/apikey"
 	native_iam_authentication_oauth_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oauth2"
+	native_iam_authentication_oidc_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oidc"
 	native_iam_authentication_password_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/password"
+	native_iam_authentication_sso_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/sso"
+	native_iam_authentication_totp_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/totp"
 	native_iam_authentication_x509_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/x509"
+	native_iam_config_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/config"
+	native_iam_group_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/group"
 	native_iam_identity_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	native_iam_oidc_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/oidc"
 	native_iam_policy_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
 	native_iam_role_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
 	native_iam_token_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+	native_iam_workload_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/workload"
+	native_namespace_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/actor/user"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/auth"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/apikey"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/oauth"
+	authenticationOIDC "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/oidc"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/password"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/sso"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/totp"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/x509"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/config"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/group"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/identity"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/oidc"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/policy"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/role"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/token"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/workload"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
 )
 
 const (
@@ -64,7 +83,17 @@ func main() {
 	}
 	defer systemStub.Close(context.Background())
 
-	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService())
+	serviceAuthConfig := serviceauth.NewConfigFromEnv("native_iam").WithOnViolation(logServiceAuthViolation)
+	serviceAuthDialOptions, err := serviceauth.DialOptions(serviceAuthConfig, native.NewServiceTokenIssuer(serviceAuthConfig.APIKey))
+	if err != nil {
+		panic("Failed to setup service authentication for clients: " + err.Error())
+	}
+	serviceAuthenticator, err := serviceauth.NewAuthenticator(serviceAuthConfig, systemStub.Vault, makeServiceAuthRules(), servicePublicMethods...)
+	if err != nil {
+		panic("Failed to setup service authentication: " + err.Error())
+	}
+
+	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithDialOptions(serviceAuthDialOptions...))
 	err = nativeStub.Connect()
 	if err != nil {
 		panic("Failed to connect to native services: " + err.Error())
@@ -72,13 +101,16 @@ func main() {
 	defer nativeStub.Close()
 
 	// Creating grpc server
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
-		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
-		grpc.KeepaliveParams(keepalive.ServerParameters{
-			MaxConnectionAge: time.Minute * 5,
-		}),
-	)
+	grpcServer := grpc.NewServer(append(
+		[]grpc.ServerOption{
+			grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+			grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+			grpc.KeepaliveParams(keepalive.ServerParameters{
+				MaxConnectionAge: time.Minute * 5,
+			}),
+		},
+		serviceAuthenticator.ServerOptions()...,
+	)...)
 
 	policyServer, err := policy.NewIAMPolicyServer(context.Background(), systemStub, nativeStub)
 	if err != nil {
@@ -98,16 +130,38 @@ func main() {
 	}
 	native_iam_identity_grpc.RegisterIAMIdentityServiceServer(grpcServer, identityServer)
 
-	tokenServer := token.NewIAmTokenServer(systemStub, nativeStub)
+	sweeperContext, stopSweeper := context.WithCancel(context.Background())
+	defer stopSweeper()
+	go identityServer.RunExpiredAssignmentsSweeper(sweeperContext)
+
+	groupServer, err := group.NewIAMGroupServer(context.Background(), systemStub, identityServer, policyServer, roleServer)
+	if err != nil {
+		panic("Failed to startup group server: " + err.Error())
+	}
+	native_iam_group_grpc.RegisterIAMGroupServiceServer(grpcServer, groupServer)
+
+	tokenServer, err := token.NewIAmTokenServer(systemStub, nativeStub)
+	if err != nil {
+		panic("Failed to startup token server: " + err.Error())
+	}
 	native_iam_token_grpc.RegisterIAMTokenServiceServer(grpcServer, tokenServer)
 
-	authenticationPasswordServer, err := password.NewPasswordIdentificationService(context.Background(), systemStub, nativeStub)
+	configServer := config.NewIAMConfigServer(systemStub)
+	native_iam_config_grpc.RegisterIAMConfigServiceServer(grpcServer, configServer)
+
+	authenticationPasswordServer, err := password.NewPasswordIdentificationService(context.Background(), systemStub, nativeStub, configServer)
 	if err != nil {
 		panic("Failed to startup authentication_password server: " + err.Error())
 	}
 	native_iam_authentication_password_grpc.RegisterIAMAuthenticationPasswordServiceServer(grpcServer, authenticationPasswordServer)
 
-	authenticationX509Server, err := x509.NewX509IdentificationService(context.Background(), systemStub, nativeStub)
+	authenticationTOTPServer, err := totp.NewTOTPIdentificationService(context.Background(), systemStub, nativeStub, identityServer)
+	if err != nil {
+		panic("Failed to startup authentication_totp server: " + err.Error())
+	}
+	native_iam_authentication_totp_grpc.RegisterIAMAuthenticationTOTPServiceServer(grpcServer, authenticationTOTPServer)
+
+	authenticationX509Server, err := x509.NewX509IdentificationService(context.Background(), systemStub, nativeStub, configServer, identityServer)
 	if err != nil {
 		panic("Failed to startup authentication_x509 server: " + err.Error())
 	}
@@ -122,8 +176,11 @@ func main() {
 	}
 	native_iam_authentication_oauth_grpc.RegisterIAMAuthenticationOAuth2ServiceServer(grpcServer, authenticationOAuth2Server)
 
-	iamAuthServer := auth.NewIAmAuthServer(systemStub, authenticationPasswordServer, authenticationX509Server, authenticationOAuth2Server, identityServer, policyServer, roleServer, tokenServer)
-	native_iam_auth_grpc.RegisterIAMAuthServiceServer(grpcServer, iamAuthServer)
+	authenticationSSOServer, err := sso.NewSSOServer(context.Background(), systemStub, nativeStub, identityServer)
+	if err != nil {
+		panic("Failed to startup authentication_sso server: " + err.Error())
+	}
+	native_iam_authentication_sso_grpc.RegisterIAMAuthenticationSSOServiceServer(grpcServer, authenticationSSOServer)
 
 	iamActorUserServer, err := user.NewActorUserServer(context.Background(), systemStub, identityServer)
 	if err != nil {
@@ -131,6 +188,32 @@ func main() {
 	}
 	native_iam_actor_user_grpc.RegisterActorUserServiceServer(grpcServer, iamActorUserServer)
 
+	authenticationOIDCServer, err := authenticationOIDC.NewOIDCServer(context.Background(), systemStub, nativeStub, identityServer, iamActorUserServer)
+	if err != nil {
+		panic("Failed to startup authentication_oidc server: " + err.Error())
+	}
+	native_iam_authentication_oidc_grpc.RegisterIAMAuthenticationOIDCServiceServer(grpcServer, authenticationOIDCServer)
+
+	authenticationAPIKeyServer, err := apikey.NewAPIKeyService(context.Background(), systemStub, nativeStub, identityServer, auth.AreAPIKeyScopesAllowed)
+	if err != nil {
+		panic("Failed to startup authentication_apikey server: " + err.Error())
+	}
+	native_iam_authentication_apikey_grpc.RegisterIAMAuthenticationAPIKeyServiceServer(grpcServer, authenticationAPIKeyServer)
+
+	workloadServer := workload.NewIAMWorkloadServer(identityServer, authenticationAPIKeyServer, tokenServer)
+	native_iam_workload_grpc.RegisterIAMWorkloadServiceServer(grpcServer, workloadServer)
+
+	iamAuthServer := auth.NewIAmAuthServer(systemStub, nativeStub, authenticationPasswordServer, authenticationTOTPServer, authenticationX509Server, authenticationOAuth2Server, authenticationSSOServer, authenticationOIDCServer, authenticationAPIKeyServer, identityServer, tokenServer)
+	native_iam_auth_grpc.RegisterIAMAuthServiceServer(grpcServer, iamAuthServer)
+
+	iamOIDCServer, err := oidc.NewOIDCServer(context.Background(), systemStub, nativeStub, identityServer, tokenServer, iamAuthServer)
+	if err != nil {
+		panic("Failed to startup oidc server: " + err.Error())
+	}
+	native_iam_oidc_grpc.RegisterIAMOIDCServiceServer(grpcServer, iamOIDCServer)
+
+	native_namespace_grpc.RegisterNamespaceSectionExporterServiceServer(grpcServer, native_namespace_grpc.NewSectionExporterServer("native_iam", db.NewNamespaceArchiver(systemStub.DB, namespaceArchiveCollections...)))
+
 	eventHandler, err := NewEventHandlerService(systemStub, nativeStub, policyServer, roleServer)
 	if err != nil {
 		panic("failed to setup event hanle service: " + err.Error())
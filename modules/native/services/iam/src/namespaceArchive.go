@@ -0,0 +1,17 @@
+package main
+
+import (
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
+)
+
+// Collections of the namespace database that are included in the namespace archive.
+// Tokens, API keys, second factors and external authentication links are bound to the environment and are not exported.
+var namespaceArchiveCollections = []db.ArchiveCollection{
+	{Name: "native_iam_identity", Quota: []db.ArchiveQuota{{Resource: quota.IAM_IDENTITIES}}},
+	{Name: "native_iam_policy"},
+	{Name: "native_iam_role"},
+	{Name: "native_iam_group", HexReferenceFields: []string{"identities", "groups"}},
+	{Name: "native_iam_actor_user", HexReferenceFields: []string{"identity"}},
+	{Name: "native_iam_authentication_password", HexReferenceFields: []string{"identity"}},
+}
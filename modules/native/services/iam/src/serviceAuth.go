@@ -0,0 +1,56 @@
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
+)
+
+// Methods that can be called without service credentials. Services exchange API key for the token using them.
+var servicePublicMethods = []string{
+	"/native_iam_workload.IAMWorkloadService/IssueToken",
+}
+
+// Services that can change policies, roles, assignments and other IAM data. Changes made by users come through the tools_rest.
+func getPolicyManagerServices() []string {
+	if value, ok := os.LookupEnv("NATIVE_IAM_POLICY_MANAGER_SERVICES"); ok {
+		return strings.Split(value, ",")
+	}
+	return []string{"tools_rest"}
+}
+
+// Returns new list with managers and additional services. Managers list is not modified.
+func withManagers(managers []string, services ...string) []string {
+	return append(append(make([]string, 0, len(managers)+len(services)), managers...), services...)
+}
+
+// Calls are denied by default. Only managers can call IAM services, other services get access only to the methods they need.
+func makeServiceAuthRules() serviceauth.Rules {
+	managers := getPolicyManagerServices()
+	iotCore := withManagers(managers, "iot_core")
+
+	return serviceauth.Rules{
+		serviceauth.DefaultRule: managers,
+
+		// Access checks for the SDK clients
+		"/native_iam_auth.IAMAuthService/": withManagers(managers, "tools_sdk"),
+
+		// Users of the CRM are managed by the crm_core
+		"/native_iam_actor_user.ActorUserService/": withManagers(managers, "crm_core"),
+
+		// Identities of the IoT devices are managed by the iot_core. Assignments of the policies and roles are only changed by managers.
+		"/native_iam_identity.IAMIdentityService/Create":                    iotCore,
+		"/native_iam_identity.IAMIdentityService/Get":                       iotCore,
+		"/native_iam_identity.IAMIdentityService/Exists":                    iotCore,
+		"/native_iam_identity.IAMIdentityService/Delete":                    iotCore,
+		"/native_iam_identity.IAMIdentityService/GetServiceManagedIdentity": iotCore,
+
+		"/native_namespace.NamespaceSectionExporterService/": {"native_namespace"},
+	}
+}
+
+func logServiceAuthViolation(fullMethod string, err error) {
+	logrus.WithField("method", fullMethod).Warn("Service authentication failed: " + err.Error())
+}
@@ -9,22 +9,28 @@ import (
 
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
 
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	nativeIAmAuthGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	nativeIAmAuthenticationAPIKeyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/apikey"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oauth2"
+	nativeIAmAuthenticationOIDCGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oidc"
 	nativeIAmAuthenticationPasswordGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/password"
+	nativeIAmAuthenticationSSOGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/sso"
+	nativeIAmAuthenticationTOTPGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/totp"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/x509"
 	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
 	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
-	nativeIAmRoleGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
 	nativeIAmTokenGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
 
+	authentication_apikey "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/apikey"
 	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/oauth"
 	authentication_OAuth "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/oauth"
+	authentication_oidc "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/oidc"
 	authentication_password "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/password"
+	authentication_sso "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/sso"
+	authentication_totp "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/totp"
 	authentication_x509 "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/x509"
 	identity_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/identity"
-	policy_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/policy"
-	role_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/role"
 	token_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/token"
 )
 
@@ -32,112 +38,70 @@ type IAmAuthServer struct {
 	nativeIAmAuthGRPC.UnimplementedIAMAuthServiceServer
 
 	systemStub *system.SystemStub
+	nativeStub *native.NativeStub
 
 	authenticationPasswordServer *authentication_password.PasswordIdentificationService
+	authenticationTOTPServer     *authentication_totp.TOTPIdentificationService
 	authenticationX509Server     *authentication_x509.X509IdentificationServer
 	authenticationOAuthServer    *authentication_OAuth.OAuthServer
+	authenticationSSOServer      *authentication_sso.SSOServer
+	authenticationOIDCServer     *authentication_oidc.OIDCServer
+	authenticationAPIKeyServer   *authentication_apikey.APIKeyService
 	identityServer               *identity_server.IAmIdentityServer
-	policyServer                 *policy_server.IAMPolicyServer
-	roleServer                   *role_server.IAMRoleServer
 	tokenServer                  *token_server.IAmTokenServer
 }
 
 func NewIAmAuthServer(
 	systemStub *system.SystemStub,
+	nativeStub *native.NativeStub,
 	authenticationPasswordServer *authentication_password.PasswordIdentificationService,
+	authenticationTOTPServer *authentication_totp.TOTPIdentificationService,
 	authenticationX509Server *authentication_x509.X509IdentificationServer,
 	authenticationOAuthServer *authentication_OAuth.OAuthServer,
+	authenticationSSOServer *authentication_sso.SSOServer,
+	authenticationOIDCServer *authentication_oidc.OIDCServer,
+	authenticationAPIKeyServer *authentication_apikey.APIKeyService,
 	identityServer *identity_server.IAmIdentityServer,
-	policyServer *policy_server.IAMPolicyServer,
-	roleServer *role_server.IAMRoleServer,
 	tokenServer *token_server.IAmTokenServer,
 ) *IAmAuthServer {
 	return &IAmAuthServer{
 		systemStub:                   systemStub,
+		nativeStub:                   nativeStub,
 		authenticationPasswordServer: authenticationPasswordServer,
+		authenticationTOTPServer:     authenticationTOTPServer,
 		authenticationX509Server:     authenticationX509Server,
 		authenticationOAuthServer:    authenticationOAuthServer,
+		authenticationSSOServer:      authenticationSSOServer,
+		authenticationOIDCServer:     authenticationOIDCServer,
+		authenticationAPIKeyServer:   authenticationAPIKeyServer,
 		identityServer:               identityServer,
-		policyServer:                 policyServer,
-		roleServer:                   roleServer,
 		tokenServer:                  tokenServer,
 	}
 }
 
+// Fetches all the policies of the identity (directly assigned, assigned through the roles and through the groups). Every policy is returned only once.
 func (s *IAmAuthServer) fetchIdentityPolicies(ctx context.Context, identity *nativeIAmIdentityGRPC.Identity) ([]*nativeIAmPolicyGRPC.Policy, error) {
-	searchedPolicies := make([]*nativeIAmPolicyGRPC.GetMultiplePoliciesRequest_RequestedPolicy, len(identity.Policies))
-	for index, policy := range identity.Policies {
-		searchedPolicies[index] = &nativeIAmPolicyGRPC.GetMultiplePoliciesRequest_RequestedPolicy{
-			Namespace: policy.Namespace,
-			Uuid:      policy.Uuid,
-		}
-	}
-
-	// Get all the identity roles. Get list of policies assigned for each role
-	if len(identity.Roles) > 0 {
-		requestedIdentityRoles := make([]*nativeIAmRoleGRPC.GetMultipleRolesRequest_RequestedRole, len(identity.Roles))
-		for index, role := range identity.Roles {
-			requestedIdentityRoles[index] = &nativeIAmRoleGRPC.GetMultipleRolesRequest_RequestedRole{
-				Namespace: role.Namespace,
-				Uuid:      role.Uuid,
-			}
-		}
-		responseStream := s.roleServer.OpenGetMultipleChannel(ctx, &nativeIAmRoleGRPC.GetMultipleRolesRequest{Roles: requestedIdentityRoles})
-
-		var err error = nil
-		for role := range responseStream {
-			if err != nil {
-				continue
-			}
-			if role.Err != nil {
-				err = role.Err
-				continue
-			}
-
-			for _, policy := range role.Role.Policies {
-				searchedPolicies = append(searchedPolicies, &nativeIAmPolicyGRPC.GetMultiplePoliciesRequest_RequestedPolicy{
-					Namespace: policy.Namespace,
-					Uuid:      policy.UUID,
-				})
-			}
-		}
-
-		// TODO: make sure policies are unique
-
-		if err != nil {
-			return nil, errors.New("error while fetching all roles for the identity: " + err.Error())
-		}
+	assigned, err := s.identityServer.FetchAssignedPolicies(ctx, identity)
+	if err != nil {
+		return nil, err
 	}
+	return identity_server.UniquePolicies(assigned), nil
+}
 
-	policies := make([]*nativeIAmPolicyGRPC.Policy, 0, len(searchedPolicies))
-	policiesStream := s.policyServer.OpenGetMultipleChannel(ctx, &nativeIAmPolicyGRPC.GetMultiplePoliciesRequest{
-		Policies: searchedPolicies,
-	})
-
-	var err error = nil
-	for policy := range policiesStream {
-		if err != nil {
-			continue
-		}
-		if policy.Err != nil {
-			err = policy.Err
-			continue
-		}
+var ErrCreateTokenIdentityNotActive = errors.New("identity not active")
+var ErrCreateTokenUnauthorized = errors.New("unauthorized")
 
-		policies = append(policies, policy.Policy.ToGRPCPolicy(policy.Namespace))
-	}
+// Creates token for the identity if identity is active and its policies allow requested scopes. Used by the OIDC authorization server to issue tokens for its clients.
+func (s *IAmAuthServer) CreateTokenForIdentity(ctx context.Context, namespace string, identity string, scopes []*nativeIAmAuthGRPC.Scope, metadata string, authMethod nativeIAmPolicyGRPC.AuthMethod, mfa bool) (string, string, error) {
+	return s.createTokenForIdentity(ctx, namespace, identity, scopes, metadata, authMethod, mfa)
+}
 
+func (s *IAmAuthServer) createTokenForIdentity(ctx context.Context, namespace string, identity string, scopes []*nativeIAmAuthGRPC.Scope, metadata string, authMethod nativeIAmPolicyGRPC.AuthMethod, mfa bool) (string, string, error) {
+	err := s.CheckNamespaceCanCreateTokens(ctx, namespace)
 	if err != nil {
-		return nil, errors.New("error while fetching all policies for the identity: " + err.Error())
+		return "", "", err
 	}
 
-	return policies, nil
-}
-
-var errCreateTokenIdentityNotActive = errors.New("identity not active")
-var errCreateTokenUnauthorized = errors.New("unauthorized")
-
-func (s *IAmAuthServer) createTokenForIdentity(ctx context.Context, namespace string, identity string, scopes []*nativeIAmAuthGRPC.Scope, metadata string) (string, string, error) {
 	// Check if identity is not disabled. Dont use cache, because it is rare operation and invalid cache will result in allowing access for very long period of time
 	identityResponse, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{Namespace: namespace, Uuid: identity, UseCache: false})
 	if err != nil {
@@ -151,7 +115,7 @@ func (s *IAmAuthServer) createTokenForIdentity(ctx context.Context, namespace st
 		return "", "", status.Error(codes.Internal, "Failed to get identity information: "+err.Error())
 	}
 	if !identityResponse.Identity.Active {
-		return "", "", errCreateTokenIdentityNotActive
+		return "", "", ErrCreateTokenIdentityNotActive
 	}
 
 	// Get all policies for identity
@@ -160,40 +124,20 @@ func (s *IAmAuthServer) createTokenForIdentity(ctx context.Context, namespace st
 		return "", "", status.Error(codes.Internal, "Failed to get policy information for identity: "+err.Error())
 	}
 
-	if !arePoliciesAllowScopes(policies, scopes) {
-		return "", "", errCreateTokenUnauthorized
-	}
-
-	// Get all policies as scopes if list of scopes in request is empty
-	var scopesToAssign []*nativeIAmTokenGRPC.Scope
-	if len(scopes) == 0 {
-		scopesToAssign = make([]*nativeIAmTokenGRPC.Scope, len(policies))
-		for i, policy := range policies {
-			scopesToAssign[i] = &nativeIAmTokenGRPC.Scope{
-				Namespace:            policy.Namespace,
-				Resources:            policy.Resources,
-				Actions:              policy.Actions,
-				NamespaceIndependent: policy.NamespaceIndependent,
-			}
-		}
-	} else {
-		scopesToAssign = make([]*nativeIAmTokenGRPC.Scope, len(scopes))
-		for i, scope := range scopes {
-			scopesToAssign[i] = &nativeIAmTokenGRPC.Scope{
-				Namespace:            scope.Namespace,
-				Resources:            scope.Resources,
-				Actions:              scope.Actions,
-				NamespaceIndependent: scope.NamespaceIndependent,
-			}
-		}
+	accessCtx := newTokenCreationAccessContext(authMethod, mfa)
+	if !arePoliciesAllowScopes(policies, scopes, accessCtx) {
+		return "", "", ErrCreateTokenUnauthorized
 	}
+	scopesToAssign := makeTokenScopes(policies, scopes, accessCtx)
 
 	// Generate new login token
 	tokenResponse, err := s.tokenServer.Create(ctx, &nativeIAmTokenGRPC.CreateRequest{
-		Namespace: namespace,
-		Identity:  identity,
-		Scopes:    scopesToAssign,
-		Metadata:  metadata,
+		Namespace:  namespace,
+		Identity:   identity,
+		Scopes:     scopesToAssign,
+		Metadata:   metadata,
+		AuthMethod: nativeIAmTokenGRPC.AuthMethod(authMethod),
+		Mfa:        mfa,
 	})
 	if err != nil {
 		return "", "", status.Error(codes.Internal, "Failed to create token. "+err.Error())
@@ -203,24 +147,72 @@ func (s *IAmAuthServer) createTokenForIdentity(ctx context.Context, namespace st
 }
 
 func (s *IAmAuthServer) CreateTokenWithPassword(ctx context.Context, in *nativeIAmAuthGRPC.CreateTokenWithPasswordRequest) (*nativeIAmAuthGRPC.CreateTokenWithPasswordResponse, error) {
-	authenticateResponse, err := s.authenticationPasswordServer.Authenticate(ctx, &nativeIAmAuthenticationPasswordGRPC.AuthenticateRequest{
+	// If identity has second factor enabled, it must be verified before creating the token.
+	// Failed attempts of the password are only reset after the second factor is verified, so the second factor is protected from brute-force the same way as the password.
+	totpStatusResponse, err := s.authenticationTOTPServer.GetStatus(ctx, &nativeIAmAuthenticationTOTPGRPC.GetStatusRequest{
 		Namespace: in.Namespace,
 		Identity:  in.Identity,
-		Password:  in.Password,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error while checking TOTP status: "+err.Error())
+	}
+
+	authenticateResponse, err := s.authenticationPasswordServer.Authenticate(ctx, &nativeIAmAuthenticationPasswordGRPC.AuthenticateRequest{
+		Namespace:            in.Namespace,
+		Identity:             in.Identity,
+		Password:             in.Password,
+		SourceIP:             in.Context.GetSourceIP(),
+		SecondFactorRequired: totpStatusResponse.Enabled,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Error while performing authentication: "+err.Error())
 	}
+	if authenticateResponse.Throttled {
+		return &nativeIAmAuthGRPC.CreateTokenWithPasswordResponse{Status: nativeIAmAuthGRPC.CreateTokenWithPasswordResponse_TOO_MANY_ATTEMPTS, RetryAfter: authenticateResponse.RetryAfter}, nil
+	}
 	if !authenticateResponse.Authenticated {
 		return &nativeIAmAuthGRPC.CreateTokenWithPasswordResponse{Status: nativeIAmAuthGRPC.CreateTokenWithPasswordResponse_CREDENTIALS_INVALID}, nil
 	}
 
-	accessToken, refreshToken, err := s.createTokenForIdentity(ctx, in.Namespace, in.Identity, in.Scopes, in.Metadata)
+	mfa := false
+	if totpStatusResponse.Enabled {
+		if in.Totp == "" {
+			return &nativeIAmAuthGRPC.CreateTokenWithPasswordResponse{Status: nativeIAmAuthGRPC.CreateTokenWithPasswordResponse_TOTP_REQUIRED}, nil
+		}
+
+		verifyResponse, err := s.authenticationTOTPServer.Verify(ctx, &nativeIAmAuthenticationTOTPGRPC.VerifyRequest{
+			Namespace: in.Namespace,
+			Identity:  in.Identity,
+			Code:      in.Totp,
+		})
+		if err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+				return nil, status.Error(codes.FailedPrecondition, "failed to verify TOTP. most probably vault is sealed: "+err.Error())
+			}
+			return nil, status.Error(codes.Internal, "Error while verifying TOTP: "+err.Error())
+		}
+		if verifyResponse.Status != nativeIAmAuthenticationTOTPGRPC.VerifyResponse_OK {
+			retryAfter, err := s.authenticationPasswordServer.RegisterSecondFactorFailure(ctx, in.Namespace, in.Identity, in.Context.GetSourceIP())
+			if err != nil {
+				return nil, status.Error(codes.Internal, "Failed to register failed attempt: "+err.Error())
+			}
+			return &nativeIAmAuthGRPC.CreateTokenWithPasswordResponse{Status: nativeIAmAuthGRPC.CreateTokenWithPasswordResponse_TOTP_INVALID, RetryAfter: retryAfter}, nil
+		}
+		if err := s.authenticationPasswordServer.ResetFailedAttemptsAfterSecondFactor(ctx, in.Namespace, in.Identity); err != nil {
+			return nil, status.Error(codes.Internal, "Failed to reset failed attempts: "+err.Error())
+		}
+		mfa = true
+	}
+
+	accessToken, refreshToken, err := s.createTokenForIdentity(ctx, in.Namespace, in.Identity, in.Scopes, in.Metadata, nativeIAmPolicyGRPC.AuthMethod_PASSWORD, mfa)
 	if err != nil {
-		if err == errCreateTokenIdentityNotActive {
+		if err == ErrCreateTokenNamespaceSuspended {
+			return &nativeIAmAuthGRPC.CreateTokenWithPasswordResponse{Status: nativeIAmAuthGRPC.CreateTokenWithPasswordResponse_NAMESPACE_SUSPENDED}, nil
+		}
+		if err == ErrCreateTokenIdentityNotActive {
 			return &nativeIAmAuthGRPC.CreateTokenWithPasswordResponse{Status: nativeIAmAuthGRPC.CreateTokenWithPasswordResponse_IDENTITY_NOT_ACTIVE}, nil
 		}
-		if err == errCreateTokenUnauthorized {
+		if err == ErrCreateTokenUnauthorized {
 			return &nativeIAmAuthGRPC.CreateTokenWithPasswordResponse{Status: nativeIAmAuthGRPC.CreateTokenWithPasswordResponse_UNAUTHORIZED}, nil
 		}
 
@@ -228,9 +220,10 @@ func (s *IAmAuthServer) CreateTokenWithPassword(ctx context.Context, in *nativeI
 	}
 
 	return &nativeIAmAuthGRPC.CreateTokenWithPasswordResponse{
-		Status:       nativeIAmAuthGRPC.CreateTokenWithPasswordResponse_OK,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		Status:                 nativeIAmAuthGRPC.CreateTokenWithPasswordResponse_OK,
+		AccessToken:            accessToken,
+		RefreshToken:           refreshToken,
+		PasswordChangeRequired: authenticateResponse.ChangeRequired,
 	}, status.Error(codes.OK, "")
 }
 
@@ -265,12 +258,15 @@ func (s *IAmAuthServer) CreateTokenWithOAuth2(ctx context.Context, in *nativeIAm
 
 	}
 
-	accessToken, refreshToken, err := s.createTokenForIdentity(ctx, in.Namespace, authenticateResponse.Identity, in.Scopes, in.Metadata)
+	accessToken, refreshToken, err := s.createTokenForIdentity(ctx, in.Namespace, authenticateResponse.Identity, in.Scopes, in.Metadata, nativeIAmPolicyGRPC.AuthMethod_OAUTH2, false)
 	if err != nil {
-		if err == errCreateTokenIdentityNotActive {
+		if err == ErrCreateTokenNamespaceSuspended {
+			return &nativeIAmAuthGRPC.CreateTokenWithOAuth2Response{Status: nativeIAmAuthGRPC.CreateTokenWithOAuth2Response_NAMESPACE_SUSPENDED}, nil
+		}
+		if err == ErrCreateTokenIdentityNotActive {
 			return &nativeIAmAuthGRPC.CreateTokenWithOAuth2Response{Status: nativeIAmAuthGRPC.CreateTokenWithOAuth2Response_IDENTITY_NOT_ACTIVE}, nil
 		}
-		if err == errCreateTokenUnauthorized {
+		if err == ErrCreateTokenUnauthorized {
 			return &nativeIAmAuthGRPC.CreateTokenWithOAuth2Response{Status: nativeIAmAuthGRPC.CreateTokenWithOAuth2Response_UNAUTHORIZED}, nil
 		}
 
@@ -284,28 +280,154 @@ func (s *IAmAuthServer) CreateTokenWithOAuth2(ctx context.Context, in *nativeIAm
 	}, status.Error(codes.OK, "")
 }
 
-func (s *IAmAuthServer) RefreshToken(ctx context.Context, in *nativeIAmAuthGRPC.RefreshTokenRequest) (*nativeIAmAuthGRPC.RefreshTokenResponse, error) {
-	refershResponse, err := s.tokenServer.Refresh(ctx, &nativeIAmTokenGRPC.RefreshRequest{
-		RefreshToken: in.RefreshToken,
+func (s *IAmAuthServer) CreateTokenWithSSO(ctx context.Context, in *nativeIAmAuthGRPC.CreateTokenWithSSORequest) (*nativeIAmAuthGRPC.CreateTokenWithSSOResponse, error) {
+	authenticateResponse, err := s.authenticationSSOServer.Authenticate(ctx, &nativeIAmAuthenticationSSOGRPC.AuthenticateRequest{
+		Namespace:    in.Namespace,
+		Provider:     in.Provider,
+		SamlResponse: in.SamlResponse,
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to refresh token token. "+err.Error())
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			return nil, status.Error(codes.FailedPrecondition, "failed to authenticate. most probably vault is sealed: "+err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "failed to authenticate: "+err.Error())
 	}
-	switch refershResponse.Status {
-	case nativeIAmTokenGRPC.RefreshResponse_OK:
+
+	switch authenticateResponse.Status {
+	case nativeIAmAuthenticationSSOGRPC.AuthenticateResponse_OK:
 		break
+	case nativeIAmAuthenticationSSOGRPC.AuthenticateResponse_PROVIDER_NOT_FOUND, nativeIAmAuthenticationSSOGRPC.AuthenticateResponse_PROVIDER_DISABLED:
+		return &nativeIAmAuthGRPC.CreateTokenWithSSOResponse{Status: nativeIAmAuthGRPC.CreateTokenWithSSOResponse_UNAUTHENTICATED, Message: "SAML provider doesnt exist or disabled"}, nil
+	case nativeIAmAuthenticationSSOGRPC.AuthenticateResponse_RESPONSE_INVALID, nativeIAmAuthenticationSSOGRPC.AuthenticateResponse_SIGNATURE_INVALID, nativeIAmAuthenticationSSOGRPC.AuthenticateResponse_ASSERTION_EXPIRED, nativeIAmAuthenticationSSOGRPC.AuthenticateResponse_UNAUTHENTICATED:
+		return &nativeIAmAuthGRPC.CreateTokenWithSSOResponse{Status: nativeIAmAuthGRPC.CreateTokenWithSSOResponse_UNAUTHENTICATED, Message: authenticateResponse.Message}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "Unknown authenticate response status: %s", authenticateResponse.Status.String())
+	}
+
+	accessToken, refreshToken, err := s.createTokenForIdentity(ctx, in.Namespace, authenticateResponse.Identity, in.Scopes, in.Metadata, nativeIAmPolicyGRPC.AuthMethod_SSO, false)
+	if err != nil {
+		if err == ErrCreateTokenNamespaceSuspended {
+			return &nativeIAmAuthGRPC.CreateTokenWithSSOResponse{Status: nativeIAmAuthGRPC.CreateTokenWithSSOResponse_NAMESPACE_SUSPENDED}, nil
+		}
+		if err == ErrCreateTokenIdentityNotActive {
+			return &nativeIAmAuthGRPC.CreateTokenWithSSOResponse{Status: nativeIAmAuthGRPC.CreateTokenWithSSOResponse_IDENTITY_NOT_ACTIVE}, nil
+		}
+		if err == ErrCreateTokenUnauthorized {
+			return &nativeIAmAuthGRPC.CreateTokenWithSSOResponse{Status: nativeIAmAuthGRPC.CreateTokenWithSSOResponse_UNAUTHORIZED}, nil
+		}
+
+		return nil, err
+	}
+
+	return &nativeIAmAuthGRPC.CreateTokenWithSSOResponse{
+		Status:       nativeIAmAuthGRPC.CreateTokenWithSSOResponse_OK,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		Identity:     authenticateResponse.Identity,
+	}, status.Error(codes.OK, "")
+}
+
+func (s *IAmAuthServer) CreateTokenWithOIDC(ctx context.Context, in *nativeIAmAuthGRPC.CreateTokenWithOIDCRequest) (*nativeIAmAuthGRPC.CreateTokenWithOIDCResponse, error) {
+	authenticateResponse, err := s.authenticationOIDCServer.Authenticate(ctx, &nativeIAmAuthenticationOIDCGRPC.AuthenticateRequest{
+		Namespace: in.Namespace,
+		Provider:  in.Provider,
+		Code:      in.Code,
+		State:     in.State,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			return nil, status.Error(codes.FailedPrecondition, "failed to authenticate. most probably vault is sealed: "+err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "failed to authenticate: "+err.Error())
+	}
+
+	switch authenticateResponse.Status {
+	case nativeIAmAuthenticationOIDCGRPC.AuthenticateResponse_OK:
+		break
+	case nativeIAmAuthenticationOIDCGRPC.AuthenticateResponse_PROVIDER_NOT_FOUND, nativeIAmAuthenticationOIDCGRPC.AuthenticateResponse_PROVIDER_DISABLED:
+		return &nativeIAmAuthGRPC.CreateTokenWithOIDCResponse{Status: nativeIAmAuthGRPC.CreateTokenWithOIDCResponse_UNAUTHENTICATED, Message: "OIDC provider doesnt exist or disabled"}, nil
+	case nativeIAmAuthenticationOIDCGRPC.AuthenticateResponse_STATE_INVALID, nativeIAmAuthenticationOIDCGRPC.AuthenticateResponse_TOKEN_EXCHANGE_FAILED, nativeIAmAuthenticationOIDCGRPC.AuthenticateResponse_ID_TOKEN_INVALID, nativeIAmAuthenticationOIDCGRPC.AuthenticateResponse_UNAUTHENTICATED, nativeIAmAuthenticationOIDCGRPC.AuthenticateResponse_LOGIN_ALREADY_TAKEN:
+		return &nativeIAmAuthGRPC.CreateTokenWithOIDCResponse{Status: nativeIAmAuthGRPC.CreateTokenWithOIDCResponse_UNAUTHENTICATED, Message: authenticateResponse.Message}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "Unknown authenticate response status: %s", authenticateResponse.Status.String())
+	}
+
+	accessToken, refreshToken, err := s.createTokenForIdentity(ctx, in.Namespace, authenticateResponse.Identity, in.Scopes, in.Metadata, nativeIAmPolicyGRPC.AuthMethod_OAUTH2, false)
+	if err != nil {
+		if err == ErrCreateTokenNamespaceSuspended {
+			return &nativeIAmAuthGRPC.CreateTokenWithOIDCResponse{Status: nativeIAmAuthGRPC.CreateTokenWithOIDCResponse_NAMESPACE_SUSPENDED}, nil
+		}
+		if err == ErrCreateTokenIdentityNotActive {
+			return &nativeIAmAuthGRPC.CreateTokenWithOIDCResponse{Status: nativeIAmAuthGRPC.CreateTokenWithOIDCResponse_IDENTITY_NOT_ACTIVE}, nil
+		}
+		if err == ErrCreateTokenUnauthorized {
+			return &nativeIAmAuthGRPC.CreateTokenWithOIDCResponse{Status: nativeIAmAuthGRPC.CreateTokenWithOIDCResponse_UNAUTHORIZED}, nil
+		}
+
+		return nil, err
+	}
+
+	return &nativeIAmAuthGRPC.CreateTokenWithOIDCResponse{
+		Status:       nativeIAmAuthGRPC.CreateTokenWithOIDCResponse_OK,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		Identity:     authenticateResponse.Identity,
+	}, status.Error(codes.OK, "")
+}
+
+// Information about the requester in the format used by the token service to track session activity
+func activityFromAccessContext(accessContext *nativeIAmAuthGRPC.AccessContext) *nativeIAmTokenGRPC.ActivityContext {
+	return &nativeIAmTokenGRPC.ActivityContext{
+		SourceIP:  accessContext.GetSourceIP(),
+		UserAgent: accessContext.GetUserAgent(),
+	}
+}
+
+// Converts unsuccessful status of the token refresh to the response. Returns nil if status is OK.
+func refreshStatusToResponse(refreshStatus nativeIAmTokenGRPC.RefreshResponse_Status) (*nativeIAmAuthGRPC.RefreshTokenResponse, error) {
+	switch refreshStatus {
+	case nativeIAmTokenGRPC.RefreshResponse_OK:
+		return nil, nil
 	case nativeIAmTokenGRPC.RefreshResponse_DISABLED:
-		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_TOKEN_DISABLED}, status.Error(codes.OK, "")
+		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_TOKEN_DISABLED}, nil
 	case nativeIAmTokenGRPC.RefreshResponse_EXPIRED:
-		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_TOKEN_EXPIRED}, status.Error(codes.OK, "")
+		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_TOKEN_EXPIRED}, nil
 	case nativeIAmTokenGRPC.RefreshResponse_INVALID:
-		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_TOKEN_INVALID}, status.Error(codes.OK, "")
+		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_TOKEN_INVALID}, nil
 	case nativeIAmTokenGRPC.RefreshResponse_NOT_FOUND:
-		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_TOKEN_NOT_FOUND}, status.Error(codes.OK, "")
+		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_TOKEN_NOT_FOUND}, nil
 	case nativeIAmTokenGRPC.RefreshResponse_NOT_REFRESH_TOKEN:
-		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_TOKEN_IS_NOT_REFRESH_TOKEN}, status.Error(codes.OK, "")
+		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_TOKEN_IS_NOT_REFRESH_TOKEN}, nil
+	case nativeIAmTokenGRPC.RefreshResponse_REUSED:
+		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_TOKEN_REUSED}, nil
 	default:
-		return nil, status.Error(codes.Internal, "Unknow refresh response from the native_iam_token service. Received status: "+refershResponse.Status.String())
+		return nil, status.Error(codes.Internal, "Unknow refresh response from the native_iam_token service. Received status: "+refreshStatus.String())
+	}
+}
+
+func (s *IAmAuthServer) RefreshToken(ctx context.Context, in *nativeIAmAuthGRPC.RefreshTokenRequest) (*nativeIAmAuthGRPC.RefreshTokenResponse, error) {
+	refreshRequest := &nativeIAmTokenGRPC.RefreshRequest{
+		RefreshToken: in.RefreshToken,
+		Activity:     activityFromAccessContext(in.Context),
+	}
+
+	// Refresh token is rotated only after all the checks passed. Otherwise failed check will invalidate token that is still valid.
+	refershResponse, err := s.tokenServer.CheckRefresh(ctx, refreshRequest)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check refresh token. "+err.Error())
+	}
+	if response, err := refreshStatusToResponse(refershResponse.Status); response != nil || err != nil {
+		return response, err
+	}
+
+	suspended, err := s.isNamespaceSuspended(ctx, refershResponse.TokenData.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if suspended {
+		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_NAMESPACE_SUSPENDED}, status.Error(codes.OK, "")
 	}
 
 	identityResponse, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{
@@ -332,13 +454,22 @@ func (s *IAmAuthServer) RefreshToken(ctx context.Context, in *nativeIAmAuthGRPC.
 		return nil, status.Error(codes.Internal, "Failed to get policy information for identity: "+err.Error())
 	}
 
-	if !areTokenScopesValidForIdentityScopes(policies, refershResponse.TokenData.Scopes) {
+	if !areTokenScopesValidForIdentityScopes(policies, refershResponse.TokenData) {
 		return &nativeIAmAuthGRPC.RefreshTokenResponse{Status: nativeIAmAuthGRPC.RefreshTokenResponse_IDENTITY_UNAUTHENTICATED}, status.Error(codes.OK, "")
 	}
 
+	refershResponse, err = s.tokenServer.Refresh(ctx, refreshRequest)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to refresh token token. "+err.Error())
+	}
+	if response, err := refreshStatusToResponse(refershResponse.Status); response != nil || err != nil {
+		return response, err
+	}
+
 	return &nativeIAmAuthGRPC.RefreshTokenResponse{
-		Status:      nativeIAmAuthGRPC.RefreshTokenResponse_OK,
-		AccessToken: refershResponse.Token,
+		Status:       nativeIAmAuthGRPC.RefreshTokenResponse_OK,
+		AccessToken:  refershResponse.Token,
+		RefreshToken: refershResponse.RefreshToken,
 	}, status.Error(codes.OK, "")
 }
 
@@ -346,6 +477,7 @@ func (s *IAmAuthServer) CheckAccessWithToken(ctx context.Context, in *nativeIAmA
 	tokenResponse, err := s.tokenServer.Validate(ctx, &nativeIAmTokenGRPC.ValidateRequest{
 		Token:    in.AccessToken,
 		UseCache: true,
+		Activity: activityFromAccessContext(in.Context),
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Failed to validate token. "+err.Error())
@@ -364,7 +496,16 @@ func (s *IAmAuthServer) CheckAccessWithToken(ctx context.Context, in *nativeIAmA
 		return &nativeIAmAuthGRPC.CheckAccessWithTokenResponse{Status: nativeIAmAuthGRPC.CheckAccessWithTokenResponse_TOKEN_NOT_FOUND, Message: "Token not found. Most probably it was deteled and cant be used."}, status.Error(codes.OK, "")
 	}
 
-	if !areTokenScopesAllowAccess(tokenResponse.TokenData.Scopes, in.Scopes) {
+	suspended, err := s.isNamespaceSuspended(ctx, tokenResponse.TokenData.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if suspended {
+		return &nativeIAmAuthGRPC.CheckAccessWithTokenResponse{Status: nativeIAmAuthGRPC.CheckAccessWithTokenResponse_NAMESPACE_SUSPENDED, Message: "Namespace of the identity is suspended."}, status.Error(codes.OK, "")
+	}
+
+	accessCtx := newAccessContext(in.Context, nativeIAmPolicyGRPC.AuthMethod(tokenResponse.TokenData.AuthMethod), tokenResponse.TokenData.Mfa)
+	if !areTokenScopesAllowAccess(tokenResponse.TokenData.Scopes, in.Scopes, accessCtx) {
 		return &nativeIAmAuthGRPC.CheckAccessWithTokenResponse{
 			Status:  nativeIAmAuthGRPC.CheckAccessWithTokenResponse_UNAUTHORIZED,
 			Message: "Token doesnt have enought privileges to access provided scopes", //TODO: add here information about additional required policies
@@ -383,18 +524,71 @@ func (s *IAmAuthServer) CheckAccessWithToken(ctx context.Context, in *nativeIAmA
 func (s *IAmAuthServer) CheckAccessWithPassword(ctx context.Context, in *nativeIAmAuthGRPC.CheckAccessWithPasswordRequest) (*nativeIAmAuthGRPC.CheckAccessWithPasswordResponse, error) {
 	//TODO: use provided metadata
 
-	authenticateResponse, err := s.authenticationPasswordServer.Authenticate(ctx, &nativeIAmAuthenticationPasswordGRPC.AuthenticateRequest{
+	// Valid password must not reset failed attempts of the identity with second factor. Otherwise it can be used to bypass brute-force protection of the second factor.
+	totpStatusResponse, err := s.authenticationTOTPServer.GetStatus(ctx, &nativeIAmAuthenticationTOTPGRPC.GetStatusRequest{
 		Namespace: in.Namespace,
 		Identity:  in.Identity,
-		Password:  in.Password,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error while checking TOTP status: "+err.Error())
+	}
+
+	authenticateResponse, err := s.authenticationPasswordServer.Authenticate(ctx, &nativeIAmAuthenticationPasswordGRPC.AuthenticateRequest{
+		Namespace:            in.Namespace,
+		Identity:             in.Identity,
+		Password:             in.Password,
+		SourceIP:             in.Context.GetSourceIP(),
+		SecondFactorRequired: totpStatusResponse.Enabled,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Error while authorizing using password. "+err.Error())
 	}
+	if authenticateResponse.Throttled {
+		return &nativeIAmAuthGRPC.CheckAccessWithPasswordResponse{Status: nativeIAmAuthGRPC.CheckAccessWithPasswordResponse_TOO_MANY_ATTEMPTS, Message: "Too many failed attempts. Try again later.", RetryAfter: authenticateResponse.RetryAfter}, status.Error(codes.OK, "")
+	}
 	if !authenticateResponse.Authenticated {
 		return &nativeIAmAuthGRPC.CheckAccessWithPasswordResponse{Status: nativeIAmAuthGRPC.CheckAccessWithPasswordResponse_UNAUTHENTICATED, Message: "Identity or password doesnt match."}, status.Error(codes.OK, "")
 	}
 
+	// Password alone must not grant access to the identity with second factor
+	mfa := false
+	if totpStatusResponse.Enabled {
+		if in.Totp == "" {
+			return &nativeIAmAuthGRPC.CheckAccessWithPasswordResponse{Status: nativeIAmAuthGRPC.CheckAccessWithPasswordResponse_TOTP_REQUIRED, Message: "TOTP code is required."}, status.Error(codes.OK, "")
+		}
+
+		verifyResponse, err := s.authenticationTOTPServer.Verify(ctx, &nativeIAmAuthenticationTOTPGRPC.VerifyRequest{
+			Namespace: in.Namespace,
+			Identity:  in.Identity,
+			Code:      in.Totp,
+		})
+		if err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+				return nil, status.Error(codes.FailedPrecondition, "failed to verify TOTP. most probably vault is sealed: "+err.Error())
+			}
+			return nil, status.Error(codes.Internal, "Error while verifying TOTP: "+err.Error())
+		}
+		if verifyResponse.Status != nativeIAmAuthenticationTOTPGRPC.VerifyResponse_OK {
+			retryAfter, err := s.authenticationPasswordServer.RegisterSecondFactorFailure(ctx, in.Namespace, in.Identity, in.Context.GetSourceIP())
+			if err != nil {
+				return nil, status.Error(codes.Internal, "Failed to register failed attempt: "+err.Error())
+			}
+			return &nativeIAmAuthGRPC.CheckAccessWithPasswordResponse{Status: nativeIAmAuthGRPC.CheckAccessWithPasswordResponse_TOTP_INVALID, Message: "TOTP code is invalid.", RetryAfter: retryAfter}, status.Error(codes.OK, "")
+		}
+		if err := s.authenticationPasswordServer.ResetFailedAttemptsAfterSecondFactor(ctx, in.Namespace, in.Identity); err != nil {
+			return nil, status.Error(codes.Internal, "Failed to reset failed attempts: "+err.Error())
+		}
+		mfa = true
+	}
+
+	suspended, err := s.isNamespaceSuspended(ctx, in.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if suspended {
+		return &nativeIAmAuthGRPC.CheckAccessWithPasswordResponse{Status: nativeIAmAuthGRPC.CheckAccessWithPasswordResponse_NAMESPACE_SUSPENDED, Message: "Namespace of the identity is suspended."}, status.Error(codes.OK, "")
+	}
+
 	// Find identity and its policies
 	identityGetResponse, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{
 		Namespace: in.Namespace,
@@ -423,7 +617,7 @@ func (s *IAmAuthServer) CheckAccessWithPassword(ctx context.Context, in *nativeI
 		return nil, status.Error(codes.Internal, "Failed to get policy information for identity: "+err.Error())
 	}
 
-	if !arePoliciesAllowScopes(policies, in.Scopes) {
+	if !arePoliciesAllowScopes(policies, in.Scopes, newAccessContext(in.Context, nativeIAmPolicyGRPC.AuthMethod_PASSWORD, mfa)) {
 		return &nativeIAmAuthGRPC.CheckAccessWithPasswordResponse{Status: nativeIAmAuthGRPC.CheckAccessWithPasswordResponse_UNAUTHORIZED, Message: "Not enought privileges"}, status.Error(codes.OK, "")
 	}
 
@@ -432,7 +626,9 @@ func (s *IAmAuthServer) CheckAccessWithPassword(ctx context.Context, in *nativeI
 
 func (s *IAmAuthServer) CheckAccessWithX509(ctx context.Context, in *nativeIAmAuthGRPC.CheckAccessWithX509Request) (*nativeIAmAuthGRPC.CheckAccessWithX509Response, error) {
 	certificateValidateResponse, err := s.authenticationX509Server.ValidateAndGetFromRawX509(ctx, &x509.ValidateAndGetFromRawX509Request{
-		Raw: in.Certificate,
+		Raw:             in.Certificate,
+		Namespace:       in.Namespace,
+		VerifyNamespace: in.VerifyNamespace,
 	})
 	if err != nil {
 		st, ok := status.FromError(err)
@@ -460,6 +656,10 @@ func (s *IAmAuthServer) CheckAccessWithX509(ctx context.Context, in *nativeIAmAu
 		return &nativeIAmAuthGRPC.CheckAccessWithX509Response{Status: nativeIAmAuthGRPC.CheckAccessWithX509Response_CERTIFICATE_INVALID, Message: "Invalid signature or other fields of the certificate"}, status.Error(codes.OK, "")
 	case x509.ValidateAndGetFromRawX509Response_NOT_FOUND:
 		return &nativeIAmAuthGRPC.CheckAccessWithX509Response{Status: nativeIAmAuthGRPC.CheckAccessWithX509Response_CERTIFICATE_NOT_FOUND, Message: "Certificate has valid signature, but doesnt exist in the system"}, status.Error(codes.OK, "")
+	case x509.ValidateAndGetFromRawX509Response_EXPIRED:
+		return &nativeIAmAuthGRPC.CheckAccessWithX509Response{Status: nativeIAmAuthGRPC.CheckAccessWithX509Response_CERTIFICATE_EXPIRED, Message: "Certificate expired or is not valid yet"}, status.Error(codes.OK, "")
+	case x509.ValidateAndGetFromRawX509Response_NAMESPACE_MISMATCH:
+		return &nativeIAmAuthGRPC.CheckAccessWithX509Response{Status: nativeIAmAuthGRPC.CheckAccessWithX509Response_CERTIFICATE_NAMESPACE_MISMATCH, Message: "Certificate was issued in other namespace"}, status.Error(codes.OK, "")
 	default:
 		return &nativeIAmAuthGRPC.CheckAccessWithX509Response{Status: nativeIAmAuthGRPC.CheckAccessWithX509Response_CERTIFICATE_INVALID, Message: "Certificate invalid for unknown reason"}, status.Error(codes.OK, "")
 	}
@@ -478,6 +678,14 @@ func (s *IAmAuthServer) CheckAccessWithX509(ctx context.Context, in *nativeIAmAu
 		}, status.Error(codes.OK, "")
 	}
 
+	suspended, err := s.isNamespaceSuspended(ctx, certificateInfo.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if suspended {
+		return &nativeIAmAuthGRPC.CheckAccessWithX509Response{Status: nativeIAmAuthGRPC.CheckAccessWithX509Response_NAMESPACE_SUSPENDED, Message: "Namespace of the identity is suspended.", CertificateInfo: certificateInfo}, status.Error(codes.OK, "")
+	}
+
 	// Find identity
 	identityGetResponse, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{
 		Namespace: certificateInfo.Namespace,
@@ -505,14 +713,116 @@ func (s *IAmAuthServer) CheckAccessWithX509(ctx context.Context, in *nativeIAmAu
 		return nil, status.Error(codes.Internal, "Failed to get policy information for identity: "+err.Error())
 	}
 
-	if !arePoliciesAllowScopes(policies, in.Scopes) {
+	if !arePoliciesAllowScopes(policies, in.Scopes, newAccessContext(in.Context, nativeIAmPolicyGRPC.AuthMethod_X509, false)) {
 		return &nativeIAmAuthGRPC.CheckAccessWithX509Response{Status: nativeIAmAuthGRPC.CheckAccessWithX509Response_UNAUTHORIZED, Message: "Not enought privileges", CertificateInfo: certificateInfo}, status.Error(codes.OK, "")
 	}
 
 	return &nativeIAmAuthGRPC.CheckAccessWithX509Response{Status: nativeIAmAuthGRPC.CheckAccessWithX509Response_OK, Message: "", CertificateInfo: certificateInfo}, status.Error(codes.OK, "")
 }
 
+func (s *IAmAuthServer) CheckAccessWithAPIKey(ctx context.Context, in *nativeIAmAuthGRPC.CheckAccessWithAPIKeyRequest) (*nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse, error) {
+	authenticateResponse, err := s.authenticationAPIKeyServer.Authenticate(ctx, &nativeIAmAuthenticationAPIKeyGRPC.AuthenticateRequest{Key: in.ApiKey})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "Failed to authenticate API key. "+err.Error())
+	}
+
+	switch authenticateResponse.Status {
+	case nativeIAmAuthenticationAPIKeyGRPC.AuthenticateResponse_OK:
+		break
+	case nativeIAmAuthenticationAPIKeyGRPC.AuthenticateResponse_EXPIRED:
+		return &nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse{Status: nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse_KEY_EXPIRED, Message: "API key expired"}, status.Error(codes.OK, "")
+	case nativeIAmAuthenticationAPIKeyGRPC.AuthenticateResponse_REVOKED:
+		return &nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse{Status: nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse_KEY_REVOKED, Message: "API key was revoked"}, status.Error(codes.OK, "")
+	case nativeIAmAuthenticationAPIKeyGRPC.AuthenticateResponse_NOT_FOUND:
+		return &nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse{Status: nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse_KEY_NOT_FOUND, Message: "API key not found. Most probably it was deleted and cant be used."}, status.Error(codes.OK, "")
+	default:
+		return &nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse{Status: nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse_KEY_INVALID, Message: "API key invalid. Maybe it has bad structure or secret"}, status.Error(codes.OK, "")
+	}
+	apiKey := authenticateResponse.ApiKey
+
+	suspended, err := s.isNamespaceSuspended(ctx, apiKey.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if suspended {
+		return &nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse{Status: nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse_NAMESPACE_SUSPENDED, Message: "Namespace of the identity is suspended."}, status.Error(codes.OK, "")
+	}
+
+	identityGetResponse, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{
+		Namespace: apiKey.Namespace,
+		Uuid:      apiKey.Identity,
+		UseCache:  true,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.NotFound {
+				return &nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse{Status: nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse_KEY_NOT_FOUND, Message: "Identity of the API key not found."}, status.Error(codes.OK, "")
+			}
+		}
+
+		return nil, status.Error(codes.Internal, "Error while searching for identity. "+err.Error())
+	}
+	if !identityGetResponse.Identity.Active {
+		return &nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse{Status: nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse_IDENTITY_NOT_ACTIVE, Message: "Identity is not active."}, status.Error(codes.OK, "")
+	}
+
+	policies, err := s.fetchIdentityPolicies(ctx, identityGetResponse.Identity)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to get policy information for identity: "+err.Error())
+	}
+
+	// API key is handled as a token whose scopes are stored in the key. Scopes are checked against the current identity policies on every request, so privileges of the key shrink together with privileges of the identity.
+	keyScopes := make([]*nativeIAmAuthGRPC.Scope, len(apiKey.Scopes))
+	for i, scope := range apiKey.Scopes {
+		keyScopes[i] = &nativeIAmAuthGRPC.Scope{
+			Namespace:            scope.Namespace,
+			Resources:            scope.Resources,
+			Actions:              scope.Actions,
+			NamespaceIndependent: scope.NamespaceIndependent,
+		}
+	}
+	accessCtx := newAccessContext(in.Context, nativeIAmPolicyGRPC.AuthMethod_API_KEY, false)
+	tokenData := &nativeIAmTokenGRPC.TokenData{
+		Namespace:  apiKey.Namespace,
+		Uuid:       apiKey.Uuid,
+		Identity:   apiKey.Identity,
+		Scopes:     makeTokenScopes(policies, keyScopes, accessCtx),
+		AuthMethod: nativeIAmTokenGRPC.AuthMethod_API_KEY,
+	}
+	if !areTokenScopesValidForIdentityScopes(policies, tokenData) {
+		return &nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse{
+			Status:  nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse_UNAUTHORIZED,
+			Message: "API key scopes are not allowed by the identity policies",
+		}, status.Error(codes.OK, "")
+	}
+	if !areTokenScopesAllowAccess(tokenData.Scopes, in.Scopes, accessCtx) {
+		return &nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse{
+			Status:  nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse_UNAUTHORIZED,
+			Message: "API key doesnt have enought privileges to access provided scopes",
+		}, status.Error(codes.OK, "")
+	}
+
+	return &nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse{
+		Status:       nativeIAmAuthGRPC.CheckAccessWithAPIKeyResponse_OK,
+		Message:      "",
+		Namespace:    apiKey.Namespace,
+		ApiKeyUUID:   apiKey.Uuid,
+		IdentityUUID: apiKey.Identity,
+	}, status.Error(codes.OK, "")
+}
+
 func (s *IAmAuthServer) CheckAccess(ctx context.Context, in *nativeIAmAuthGRPC.CheckAccessRequest) (*nativeIAmAuthGRPC.CheckAccessResponse, error) {
+	suspended, err := s.isNamespaceSuspended(ctx, in.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if suspended {
+		return &nativeIAmAuthGRPC.CheckAccessResponse{Status: nativeIAmAuthGRPC.CheckAccessResponse_NAMESPACE_SUSPENDED, Message: "Namespace of the identity is suspended."}, status.Error(codes.OK, "")
+	}
+
 	// Find identity
 	identityGetResponse, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{
 		Namespace: in.Namespace,
@@ -540,7 +850,7 @@ func (s *IAmAuthServer) CheckAccess(ctx context.Context, in *nativeIAmAuthGRPC.C
 		return nil, status.Error(codes.Internal, "Failed to get policy information for identity: "+err.Error())
 	}
 
-	if !arePoliciesAllowScopes(policies, in.Scopes) {
+	if !arePoliciesAllowScopes(policies, in.Scopes, newAccessContext(in.Context, nativeIAmPolicyGRPC.AuthMethod_UNKNOWN, false)) {
 		return &nativeIAmAuthGRPC.CheckAccessResponse{Status: nativeIAmAuthGRPC.CheckAccessResponse_UNAUTHORIZED, Message: "Not enought privileges"}, status.Error(codes.OK, "")
 	}
 
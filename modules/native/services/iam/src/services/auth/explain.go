@@ -0,0 +1,261 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	nativeIAmAuthGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	nativeIAmTokenGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+
+	identity_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/identity"
+)
+
+// Makes list of explained policies for every policy (in the same order). Policy can be assigned multiple times (directly, through the roles and through the groups), so every policy can have multiple entries.
+func makeExplainedPolicies(policies []*nativeIAmPolicyGRPC.Policy, sources []identity_server.AssignedPolicy) [][]*nativeIAmAuthGRPC.ExplainedPolicy {
+	sourcesByPolicy := make(map[string][]identity_server.AssignedPolicy, len(sources))
+	for _, source := range sources {
+		key := source.Policy.Namespace + "/" + source.Policy.Uuid
+		sourcesByPolicy[key] = append(sourcesByPolicy[key], source)
+	}
+
+	explained := make([][]*nativeIAmAuthGRPC.ExplainedPolicy, len(policies))
+	for i, policy := range policies {
+		policySources := sourcesByPolicy[policy.Namespace+"/"+policy.Uuid]
+		if len(policySources) == 0 {
+			policySources = []identity_server.AssignedPolicy{{Policy: policy}}
+		}
+		for _, source := range policySources {
+			explainedPolicy := &nativeIAmAuthGRPC.ExplainedPolicy{
+				Namespace:     policy.Namespace,
+				Uuid:          policy.Uuid,
+				Name:          policy.Name,
+				RoleNamespace: source.RoleNamespace,
+				RoleUUID:      source.RoleUUID,
+			}
+			if source.Group != nil {
+				explainedPolicy.GroupNamespace = source.Group.Namespace
+				explainedPolicy.GroupUUID = source.Group.Uuid
+			}
+			explained[i] = append(explained[i], explainedPolicy)
+		}
+	}
+	return explained
+}
+
+// Returns values that are not covered by any applicable ALLOW rule
+func uncoveredScopeValues(rules []*accessRule, ctx *accessContext, scope *nativeIAmAuthGRPC.Scope, values []string, ruleValues func(*accessRule) []string) []string {
+	uncovered := []string{}
+	for _, value := range values {
+		covered := false
+		for _, rule := range rules {
+			if rule.deny || !rule.coversNamespace(scope.Namespace, scope.NamespaceIndependent) || !rule.applies(ctx) {
+				continue
+			}
+			if compareStringList(ruleValues(rule), []string{value}) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			uncovered = append(uncovered, value)
+		}
+	}
+	return uncovered
+}
+
+// Explains the decision of the rulesAllowScope. explained[i] are the policies of the rules[i]. It is nil if rules were not created from policies (token scopes).
+func explainScope(rules []*accessRule, explained [][]*nativeIAmAuthGRPC.ExplainedPolicy, ctx *accessContext, scope *nativeIAmAuthGRPC.Scope) *nativeIAmAuthGRPC.ScopeExplanation {
+	explanation := &nativeIAmAuthGRPC.ScopeExplanation{
+		Scope:                        scope,
+		MatchedPolicies:              []*nativeIAmAuthGRPC.ExplainedPolicy{},
+		DenyingPolicies:              []*nativeIAmAuthGRPC.ExplainedPolicy{},
+		UnsatisfiedConditionPolicies: []*nativeIAmAuthGRPC.ExplainedPolicy{},
+		UnsatisfiedResources:         []string{},
+		UnsatisfiedActions:           []string{},
+	}
+
+	denied := false
+	allowed := false
+	conditional := false
+	for i, rule := range rules {
+		var rulePolicies []*nativeIAmAuthGRPC.ExplainedPolicy
+		if explained != nil {
+			rulePolicies = explained[i]
+		}
+
+		if rule.deny {
+			if rule.applies(ctx) && rule.deniesScope(scope.Namespace, scope.NamespaceIndependent, scope.Resources, scope.Actions) {
+				denied = true
+				explanation.DenyingPolicies = append(explanation.DenyingPolicies, rulePolicies...)
+			}
+			continue
+		}
+
+		if !rule.allowsScope(scope.Namespace, scope.NamespaceIndependent, scope.Resources, scope.Actions) {
+			continue
+		}
+		if rule.applies(ctx) {
+			allowed = true
+			explanation.MatchedPolicies = append(explanation.MatchedPolicies, rulePolicies...)
+		} else {
+			conditional = true
+			explanation.UnsatisfiedConditionPolicies = append(explanation.UnsatisfiedConditionPolicies, rulePolicies...)
+		}
+	}
+
+	switch {
+	case denied:
+		explanation.Decision = nativeIAmAuthGRPC.ScopeExplanation_DENIED_BY_POLICY
+	case allowed:
+		explanation.Decision = nativeIAmAuthGRPC.ScopeExplanation_GRANTED
+		explanation.Granted = true
+	case conditional:
+		explanation.Decision = nativeIAmAuthGRPC.ScopeExplanation_CONDITIONS_NOT_SATISFIED
+	default:
+		explanation.Decision = nativeIAmAuthGRPC.ScopeExplanation_NO_MATCHING_POLICY
+	}
+
+	if !explanation.Granted {
+		explanation.UnsatisfiedResources = uncoveredScopeValues(rules, ctx, scope, scope.Resources, func(r *accessRule) []string { return r.resources })
+		explanation.UnsatisfiedActions = uncoveredScopeValues(rules, ctx, scope, scope.Actions, func(r *accessRule) []string { return r.actions })
+	}
+
+	return explanation
+}
+
+func explainPolicies(policies []*nativeIAmPolicyGRPC.Policy, sources []identity_server.AssignedPolicy, scopes []*nativeIAmAuthGRPC.Scope, ctx *accessContext) []*nativeIAmAuthGRPC.ScopeExplanation {
+	rules := policiesToRules(policies)
+	explained := makeExplainedPolicies(policies, sources)
+
+	explanations := make([]*nativeIAmAuthGRPC.ScopeExplanation, len(scopes))
+	for i, scope := range scopes {
+		explanations[i] = explainScope(rules, explained, ctx, scope)
+	}
+	return explanations
+}
+
+// Explains access of the token. Token scopes are the final decision, identity policies are used to find out the reason of the decision.
+func explainToken(tokenScopes []*nativeIAmTokenGRPC.Scope, policies []*nativeIAmPolicyGRPC.Policy, sources []identity_server.AssignedPolicy, scopes []*nativeIAmAuthGRPC.Scope, ctx *accessContext) []*nativeIAmAuthGRPC.ScopeExplanation {
+	tokenRules := tokenScopesToRules(tokenScopes)
+	explanations := explainPolicies(policies, sources, scopes, ctx)
+
+	for i, scope := range scopes {
+		tokenExplanation := explainScope(tokenRules, nil, ctx, scope)
+		explanation := explanations[i]
+
+		if tokenExplanation.Granted {
+			explanation.Granted = true
+			explanation.Decision = nativeIAmAuthGRPC.ScopeExplanation_GRANTED
+			explanation.UnsatisfiedResources = []string{}
+			explanation.UnsatisfiedActions = []string{}
+		} else if explanation.Granted {
+			explanation.Granted = false
+			explanation.Decision = nativeIAmAuthGRPC.ScopeExplanation_TOKEN_SCOPE_NARROWED
+			explanation.UnsatisfiedResources = tokenExplanation.UnsatisfiedResources
+			explanation.UnsatisfiedActions = tokenExplanation.UnsatisfiedActions
+		}
+	}
+
+	return explanations
+}
+
+func (s *IAmAuthServer) ExplainAccess(ctx context.Context, in *nativeIAmAuthGRPC.ExplainAccessRequest) (*nativeIAmAuthGRPC.ExplainAccessResponse, error) {
+	var identityNamespace string
+	var identityUUID string
+	var tokenData *nativeIAmTokenGRPC.TokenData
+
+	switch subject := in.Subject.(type) {
+	case *nativeIAmAuthGRPC.ExplainAccessRequest_Identity:
+		identityNamespace = subject.Identity.Namespace
+		identityUUID = subject.Identity.Uuid
+	case *nativeIAmAuthGRPC.ExplainAccessRequest_AccessToken:
+		tokenResponse, err := s.tokenServer.Validate(ctx, &nativeIAmTokenGRPC.ValidateRequest{
+			Token:    subject.AccessToken,
+			UseCache: true,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to validate token. "+err.Error())
+		}
+
+		switch tokenResponse.Status {
+		case nativeIAmTokenGRPC.ValidateResponse_OK:
+			break
+		case nativeIAmTokenGRPC.ValidateResponse_EXPIRED:
+			return &nativeIAmAuthGRPC.ExplainAccessResponse{Status: nativeIAmAuthGRPC.ExplainAccessResponse_TOKEN_EXPIRED, Message: "Token expired"}, status.Error(codes.OK, "")
+		case nativeIAmTokenGRPC.ValidateResponse_DISABLED:
+			return &nativeIAmAuthGRPC.ExplainAccessResponse{Status: nativeIAmAuthGRPC.ExplainAccessResponse_TOKEN_DISABLED, Message: "Token was manually disabled"}, status.Error(codes.OK, "")
+		case nativeIAmTokenGRPC.ValidateResponse_INVALID:
+			return &nativeIAmAuthGRPC.ExplainAccessResponse{Status: nativeIAmAuthGRPC.ExplainAccessResponse_TOKEN_INVALID, Message: "Token invalid. Maybe it has bad structure or signature"}, status.Error(codes.OK, "")
+		case nativeIAmTokenGRPC.ValidateResponse_NOT_FOUND:
+			return &nativeIAmAuthGRPC.ExplainAccessResponse{Status: nativeIAmAuthGRPC.ExplainAccessResponse_TOKEN_NOT_FOUND, Message: "Token not found. Most probably it was deteled and cant be used."}, status.Error(codes.OK, "")
+		default:
+			return nil, status.Error(codes.Internal, "Unknown token validation status: "+tokenResponse.Status.String())
+		}
+
+		tokenData = tokenResponse.TokenData
+		identityNamespace = tokenData.Namespace
+		identityUUID = tokenData.Identity
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Identity or access token must be provided")
+	}
+
+	suspended, err := s.isNamespaceSuspended(ctx, identityNamespace)
+	if err != nil {
+		return nil, err
+	}
+	if suspended {
+		return &nativeIAmAuthGRPC.ExplainAccessResponse{Status: nativeIAmAuthGRPC.ExplainAccessResponse_NAMESPACE_SUSPENDED, Message: "Namespace of the identity is suspended."}, status.Error(codes.OK, "")
+	}
+
+	identityGetResponse, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{
+		Namespace: identityNamespace,
+		Uuid:      identityUUID,
+		UseCache:  false,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.NotFound {
+				return &nativeIAmAuthGRPC.ExplainAccessResponse{Status: nativeIAmAuthGRPC.ExplainAccessResponse_IDENTITY_NOT_FOUND, Message: "Identity not found."}, status.Error(codes.OK, "")
+			}
+		}
+
+		return nil, status.Error(codes.Internal, "Error while searching for identity. "+err.Error())
+	}
+
+	if !identityGetResponse.Identity.Active {
+		return &nativeIAmAuthGRPC.ExplainAccessResponse{Status: nativeIAmAuthGRPC.ExplainAccessResponse_IDENTITY_NOT_ACTIVE, Message: "Identity is not active."}, status.Error(codes.OK, "")
+	}
+
+	sources, err := s.identityServer.FetchAssignedPolicies(ctx, identityGetResponse.Identity)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to get policy information for identity: "+err.Error())
+	}
+	policies := identity_server.UniquePolicies(sources)
+
+	var explanations []*nativeIAmAuthGRPC.ScopeExplanation
+	if tokenData != nil {
+		accessCtx := newAccessContext(in.Context, nativeIAmPolicyGRPC.AuthMethod(tokenData.AuthMethod), tokenData.Mfa)
+		explanations = explainToken(tokenData.Scopes, policies, sources, in.Scopes, accessCtx)
+	} else {
+		accessCtx := newAccessContext(in.Context, nativeIAmPolicyGRPC.AuthMethod_UNKNOWN, false)
+		explanations = explainPolicies(policies, sources, in.Scopes, accessCtx)
+	}
+
+	granted := true
+	for _, explanation := range explanations {
+		granted = granted && explanation.Granted
+	}
+
+	return &nativeIAmAuthGRPC.ExplainAccessResponse{
+		Status:    nativeIAmAuthGRPC.ExplainAccessResponse_OK,
+		Message:   "",
+		Namespace: identityNamespace,
+		Identity:  identityUUID,
+		Granted:   granted,
+		Scopes:    explanations,
+	}, status.Error(codes.OK, "")
+}
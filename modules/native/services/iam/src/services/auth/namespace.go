@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	nativeNamespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+)
+
+var ErrCreateTokenNamespaceSuspended = errors.New("namespace suspended")
+
+// Checks if identities of the namespace can authenticate and access resources. Suspended and archived namespaces keep their data, but their identities are blocked.
+// Namespace data is cached. Cache is cleared by the native_namespace service when the state changes, so the check takes effect immediately.
+func (s *IAmAuthServer) isNamespaceSuspended(ctx context.Context, namespace string) (bool, error) {
+	// Global namespace can not be suspended
+	if namespace == "" {
+		return false, nil
+	}
+
+	response, err := s.nativeStub.Services.Namespace.Get(ctx, &nativeNamespaceGRPC.GetNamespaceRequest{Name: namespace, UseCache: true})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			// Identity will not be found later
+			return false, nil
+		}
+		return false, status.Error(codes.Internal, "Failed to get namespace of the identity: "+err.Error())
+	}
+
+	state := response.Namespace.State
+	return state == nativeNamespaceGRPC.Namespace_SUSPENDED || state == nativeNamespaceGRPC.Namespace_ARCHIVED, nil
+}
+
+// Returns ErrCreateTokenNamespaceSuspended if tokens can not be created for the identities of the namespace. Used by the OIDC authorization server.
+func (s *IAmAuthServer) CheckNamespaceCanCreateTokens(ctx context.Context, namespace string) error {
+	suspended, err := s.isNamespaceSuspended(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	if suspended {
+		return ErrCreateTokenNamespaceSuspended
+	}
+	return nil
+}
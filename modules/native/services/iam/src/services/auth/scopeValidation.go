@@ -1,9 +1,13 @@
 package auth
 
 import (
+	"net"
+	"slices"
 	"strings"
+	"time"
 
 	nativeIAmAuthGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	nativeIAmAuthenticationAPIKeyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/apikey"
 	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
 	nativeIAmTokenGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
 )
@@ -56,37 +60,365 @@ func compareStringList(hasList []string, requiredList []string) bool {
 	return true
 }
 
-func arePoliciesAllowScopes(policies []*nativeIAmPolicyGRPC.Policy, scopes []*nativeIAmAuthGRPC.Scope) bool {
-	for _, scope := range scopes {
-		scopeIsOk := false
-		for _, policy := range policies {
-			if (!scope.NamespaceIndependent && scope.Namespace == policy.Namespace) || policy.NamespaceIndependent {
-				if compareStringList(policy.Resources, scope.Resources) && compareStringList(policy.Actions, scope.Actions) {
-					scopeIsOk = true
-					break
-				}
+// Checks if at least one value matched by the first list is also matched by the second list.
+// Used for deny rules: requesting "crm.*" must be denied if there is a deny rule for "crm.client".
+func intersectStringList(first []string, second []string) bool {
+	for _, a := range first {
+		for _, b := range second {
+			if compareString(a, b) || compareString(b, a) {
+				return true
 			}
 		}
-		if !scopeIsOk {
+	}
+	return false
+}
+
+// Information about the request used to evaluate policy conditions
+type accessContext struct {
+	// IP of the requester. Nil if unknown
+	sourceIP net.IP
+	now      time.Time
+	// UNKNOWN if identity wasnt authenticated during this request (for example direct access check)
+	authMethod nativeIAmPolicyGRPC.AuthMethod
+	mfa        bool
+	// On token creation source IP and time conditions are not checked. Instead they are copied to the token and checked on every usage of the token.
+	deferRequestConditions bool
+}
+
+func newAccessContext(accessContext *nativeIAmAuthGRPC.AccessContext, authMethod nativeIAmPolicyGRPC.AuthMethod, mfa bool) *accessContext {
+	ctx := newTokenCreationAccessContext(authMethod, mfa)
+	ctx.deferRequestConditions = false
+	if accessContext != nil {
+		ctx.sourceIP = net.ParseIP(accessContext.SourceIP)
+	}
+	return ctx
+}
+
+func newTokenCreationAccessContext(authMethod nativeIAmPolicyGRPC.AuthMethod, mfa bool) *accessContext {
+	return &accessContext{
+		sourceIP:               nil,
+		now:                    time.Now().UTC(),
+		authMethod:             authMethod,
+		mfa:                    mfa,
+		deferRequestConditions: true,
+	}
+}
+
+type conditionState int
+
+const (
+	conditionSatisfied conditionState = iota
+	conditionNotSatisfied
+	// Information required to evaluate condition is not available in the request
+	conditionUnknown
+	// Condition will be evaluated later (on token usage)
+	conditionDeferred
+)
+
+func boolToConditionState(ok bool) conditionState {
+	if ok {
+		return conditionSatisfied
+	}
+	return conditionNotSatisfied
+}
+
+type timeWindowRule struct {
+	notBefore        *time.Time
+	notAfter         *time.Time
+	dailyStartMinute uint32
+	dailyEndMinute   uint32
+	timezone         string
+}
+
+type conditionsRule struct {
+	sourceIPs   []string
+	timeWindow  *timeWindowRule
+	authMethods []nativeIAmPolicyGRPC.AuthMethod
+	requireMFA  bool
+}
+
+// Common representation of the policies and token scopes
+type accessRule struct {
+	namespace            string
+	namespaceIndependent bool
+	resources            []string
+	actions              []string
+	deny                 bool
+	conditions           *conditionsRule
+}
+
+func makeTimeWindowRule(notBefore *time.Time, notAfter *time.Time, dailyStartMinute uint32, dailyEndMinute uint32, timezone string) *timeWindowRule {
+	return &timeWindowRule{
+		notBefore:        notBefore,
+		notAfter:         notAfter,
+		dailyStartMinute: dailyStartMinute,
+		dailyEndMinute:   dailyEndMinute,
+		timezone:         timezone,
+	}
+}
+
+func policyConditionsToRule(conditions *nativeIAmPolicyGRPC.PolicyConditions) *conditionsRule {
+	if conditions == nil {
+		return nil
+	}
+	rule := &conditionsRule{
+		sourceIPs:   conditions.SourceIPs,
+		authMethods: conditions.AuthMethods,
+		requireMFA:  conditions.RequireMFA,
+	}
+	if window := conditions.TimeWindow; window != nil {
+		var notBefore, notAfter *time.Time
+		if window.NotBefore != nil {
+			t := window.NotBefore.AsTime()
+			notBefore = &t
+		}
+		if window.NotAfter != nil {
+			t := window.NotAfter.AsTime()
+			notAfter = &t
+		}
+		rule.timeWindow = makeTimeWindowRule(notBefore, notAfter, window.DailyStartMinute, window.DailyEndMinute, window.Timezone)
+	}
+	return rule
+}
+
+func tokenConditionsToRule(conditions *nativeIAmTokenGRPC.ScopeConditions) *conditionsRule {
+	if conditions == nil {
+		return nil
+	}
+	rule := &conditionsRule{
+		sourceIPs:   conditions.SourceIPs,
+		authMethods: make([]nativeIAmPolicyGRPC.AuthMethod, len(conditions.AuthMethods)),
+		requireMFA:  conditions.RequireMFA,
+	}
+	for i, method := range conditions.AuthMethods {
+		rule.authMethods[i] = nativeIAmPolicyGRPC.AuthMethod(method)
+	}
+	if window := conditions.TimeWindow; window != nil {
+		var notBefore, notAfter *time.Time
+		if window.NotBefore != nil {
+			t := window.NotBefore.AsTime()
+			notBefore = &t
+		}
+		if window.NotAfter != nil {
+			t := window.NotAfter.AsTime()
+			notAfter = &t
+		}
+		rule.timeWindow = makeTimeWindowRule(notBefore, notAfter, window.DailyStartMinute, window.DailyEndMinute, window.Timezone)
+	}
+	return rule
+}
+
+func policyConditionsToTokenConditions(conditions *nativeIAmPolicyGRPC.PolicyConditions) *nativeIAmTokenGRPC.ScopeConditions {
+	if conditions == nil {
+		return nil
+	}
+	result := &nativeIAmTokenGRPC.ScopeConditions{
+		SourceIPs:   conditions.SourceIPs,
+		AuthMethods: make([]nativeIAmTokenGRPC.AuthMethod, len(conditions.AuthMethods)),
+		RequireMFA:  conditions.RequireMFA,
+	}
+	for i, method := range conditions.AuthMethods {
+		result.AuthMethods[i] = nativeIAmTokenGRPC.AuthMethod(method)
+	}
+	if window := conditions.TimeWindow; window != nil {
+		result.TimeWindow = &nativeIAmTokenGRPC.TimeWindow{
+			NotBefore:        window.NotBefore,
+			NotAfter:         window.NotAfter,
+			DailyStartMinute: window.DailyStartMinute,
+			DailyEndMinute:   window.DailyEndMinute,
+			Timezone:         window.Timezone,
+		}
+	}
+	return result
+}
+
+func policiesToRules(policies []*nativeIAmPolicyGRPC.Policy) []*accessRule {
+	rules := make([]*accessRule, len(policies))
+	for i, policy := range policies {
+		rules[i] = &accessRule{
+			namespace:            policy.Namespace,
+			namespaceIndependent: policy.NamespaceIndependent,
+			resources:            policy.Resources,
+			actions:              policy.Actions,
+			deny:                 policy.Effect == nativeIAmPolicyGRPC.PolicyEffect_DENY,
+			conditions:           policyConditionsToRule(policy.Conditions),
+		}
+	}
+	return rules
+}
+
+func tokenScopesToRules(scopes []*nativeIAmTokenGRPC.Scope) []*accessRule {
+	rules := make([]*accessRule, len(scopes))
+	for i, scope := range scopes {
+		rules[i] = &accessRule{
+			namespace:            scope.Namespace,
+			namespaceIndependent: scope.NamespaceIndependent,
+			resources:            scope.Resources,
+			actions:              scope.Actions,
+			deny:                 scope.Deny,
+			conditions:           tokenConditionsToRule(scope.Conditions),
+		}
+	}
+	return rules
+}
+
+func (c *conditionsRule) hasRequestConditions() bool {
+	return c != nil && (len(c.sourceIPs) != 0 || c.timeWindow != nil)
+}
+
+func (c *conditionsRule) evaluateSourceIP(ctx *accessContext) conditionState {
+	if len(c.sourceIPs) == 0 {
+		return conditionSatisfied
+	}
+	if ctx.deferRequestConditions {
+		return conditionDeferred
+	}
+	if ctx.sourceIP == nil {
+		return conditionUnknown
+	}
+
+	for _, allowed := range c.sourceIPs {
+		if strings.Contains(allowed, "/") {
+			if _, network, err := net.ParseCIDR(allowed); err == nil && network.Contains(ctx.sourceIP) {
+				return conditionSatisfied
+			}
+		} else if ip := net.ParseIP(allowed); ip != nil && ip.Equal(ctx.sourceIP) {
+			return conditionSatisfied
+		}
+	}
+	return conditionNotSatisfied
+}
+
+func (c *conditionsRule) evaluateTimeWindow(ctx *accessContext) conditionState {
+	window := c.timeWindow
+	if window == nil {
+		return conditionSatisfied
+	}
+	if ctx.deferRequestConditions {
+		return conditionDeferred
+	}
+
+	if window.notBefore != nil && ctx.now.Before(*window.notBefore) {
+		return conditionNotSatisfied
+	}
+	if window.notAfter != nil && !ctx.now.Before(*window.notAfter) {
+		return conditionNotSatisfied
+	}
+
+	if window.dailyStartMinute == window.dailyEndMinute {
+		return conditionSatisfied
+	}
+	location, err := time.LoadLocation(window.timezone)
+	if err != nil {
+		// Timezone is validated on policy creation. It can only fail if timezone database changed.
+		return conditionUnknown
+	}
+	localNow := ctx.now.In(location)
+	minute := uint32(localNow.Hour()*60 + localNow.Minute())
+	if window.dailyStartMinute < window.dailyEndMinute {
+		return boolToConditionState(minute >= window.dailyStartMinute && minute < window.dailyEndMinute)
+	}
+	// Window goes over the midnight
+	return boolToConditionState(minute >= window.dailyStartMinute || minute < window.dailyEndMinute)
+}
+
+func (c *conditionsRule) evaluateAuthMethod(ctx *accessContext) conditionState {
+	if len(c.authMethods) == 0 {
+		return conditionSatisfied
+	}
+	if ctx.authMethod == nativeIAmPolicyGRPC.AuthMethod_UNKNOWN {
+		return conditionUnknown
+	}
+	return boolToConditionState(slices.Contains(c.authMethods, ctx.authMethod))
+}
+
+func (c *conditionsRule) evaluateMFA(ctx *accessContext) conditionState {
+	if !c.requireMFA {
+		return conditionSatisfied
+	}
+	if ctx.authMethod == nativeIAmPolicyGRPC.AuthMethod_UNKNOWN {
+		return conditionUnknown
+	}
+	return boolToConditionState(ctx.mfa)
+}
+
+// Checks if the rule must be applied for the request.
+// Conditions fail closed: ALLOW rule applies only if all the conditions are satisfied, DENY rule applies if none of the conditions is known to be not satisfied.
+// Deferred conditions keep ALLOW rule and ignore DENY rule, because both will be copied to the token and evaluated on its usage.
+func (r *accessRule) applies(ctx *accessContext) bool {
+	if r.conditions == nil {
+		return true
+	}
+
+	for _, state := range []conditionState{
+		r.conditions.evaluateSourceIP(ctx),
+		r.conditions.evaluateTimeWindow(ctx),
+		r.conditions.evaluateAuthMethod(ctx),
+		r.conditions.evaluateMFA(ctx),
+	} {
+		switch state {
+		case conditionNotSatisfied:
 			return false
+		case conditionUnknown:
+			if !r.deny {
+				return false
+			}
+		case conditionDeferred:
+			if r.deny {
+				return false
+			}
 		}
 	}
 
 	return true
 }
 
-func areTokenScopesValidForIdentityScopes(policies []*nativeIAmPolicyGRPC.Policy, scopes []*nativeIAmTokenGRPC.Scope) bool {
-	for _, scope := range scopes {
-		scopeIsOk := false
-		for _, policy := range policies {
-			if (!scope.NamespaceIndependent && scope.Namespace == policy.Namespace) || policy.NamespaceIndependent {
-				if compareStringList(policy.Resources, scope.Resources) && compareStringList(policy.Actions, scope.Actions) {
-					scopeIsOk = true
-					break
-				}
+func (r *accessRule) coversNamespace(namespace string, namespaceIndependent bool) bool {
+	return (!namespaceIndependent && namespace == r.namespace) || r.namespaceIndependent
+}
+
+func (r *accessRule) allowsScope(namespace string, namespaceIndependent bool, resources []string, actions []string) bool {
+	if r.coversNamespace(namespace, namespaceIndependent) {
+		return compareStringList(r.resources, resources) && compareStringList(r.actions, actions)
+	}
+	return false
+}
+
+func (r *accessRule) deniesScope(namespace string, namespaceIndependent bool, resources []string, actions []string) bool {
+	if namespaceIndependent || r.namespaceIndependent || namespace == r.namespace {
+		return intersectStringList(r.resources, resources) && intersectStringList(r.actions, actions)
+	}
+	return false
+}
+
+// Checks if rules allow access to the scope. Returns the rule that allowed access.
+func rulesAllowScope(rules []*accessRule, ctx *accessContext, namespace string, namespaceIndependent bool, resources []string, actions []string) (*accessRule, bool) {
+	for _, rule := range rules {
+		if rule.deny && rule.applies(ctx) && rule.deniesScope(namespace, namespaceIndependent, resources, actions) {
+			return nil, false
+		}
+	}
+
+	// Prefer rules without deferred conditions, so the token will not get unnecessary restrictions
+	var allowedBy *accessRule
+	for _, rule := range rules {
+		if !rule.deny && rule.applies(ctx) && rule.allowsScope(namespace, namespaceIndependent, resources, actions) {
+			if !rule.conditions.hasRequestConditions() {
+				return rule, true
+			}
+			if allowedBy == nil {
+				allowedBy = rule
 			}
 		}
-		if !scopeIsOk {
+	}
+
+	return allowedBy, allowedBy != nil
+}
+
+func arePoliciesAllowScopes(policies []*nativeIAmPolicyGRPC.Policy, scopes []*nativeIAmAuthGRPC.Scope, ctx *accessContext) bool {
+	rules := policiesToRules(policies)
+	for _, scope := range scopes {
+		if _, ok := rulesAllowScope(rules, ctx, scope.Namespace, scope.NamespaceIndependent, scope.Resources, scope.Actions); !ok {
 			return false
 		}
 	}
@@ -94,21 +426,125 @@ func areTokenScopesValidForIdentityScopes(policies []*nativeIAmPolicyGRPC.Policy
 	return true
 }
 
-func areTokenScopesAllowAccess(tokenScopes []*nativeIAmTokenGRPC.Scope, systemScopes []*nativeIAmAuthGRPC.Scope) bool {
-	for _, systemScope := range systemScopes {
-		scopeIsOk := false
-		for _, tokenScope := range tokenScopes {
-			if (!systemScope.NamespaceIndependent && systemScope.Namespace == tokenScope.Namespace) || tokenScope.NamespaceIndependent {
-				if compareStringList(tokenScope.Resources, systemScope.Resources) && compareStringList(tokenScope.Actions, systemScope.Actions) {
-					scopeIsOk = true
-					break
-				}
+// Makes list of the scopes that will be assigned to the new token. Scopes must be already validated with arePoliciesAllowScopes.
+// Conditions of the policies and all the DENY policies are copied to the token, so they will be evaluated on every token usage.
+func makeTokenScopes(policies []*nativeIAmPolicyGRPC.Policy, scopes []*nativeIAmAuthGRPC.Scope, ctx *accessContext) []*nativeIAmTokenGRPC.Scope {
+	// Get all policies as scopes if list of scopes in request is empty
+	if len(scopes) == 0 {
+		scopesToAssign := make([]*nativeIAmTokenGRPC.Scope, len(policies))
+		for i, policy := range policies {
+			scopesToAssign[i] = &nativeIAmTokenGRPC.Scope{
+				Namespace:            policy.Namespace,
+				Resources:            policy.Resources,
+				Actions:              policy.Actions,
+				NamespaceIndependent: policy.NamespaceIndependent,
+				Deny:                 policy.Effect == nativeIAmPolicyGRPC.PolicyEffect_DENY,
+				Conditions:           policyConditionsToTokenConditions(policy.Conditions),
+			}
+		}
+		return scopesToAssign
+	}
+
+	rules := policiesToRules(policies)
+	scopesToAssign := make([]*nativeIAmTokenGRPC.Scope, 0, len(scopes))
+	for _, scope := range scopes {
+		tokenScope := &nativeIAmTokenGRPC.Scope{
+			Namespace:            scope.Namespace,
+			Resources:            scope.Resources,
+			Actions:              scope.Actions,
+			NamespaceIndependent: scope.NamespaceIndependent,
+		}
+		if rule, ok := rulesAllowScope(rules, ctx, scope.Namespace, scope.NamespaceIndependent, scope.Resources, scope.Actions); ok {
+			tokenScope.Conditions = policyConditionsToTokenConditions(policies[slices.Index(rules, rule)].Conditions)
+		}
+		scopesToAssign = append(scopesToAssign, tokenScope)
+	}
+	for _, policy := range policies {
+		if policy.Effect == nativeIAmPolicyGRPC.PolicyEffect_DENY {
+			scopesToAssign = append(scopesToAssign, &nativeIAmTokenGRPC.Scope{
+				Namespace:            policy.Namespace,
+				Resources:            policy.Resources,
+				Actions:              policy.Actions,
+				NamespaceIndependent: policy.NamespaceIndependent,
+				Deny:                 true,
+				Conditions:           policyConditionsToTokenConditions(policy.Conditions),
+			})
+		}
+	}
+
+	return scopesToAssign
+}
+
+// Checks if the scopes of the token are still allowed by the identity policies.
+// Every ALLOW scope must be allowed by some policy and every DENY policy that affects the token must be present in the token.
+func areTokenScopesValidForIdentityScopes(policies []*nativeIAmPolicyGRPC.Policy, tokenData *nativeIAmTokenGRPC.TokenData) bool {
+	ctx := newTokenCreationAccessContext(nativeIAmPolicyGRPC.AuthMethod(tokenData.AuthMethod), tokenData.Mfa)
+	policyRules := policiesToRules(policies)
+	tokenRules := tokenScopesToRules(tokenData.Scopes)
+
+	for _, tokenRule := range tokenRules {
+		if tokenRule.deny {
+			continue
+		}
+		if _, ok := rulesAllowScope(policyRules, ctx, tokenRule.namespace, tokenRule.namespaceIndependent, tokenRule.resources, tokenRule.actions); !ok {
+			return false
+		}
+	}
+
+	for _, policyRule := range policyRules {
+		if !policyRule.deny {
+			continue
+		}
+		affectsToken := false
+		for _, tokenRule := range tokenRules {
+			if !tokenRule.deny && policyRule.deniesScope(tokenRule.namespace, tokenRule.namespaceIndependent, tokenRule.resources, tokenRule.actions) {
+				affectsToken = true
+				break
 			}
 		}
-		if !scopeIsOk {
+		if !affectsToken {
+			continue
+		}
+		presentInToken := slices.ContainsFunc(tokenRules, func(tokenRule *accessRule) bool {
+			return tokenRule.deny &&
+				tokenRule.namespace == policyRule.namespace &&
+				tokenRule.namespaceIndependent == policyRule.namespaceIndependent &&
+				slices.Equal(tokenRule.resources, policyRule.resources) &&
+				slices.Equal(tokenRule.actions, policyRule.actions)
+		})
+		if !presentInToken {
+			return false
+		}
+	}
+
+	return true
+}
+
+func areTokenScopesAllowAccess(tokenScopes []*nativeIAmTokenGRPC.Scope, systemScopes []*nativeIAmAuthGRPC.Scope, ctx *accessContext) bool {
+	rules := tokenScopesToRules(tokenScopes)
+	for _, systemScope := range systemScopes {
+		if _, ok := rulesAllowScope(rules, ctx, systemScope.Namespace, systemScope.NamespaceIndependent, systemScope.Resources, systemScope.Actions); !ok {
 			return false
 		}
 	}
 
 	return true
 }
+
+// Checks if the scopes of the API key are allowed by the identity policies. Used by the API key service when key is created or rotated.
+func AreAPIKeyScopesAllowed(policies []*nativeIAmPolicyGRPC.Policy, scopes []*nativeIAmAuthenticationAPIKeyGRPC.Scope) bool {
+	keyScopes := make([]*nativeIAmAuthGRPC.Scope, len(scopes))
+	for i, scope := range scopes {
+		keyScopes[i] = &nativeIAmAuthGRPC.Scope{
+			Namespace:            scope.Namespace,
+			Resources:            scope.Resources,
+			Actions:              scope.Actions,
+			NamespaceIndependent: scope.NamespaceIndependent,
+		}
+	}
+	tokenData := &nativeIAmTokenGRPC.TokenData{
+		Scopes:     makeTokenScopes(policies, keyScopes, newTokenCreationAccessContext(nativeIAmPolicyGRPC.AuthMethod_API_KEY, false)),
+		AuthMethod: nativeIAmTokenGRPC.AuthMethod_API_KEY,
+	}
+	return areTokenScopesValidForIdentityScopes(policies, tokenData)
+}
@@ -0,0 +1,463 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	log "github.com/sirupsen/logrus"
+	grpccodes "google.golang.org/grpc/codes"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/apikey"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	nativeNamespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
+
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/identity"
+)
+
+const (
+	// Every API key starts with this prefix. It allows to quickly distinguish API keys from other secrets (e.g. in secret scanners)
+	API_KEY_PREFIX = "obp"
+	// Length of the random part of the key in bytes
+	API_KEY_SECRET_LENGTH = 32
+)
+
+// Checks if the policies of the identity allow the scopes of the API key. Implemented by the auth service, which depends on this package.
+type ScopesValidator func(policies []*nativeIAmPolicyGRPC.Policy, scopes []*grpc.Scope) bool
+
+type APIKeyService struct {
+	grpc.UnimplementedIAMAuthenticationAPIKeyServiceServer
+
+	nativeStub      *native.NativeStub
+	systemStub      *system.SystemStub
+	identityServer  *identity.IAmIdentityServer
+	scopesValidator ScopesValidator
+
+	globalMongoCollection *mongo.Collection
+	auditPublisher        *audit.Publisher
+}
+
+type scopeInMongo struct {
+	Namespace            string   `bson:"namespace"`
+	Resources            []string `bson:"resources"`
+	Actions              []string `bson:"actions"`
+	NamespaceIndependent bool     `bson:"namespaceIndependent"`
+}
+
+type apiKeyInMongo struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	Identity string             `bson:"identity"`
+	Name     string             `bson:"name"`
+	Scopes   []scopeInMongo     `bson:"scopes"`
+
+	Salt []byte `bson:"salt"`
+	Hash []byte `bson:"hash"`
+
+	Revoked   bool       `bson:"revoked"`
+	ExpiresAt *time.Time `bson:"expiresAt,omitempty"`
+
+	Created time.Time `bson:"created"`
+	Rotated time.Time `bson:"rotated"`
+	Version uint64    `bson:"version"`
+}
+
+func (k *apiKeyInMongo) ToGRPCAPIKey(namespace string) *grpc.APIKey {
+	scopes := make([]*grpc.Scope, 0, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		scopes = append(scopes, &grpc.Scope{
+			Namespace:            scope.Namespace,
+			Resources:            scope.Resources,
+			Actions:              scope.Actions,
+			NamespaceIndependent: scope.NamespaceIndependent,
+		})
+	}
+
+	var expiresAt *timestamppb.Timestamp
+	if k.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*k.ExpiresAt)
+	}
+
+	return &grpc.APIKey{
+		Namespace: namespace,
+		Uuid:      k.ID.Hex(),
+		Identity:  k.Identity,
+		Name:      k.Name,
+		Scopes:    scopes,
+		Revoked:   k.Revoked,
+		ExpiresAt: expiresAt,
+		Created:   timestamppb.New(k.Created),
+		Rotated:   timestamppb.New(k.Rotated),
+		Version:   k.Version,
+	}
+}
+
+func collectionByNamespace(s *APIKeyService, namespace string) *mongo.Collection {
+	if namespace == "" {
+		return s.globalMongoCollection
+	} else {
+		dbName := fmt.Sprintf("openbp_namespace_%s", namespace)
+		return s.systemStub.DB.Database(dbName).Collection("native_iam_authentication_apikey")
+	}
+}
+
+func NewAPIKeyService(ctx context.Context, systemStub *system.SystemStub, nativeStub *native.NativeStub, identityServer *identity.IAmIdentityServer, scopesValidator ScopesValidator) (*APIKeyService, error) {
+	err := EnsureIndexesForNamespace(ctx, "", systemStub)
+	if err != nil {
+		return nil, errors.New("failed to ensure indexes in global namespace: " + err.Error())
+	}
+
+	auditPublisher, err := audit.NewPublisher(systemStub.Nats, "native_iam")
+	if err != nil {
+		return nil, errors.New("failed to create audit publisher: " + err.Error())
+	}
+
+	return &APIKeyService{
+		systemStub:            systemStub,
+		nativeStub:            nativeStub,
+		identityServer:        identityServer,
+		scopesValidator:       scopesValidator,
+		globalMongoCollection: systemStub.DB.Database("openbp_global").Collection("native_iam_authentication_apikey"),
+		auditPublisher:        auditPublisher,
+	}, nil
+}
+
+// Builds key in the format "obp.<namespace>.<uuid>.<secret>". Namespace and uuid are used to find the key without scanning all the namespaces.
+func formatKey(namespace string, uuid string, secret string) string {
+	return strings.Join([]string{API_KEY_PREFIX, namespace, uuid, secret}, ".")
+}
+
+// Splits key into namespace, uuid and secret. Returns false if key has bad format.
+func parseKey(key string) (string, primitive.ObjectID, string, bool) {
+	parts := strings.Split(key, ".")
+	if len(parts) != 4 || parts[0] != API_KEY_PREFIX || parts[3] == "" {
+		return "", primitive.NilObjectID, "", false
+	}
+	id, err := primitive.ObjectIDFromHex(parts[2])
+	if err != nil {
+		return "", primitive.NilObjectID, "", false
+	}
+	return parts[1], id, parts[3], true
+}
+
+// Generates new random secret and its salted hash
+func (s *APIKeyService) generateSecret(ctx context.Context) (string, []byte, []byte, error) {
+	secretBytes := make([]byte, API_KEY_SECRET_LENGTH)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", nil, nil, status.Error(grpccodes.Internal, "Failed to generate secret: "+err.Error())
+	}
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+
+	salt := make([]byte, 32, 32+len(secret))
+	if _, err := rand.Read(salt); err != nil {
+		return "", nil, nil, status.Error(grpccodes.Internal, "Failed to generate salt: "+err.Error())
+	}
+
+	hashResponse, err := s.systemStub.Vault.HMACSign(ctx, &vault.HMACSignRequest{
+		Data: append(salt, secret...),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == grpccodes.FailedPrecondition {
+				return "", nil, nil, status.Error(grpccodes.FailedPrecondition, "The vault is sealed. Message from system_vault: "+err.Error())
+			}
+		}
+		return "", nil, nil, status.Error(grpccodes.Internal, "Failed to hash API key: "+err.Error())
+	}
+
+	return secret, salt, hashResponse.Signature, nil
+}
+
+// Checks if secret matches salted hash
+func (s *APIKeyService) verifySecret(ctx context.Context, key *apiKeyInMongo, secret string) (bool, error) {
+	dataToValidate := make([]byte, 0, len(key.Salt)+len(secret))
+	dataToValidate = append(dataToValidate, key.Salt...)
+	dataToValidate = append(dataToValidate, secret...)
+	verifyResponse, err := s.systemStub.Vault.HMACVerify(ctx, &vault.HMACVerifyRequest{
+		Data:      dataToValidate,
+		Signature: key.Hash,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == grpccodes.FailedPrecondition {
+				return false, status.Error(grpccodes.FailedPrecondition, "The vault is sealed. Message from system_vault: "+err.Error())
+			}
+		}
+		return false, status.Error(grpccodes.Internal, "Failed to verify API key: "+err.Error())
+	}
+
+	return verifyResponse.Valid, nil
+}
+
+func (s *APIKeyService) publishChange(ctx context.Context, namespace string, key *apiKeyInMongo, action string, beforeVersion uint64, details string) {
+	err := s.auditPublisher.Publish(ctx, audit.Change{
+		Namespace:     namespace,
+		Resource:      "native.iam.authentication.apikey." + key.ID.Hex(),
+		Action:        action,
+		BeforeVersion: beforeVersion,
+		AfterVersion:  key.Version,
+		Details:       details,
+	})
+	if err != nil {
+		log.Error("Failed to publish audit record about API key [" + key.ID.Hex() + "]: " + err.Error())
+	}
+}
+
+// Checks that identity exists and its policies allow the scopes of the API key
+func (s *APIKeyService) validateScopes(ctx context.Context, namespace string, identityUUID string, scopes []*grpc.Scope) error {
+	identityResponse, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{Namespace: namespace, Uuid: identityUUID, UseCache: true})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && (st.Code() == grpccodes.NotFound || st.Code() == grpccodes.InvalidArgument) {
+			return status.Error(grpccodes.InvalidArgument, "Identity doesnt exist")
+		}
+		return status.Error(grpccodes.Internal, "Failed to get identity: "+err.Error())
+	}
+
+	assigned, err := s.identityServer.FetchAssignedPolicies(ctx, identityResponse.Identity)
+	if err != nil {
+		return status.Error(grpccodes.Internal, "Failed to get policies of the identity: "+err.Error())
+	}
+	if !s.scopesValidator(identity.UniquePolicies(assigned), scopes) {
+		return status.Error(grpccodes.PermissionDenied, "API key scopes are not allowed by the identity policies")
+	}
+	return nil
+}
+
+func (s *APIKeyService) Create(ctx context.Context, in *grpc.CreateRequest) (*grpc.CreateResponse, error) {
+	if in.Identity == "" {
+		return nil, status.Error(grpccodes.InvalidArgument, "Identity must be provided")
+	}
+	if in.ExpiresAt != nil && !in.ExpiresAt.AsTime().After(time.Now()) {
+		return nil, status.Error(grpccodes.InvalidArgument, "Expiration time must be in the future")
+	}
+
+	// Check if namespace exists
+	if in.Namespace != "" {
+		namespaceExistResponse, err := s.nativeStub.Services.Namespace.Exists(ctx, &nativeNamespaceGRPC.IsNamespaceExistRequest{Name: in.Namespace, UseCache: true})
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to check if namespace exist "+err.Error())
+		}
+		if !namespaceExistResponse.Exist {
+			return nil, status.Error(grpccodes.FailedPrecondition, "Namespace doesnt exist")
+		}
+	}
+
+	if err := s.validateScopes(ctx, in.Namespace, in.Identity, in.Scopes); err != nil {
+		return nil, err
+	}
+
+	secret, salt, hash, err := s.generateSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]scopeInMongo, 0, len(in.Scopes))
+	for _, scope := range in.Scopes {
+		scopes = append(scopes, scopeInMongo{
+			Namespace:            scope.Namespace,
+			Resources:            scope.Resources,
+			Actions:              scope.Actions,
+			NamespaceIndependent: scope.NamespaceIndependent,
+		})
+	}
+
+	now := time.Now().UTC()
+	key := apiKeyInMongo{
+		Identity: in.Identity,
+		Name:     in.Name,
+		Scopes:   scopes,
+		Salt:     salt,
+		Hash:     hash,
+		Revoked:  false,
+		Created:  now,
+		Rotated:  now,
+		Version:  0,
+	}
+	if in.ExpiresAt != nil {
+		expiresAt := in.ExpiresAt.AsTime().UTC()
+		key.ExpiresAt = &expiresAt
+	}
+
+	collection := collectionByNamespace(s, in.Namespace)
+	insertResult, err := collection.InsertOne(ctx, key)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to insert API key to the database: "+err.Error())
+	}
+	key.ID = insertResult.InsertedID.(primitive.ObjectID)
+
+	s.publishChange(ctx, in.Namespace, &key, "native.iam.authentication.apikey.create", 0, "API key ["+key.Name+"] was created for identity ["+key.Identity+"]")
+
+	return &grpc.CreateResponse{
+		ApiKey: key.ToGRPCAPIKey(in.Namespace),
+		Key:    formatKey(in.Namespace, key.ID.Hex(), secret),
+	}, status.Error(grpccodes.OK, "")
+}
+
+func (s *APIKeyService) List(ctx context.Context, in *grpc.ListRequest) (*grpc.ListResponse, error) {
+	collection := collectionByNamespace(s, in.Namespace)
+	cursor, err := collection.Find(ctx, bson.M{"identity": in.Identity}, options.Find().SetSort(bson.D{{Key: "created", Value: -1}}))
+	if err != nil {
+		if err, ok := err.(mongo.ServerError); ok {
+			if err.HasErrorCode(73) { // InvalidNamespace
+				return &grpc.ListResponse{ApiKeys: []*grpc.APIKey{}}, status.Error(grpccodes.OK, "")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to fetch API keys from the database: "+err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	apiKeys := []*grpc.APIKey{}
+	for cursor.Next(ctx) {
+		var key apiKeyInMongo
+		if err := cursor.Decode(&key); err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to decode API key from the database: "+err.Error())
+		}
+		apiKeys = append(apiKeys, key.ToGRPCAPIKey(in.Namespace))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to fetch API keys from the database: "+err.Error())
+	}
+
+	return &grpc.ListResponse{ApiKeys: apiKeys}, status.Error(grpccodes.OK, "")
+}
+
+func (s *APIKeyService) Rotate(ctx context.Context, in *grpc.RotateRequest) (*grpc.RotateResponse, error) {
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return nil, status.Error(grpccodes.InvalidArgument, "API key UUID has bad format")
+	}
+
+	collection := collectionByNamespace(s, in.Namespace)
+	var current apiKeyInMongo
+	err = collection.FindOne(ctx, bson.M{"_id": id, "revoked": false}).Decode(&current)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(grpccodes.NotFound, "API key not found or was revoked")
+		}
+		if err, ok := err.(mongo.ServerError); ok {
+			if err.HasErrorCode(73) { // InvalidNamespace
+				return nil, status.Error(grpccodes.NotFound, "API key not found or was revoked")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to get API key from the database: "+err.Error())
+	}
+
+	// Privileges of the identity could shrink after the key was created. Rotated key must not keep scopes the identity doesnt have anymore.
+	if err := s.validateScopes(ctx, in.Namespace, current.Identity, current.ToGRPCAPIKey(in.Namespace).Scopes); err != nil {
+		return nil, err
+	}
+
+	secret, salt, hash, err := s.generateSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var key apiKeyInMongo
+	err = collection.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": id, "revoked": false, "version": current.Version},
+		bson.M{
+			"$set": bson.M{"salt": salt, "hash": hash, "rotated": time.Now().UTC()},
+			"$inc": bson.M{"version": 1},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&key)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(grpccodes.NotFound, "API key not found or was revoked")
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to update API key in the database: "+err.Error())
+	}
+
+	s.publishChange(ctx, in.Namespace, &key, "native.iam.authentication.apikey.rotate", key.Version-1, "API key ["+key.Name+"] of identity ["+key.Identity+"] was rotated")
+
+	return &grpc.RotateResponse{
+		ApiKey: key.ToGRPCAPIKey(in.Namespace),
+		Key:    formatKey(in.Namespace, key.ID.Hex(), secret),
+	}, status.Error(grpccodes.OK, "")
+}
+
+func (s *APIKeyService) Revoke(ctx context.Context, in *grpc.RevokeRequest) (*grpc.RevokeResponse, error) {
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return nil, status.Error(grpccodes.InvalidArgument, "API key UUID has bad format")
+	}
+
+	collection := collectionByNamespace(s, in.Namespace)
+	var key apiKeyInMongo
+	err = collection.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": id, "revoked": false},
+		bson.M{"$set": bson.M{"revoked": true}, "$inc": bson.M{"version": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&key)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return &grpc.RevokeResponse{Revoked: false}, status.Error(grpccodes.OK, "")
+		}
+		if err, ok := err.(mongo.ServerError); ok {
+			if err.HasErrorCode(73) { // InvalidNamespace
+				return &grpc.RevokeResponse{Revoked: false}, status.Error(grpccodes.OK, "")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to update API key in the database: "+err.Error())
+	}
+
+	s.publishChange(ctx, in.Namespace, &key, "native.iam.authentication.apikey.revoke", key.Version-1, "API key ["+key.Name+"] of identity ["+key.Identity+"] was revoked")
+
+	return &grpc.RevokeResponse{Revoked: true}, status.Error(grpccodes.OK, "")
+}
+
+func (s *APIKeyService) Authenticate(ctx context.Context, in *grpc.AuthenticateRequest) (*grpc.AuthenticateResponse, error) {
+	namespace, id, secret, ok := parseKey(in.Key)
+	if !ok {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_INVALID}, status.Error(grpccodes.OK, "")
+	}
+
+	collection := collectionByNamespace(s, namespace)
+	var key apiKeyInMongo
+	err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&key)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_NOT_FOUND}, status.Error(grpccodes.OK, "")
+		}
+		if err, ok := err.(mongo.ServerError); ok {
+			if err.HasErrorCode(73) { // InvalidNamespace
+				return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_NOT_FOUND}, status.Error(grpccodes.OK, "")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to fetch API key from the database: "+err.Error())
+	}
+
+	valid, err := s.verifySecret(ctx, &key, secret)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_INVALID}, status.Error(grpccodes.OK, "")
+	}
+
+	if key.Revoked {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_REVOKED}, status.Error(grpccodes.OK, "")
+	}
+	if key.ExpiresAt != nil && time.Now().After(*key.ExpiresAt) {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_EXPIRED}, status.Error(grpccodes.OK, "")
+	}
+
+	return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_OK, ApiKey: key.ToGRPCAPIKey(namespace)}, status.Error(grpccodes.OK, "")
+}
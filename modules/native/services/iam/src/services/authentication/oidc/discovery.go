@@ -0,0 +1,185 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	goJWT "github.com/golang-jwt/jwt"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+)
+
+const (
+	// How long discovered provider configuration is cached
+	oidc_discovery_cache_ttl = time.Hour
+	// How long user has to complete authentication on the provider side
+	oidc_request_lifetime = time.Minute * 10
+	// Timeout for every request to the provider
+	oidc_http_timeout = time.Second * 10
+	// Maximum allowed difference between clocks of OpenBP and provider
+	oidc_max_clock_skew = time.Minute * 3
+	// Maximum size of the discovery document
+	oidc_max_discovery_size = 1024 * 1024
+)
+
+// Part of the OpenID Provider Metadata used by this service
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+func makeDiscoveryCacheKey(issuer string) string {
+	return "native_iam_authentication_oidc_discovery_" + issuer
+}
+
+// Loads provider configuration from "<issuer>/.well-known/openid-configuration"
+func discover(ctx context.Context, systemStub *system.SystemStub, issuer string) (*discoveryDocument, error) {
+	cacheKey := makeDiscoveryCacheKey(issuer)
+	if cached, _ := systemStub.Cache.Get(ctx, cacheKey); cached != nil {
+		var document discoveryDocument
+		if err := json.Unmarshal(cached, &document); err == nil {
+			return &document, nil
+		}
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, oidc_http_timeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(requestCtx, http.MethodGet, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, errors.New("failed to create discovery request: " + err.Error())
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, errors.New("failed to load discovery document: " + err.Error())
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to load discovery document: provider responded with status %d", response.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(response.Body, oidc_max_discovery_size))
+	if err != nil {
+		return nil, errors.New("failed to read discovery document: " + err.Error())
+	}
+
+	var document discoveryDocument
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil, errors.New("failed to parse discovery document: " + err.Error())
+	}
+	if strings.TrimSuffix(document.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return nil, fmt.Errorf("issuer in the discovery document [%s] doesnt match configured issuer [%s]", document.Issuer, issuer)
+	}
+	if document.AuthorizationEndpoint == "" || document.TokenEndpoint == "" {
+		return nil, errors.New("discovery document doesnt have authorization or token endpoint")
+	}
+
+	if encoded, err := json.Marshal(document); err == nil {
+		systemStub.Cache.Set(ctx, cacheKey, encoded, oidc_discovery_cache_ttl)
+	}
+
+	return &document, nil
+}
+
+func generateRandomString() (string, error) {
+	value := make([]byte, 32)
+	if _, err := rand.Read(value); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(value), nil
+}
+
+type idTokenValidationError struct {
+	message string
+}
+
+func (e *idTokenValidationError) Error() string {
+	return e.message
+}
+
+// Validates claims of the ID token and returns them.
+// Signature is not verified: ID token was received directly from the token endpoint of the provider over TLS, so it can not be forged by the user (OpenID Connect Core 1.0, section 3.1.3.7).
+func validateIDToken(raw string, document *discoveryDocument, clientID string, nonce string, now time.Time) (goJWT.MapClaims, error) {
+	if raw == "" {
+		return nil, &idTokenValidationError{message: "token response doesnt have ID token"}
+	}
+
+	claims := goJWT.MapClaims{}
+	if _, _, err := new(goJWT.Parser).ParseUnverified(raw, claims); err != nil {
+		return nil, &idTokenValidationError{message: "failed to parse ID token: " + err.Error()}
+	}
+
+	if !claims.VerifyIssuer(document.Issuer, true) {
+		return nil, &idTokenValidationError{message: "ID token was issued by other issuer"}
+	}
+	if !claims.VerifyAudience(clientID, true) {
+		return nil, &idTokenValidationError{message: "ID token was issued for other client"}
+	}
+	if !claims.VerifyExpiresAt(now.Add(-oidc_max_clock_skew).Unix(), true) {
+		return nil, &idTokenValidationError{message: "ID token is expired"}
+	}
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		return nil, &idTokenValidationError{message: "ID token nonce doesnt match authentication request"}
+	}
+
+	return claims, nil
+}
+
+// Adds claims from the userinfo response that are not present in the ID token
+func mergeUserinfoClaims(claims goJWT.MapClaims, userinfo []byte) error {
+	var userinfoClaims map[string]interface{}
+	if err := json.Unmarshal(userinfo, &userinfoClaims); err != nil {
+		return errors.New("failed to parse userinfo response: " + err.Error())
+	}
+	// Userinfo response must be about the same user (OpenID Connect Core 1.0, section 5.3.2)
+	if sub, _ := userinfoClaims["sub"].(string); sub != claims["sub"] {
+		return errors.New("subject of the userinfo response doesnt match ID token")
+	}
+
+	for key, value := range userinfoClaims {
+		if _, ok := claims[key]; !ok {
+			claims[key] = value
+		}
+	}
+	return nil
+}
+
+// Returns claim as a string. Numbers are formatted. Other types are ignored.
+func claimString(claims goJWT.MapClaims, name string) string {
+	switch value := claims[name].(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	}
+	return ""
+}
+
+// Returns claim as a list of strings. Single string is treated as a list with one element.
+func claimStrings(claims goJWT.MapClaims, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		result := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return []string{}
+}
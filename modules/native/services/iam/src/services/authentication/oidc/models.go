@@ -0,0 +1,156 @@
+package oidc
+
+import (
+	"time"
+
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oidc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var defaultScopes = []string{"openid", "email", "profile"}
+
+type claimMappingInMongo struct {
+	Subject  string `bson:"subject"`
+	Login    string `bson:"login"`
+	Email    string `bson:"email"`
+	FullName string `bson:"fullName"`
+	Avatar   string `bson:"avatar"`
+	Groups   string `bson:"groups"`
+}
+
+type roleAssignmentRuleInMongo struct {
+	Group         string `bson:"group"`
+	RoleNamespace string `bson:"roleNamespace"`
+	Role          string `bson:"role"`
+}
+
+type providerInMongo struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty"`
+	Name    string             `bson:"name"`
+	Enabled bool               `bson:"enabled"`
+
+	Issuer   string `bson:"issuer"`
+	ClientID string `bson:"clientId"`
+	// Encrypted with system_vault
+	ClientSecret []byte   `bson:"clientSecret"`
+	Scopes       []string `bson:"scopes"`
+
+	ClaimMapping        claimMappingInMongo         `bson:"claimMapping"`
+	JITProvisioning     bool                        `bson:"jitProvisioning"`
+	RoleAssignmentRules []roleAssignmentRuleInMongo `bson:"roleAssignmentRules"`
+
+	Created time.Time `bson:"created"`
+	Updated time.Time `bson:"updated"`
+	Version uint64    `bson:"version"`
+}
+
+func (p *providerInMongo) ToGRPCProvider(namespace string) *grpc.OIDCProvider {
+	rules := make([]*grpc.RoleAssignmentRule, len(p.RoleAssignmentRules))
+	for i, rule := range p.RoleAssignmentRules {
+		rules[i] = &grpc.RoleAssignmentRule{
+			Group:         rule.Group,
+			RoleNamespace: rule.RoleNamespace,
+			Role:          rule.Role,
+		}
+	}
+
+	return &grpc.OIDCProvider{
+		Namespace:       namespace,
+		Uuid:            p.ID.Hex(),
+		Name:            p.Name,
+		Enabled:         p.Enabled,
+		Issuer:          p.Issuer,
+		ClientId:        p.ClientID,
+		HasClientSecret: len(p.ClientSecret) != 0,
+		Scopes:          p.Scopes,
+		ClaimMapping: &grpc.ClaimMapping{
+			Subject:  p.ClaimMapping.Subject,
+			Login:    p.ClaimMapping.Login,
+			Email:    p.ClaimMapping.Email,
+			FullName: p.ClaimMapping.FullName,
+			Avatar:   p.ClaimMapping.Avatar,
+			Groups:   p.ClaimMapping.Groups,
+		},
+		JitProvisioning:     p.JITProvisioning,
+		RoleAssignmentRules: rules,
+		Created:             timestamppb.New(p.Created),
+		Updated:             timestamppb.New(p.Updated),
+		Version:             p.Version,
+	}
+}
+
+// Fills empty values with the standard OIDC claims
+func claimMappingFromGRPC(mapping *grpc.ClaimMapping) claimMappingInMongo {
+	result := claimMappingInMongo{
+		Subject:  "sub",
+		Login:    "preferred_username",
+		Email:    "email",
+		FullName: "name",
+		Avatar:   "picture",
+		Groups:   "groups",
+	}
+	if mapping == nil {
+		return result
+	}
+
+	if mapping.Subject != "" {
+		result.Subject = mapping.Subject
+	}
+	if mapping.Login != "" {
+		result.Login = mapping.Login
+	}
+	if mapping.Email != "" {
+		result.Email = mapping.Email
+	}
+	if mapping.FullName != "" {
+		result.FullName = mapping.FullName
+	}
+	if mapping.Avatar != "" {
+		result.Avatar = mapping.Avatar
+	}
+	if mapping.Groups != "" {
+		result.Groups = mapping.Groups
+	}
+	return result
+}
+
+func roleAssignmentRulesFromGRPC(rules []*grpc.RoleAssignmentRule) []roleAssignmentRuleInMongo {
+	result := make([]roleAssignmentRuleInMongo, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, roleAssignmentRuleInMongo{
+			Group:         rule.Group,
+			RoleNamespace: rule.RoleNamespace,
+			Role:          rule.Role,
+		})
+	}
+	return result
+}
+
+// "openid" scope is required to receive ID token
+func scopesFromGRPC(scopes []string) []string {
+	if len(scopes) == 0 {
+		return defaultScopes
+	}
+	for _, scope := range scopes {
+		if scope == "openid" {
+			return scopes
+		}
+	}
+	return append([]string{"openid"}, scopes...)
+}
+
+// Link between subject on the provider side and identity
+type linkInMongo struct {
+	Provider string    `bson:"provider"`
+	Subject  string    `bson:"subject"`
+	Identity string    `bson:"identity"`
+	Created  time.Time `bson:"created"`
+}
+
+// Authentication that was started, but not completed yet. Stored in redis until provider redirects user back.
+type pendingAuthentication struct {
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"codeVerifier"`
+	RedirectURL  string `json:"redirectURL"`
+}
@@ -0,0 +1,546 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/redis/go-redis/v9"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	nativeActorUserGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/actor/user"
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oidc"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	nativeNamespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/actor/user"
+	oauthProvider "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/oauth/provider"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/identity"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
+)
+
+type OIDCServer struct {
+	grpc.UnimplementedIAMAuthenticationOIDCServiceServer
+
+	systemStub     *system.SystemStub
+	nativeStub     *native.NativeStub
+	identityServer *identity.IAmIdentityServer
+	userServer     *user.ActorUserServer
+}
+
+func NewOIDCServer(ctx context.Context, systemStub *system.SystemStub, nativeStub *native.NativeStub, identityServer *identity.IAmIdentityServer, userServer *user.ActorUserServer) (*OIDCServer, error) {
+	err := EnsureIndexesForNamespace(ctx, "", systemStub)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to ensure indexes for global namespace"), err)
+	}
+
+	return &OIDCServer{
+		systemStub:     systemStub,
+		nativeStub:     nativeStub,
+		identityServer: identityServer,
+		userServer:     userServer,
+	}, nil
+}
+
+func makeStateRedisKey(namespace string, provider string, state string) string {
+	return "openbp_native_iam_authentication_oidc_state_" + namespace + "_" + provider + "_" + state
+}
+
+func (s *OIDCServer) encryptClientSecret(ctx context.Context, secret string) ([]byte, error) {
+	response, err := s.systemStub.Vault.Encrypt(ctx, &vault.EncryptRequest{PlainData: []byte(secret)})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			return nil, status.Error(codes.FailedPrecondition, "failed to encrypt client secret: vault is sealed")
+		}
+		return nil, status.Error(codes.Internal, "failed to encrypt client secret: "+err.Error())
+	}
+	return response.EncryptedData, nil
+}
+
+func (s *OIDCServer) decryptClientSecret(ctx context.Context, secret []byte) (string, error) {
+	response, err := s.systemStub.Vault.Decrypt(ctx, &vault.DecryptRequest{EncryptedData: secret})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			return "", status.Error(codes.FailedPrecondition, "failed to decrypt client secret: vault is sealed")
+		}
+		return "", status.Error(codes.Internal, "failed to decrypt client secret: "+err.Error())
+	}
+	return string(response.PlainData), nil
+}
+
+// Returns nil if provider doesnt exist
+func (s *OIDCServer) getProvider(ctx context.Context, namespace string, uuid string) (*providerInMongo, error) {
+	id, err := primitive.ObjectIDFromHex(uuid)
+	if err != nil {
+		return nil, nil
+	}
+
+	var provider providerInMongo
+	if err := providerCollectionByNamespace(s.systemStub, namespace).FindOne(ctx, bson.M{"_id": id}).Decode(&provider); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return nil, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load provider from database: %v", err)
+	}
+	return &provider, nil
+}
+
+func validateProviderSettings(issuer string, clientID string, rules []*grpc.RoleAssignmentRule) error {
+	if u, err := url.Parse(issuer); err != nil || !u.IsAbs() {
+		return status.Error(codes.InvalidArgument, "issuer must be absolute URL")
+	}
+	if clientID == "" {
+		return status.Error(codes.InvalidArgument, "clientId must not be empty")
+	}
+	for _, rule := range rules {
+		if rule.Group == "" {
+			return status.Error(codes.InvalidArgument, "group of the role assignment rule must not be empty")
+		}
+		if _, err := primitive.ObjectIDFromHex(rule.Role); err != nil {
+			return status.Error(codes.InvalidArgument, "role of the role assignment rule has bad format")
+		}
+	}
+	return nil
+}
+
+func (s *OIDCServer) CreateProvider(ctx context.Context, in *grpc.CreateProviderRequest) (*grpc.CreateProviderResponse, error) {
+	if err := validateProviderSettings(in.Issuer, in.ClientId, in.RoleAssignmentRules); err != nil {
+		return nil, err
+	}
+
+	if in.Namespace != "" {
+		namespaceExistResponse, err := s.nativeStub.Services.Namespace.Exists(ctx, &nativeNamespaceGRPC.IsNamespaceExistRequest{Name: in.Namespace, UseCache: true})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to check if namespace exist: "+err.Error())
+		}
+		if !namespaceExistResponse.Exist {
+			return nil, status.Error(codes.FailedPrecondition, "namespace doesnt exist")
+		}
+	}
+
+	var clientSecret []byte
+	if in.ClientSecret != "" {
+		var err error
+		clientSecret, err = s.encryptClientSecret(ctx, in.ClientSecret)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	creationTime := time.Now().UTC()
+	provider := providerInMongo{
+		Name:                in.Name,
+		Enabled:             in.Enabled,
+		Issuer:              in.Issuer,
+		ClientID:            in.ClientId,
+		ClientSecret:        clientSecret,
+		Scopes:              scopesFromGRPC(in.Scopes),
+		ClaimMapping:        claimMappingFromGRPC(in.ClaimMapping),
+		JITProvisioning:     in.JitProvisioning,
+		RoleAssignmentRules: roleAssignmentRulesFromGRPC(in.RoleAssignmentRules),
+		Created:             creationTime,
+		Updated:             creationTime,
+		Version:             0,
+	}
+
+	insertResult, err := providerCollectionByNamespace(s.systemStub, in.Namespace).InsertOne(ctx, provider)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to insert provider to the database: %v", err)
+	}
+	provider.ID = insertResult.InsertedID.(primitive.ObjectID)
+
+	return &grpc.CreateProviderResponse{Provider: provider.ToGRPCProvider(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) UpdateProvider(ctx context.Context, in *grpc.UpdateProviderRequest) (*grpc.UpdateProviderResponse, error) {
+	if err := validateProviderSettings(in.Issuer, in.ClientId, in.RoleAssignmentRules); err != nil {
+		return nil, err
+	}
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "provider not found")
+	}
+
+	setQuery := bson.M{
+		"name":                in.Name,
+		"enabled":             in.Enabled,
+		"issuer":              in.Issuer,
+		"clientId":            in.ClientId,
+		"scopes":              scopesFromGRPC(in.Scopes),
+		"claimMapping":        claimMappingFromGRPC(in.ClaimMapping),
+		"jitProvisioning":     in.JitProvisioning,
+		"roleAssignmentRules": roleAssignmentRulesFromGRPC(in.RoleAssignmentRules),
+		"updated":             time.Now().UTC(),
+	}
+	if in.ClientSecret != "" {
+		clientSecret, err := s.encryptClientSecret(ctx, in.ClientSecret)
+		if err != nil {
+			return nil, err
+		}
+		setQuery["clientSecret"] = clientSecret
+	}
+
+	var provider providerInMongo
+	err = providerCollectionByNamespace(s.systemStub, in.Namespace).FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": id},
+		bson.M{"$set": setQuery, "$inc": bson.M{"version": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&provider)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "provider not found")
+		}
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return nil, status.Error(codes.NotFound, "provider not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update provider in the database: %v", err)
+	}
+
+	return &grpc.UpdateProviderResponse{Provider: provider.ToGRPCProvider(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) GetProvider(ctx context.Context, in *grpc.GetProviderRequest) (*grpc.GetProviderResponse, error) {
+	provider, err := s.getProvider(ctx, in.Namespace, in.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return nil, status.Error(codes.NotFound, "provider not found")
+	}
+
+	return &grpc.GetProviderResponse{Provider: provider.ToGRPCProvider(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) ListProviders(ctx context.Context, in *grpc.ListProvidersRequest) (*grpc.ListProvidersResponse, error) {
+	cursor, err := providerCollectionByNamespace(s.systemStub, in.Namespace).Find(ctx, bson.M{})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return &grpc.ListProvidersResponse{Providers: []*grpc.OIDCProvider{}}, status.Error(codes.OK, "")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list providers from the database: %v", err)
+	}
+	var providers []providerInMongo
+	if err := cursor.All(ctx, &providers); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode providers from the database: %v", err)
+	}
+
+	result := make([]*grpc.OIDCProvider, len(providers))
+	for i, provider := range providers {
+		result[i] = provider.ToGRPCProvider(in.Namespace)
+	}
+	return &grpc.ListProvidersResponse{Providers: result}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) DeleteProvider(ctx context.Context, in *grpc.DeleteProviderRequest) (*grpc.DeleteProviderResponse, error) {
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return &grpc.DeleteProviderResponse{Existed: false}, status.Error(codes.OK, "")
+	}
+
+	deleteResult, err := providerCollectionByNamespace(s.systemStub, in.Namespace).DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return &grpc.DeleteProviderResponse{Existed: false}, status.Error(codes.OK, "")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete provider from the database: %v", err)
+	}
+
+	if _, err := linkCollectionByNamespace(s.systemStub, in.Namespace).DeleteMany(ctx, bson.M{"provider": in.Uuid}); err != nil {
+		return nil, status.Errorf(codes.Internal, "provider was deleted, but failed to delete its links: %v", err)
+	}
+
+	return &grpc.DeleteProviderResponse{Existed: deleteResult.DeletedCount != 0}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) StartAuthentication(ctx context.Context, in *grpc.StartAuthenticationRequest) (*grpc.StartAuthenticationResponse, error) {
+	if u, err := url.Parse(in.RedirectURL); err != nil || !u.IsAbs() {
+		return nil, status.Error(codes.InvalidArgument, "redirectURL must be absolute URL")
+	}
+
+	provider, err := s.getProvider(ctx, in.Namespace, in.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return &grpc.StartAuthenticationResponse{Status: grpc.StartAuthenticationResponse_PROVIDER_NOT_FOUND}, status.Error(codes.OK, "")
+	}
+	if !provider.Enabled {
+		return &grpc.StartAuthenticationResponse{Status: grpc.StartAuthenticationResponse_PROVIDER_DISABLED}, status.Error(codes.OK, "")
+	}
+
+	document, err := discover(ctx, s.systemStub, provider.Issuer)
+	if err != nil {
+		log.Warn("OIDC discovery failed for provider [" + provider.ID.Hex() + "] in namespace [" + in.Namespace + "]: " + err.Error())
+		return &grpc.StartAuthenticationResponse{Status: grpc.StartAuthenticationResponse_DISCOVERY_FAILED}, status.Error(codes.OK, "")
+	}
+
+	state, err := generateRandomString()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate state: %v", err)
+	}
+	nonce, err := generateRandomString()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate nonce: %v", err)
+	}
+	pending := pendingAuthentication{
+		Nonce:        nonce,
+		CodeVerifier: oauth2.GenerateVerifier(),
+		RedirectURL:  in.RedirectURL,
+	}
+	encodedPending, err := json.Marshal(pending)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode authentication request: %v", err)
+	}
+
+	// Authorization code is only accepted together with the state created by this service
+	if err := s.systemStub.Redis.Set(ctx, makeStateRedisKey(in.Namespace, in.Provider, state), encodedPending, oidc_request_lifetime).Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save authentication request in redis: %v", err)
+	}
+
+	oidcProvider := oauthProvider.NewOIDCProvider()
+	oidcProvider.SetAuthUrl(document.AuthorizationEndpoint)
+	oidcProvider.SetClientId(provider.ClientID)
+	oidcProvider.SetScopes(provider.Scopes)
+	oidcProvider.SetRedirectUrl(in.RedirectURL)
+
+	return &grpc.StartAuthenticationResponse{
+		Status:           grpc.StartAuthenticationResponse_OK,
+		AuthorizationURL: oidcProvider.BuildAuthUrl(state, oauth2.S256ChallengeOption(pending.CodeVerifier), oauth2.SetAuthURLParam("nonce", nonce)),
+		State:            state,
+	}, status.Error(codes.OK, "")
+}
+
+// Exchanges authorization code for tokens and returns validated claims of the user
+func (s *OIDCServer) fetchClaims(ctx context.Context, providerData *providerInMongo, pending *pendingAuthentication, code string) (map[string]interface{}, *grpc.AuthenticateResponse, error) {
+	document, err := discover(ctx, s.systemStub, providerData.Issuer)
+	if err != nil {
+		return nil, &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_TOKEN_EXCHANGE_FAILED, Message: "failed to load provider configuration"}, nil
+	}
+
+	oidcProvider := oauthProvider.NewOIDCProvider()
+	oidcProvider.SetTokenUrl(document.TokenEndpoint)
+	oidcProvider.SetUserApiUrl(document.UserinfoEndpoint)
+	oidcProvider.SetClientId(providerData.ClientID)
+	oidcProvider.SetScopes(providerData.Scopes)
+	oidcProvider.SetRedirectUrl(pending.RedirectURL)
+	if len(providerData.ClientSecret) != 0 {
+		secret, err := s.decryptClientSecret(ctx, providerData.ClientSecret)
+		if err != nil {
+			return nil, nil, err
+		}
+		oidcProvider.SetClientSecret(secret)
+	}
+
+	providerContext, cancelProviderContext := context.WithTimeout(ctx, oidc_http_timeout)
+	defer cancelProviderContext()
+	oidcProvider.SetContext(providerContext)
+
+	token, err := oidcProvider.FetchToken(code, oauth2.VerifierOption(pending.CodeVerifier))
+	if err != nil {
+		log.Warn("OIDC token exchange failed for provider [" + providerData.ID.Hex() + "]: " + err.Error())
+		return nil, &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_TOKEN_EXCHANGE_FAILED, Message: "failed to exchange authorization code"}, nil
+	}
+
+	rawIDToken, _ := token.Extra("id_token").(string)
+	claims, err := validateIDToken(rawIDToken, document, providerData.ClientID, pending.Nonce, time.Now())
+	if err != nil {
+		return nil, &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_ID_TOKEN_INVALID, Message: err.Error()}, nil
+	}
+
+	// Some providers only put profile claims to the userinfo response
+	if document.UserinfoEndpoint != "" {
+		userinfo, err := oidcProvider.FetchRawUserData(token)
+		if err != nil {
+			log.Warn("OIDC userinfo request failed for provider [" + providerData.ID.Hex() + "]: " + err.Error())
+			return nil, &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_TOKEN_EXCHANGE_FAILED, Message: "failed to load user information"}, nil
+		}
+		if err := mergeUserinfoClaims(claims, userinfo); err != nil {
+			return nil, &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_ID_TOKEN_INVALID, Message: err.Error()}, nil
+		}
+	}
+
+	return claims, nil, nil
+}
+
+func (s *OIDCServer) Authenticate(ctx context.Context, in *grpc.AuthenticateRequest) (*grpc.AuthenticateResponse, error) {
+	provider, err := s.getProvider(ctx, in.Namespace, in.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_PROVIDER_NOT_FOUND}, status.Error(codes.OK, "")
+	}
+	if !provider.Enabled {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_PROVIDER_DISABLED}, status.Error(codes.OK, "")
+	}
+
+	// Every state can only be used once
+	encodedPending, err := s.systemStub.Redis.GetDel(ctx, makeStateRedisKey(in.Namespace, in.Provider, in.State)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_STATE_INVALID, Message: "unknown or already used state"}, status.Error(codes.OK, "")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load authentication request from redis: %v", err)
+	}
+	var pending pendingAuthentication
+	if err := json.Unmarshal(encodedPending, &pending); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode authentication request: %v", err)
+	}
+
+	claims, failedResponse, err := s.fetchClaims(ctx, provider, &pending, in.Code)
+	if err != nil {
+		return nil, err
+	}
+	if failedResponse != nil {
+		return failedResponse, status.Error(codes.OK, "")
+	}
+
+	subject := claimString(claims, provider.ClaimMapping.Subject)
+	if subject == "" {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_ID_TOKEN_INVALID, Message: "subject claim [" + provider.ClaimMapping.Subject + "] is missing"}, status.Error(codes.OK, "")
+	}
+
+	linkCollection := linkCollectionByNamespace(s.systemStub, in.Namespace)
+	var link linkInMongo
+	err = linkCollection.FindOne(ctx, bson.M{"provider": in.Provider, "subject": subject}).Decode(&link)
+	if err == nil {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_OK, Identity: link.Identity, Subject: subject}, status.Error(codes.OK, "")
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, status.Errorf(codes.Internal, "failed to load link from the database: %v", err)
+	}
+
+	if !provider.JITProvisioning {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_UNAUTHENTICATED, Subject: subject}, status.Error(codes.OK, "")
+	}
+
+	// Just-in-time provisioning
+	login := claimString(claims, provider.ClaimMapping.Login)
+	if login == "" {
+		login = subject
+	}
+	userResponse, err := s.userServer.Create(ctx, &nativeActorUserGRPC.CreateRequest{
+		Namespace: in.Namespace,
+		Login:     login,
+		FullName:  claimString(claims, provider.ClaimMapping.FullName),
+		Avatar:    claimString(claims, provider.ClaimMapping.Avatar),
+		Email:     claimString(claims, provider.ClaimMapping.Email),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.AlreadyExists {
+			return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_LOGIN_ALREADY_TAKEN, Message: "user with login [" + login + "] already exists", Subject: subject}, status.Error(codes.OK, "")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
+	}
+	identityUUID := userResponse.User.Identity
+
+	_, err = linkCollection.InsertOne(ctx, linkInMongo{
+		Provider: in.Provider,
+		Subject:  subject,
+		Identity: identityUUID,
+		Created:  time.Now().UTC(),
+	})
+	if err != nil {
+		s.userServer.Delete(ctx, &nativeActorUserGRPC.DeleteRequest{
+			Namespace: in.Namespace,
+			Uuid:      userResponse.User.Uuid,
+		})
+		return nil, status.Errorf(codes.Internal, "failed to link created identity: %v", err)
+	}
+
+	s.assignRoles(ctx, in.Namespace, identityUUID, provider, claimStrings(claims, provider.ClaimMapping.Groups))
+
+	return &grpc.AuthenticateResponse{
+		Status:          grpc.AuthenticateResponse_OK,
+		Identity:        identityUUID,
+		Subject:         subject,
+		IdentityCreated: true,
+	}, status.Error(codes.OK, "")
+}
+
+// Assigns roles to the created identity based on its groups. Misconfigured rules (for example with deleted roles) are skipped, so they dont block the login.
+func (s *OIDCServer) assignRoles(ctx context.Context, namespace string, identityUUID string, provider *providerInMongo, groups []string) {
+	groupSet := make(map[string]struct{}, len(groups))
+	for _, group := range groups {
+		groupSet[group] = struct{}{}
+	}
+
+	for _, rule := range provider.RoleAssignmentRules {
+		if _, ok := groupSet[rule.Group]; !ok {
+			continue
+		}
+		_, err := s.identityServer.AddRole(ctx, &nativeIAmIdentityGRPC.AddRoleRequest{
+			IdentityNamespace: namespace,
+			IdentityUUID:      identityUUID,
+			RoleNamespace:     rule.RoleNamespace,
+			RoleUUID:          rule.Role,
+		})
+		if err != nil {
+			log.Warn("Failed to assign role [" + rule.Role + "] from group [" + rule.Group + "] of OIDC provider [" + provider.ID.Hex() + "] to identity [" + identityUUID + "]: " + err.Error())
+		}
+	}
+}
+
+func (s *OIDCServer) LinkIdentity(ctx context.Context, in *grpc.LinkIdentityRequest) (*grpc.LinkIdentityResponse, error) {
+	if in.Subject == "" {
+		return nil, status.Error(codes.InvalidArgument, "subject must not be empty")
+	}
+
+	provider, err := s.getProvider(ctx, in.Namespace, in.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return &grpc.LinkIdentityResponse{Status: grpc.LinkIdentityResponse_PROVIDER_NOT_FOUND}, status.Error(codes.OK, "")
+	}
+
+	existResponse, err := s.identityServer.Exists(ctx, &nativeIAmIdentityGRPC.ExistsIdentityRequest{Namespace: in.Namespace, Uuid: in.Identity, UseCache: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check if identity exists: %v", err)
+	}
+	if !existResponse.Exists {
+		return nil, status.Error(codes.NotFound, "identity not found")
+	}
+
+	_, err = linkCollectionByNamespace(s.systemStub, in.Namespace).InsertOne(ctx, linkInMongo{
+		Provider: in.Provider,
+		Subject:  in.Subject,
+		Identity: in.Identity,
+		Created:  time.Now().UTC(),
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return &grpc.LinkIdentityResponse{Status: grpc.LinkIdentityResponse_ALREADY_LINKED}, status.Error(codes.OK, "")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to insert link to the database: %v", err)
+	}
+
+	return &grpc.LinkIdentityResponse{Status: grpc.LinkIdentityResponse_OK}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) UnlinkIdentity(ctx context.Context, in *grpc.UnlinkIdentityRequest) (*grpc.UnlinkIdentityResponse, error) {
+	deleteResult, err := linkCollectionByNamespace(s.systemStub, in.Namespace).DeleteOne(ctx, bson.M{"provider": in.Provider, "subject": in.Subject})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return &grpc.UnlinkIdentityResponse{Existed: false}, status.Error(codes.OK, "")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete link from the database: %v", err)
+	}
+
+	return &grpc.UnlinkIdentityResponse{Existed: deleteResult.DeletedCount != 0}, status.Error(codes.OK, "")
+}
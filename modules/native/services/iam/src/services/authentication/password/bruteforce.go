@@ -0,0 +1,248 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/password"
+	nativeIAmConfigGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/config"
+)
+
+const (
+	LOCKOUT_EVENT_SUBJECT = "native.iam.event.lockout.created"
+
+	lockout_redis_key_prefix = "native_iam_lockout_"
+)
+
+func makeIdentityFailuresRedisKey(namespace string, identity string) string {
+	return fmt.Sprintf("native_iam_bruteforce_identity_%s_%s", namespace, identity)
+}
+
+func makeIdentityDelayRedisKey(namespace string, identity string) string {
+	return fmt.Sprintf("native_iam_bruteforce_delay_%s_%s", namespace, identity)
+}
+
+func makeSourceFailuresRedisKey(sourceIP string) string {
+	return fmt.Sprintf("native_iam_bruteforce_source_%s", sourceIP)
+}
+
+func makeIdentityLockoutRedisKey(namespace string, identity string) string {
+	return fmt.Sprintf("%sidentity_%s_%s", lockout_redis_key_prefix, namespace, identity)
+}
+
+func makeSourceLockoutRedisKey(sourceIP string) string {
+	return fmt.Sprintf("%ssource_%s", lockout_redis_key_prefix, sourceIP)
+}
+
+// Converts remaining time to live of the redis key to the number of seconds the caller must wait. Rounds up.
+func ttlToRetryAfter(ttl time.Duration) uint32 {
+	if ttl <= 0 {
+		return 0
+	}
+	return uint32((ttl + time.Second - 1) / time.Second)
+}
+
+// Checks if authentication attempt for the identity from the source is allowed. Returns number of seconds the caller must wait or 0 if attempt is allowed.
+func (s *PasswordIdentificationService) checkThrottled(ctx context.Context, namespace string, identity string, sourceIP string) (uint32, error) {
+	keys := []string{
+		makeIdentityLockoutRedisKey(namespace, identity),
+		makeIdentityDelayRedisKey(namespace, identity),
+	}
+	if sourceIP != "" {
+		keys = append(keys, makeSourceLockoutRedisKey(sourceIP))
+	}
+
+	pipe := s.systemStub.Redis.Pipeline()
+	ttlCommands := make([]*redis.DurationCmd, len(keys))
+	for index, key := range keys {
+		ttlCommands[index] = pipe.PTTL(ctx, key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return 0, errors.New("failed to check lockouts: " + err.Error())
+	}
+
+	var retryAfter uint32 = 0
+	for _, command := range ttlCommands {
+		if wait := ttlToRetryAfter(command.Val()); wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+	return retryAfter, nil
+}
+
+// Sets expiration together with the first increment, so counter can not stay without expiration if the process dies between the calls
+var incrementFailuresScript = redis.NewScript(`
+local failures = redis.call("INCR", KEYS[1])
+if failures == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return failures
+`)
+
+// Increments failures counter. Counter expires after the window that starts on the first failure.
+func (s *PasswordIdentificationService) incrementFailures(ctx context.Context, key string, window time.Duration) (uint32, error) {
+	failures, err := incrementFailuresScript.Run(ctx, s.systemStub.Redis, []string{key}, window.Milliseconds()).Int64()
+	if err != nil {
+		return 0, err
+	}
+	return uint32(failures), nil
+}
+
+// Creates lockout if it doesnt exist yet and publishes lockout event
+func (s *PasswordIdentificationService) createLockout(ctx context.Context, key string, lockout *grpc.Lockout, duration time.Duration) error {
+	now := time.Now().UTC()
+	lockout.LockedAt = timestamppb.New(now)
+	lockout.LockedUntil = timestamppb.New(now.Add(duration))
+
+	lockoutBytes, err := proto.Marshal(lockout)
+	if err != nil {
+		return errors.New("failed to marshal lockout: " + err.Error())
+	}
+	created, err := s.systemStub.Redis.SetNX(ctx, key, lockoutBytes, duration).Result()
+	if err != nil {
+		return errors.New("failed to save lockout: " + err.Error())
+	}
+	if created {
+		// Lockout is already saved, so failure to notify about it must not fail the authentication flow
+		if _, err := s.jetstreamClient.Publish(LOCKOUT_EVENT_SUBJECT, lockoutBytes); err != nil {
+			log.Error("Failed to publish event about lockout [" + key + "]: " + err.Error())
+		}
+	}
+	return nil
+}
+
+// Registers failed authentication attempt. Increases delay for the next attempt and locks identity or source if there were too many failed attempts.
+// Returns number of seconds the caller must wait before the next attempt.
+func (s *PasswordIdentificationService) registerFailedAttempt(ctx context.Context, config *nativeIAmConfigGRPC.Configuration_BruteForceProtection, namespace string, identity string, sourceIP string) (uint32, error) {
+	window := time.Duration(config.FailedAttemptsWindow) * time.Second
+	lockoutDuration := time.Duration(config.LockoutDuration) * time.Second
+
+	identityFailures, err := s.incrementFailures(ctx, makeIdentityFailuresRedisKey(namespace, identity), window)
+	if err != nil {
+		return 0, errors.New("failed to count failed attempt for the identity: " + err.Error())
+	}
+
+	// Delay doubles after every failed attempt: 1s, 2s, 4s, ... up to the maximal delay
+	delay := time.Duration(config.MaxDelay) * time.Second
+	if identityFailures <= 32 && time.Second<<(identityFailures-1) < delay {
+		delay = time.Second << (identityFailures - 1)
+	}
+	if err := s.systemStub.Redis.Set(ctx, makeIdentityDelayRedisKey(namespace, identity), identityFailures, delay).Err(); err != nil {
+		return 0, errors.New("failed to save delay for the identity: " + err.Error())
+	}
+	retryAfter := ttlToRetryAfter(delay)
+
+	if identityFailures >= config.MaxIdentityFailedAttempts {
+		err := s.createLockout(ctx, makeIdentityLockoutRedisKey(namespace, identity), &grpc.Lockout{
+			Type:           grpc.Lockout_IDENTITY,
+			Namespace:      namespace,
+			Identity:       identity,
+			SourceIP:       sourceIP,
+			FailedAttempts: identityFailures,
+		}, lockoutDuration)
+		if err != nil {
+			return 0, err
+		}
+		s.systemStub.Redis.Del(ctx, makeIdentityFailuresRedisKey(namespace, identity))
+		retryAfter = config.LockoutDuration
+	}
+
+	if sourceIP == "" {
+		return retryAfter, nil
+	}
+
+	sourceFailures, err := s.incrementFailures(ctx, makeSourceFailuresRedisKey(sourceIP), window)
+	if err != nil {
+		return 0, errors.New("failed to count failed attempt for the source: " + err.Error())
+	}
+	if sourceFailures >= config.MaxSourceFailedAttempts {
+		err := s.createLockout(ctx, makeSourceLockoutRedisKey(sourceIP), &grpc.Lockout{
+			Type:           grpc.Lockout_SOURCE,
+			SourceIP:       sourceIP,
+			FailedAttempts: sourceFailures,
+		}, lockoutDuration)
+		if err != nil {
+			return 0, err
+		}
+		s.systemStub.Redis.Del(ctx, makeSourceFailuresRedisKey(sourceIP))
+		retryAfter = config.LockoutDuration
+	}
+
+	return retryAfter, nil
+}
+
+// Resets failed attempts of the identity after successful authentication
+func (s *PasswordIdentificationService) resetFailedAttempts(ctx context.Context, namespace string, identity string) error {
+	return s.systemStub.Redis.Del(ctx, makeIdentityFailuresRedisKey(namespace, identity), makeIdentityDelayRedisKey(namespace, identity)).Err()
+}
+
+// Registers failed verification of the second factor as a failed authentication attempt. Returns number of seconds the caller must wait before the next attempt.
+func (s *PasswordIdentificationService) RegisterSecondFactorFailure(ctx context.Context, namespace string, identity string, sourceIP string) (uint32, error) {
+	config, err := s.configServer.GetBruteForceProtectionConfig(ctx)
+	if err != nil {
+		return 0, errors.New("failed to get brute-force protection configuration: " + err.Error())
+	}
+	if config.Disabled {
+		return 0, nil
+	}
+	return s.registerFailedAttempt(ctx, config, namespace, identity, sourceIP)
+}
+
+// Resets failed attempts of the identity after the password and the second factor were verified
+func (s *PasswordIdentificationService) ResetFailedAttemptsAfterSecondFactor(ctx context.Context, namespace string, identity string) error {
+	return s.resetFailedAttempts(ctx, namespace, identity)
+}
+
+func (s *PasswordIdentificationService) listLockouts(ctx context.Context) ([]*grpc.Lockout, error) {
+	lockouts := []*grpc.Lockout{}
+	iter := s.systemStub.Redis.Scan(ctx, 0, lockout_redis_key_prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		lockoutBytes, err := s.systemStub.Redis.Get(ctx, iter.Val()).Bytes()
+		if err != nil {
+			if err == redis.Nil {
+				// Lockout expired while iterating
+				continue
+			}
+			return nil, errors.New("failed to get lockout: " + err.Error())
+		}
+
+		var lockout grpc.Lockout
+		if err := proto.Unmarshal(lockoutBytes, &lockout); err != nil {
+			return nil, errors.New("failed to unmarshal lockout: " + err.Error())
+		}
+		lockouts = append(lockouts, &lockout)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, errors.New("failed to scan lockouts: " + err.Error())
+	}
+	return lockouts, nil
+}
+
+func (s *PasswordIdentificationService) clearLockout(ctx context.Context, in *grpc.ClearLockoutRequest) (bool, error) {
+	var lockoutKey string
+	var relatedKeys []string
+	switch in.Type {
+	case grpc.Lockout_IDENTITY:
+		lockoutKey = makeIdentityLockoutRedisKey(in.Namespace, in.Identity)
+		relatedKeys = []string{makeIdentityFailuresRedisKey(in.Namespace, in.Identity), makeIdentityDelayRedisKey(in.Namespace, in.Identity)}
+	case grpc.Lockout_SOURCE:
+		lockoutKey = makeSourceLockoutRedisKey(in.SourceIP)
+		relatedKeys = []string{makeSourceFailuresRedisKey(in.SourceIP)}
+	}
+
+	deleted, err := s.systemStub.Redis.Del(ctx, lockoutKey).Result()
+	if err != nil {
+		return false, err
+	}
+	if err := s.systemStub.Redis.Del(ctx, relatedKeys...).Err(); err != nil {
+		return false, err
+	}
+	return deleted != 0, nil
+}
@@ -20,3 +20,14 @@ func HandleNamespaceCreationEvent(ctx context.Context, logger *log.Entry, namesp
 	logger.Info("Successfully handled namespace creation event.")
 	return nil
 }
+
+func HandleNamespaceDeletionEvent(ctx context.Context, logger *log.Entry, namespace *namespaceGRPC.Namespace, systemStub *system.SystemStub) error {
+	err := systemStub.Cache.RemoveByPattern(ctx, makeIdentityFailuresRedisKey(namespace.Name, "*"), makeIdentityDelayRedisKey(namespace.Name, "*"), makeIdentityLockoutRedisKey(namespace.Name, "*"))
+	if err != nil {
+		logger.Error("failed to remove cache: " + err.Error())
+		return errors.New("failed to remove cache: " + err.Error())
+	}
+
+	logger.Info("Successfully handled namespace deletion event.")
+	return nil
+}
@@ -5,7 +5,9 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/nats-io/nats.go"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -19,6 +21,8 @@ import (
 	nativeNamespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
 	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
+
+	config_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/config"
 )
 
 type PasswordIdentificationService struct {
@@ -28,12 +32,27 @@ type PasswordIdentificationService struct {
 	systemStub *system.SystemStub
 
 	globalMongoCollection *mongo.Collection
+	jetstreamClient       nats.JetStreamContext
+
+	configServer *config_server.IAMConfigServer
+}
+
+type passwordHashInMongo struct {
+	Salt     []byte `bson:"salt"`
+	Password []byte `bson:"password"`
 }
 
 type passwordInMongo struct {
 	Identity string `bson:"identity"`
 	Salt     []byte `bson:"salt"`
 	Password []byte `bson:"password"`
+
+	// Time of the last password update. Used to check password expiration
+	Updated time.Time `bson:"updated"`
+	// Password change was forced
+	ChangeRequired bool `bson:"changeRequired"`
+	// Previous password hashes. The most recent first
+	History []passwordHashInMongo `bson:"history,omitempty"`
 }
 
 func collectionByNamespace(s *PasswordIdentificationService, namespace string) *mongo.Collection {
@@ -45,52 +64,126 @@ func collectionByNamespace(s *PasswordIdentificationService, namespace string) *
 	}
 }
 
-func NewPasswordIdentificationService(ctx context.Context, systemStub *system.SystemStub, nativeStub *native.NativeStub) (*PasswordIdentificationService, error) {
+func NewPasswordIdentificationService(ctx context.Context, systemStub *system.SystemStub, nativeStub *native.NativeStub, configServer *config_server.IAMConfigServer) (*PasswordIdentificationService, error) {
 	err := EnsureIndexesForNamespace(ctx, "", systemStub)
 	if err != nil {
 		return nil, errors.New("failed to ensure indexes in global namespace: " + err.Error())
 	}
 
+	// Make sure there is stream for events on system_nats
+	js, err := systemStub.Nats.JetStream()
+	if err != nil {
+		return nil, errors.New("failed to open jetstream context: " + err.Error())
+	}
+	_, err = js.AddStream(&nats.StreamConfig{
+		Name:      "native_iam_event",
+		Retention: nats.InterestPolicy,
+		Subjects:  []string{"native.iam.event.>"},
+		Storage:   nats.FileStorage,
+		Replicas:  1, // TODO: use envirnment variable to enable HA
+	})
+	if err != nil {
+		return nil, errors.New("failed to create stream for events: " + err.Error())
+	}
+
 	return &PasswordIdentificationService{
 		systemStub:            systemStub,
 		globalMongoCollection: systemStub.DB.Database("openbp_global").Collection("native_iam_authentication_password"),
 		nativeStub:            nativeStub,
+		jetstreamClient:       js,
+		configServer:          configServer,
 	}, nil
 }
 
+// Checks if password matches salted hash
+func (s *PasswordIdentificationService) verifyPasswordHash(ctx context.Context, hash *passwordHashInMongo, password string) (bool, error) {
+	dataToValidate := make([]byte, 0, 32+len(password))
+	dataToValidate = append(dataToValidate, hash.Salt...)
+	dataToValidate = append(dataToValidate, password...)
+	verifyResponse, err := s.systemStub.Vault.HMACVerify(ctx, &vault.HMACVerifyRequest{
+		Data:      dataToValidate,
+		Signature: hash.Password,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == grpccodes.FailedPrecondition {
+				return false, status.Error(grpccodes.FailedPrecondition, "The vault is sealed. Message from system_vault: "+err.Error())
+			}
+		}
+		return false, status.Error(grpccodes.Internal, "Failed to verify password: "+err.Error())
+	}
+
+	return verifyResponse.Valid, nil
+}
+
 func (s *PasswordIdentificationService) Authenticate(ctx context.Context, in *grpc.AuthenticateRequest) (*grpc.AuthenticateResponse, error) {
+	bruteForceConfig, err := s.configServer.GetBruteForceProtectionConfig(ctx)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to get brute-force protection configuration: "+err.Error())
+	}
+	if !bruteForceConfig.Disabled {
+		retryAfter, err := s.checkThrottled(ctx, in.Namespace, in.Identity, in.SourceIP)
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, err.Error())
+		}
+		if retryAfter != 0 {
+			return &grpc.AuthenticateResponse{Authenticated: false, Throttled: true, RetryAfter: retryAfter}, status.Error(grpccodes.OK, "")
+		}
+	}
+
+	failed := func() (*grpc.AuthenticateResponse, error) {
+		if bruteForceConfig.Disabled {
+			return &grpc.AuthenticateResponse{Authenticated: false}, status.Error(grpccodes.OK, "")
+		}
+		retryAfter, err := s.registerFailedAttempt(ctx, bruteForceConfig, in.Namespace, in.Identity, in.SourceIP)
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to register failed attempt: "+err.Error())
+		}
+		return &grpc.AuthenticateResponse{Authenticated: false, RetryAfter: retryAfter}, status.Error(grpccodes.OK, "")
+	}
+
 	collection := collectionByNamespace(s, in.Namespace)
 	var entry passwordInMongo
-	err := collection.FindOne(ctx, bson.M{"identity": in.Identity}).Decode(&entry)
+	err = collection.FindOne(ctx, bson.M{"identity": in.Identity}).Decode(&entry)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return &grpc.AuthenticateResponse{Authenticated: false}, status.Error(grpccodes.OK, "")
+			return failed()
 		}
 		if err, ok := err.(mongo.WriteException); ok {
 			if err.HasErrorLabel("InvalidNamespace") {
-				return &grpc.AuthenticateResponse{Authenticated: false}, status.Error(grpccodes.OK, "")
+				return failed()
 			}
 		}
 		return nil, status.Error(grpccodes.Internal, "Failed to fetch information about identity: "+err.Error())
 	}
 
-	dataToValidate := make([]byte, 0, 32+len(in.Password))
-	dataToValidate = append(dataToValidate, entry.Salt...)
-	dataToValidate = append(dataToValidate, in.Password...)
-	verifyResponse, err := s.systemStub.Vault.HMACVerify(ctx, &vault.HMACVerifyRequest{
-		Data:      dataToValidate,
-		Signature: entry.Password,
-	})
+	valid, err := s.verifyPasswordHash(ctx, &passwordHashInMongo{Salt: entry.Salt, Password: entry.Password}, in.Password)
 	if err != nil {
-		if st, ok := status.FromError(err); ok {
-			if st.Code() == grpccodes.FailedPrecondition {
-				return nil, status.Error(grpccodes.FailedPrecondition, "The vault is sealed. Message from system_vault: "+err.Error())
-			}
+		return nil, err
+	}
+	if !valid {
+		return failed()
+	}
+
+	if !bruteForceConfig.Disabled && !in.SecondFactorRequired {
+		if err := s.resetFailedAttempts(ctx, in.Namespace, in.Identity); err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to reset failed attempts: "+err.Error())
 		}
-		return nil, status.Error(grpccodes.Internal, "Failed to verify password: "+err.Error())
 	}
 
-	return &grpc.AuthenticateResponse{Authenticated: verifyResponse.Valid}, status.Error(grpccodes.OK, "")
+	changeRequired := entry.ChangeRequired
+	if !changeRequired && !entry.Updated.IsZero() {
+		passwordConfig, err := s.configServer.GetPasswordAuthConfig(ctx)
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to get password policy: "+err.Error())
+		}
+		if passwordConfig.PasswordExpiration != 0 {
+			expiration := time.Duration(passwordConfig.PasswordExpiration) * time.Hour * 24
+			changeRequired = time.Since(entry.Updated) > expiration
+		}
+	}
+
+	return &grpc.AuthenticateResponse{Authenticated: true, ChangeRequired: changeRequired}, status.Error(grpccodes.OK, "")
 }
 
 func (s *PasswordIdentificationService) CreateOrUpdate(ctx context.Context, in *grpc.CreateOrUpdateRequest) (*grpc.CreateOrUpdateResponse, error) {
@@ -105,8 +198,46 @@ func (s *PasswordIdentificationService) CreateOrUpdate(ctx context.Context, in *
 		}
 	}
 
+	passwordConfig, err := s.configServer.GetPasswordAuthConfig(ctx)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to get password policy: "+err.Error())
+	}
+	if err := validatePasswordStrength(in.Password, passwordConfig); err != nil {
+		return nil, status.Error(grpccodes.InvalidArgument, "Password doesnt satisfy password policy: "+err.Error())
+	}
+
+	collection := collectionByNamespace(s, in.Namespace)
+
+	// Check that password wasnt used recently
+	var previousEntry *passwordInMongo
+	if passwordConfig.PasswordHistory != 0 {
+		var entry passwordInMongo
+		err := collection.FindOne(ctx, bson.M{"identity": in.Identity}).Decode(&entry)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return nil, status.Error(grpccodes.Internal, "Failed to fetch current password of the identity: "+err.Error())
+		}
+		if err == nil {
+			previousEntry = &entry
+		}
+	}
+	if previousEntry != nil {
+		previousHashes := append([]passwordHashInMongo{{Salt: previousEntry.Salt, Password: previousEntry.Password}}, previousEntry.History...)
+		if len(previousHashes) > int(passwordConfig.PasswordHistory) {
+			previousHashes = previousHashes[:passwordConfig.PasswordHistory]
+		}
+		for _, hash := range previousHashes {
+			used, err := s.verifyPasswordHash(ctx, &hash, in.Password)
+			if err != nil {
+				return nil, err
+			}
+			if used {
+				return nil, status.Errorf(grpccodes.InvalidArgument, "Password doesnt satisfy password policy: password must differ from the last %d passwords", passwordConfig.PasswordHistory)
+			}
+		}
+	}
+
 	salt := make([]byte, 32, 32+len(in.Password))
-	_, err := rand.Read(salt)
+	_, err = rand.Read(salt)
 	if err != nil {
 		return nil, status.Error(grpccodes.Internal, "Failed to generate salt: "+err.Error())
 	}
@@ -124,8 +255,23 @@ func (s *PasswordIdentificationService) CreateOrUpdate(ctx context.Context, in *
 		return nil, status.Error(grpccodes.Internal, "Failed to hash password: "+err.Error())
 	}
 
-	collection := collectionByNamespace(s, in.Namespace)
-	updateResponse, err := collection.UpdateOne(ctx, bson.M{"identity": in.Identity}, bson.M{"$set": bson.M{"password": passwordHashResponse.Signature, "salt": salt}, "$setOnInsert": bson.M{"identity": in.Identity}}, options.Update().SetUpsert(true))
+	update := bson.M{
+		"$set": bson.M{
+			"password":       passwordHashResponse.Signature,
+			"salt":           salt,
+			"updated":        time.Now().UTC(),
+			"changeRequired": in.RequireChange,
+		},
+		"$setOnInsert": bson.M{"identity": in.Identity},
+	}
+	if previousEntry != nil {
+		update["$push"] = bson.M{"history": bson.M{
+			"$each":     bson.A{passwordHashInMongo{Salt: previousEntry.Salt, Password: previousEntry.Password}},
+			"$position": 0,
+			"$slice":    passwordConfig.PasswordHistory,
+		}}
+	}
+	updateResponse, err := collection.UpdateOne(ctx, bson.M{"identity": in.Identity}, update, options.Update().SetUpsert(true))
 	if err != nil {
 		return nil, status.Error(grpccodes.Internal, "Error on updating password in database: "+err.Error())
 	}
@@ -163,3 +309,26 @@ func (s *PasswordIdentificationService) Exists(ctx context.Context, in *grpc.Exi
 
 	return &grpc.ExistsResponse{Exists: count == 1}, status.Error(grpccodes.OK, "")
 }
+
+func (s *PasswordIdentificationService) ListLockouts(ctx context.Context, in *grpc.ListLockoutsRequest) (*grpc.ListLockoutsResponse, error) {
+	lockouts, err := s.listLockouts(ctx)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, err.Error())
+	}
+	return &grpc.ListLockoutsResponse{Lockouts: lockouts}, status.Error(grpccodes.OK, "")
+}
+
+func (s *PasswordIdentificationService) ClearLockout(ctx context.Context, in *grpc.ClearLockoutRequest) (*grpc.ClearLockoutResponse, error) {
+	if in.Type == grpc.Lockout_SOURCE && in.SourceIP == "" {
+		return nil, status.Error(grpccodes.InvalidArgument, "Source IP must be provided")
+	}
+	if in.Type == grpc.Lockout_IDENTITY && in.Identity == "" {
+		return nil, status.Error(grpccodes.InvalidArgument, "Identity must be provided")
+	}
+
+	existed, err := s.clearLockout(ctx, in)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to clear lockout: "+err.Error())
+	}
+	return &grpc.ClearLockoutResponse{Existed: existed}, status.Error(grpccodes.OK, "")
+}
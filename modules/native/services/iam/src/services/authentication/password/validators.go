@@ -0,0 +1,52 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	nativeIAmConfigGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/config"
+
+	config_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/config"
+)
+
+// Checks if password satisfies password policy from the configuration
+func validatePasswordStrength(password string, config *nativeIAmConfigGRPC.Configuration_PasswordAuth) error {
+	minLength := config.MinPasswordLength
+	if minLength == 0 {
+		minLength = config_server.DEFAULT_MIN_PASSWORD_LENGTH
+	}
+	if uint32(utf8.RuneCountInString(password)) < minLength {
+		return fmt.Errorf("password must have at least %d characters", minLength)
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r):
+			hasSpecial = true
+		}
+	}
+
+	if config.Lowercase && !hasLower {
+		return errors.New("password must contain at least one lowercase letter")
+	}
+	if config.Uppercase && !hasUpper {
+		return errors.New("password must contain at least one uppercase letter")
+	}
+	if config.Digits && !hasDigit {
+		return errors.New("password must contain at least one digit")
+	}
+	if config.SpecialCharacters && !hasSpecial {
+		return errors.New("password must contain at least one special character")
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func providerCollectionByNamespace(systemStub *system.SystemStub, namespace string) *mongo.Collection {
+	if namespace == "" {
+		return systemStub.DB.Database("openbp_global").Collection("native_iam_authentication_sso_provider")
+	} else {
+		dbName := fmt.Sprintf("openbp_namespace_%s", namespace)
+		return systemStub.DB.Database(dbName).Collection("native_iam_authentication_sso_provider")
+	}
+}
+
+func linkCollectionByNamespace(systemStub *system.SystemStub, namespace string) *mongo.Collection {
+	if namespace == "" {
+		return systemStub.DB.Database("openbp_global").Collection("native_iam_authentication_sso_link")
+	} else {
+		dbName := fmt.Sprintf("openbp_namespace_%s", namespace)
+		return systemStub.DB.Database(dbName).Collection("native_iam_authentication_sso_link")
+	}
+}
+
+func EnsureIndexesForNamespace(ctx context.Context, namespace string, systemStub *system.SystemStub) error {
+	collection := linkCollectionByNamespace(systemStub, namespace)
+
+	// Subject of the IdP can only be linked to one identity
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				bson.E{Key: "provider", Value: 1},
+				bson.E{Key: "subject", Value: 1},
+			},
+			Options: options.Index().SetName("unique_provider_subject").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{bson.E{Key: "identity", Value: 1}},
+			Options: options.Index().SetName("identity"),
+		},
+	})
+	if err != nil {
+		return errors.Join(errors.New("failed to ensure indexes for sso links"), err)
+	}
+
+	return nil
+}
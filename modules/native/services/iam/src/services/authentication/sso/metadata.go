@@ -0,0 +1,126 @@
+package sso
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+const (
+	saml_metadata_namespace = "urn:oasis:names:tc:SAML:2.0:metadata"
+	saml_protocol_namespace = "urn:oasis:names:tc:SAML:2.0:protocol"
+	saml_assertion_namepace = "urn:oasis:names:tc:SAML:2.0:assertion"
+	xmldsig_namespace       = "http://www.w3.org/2000/09/xmldsig#"
+
+	saml_binding_http_redirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	saml_binding_http_post     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	saml_nameid_unspecified    = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+)
+
+var errMetadataInvalid = errors.New("invalid IdP metadata")
+
+type idpMetadata struct {
+	EntityID     string
+	SSOURL       string
+	Certificates [][]byte
+}
+
+type entityDescriptorXML struct {
+	XMLName           xml.Name              `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID          string                `xml:"entityID,attr"`
+	IDPSSODescriptors []idpSSODescriptorXML `xml:"IDPSSODescriptor"`
+}
+type idpSSODescriptorXML struct {
+	KeyDescriptors       []keyDescriptorXML `xml:"KeyDescriptor"`
+	SingleSignOnServices []endpointXML      `xml:"SingleSignOnService"`
+}
+type keyDescriptorXML struct {
+	Use          string   `xml:"use,attr"`
+	Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+type endpointXML struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// Parses IdP EntityDescriptor and extracts information required for SP-initiated login
+func parseIdPMetadata(data []byte) (*idpMetadata, error) {
+	var descriptor entityDescriptorXML
+	if err := xml.Unmarshal(data, &descriptor); err != nil {
+		return nil, errors.Join(errMetadataInvalid, err)
+	}
+	if descriptor.EntityID == "" {
+		return nil, errors.Join(errMetadataInvalid, errors.New("entityID is empty"))
+	}
+	if len(descriptor.IDPSSODescriptors) == 0 {
+		return nil, errors.Join(errMetadataInvalid, errors.New("IDPSSODescriptor not found"))
+	}
+
+	metadata := idpMetadata{EntityID: descriptor.EntityID, Certificates: [][]byte{}}
+	for _, idp := range descriptor.IDPSSODescriptors {
+		for _, service := range idp.SingleSignOnServices {
+			if service.Binding == saml_binding_http_redirect && metadata.SSOURL == "" {
+				metadata.SSOURL = service.Location
+			}
+		}
+		for _, key := range idp.KeyDescriptors {
+			if key.Use != "" && key.Use != "signing" {
+				continue
+			}
+			for _, encodedCertificate := range key.Certificates {
+				der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encodedCertificate), ""))
+				if err != nil {
+					return nil, errors.Join(errMetadataInvalid, errors.New("failed to decode certificate"), err)
+				}
+				if _, err := x509.ParseCertificate(der); err != nil {
+					return nil, errors.Join(errMetadataInvalid, errors.New("failed to parse certificate"), err)
+				}
+				metadata.Certificates = append(metadata.Certificates, der)
+			}
+		}
+	}
+
+	if metadata.SSOURL == "" {
+		return nil, errors.Join(errMetadataInvalid, errors.New("SingleSignOnService with HTTP-Redirect binding not found"))
+	}
+	if len(metadata.Certificates) == 0 {
+		return nil, errors.Join(errMetadataInvalid, errors.New("signing certificate not found"))
+	}
+
+	return &metadata, nil
+}
+
+// Generates SP EntityDescriptor that can be imported to the IdP
+func makeSPMetadata(provider *providerInMongo, certificate []byte) ([]byte, error) {
+	doc := etree.NewDocument()
+	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8"`)
+
+	entityDescriptor := doc.CreateElement("md:EntityDescriptor")
+	entityDescriptor.CreateAttr("xmlns:md", saml_metadata_namespace)
+	entityDescriptor.CreateAttr("xmlns:ds", xmldsig_namespace)
+	entityDescriptor.CreateAttr("entityID", provider.SPEntityID)
+
+	spDescriptor := entityDescriptor.CreateElement("md:SPSSODescriptor")
+	spDescriptor.CreateAttr("AuthnRequestsSigned", "true")
+	spDescriptor.CreateAttr("WantAssertionsSigned", "true")
+	spDescriptor.CreateAttr("protocolSupportEnumeration", saml_protocol_namespace)
+
+	keyDescriptor := spDescriptor.CreateElement("md:KeyDescriptor")
+	keyDescriptor.CreateAttr("use", "signing")
+	keyDescriptor.CreateElement("ds:KeyInfo").CreateElement("ds:X509Data").CreateElement("ds:X509Certificate").SetText(base64.StdEncoding.EncodeToString(certificate))
+
+	spDescriptor.CreateElement("md:NameIDFormat").SetText(saml_nameid_unspecified)
+
+	acs := spDescriptor.CreateElement("md:AssertionConsumerService")
+	acs.CreateAttr("Binding", saml_binding_http_post)
+	acs.CreateAttr("Location", provider.AssertionConsumerServiceURL)
+	acs.CreateAttr("index", "0")
+	acs.CreateAttr("isDefault", "true")
+
+	doc.Indent(2)
+	return doc.WriteToBytes()
+}
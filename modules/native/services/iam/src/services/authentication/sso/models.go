@@ -0,0 +1,74 @@
+package sso
+
+import (
+	"time"
+
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/sso"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type attributeMappingInMongo struct {
+	SubjectAttribute string `bson:"subjectAttribute"`
+	NameAttribute    string `bson:"nameAttribute"`
+}
+
+type providerInMongo struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty"`
+	Name    string             `bson:"name"`
+	Enabled bool               `bson:"enabled"`
+
+	IdPEntityID     string   `bson:"idpEntityId"`
+	IdPSSOURL       string   `bson:"idpSSOURL"`
+	IdPCertificates [][]byte `bson:"idpCertificates"`
+
+	SPEntityID                  string `bson:"spEntityId"`
+	AssertionConsumerServiceURL string `bson:"assertionConsumerServiceURL"`
+
+	AttributeMapping attributeMappingInMongo `bson:"attributeMapping"`
+	JITProvisioning  bool                    `bson:"jitProvisioning"`
+
+	Created time.Time `bson:"created"`
+	Updated time.Time `bson:"updated"`
+	Version uint64    `bson:"version"`
+}
+
+func (p *providerInMongo) ToGRPCProvider(namespace string) *grpc.SAMLProvider {
+	return &grpc.SAMLProvider{
+		Namespace:                   namespace,
+		Uuid:                        p.ID.Hex(),
+		Name:                        p.Name,
+		Enabled:                     p.Enabled,
+		IdpEntityId:                 p.IdPEntityID,
+		IdpSSOURL:                   p.IdPSSOURL,
+		IdpCertificates:             p.IdPCertificates,
+		SpEntityId:                  p.SPEntityID,
+		AssertionConsumerServiceURL: p.AssertionConsumerServiceURL,
+		AttributeMapping: &grpc.AttributeMapping{
+			SubjectAttribute: p.AttributeMapping.SubjectAttribute,
+			NameAttribute:    p.AttributeMapping.NameAttribute,
+		},
+		JitProvisioning: p.JITProvisioning,
+		Created:         timestamppb.New(p.Created),
+		Updated:         timestamppb.New(p.Updated),
+		Version:         p.Version,
+	}
+}
+
+func attributeMappingFromGRPC(mapping *grpc.AttributeMapping) attributeMappingInMongo {
+	if mapping == nil {
+		return attributeMappingInMongo{}
+	}
+	return attributeMappingInMongo{
+		SubjectAttribute: mapping.SubjectAttribute,
+		NameAttribute:    mapping.NameAttribute,
+	}
+}
+
+// Link between subject on the IdP side and identity
+type linkInMongo struct {
+	Provider string    `bson:"provider"`
+	Subject  string    `bson:"subject"`
+	Identity string    `bson:"identity"`
+	Created  time.Time `bson:"created"`
+}
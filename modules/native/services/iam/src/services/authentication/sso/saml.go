@@ -0,0 +1,315 @@
+package sso
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	xrv "github.com/mattermost/xml-roundtrip-validator"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/sso"
+)
+
+const (
+	saml_status_success                 = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	saml_subject_confirmation_bearer    = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	saml_signature_algorithm_rsa_sha256 = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+
+	// Allowed difference between clocks of the IdP and this service
+	saml_max_clock_skew = time.Minute * 3
+	// How long AuthnRequest waits for the response from IdP
+	saml_request_lifetime = time.Minute * 10
+)
+
+// Error of the SAML response validation. Status and message can be returned to the requester.
+type samlValidationError struct {
+	status  grpc.AuthenticateResponse_Status
+	message string
+}
+
+func (e *samlValidationError) Error() string {
+	return e.message
+}
+
+func invalidResponse(message string) error {
+	return &samlValidationError{status: grpc.AuthenticateResponse_RESPONSE_INVALID, message: message}
+}
+func invalidSignature(message string) error {
+	return &samlValidationError{status: grpc.AuthenticateResponse_SIGNATURE_INVALID, message: message}
+}
+func expiredAssertion(message string) error {
+	return &samlValidationError{status: grpc.AuthenticateResponse_ASSERTION_EXPIRED, message: message}
+}
+
+func generateRequestID() (string, error) {
+	id := make([]byte, 20)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	// ID must start with letter or underscore (xsd:ID)
+	return "_" + hex.EncodeToString(id), nil
+}
+
+func makeAuthnRequest(provider *providerInMongo, requestID string, now time.Time) ([]byte, error) {
+	doc := etree.NewDocument()
+	request := doc.CreateElement("samlp:AuthnRequest")
+	request.CreateAttr("xmlns:samlp", saml_protocol_namespace)
+	request.CreateAttr("xmlns:saml", saml_assertion_namepace)
+	request.CreateAttr("ID", requestID)
+	request.CreateAttr("Version", "2.0")
+	request.CreateAttr("IssueInstant", now.UTC().Format(time.RFC3339))
+	request.CreateAttr("Destination", provider.IdPSSOURL)
+	request.CreateAttr("ProtocolBinding", saml_binding_http_post)
+	request.CreateAttr("AssertionConsumerServiceURL", provider.AssertionConsumerServiceURL)
+	request.CreateElement("saml:Issuer").SetText(provider.SPEntityID)
+	nameIDPolicy := request.CreateElement("samlp:NameIDPolicy")
+	nameIDPolicy.CreateAttr("Format", saml_nameid_unspecified)
+	nameIDPolicy.CreateAttr("AllowCreate", "true")
+
+	return doc.WriteToBytes()
+}
+
+// Encodes AuthnRequest for HTTP-Redirect binding. Returns query that has to be signed.
+func makeRedirectQuery(authnRequest []byte, relayState string) (string, error) {
+	var compressed bytes.Buffer
+	writer, err := flate.NewWriter(&compressed, flate.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := writer.Write(authnRequest); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	// Order of the parameters is defined by the specification and is important for signature
+	query := "SAMLRequest=" + url.QueryEscape(base64.StdEncoding.EncodeToString(compressed.Bytes()))
+	if relayState != "" {
+		query += "&RelayState=" + url.QueryEscape(relayState)
+	}
+	query += "&SigAlg=" + url.QueryEscape(saml_signature_algorithm_rsa_sha256)
+	return query, nil
+}
+
+// Information extracted from the validated assertion
+type assertionInfo struct {
+	ID           string
+	InResponseTo string
+	NotOnOrAfter time.Time
+	NameID       string
+	Attributes   map[string][]string
+}
+
+func parseSAMLTime(value string) (time.Time, error) {
+	return time.Parse(time.RFC3339, value)
+}
+
+// Validates SAML response and its assertion. Only data from the elements covered by the signature is returned.
+// Replay protection (InResponseTo and assertion ID) must be checked by the caller.
+func validateSAMLResponse(provider *providerInMongo, encodedResponse string, now time.Time) (*assertionInfo, error) {
+	rawResponse, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encodedResponse), ""))
+	if err != nil {
+		return nil, invalidResponse("SAML response is not valid base64")
+	}
+
+	// Protects against XML round-trip attacks on encoding/xml based processing
+	if err := xrv.Validate(bytes.NewReader(rawResponse)); err != nil {
+		return nil, invalidResponse("SAML response is not valid XML: " + err.Error())
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(rawResponse); err != nil {
+		return nil, invalidResponse("SAML response is not valid XML: " + err.Error())
+	}
+	response := doc.Root()
+	if response == nil || response.Tag != "Response" || response.NamespaceURI() != saml_protocol_namespace {
+		return nil, invalidResponse("root element must be samlp:Response")
+	}
+
+	if response.FindElement("./EncryptedAssertion") != nil {
+		return nil, invalidResponse("encrypted assertions are not supported")
+	}
+	if len(response.FindElements("./Assertion")) != 1 {
+		return nil, invalidResponse("SAML response must contain exactly one assertion")
+	}
+
+	// Signature validation
+	certificates := make([]*x509.Certificate, 0, len(provider.IdPCertificates))
+	for _, der := range provider.IdPCertificates {
+		certificate, err := x509.ParseCertificate(der)
+		if err != nil {
+			continue
+		}
+		certificates = append(certificates, certificate)
+	}
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: certificates})
+	validationContext.Clock = dsig.NewFakeClockAt(now)
+
+	responseSigned := response.FindElement("./Signature") != nil
+	if responseSigned {
+		response, err = validationContext.Validate(response)
+		if err != nil {
+			return nil, invalidSignature("response signature is invalid: " + err.Error())
+		}
+	}
+	assertion := response.FindElement("./Assertion")
+	if assertion == nil {
+		return nil, invalidSignature("assertion is not covered by the signature")
+	}
+	if assertion.FindElement("./Signature") != nil {
+		// Assertion is validated as standalone element, so it must carry namespace declarations of its parents
+		namespaceContext, err := etreeutils.NSBuildParentContext(assertion)
+		if err != nil {
+			return nil, invalidResponse("failed to resolve assertion namespaces: " + err.Error())
+		}
+		assertion, err = etreeutils.NSDetatch(namespaceContext, assertion)
+		if err != nil {
+			return nil, invalidResponse("failed to resolve assertion namespaces: " + err.Error())
+		}
+		assertion, err = validationContext.Validate(assertion)
+		if err != nil {
+			return nil, invalidSignature("assertion signature is invalid: " + err.Error())
+		}
+	} else if !responseSigned {
+		return nil, invalidSignature("neither response nor assertion is signed")
+	}
+
+	// Response checks
+	if destination := response.SelectAttrValue("Destination", ""); destination != "" && destination != provider.AssertionConsumerServiceURL {
+		return nil, invalidResponse("response destination doesnt match assertion consumer service URL")
+	}
+	statusCode := response.FindElement("./Status/StatusCode")
+	if statusCode == nil || statusCode.SelectAttrValue("Value", "") != saml_status_success {
+		return nil, invalidResponse("IdP returned unsuccessful status")
+	}
+
+	// Assertion checks
+	info := &assertionInfo{ID: assertion.SelectAttrValue("ID", ""), Attributes: map[string][]string{}}
+	if info.ID == "" {
+		return nil, invalidResponse("assertion ID is missing")
+	}
+	issuer := assertion.FindElement("./Issuer")
+	if issuer == nil || strings.TrimSpace(issuer.Text()) != provider.IdPEntityID {
+		return nil, invalidResponse("assertion issuer doesnt match IdP entity ID")
+	}
+
+	conditions := assertion.FindElement("./Conditions")
+	if conditions == nil {
+		return nil, invalidResponse("assertion conditions are missing")
+	}
+	if notBefore := conditions.SelectAttrValue("NotBefore", ""); notBefore != "" {
+		t, err := parseSAMLTime(notBefore)
+		if err != nil {
+			return nil, invalidResponse("invalid NotBefore condition")
+		}
+		if now.Add(saml_max_clock_skew).Before(t) {
+			return nil, expiredAssertion("assertion is not valid yet")
+		}
+	}
+	if notOnOrAfter := conditions.SelectAttrValue("NotOnOrAfter", ""); notOnOrAfter != "" {
+		t, err := parseSAMLTime(notOnOrAfter)
+		if err != nil {
+			return nil, invalidResponse("invalid NotOnOrAfter condition")
+		}
+		if !now.Add(-saml_max_clock_skew).Before(t) {
+			return nil, expiredAssertion("assertion expired")
+		}
+		info.NotOnOrAfter = t
+	}
+	audienceFound := false
+	for _, audience := range conditions.FindElements("./AudienceRestriction/Audience") {
+		if strings.TrimSpace(audience.Text()) == provider.SPEntityID {
+			audienceFound = true
+			break
+		}
+	}
+	if !audienceFound {
+		return nil, invalidResponse("assertion audience doesnt match SP entity ID")
+	}
+
+	subject := assertion.FindElement("./Subject")
+	if subject == nil {
+		return nil, invalidResponse("assertion subject is missing")
+	}
+	if nameID := subject.FindElement("./NameID"); nameID != nil {
+		info.NameID = strings.TrimSpace(nameID.Text())
+	}
+	bearerConfirmed := false
+	for _, confirmation := range subject.FindElements("./SubjectConfirmation") {
+		if confirmation.SelectAttrValue("Method", "") != saml_subject_confirmation_bearer {
+			continue
+		}
+		data := confirmation.FindElement("./SubjectConfirmationData")
+		if data == nil {
+			continue
+		}
+		if data.SelectAttrValue("Recipient", "") != provider.AssertionConsumerServiceURL {
+			continue
+		}
+		notOnOrAfter, err := parseSAMLTime(data.SelectAttrValue("NotOnOrAfter", ""))
+		if err != nil || !now.Add(-saml_max_clock_skew).Before(notOnOrAfter) {
+			continue
+		}
+		info.InResponseTo = data.SelectAttrValue("InResponseTo", "")
+		if info.NotOnOrAfter.IsZero() || notOnOrAfter.Before(info.NotOnOrAfter) {
+			info.NotOnOrAfter = notOnOrAfter
+		}
+		bearerConfirmed = true
+		break
+	}
+	if !bearerConfirmed {
+		return nil, expiredAssertion("assertion doesnt have valid bearer subject confirmation")
+	}
+	// Only SP-initiated flow is supported. IdP-initiated responses are vulnerable to login CSRF.
+	if info.InResponseTo == "" {
+		return nil, expiredAssertion("assertion is not issued in response to the AuthnRequest")
+	}
+	if responseInResponseTo := response.SelectAttrValue("InResponseTo", ""); responseInResponseTo != "" && responseInResponseTo != info.InResponseTo {
+		return nil, invalidResponse("InResponseTo of the response and assertion doesnt match")
+	}
+
+	for _, attribute := range assertion.FindElements("./AttributeStatement/Attribute") {
+		name := attribute.SelectAttrValue("Name", "")
+		for _, value := range attribute.FindElements("./AttributeValue") {
+			info.Attributes[name] = append(info.Attributes[name], strings.TrimSpace(value.Text()))
+		}
+	}
+
+	return info, nil
+}
+
+// Resolves subject and name of the user using provider attribute mapping
+func mapAssertionSubject(provider *providerInMongo, info *assertionInfo) (string, string, error) {
+	subject := info.NameID
+	if provider.AttributeMapping.SubjectAttribute != "" {
+		values := info.Attributes[provider.AttributeMapping.SubjectAttribute]
+		if len(values) == 0 {
+			subject = ""
+		} else {
+			subject = values[0]
+		}
+	}
+	if subject == "" {
+		return "", "", errors.New("assertion doesnt contain subject")
+	}
+
+	name := subject
+	if provider.AttributeMapping.NameAttribute != "" {
+		if values := info.Attributes[provider.AttributeMapping.NameAttribute]; len(values) != 0 && values[0] != "" {
+			name = values[0]
+		}
+	}
+
+	return subject, name, nil
+}
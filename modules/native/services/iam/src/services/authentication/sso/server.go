@@ -0,0 +1,467 @@
+package sso
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/sso"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	nativeNamespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/identity"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+)
+
+const sso_identity_management_service = "native_iam_authentication_sso"
+
+type SSOServer struct {
+	grpc.UnimplementedIAMAuthenticationSSOServiceServer
+
+	systemStub     *system.SystemStub
+	nativeStub     *native.NativeStub
+	identityServer *identity.IAmIdentityServer
+
+	signer *spSigner
+}
+
+func NewSSOServer(ctx context.Context, systemStub *system.SystemStub, nativeStub *native.NativeStub, identityServer *identity.IAmIdentityServer) (*SSOServer, error) {
+	err := EnsureIndexesForNamespace(ctx, "", systemStub)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to ensure indexes for global namespace"), err)
+	}
+
+	return &SSOServer{
+		systemStub:     systemStub,
+		nativeStub:     nativeStub,
+		identityServer: identityServer,
+		signer:         newSPSigner(systemStub),
+	}, nil
+}
+
+func makeRequestRedisKey(namespace string, provider string, requestID string) string {
+	return "openbp_native_iam_authentication_sso_request_" + namespace + "_" + provider + "_" + requestID
+}
+func makeAssertionRedisKey(namespace string, provider string, assertionID string) string {
+	return "openbp_native_iam_authentication_sso_assertion_" + namespace + "_" + provider + "_" + assertionID
+}
+
+func (s *SSOServer) ensureSignerReady(ctx context.Context) error {
+	if err := s.signer.EnsureReady(ctx); err != nil {
+		if err == ErrSSOSignerVaultSealed {
+			return status.Error(codes.FailedPrecondition, "Vault is sealed")
+		}
+		return status.Error(codes.Internal, "Failed to prepare SP signing key: "+err.Error())
+	}
+	return nil
+}
+
+// Returns nil if provider doesnt exist
+func (s *SSOServer) getProvider(ctx context.Context, namespace string, uuid string) (*providerInMongo, error) {
+	id, err := primitive.ObjectIDFromHex(uuid)
+	if err != nil {
+		return nil, nil
+	}
+
+	var provider providerInMongo
+	if err := providerCollectionByNamespace(s.systemStub, namespace).FindOne(ctx, bson.M{"_id": id}).Decode(&provider); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return nil, nil
+		}
+		return nil, status.Error(codes.Internal, "Failed to load provider from database: "+err.Error())
+	}
+	return &provider, nil
+}
+
+func validateServiceProviderSettings(spEntityID string, assertionConsumerServiceURL string) error {
+	if spEntityID == "" {
+		return status.Error(codes.InvalidArgument, "SP entity ID must not be empty")
+	}
+	if u, err := url.Parse(assertionConsumerServiceURL); err != nil || !u.IsAbs() {
+		return status.Error(codes.InvalidArgument, "Assertion consumer service URL must be absolute URL")
+	}
+	return nil
+}
+
+func (s *SSOServer) CreateProvider(ctx context.Context, in *grpc.CreateProviderRequest) (*grpc.CreateProviderResponse, error) {
+	if err := validateServiceProviderSettings(in.SpEntityId, in.AssertionConsumerServiceURL); err != nil {
+		return nil, err
+	}
+	metadata, err := parseIdPMetadata(in.IdpMetadata)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if in.Namespace != "" {
+		namespaceExistResponse, err := s.nativeStub.Services.Namespace.Exists(ctx, &nativeNamespaceGRPC.IsNamespaceExistRequest{Name: in.Namespace, UseCache: true})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to check if namespace exist: "+err.Error())
+		}
+		if !namespaceExistResponse.Exist {
+			return nil, status.Error(codes.FailedPrecondition, "Namespace doesnt exist")
+		}
+	}
+
+	creationTime := time.Now().UTC()
+	provider := providerInMongo{
+		Name:                        in.Name,
+		Enabled:                     in.Enabled,
+		IdPEntityID:                 metadata.EntityID,
+		IdPSSOURL:                   metadata.SSOURL,
+		IdPCertificates:             metadata.Certificates,
+		SPEntityID:                  in.SpEntityId,
+		AssertionConsumerServiceURL: in.AssertionConsumerServiceURL,
+		AttributeMapping:            attributeMappingFromGRPC(in.AttributeMapping),
+		JITProvisioning:             in.JitProvisioning,
+		Created:                     creationTime,
+		Updated:                     creationTime,
+		Version:                     0,
+	}
+
+	insertResult, err := providerCollectionByNamespace(s.systemStub, in.Namespace).InsertOne(ctx, provider)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to insert provider to the database: "+err.Error())
+	}
+	provider.ID = insertResult.InsertedID.(primitive.ObjectID)
+
+	return &grpc.CreateProviderResponse{Provider: provider.ToGRPCProvider(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *SSOServer) UpdateProvider(ctx context.Context, in *grpc.UpdateProviderRequest) (*grpc.UpdateProviderResponse, error) {
+	if err := validateServiceProviderSettings(in.SpEntityId, in.AssertionConsumerServiceURL); err != nil {
+		return nil, err
+	}
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Provider not found")
+	}
+
+	setQuery := bson.M{
+		"name":                        in.Name,
+		"enabled":                     in.Enabled,
+		"spEntityId":                  in.SpEntityId,
+		"assertionConsumerServiceURL": in.AssertionConsumerServiceURL,
+		"attributeMapping":            attributeMappingFromGRPC(in.AttributeMapping),
+		"jitProvisioning":             in.JitProvisioning,
+		"updated":                     time.Now().UTC(),
+	}
+	if len(in.IdpMetadata) != 0 {
+		metadata, err := parseIdPMetadata(in.IdpMetadata)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		setQuery["idpEntityId"] = metadata.EntityID
+		setQuery["idpSSOURL"] = metadata.SSOURL
+		setQuery["idpCertificates"] = metadata.Certificates
+	}
+
+	var provider providerInMongo
+	err = providerCollectionByNamespace(s.systemStub, in.Namespace).FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": id},
+		bson.M{"$set": setQuery, "$inc": bson.M{"version": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&provider)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "Provider not found")
+		}
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return nil, status.Error(codes.NotFound, "Provider not found")
+		}
+		return nil, status.Error(codes.Internal, "Failed to update provider in the database: "+err.Error())
+	}
+
+	return &grpc.UpdateProviderResponse{Provider: provider.ToGRPCProvider(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *SSOServer) GetProvider(ctx context.Context, in *grpc.GetProviderRequest) (*grpc.GetProviderResponse, error) {
+	provider, err := s.getProvider(ctx, in.Namespace, in.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return nil, status.Error(codes.NotFound, "Provider not found")
+	}
+
+	return &grpc.GetProviderResponse{Provider: provider.ToGRPCProvider(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *SSOServer) ListProviders(ctx context.Context, in *grpc.ListProvidersRequest) (*grpc.ListProvidersResponse, error) {
+	cursor, err := providerCollectionByNamespace(s.systemStub, in.Namespace).Find(ctx, bson.M{})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return &grpc.ListProvidersResponse{Providers: []*grpc.SAMLProvider{}}, status.Error(codes.OK, "")
+		}
+		return nil, status.Error(codes.Internal, "Failed to list providers from the database: "+err.Error())
+	}
+	var providers []providerInMongo
+	if err := cursor.All(ctx, &providers); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to decode providers from the database: "+err.Error())
+	}
+
+	result := make([]*grpc.SAMLProvider, len(providers))
+	for i, provider := range providers {
+		result[i] = provider.ToGRPCProvider(in.Namespace)
+	}
+	return &grpc.ListProvidersResponse{Providers: result}, status.Error(codes.OK, "")
+}
+
+func (s *SSOServer) DeleteProvider(ctx context.Context, in *grpc.DeleteProviderRequest) (*grpc.DeleteProviderResponse, error) {
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return &grpc.DeleteProviderResponse{Existed: false}, status.Error(codes.OK, "")
+	}
+
+	deleteResult, err := providerCollectionByNamespace(s.systemStub, in.Namespace).DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return &grpc.DeleteProviderResponse{Existed: false}, status.Error(codes.OK, "")
+		}
+		return nil, status.Error(codes.Internal, "Failed to delete provider from the database: "+err.Error())
+	}
+
+	if _, err := linkCollectionByNamespace(s.systemStub, in.Namespace).DeleteMany(ctx, bson.M{"provider": in.Uuid}); err != nil {
+		return nil, status.Error(codes.Internal, "Provider was deleted, but failed to delete its links: "+err.Error())
+	}
+
+	return &grpc.DeleteProviderResponse{Existed: deleteResult.DeletedCount != 0}, status.Error(codes.OK, "")
+}
+
+func (s *SSOServer) GetServiceProviderMetadata(ctx context.Context, in *grpc.GetServiceProviderMetadataRequest) (*grpc.GetServiceProviderMetadataResponse, error) {
+	provider, err := s.getProvider(ctx, in.Namespace, in.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return nil, status.Error(codes.NotFound, "Provider not found")
+	}
+
+	if err := s.ensureSignerReady(ctx); err != nil {
+		return nil, err
+	}
+
+	metadata, err := makeSPMetadata(provider, s.signer.Certificate())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to generate metadata: "+err.Error())
+	}
+
+	return &grpc.GetServiceProviderMetadataResponse{Metadata: metadata}, status.Error(codes.OK, "")
+}
+
+func (s *SSOServer) StartAuthentication(ctx context.Context, in *grpc.StartAuthenticationRequest) (*grpc.StartAuthenticationResponse, error) {
+	provider, err := s.getProvider(ctx, in.Namespace, in.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return &grpc.StartAuthenticationResponse{Status: grpc.StartAuthenticationResponse_PROVIDER_NOT_FOUND}, status.Error(codes.OK, "")
+	}
+	if !provider.Enabled {
+		return &grpc.StartAuthenticationResponse{Status: grpc.StartAuthenticationResponse_PROVIDER_DISABLED}, status.Error(codes.OK, "")
+	}
+
+	if err := s.ensureSignerReady(ctx); err != nil {
+		return nil, err
+	}
+
+	requestID, err := generateRequestID()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to generate request ID: "+err.Error())
+	}
+	authnRequest, err := makeAuthnRequest(provider, requestID, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to create AuthnRequest: "+err.Error())
+	}
+	query, err := makeRedirectQuery(authnRequest, in.RelayState)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to encode AuthnRequest: "+err.Error())
+	}
+	signature, err := s.signer.SignSHA256(ctx, []byte(query))
+	if err != nil {
+		if err == ErrSSOSignerVaultSealed {
+			return nil, status.Error(codes.FailedPrecondition, "Vault is sealed")
+		}
+		return nil, status.Error(codes.Internal, "Failed to sign AuthnRequest: "+err.Error())
+	}
+	query += "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+
+	// Response from IdP is only accepted if it references request created by this service
+	if err := s.systemStub.Redis.Set(ctx, makeRequestRedisKey(in.Namespace, in.Provider, requestID), 1, saml_request_lifetime).Err(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to save AuthnRequest in redis: "+err.Error())
+	}
+
+	separator := "?"
+	if strings.Contains(provider.IdPSSOURL, "?") {
+		separator = "&"
+	}
+
+	return &grpc.StartAuthenticationResponse{
+		Status:      grpc.StartAuthenticationResponse_OK,
+		RedirectURL: provider.IdPSSOURL + separator + query,
+		RequestId:   requestID,
+	}, status.Error(codes.OK, "")
+}
+
+func (s *SSOServer) Authenticate(ctx context.Context, in *grpc.AuthenticateRequest) (*grpc.AuthenticateResponse, error) {
+	provider, err := s.getProvider(ctx, in.Namespace, in.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_PROVIDER_NOT_FOUND}, status.Error(codes.OK, "")
+	}
+	if !provider.Enabled {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_PROVIDER_DISABLED}, status.Error(codes.OK, "")
+	}
+
+	now := time.Now()
+	info, err := validateSAMLResponse(provider, in.SamlResponse, now)
+	if err != nil {
+		if validationErr, ok := err.(*samlValidationError); ok {
+			return &grpc.AuthenticateResponse{Status: validationErr.status, Message: validationErr.message}, status.Error(codes.OK, "")
+		}
+		return nil, status.Error(codes.Internal, "Failed to validate SAML response: "+err.Error())
+	}
+
+	// Every AuthnRequest can only be answered once
+	deleted, err := s.systemStub.Redis.Del(ctx, makeRequestRedisKey(in.Namespace, in.Provider, info.InResponseTo)).Result()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check AuthnRequest in redis: "+err.Error())
+	}
+	if deleted == 0 {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_ASSERTION_EXPIRED, Message: "assertion references unknown or already used AuthnRequest"}, status.Error(codes.OK, "")
+	}
+
+	// Every assertion can only be used once
+	replayTTL := info.NotOnOrAfter.Add(saml_max_clock_skew).Sub(now)
+	if replayTTL <= 0 {
+		replayTTL = saml_max_clock_skew
+	}
+	firstUse, err := s.systemStub.Redis.SetNX(ctx, makeAssertionRedisKey(in.Namespace, in.Provider, info.ID), 1, replayTTL).Result()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check assertion replay in redis: "+err.Error())
+	}
+	if !firstUse {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_ASSERTION_EXPIRED, Message: "assertion was already used"}, status.Error(codes.OK, "")
+	}
+
+	subject, name, err := mapAssertionSubject(provider, info)
+	if err != nil {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_RESPONSE_INVALID, Message: err.Error()}, status.Error(codes.OK, "")
+	}
+
+	linkCollection := linkCollectionByNamespace(s.systemStub, in.Namespace)
+	var link linkInMongo
+	err = linkCollection.FindOne(ctx, bson.M{"provider": in.Provider, "subject": subject}).Decode(&link)
+	if err == nil {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_OK, Identity: link.Identity, Subject: subject}, status.Error(codes.OK, "")
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, status.Error(codes.Internal, "Failed to load link from the database: "+err.Error())
+	}
+
+	if !provider.JITProvisioning {
+		return &grpc.AuthenticateResponse{Status: grpc.AuthenticateResponse_UNAUTHENTICATED, Subject: subject}, status.Error(codes.OK, "")
+	}
+
+	// Just-in-time provisioning
+	identityResponse, err := s.identityServer.Create(ctx, &nativeIAmIdentityGRPC.CreateIdentityRequest{
+		Namespace:       in.Namespace,
+		Name:            name,
+		InitiallyActive: true,
+		Managed: &nativeIAmIdentityGRPC.CreateIdentityRequest_Service{
+			Service: &nativeIAmIdentityGRPC.ServiceManagedData{
+				Service:      sso_identity_management_service,
+				Reason:       "Identity created on first login with SAML provider " + provider.ID.Hex(),
+				ManagementId: "",
+			},
+		},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to create identity: "+err.Error())
+	}
+
+	_, err = linkCollection.InsertOne(ctx, linkInMongo{
+		Provider: in.Provider,
+		Subject:  subject,
+		Identity: identityResponse.Identity.Uuid,
+		Created:  time.Now().UTC(),
+	})
+	if err != nil {
+		s.identityServer.Delete(ctx, &nativeIAmIdentityGRPC.DeleteIdentityRequest{
+			Namespace: in.Namespace,
+			Uuid:      identityResponse.Identity.Uuid,
+		})
+		return nil, status.Error(codes.Internal, "Failed to link created identity: "+err.Error())
+	}
+
+	return &grpc.AuthenticateResponse{
+		Status:          grpc.AuthenticateResponse_OK,
+		Identity:        identityResponse.Identity.Uuid,
+		Subject:         subject,
+		IdentityCreated: true,
+	}, status.Error(codes.OK, "")
+}
+
+func (s *SSOServer) LinkIdentity(ctx context.Context, in *grpc.LinkIdentityRequest) (*grpc.LinkIdentityResponse, error) {
+	if in.Subject == "" {
+		return nil, status.Error(codes.InvalidArgument, "Subject must not be empty")
+	}
+
+	provider, err := s.getProvider(ctx, in.Namespace, in.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return &grpc.LinkIdentityResponse{Status: grpc.LinkIdentityResponse_PROVIDER_NOT_FOUND}, status.Error(codes.OK, "")
+	}
+
+	existResponse, err := s.identityServer.Exists(ctx, &nativeIAmIdentityGRPC.ExistsIdentityRequest{Namespace: in.Namespace, Uuid: in.Identity, UseCache: true})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check if identity exists: "+err.Error())
+	}
+	if !existResponse.Exists {
+		return nil, status.Error(codes.NotFound, "Identity not found")
+	}
+
+	_, err = linkCollectionByNamespace(s.systemStub, in.Namespace).InsertOne(ctx, linkInMongo{
+		Provider: in.Provider,
+		Subject:  in.Subject,
+		Identity: in.Identity,
+		Created:  time.Now().UTC(),
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return &grpc.LinkIdentityResponse{Status: grpc.LinkIdentityResponse_ALREADY_LINKED}, status.Error(codes.OK, "")
+		}
+		return nil, status.Error(codes.Internal, "Failed to insert link to the database: "+err.Error())
+	}
+
+	return &grpc.LinkIdentityResponse{Status: grpc.LinkIdentityResponse_OK}, status.Error(codes.OK, "")
+}
+
+func (s *SSOServer) UnlinkIdentity(ctx context.Context, in *grpc.UnlinkIdentityRequest) (*grpc.UnlinkIdentityResponse, error) {
+	deleteResult, err := linkCollectionByNamespace(s.systemStub, in.Namespace).DeleteOne(ctx, bson.M{"provider": in.Provider, "subject": in.Subject})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return &grpc.UnlinkIdentityResponse{Existed: false}, status.Error(codes.OK, "")
+		}
+		return nil, status.Error(codes.Internal, "Failed to delete link from the database: "+err.Error())
+	}
+
+	return &grpc.UnlinkIdentityResponse{Existed: deleteResult.DeletedCount != 0}, status.Error(codes.OK, "")
+}
@@ -0,0 +1,192 @@
+package sso
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const sso_sp_key_name = "openbp_native_iam_authentication_sso_sp"
+const sso_sp_certificate_redis_key = "openbp_native_iam_authentication_sso_sp_certificate"
+
+var ErrSSOSignerVaultSealed = errors.New("the vault is sealed")
+
+// Signs AuthnRequests with the SP key pair. Private key never leaves system_vault.
+type spSigner struct {
+	systemStub *system.SystemStub
+
+	publicKey   *rsa.PublicKey
+	certificate []byte
+	mut         *sync.RWMutex
+}
+type spContextedSigner struct {
+	systemStub *system.SystemStub
+	publicKey  *rsa.PublicKey
+
+	ctx context.Context
+}
+
+func newSPSigner(systemStub *system.SystemStub) *spSigner {
+	return &spSigner{
+		systemStub: systemStub,
+		mut:        &sync.RWMutex{},
+	}
+}
+
+func wrapVaultError(err error) error {
+	if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+		return ErrSSOSignerVaultSealed
+	}
+	return err
+}
+
+func (s *spSigner) loadPublicKey(ctx context.Context) (*rsa.PublicKey, error) {
+	getRSAKeyResponse, err := s.systemStub.Vault.GetRSAPublicKey(ctx, &vault.GetRSAPublicKeyRequest{
+		KeyName: sso_sp_key_name,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+			return nil, wrapVaultError(err)
+		}
+
+		// Key pair doesnt exist yet
+		if _, err = s.systemStub.Vault.EnsureRSAKeyPair(ctx, &vault.EnsureRSAKeyPairRequest{KeyName: sso_sp_key_name}); err != nil {
+			return nil, errors.Join(errors.New("failed to create SP key pair in system_vault"), wrapVaultError(err))
+		}
+		getRSAKeyResponse, err = s.systemStub.Vault.GetRSAPublicKey(ctx, &vault.GetRSAPublicKeyRequest{
+			KeyName: sso_sp_key_name,
+		})
+		if err != nil {
+			return nil, errors.Join(errors.New("SP key pair was created, but still failed to get it"), wrapVaultError(err))
+		}
+	}
+
+	key, err := x509.ParsePKCS1PublicKey(getRSAKeyResponse.PublicKey)
+	if err != nil {
+		return nil, errors.New("failed to parse RSA public key: " + err.Error())
+	}
+	return key, nil
+}
+
+// Loads SP public key and certificate. Creates them if they dont exist.
+func (s *spSigner) EnsureReady(ctx context.Context) error {
+	s.mut.RLock()
+	ready := s.publicKey != nil
+	s.mut.RUnlock()
+	if ready {
+		return nil
+	}
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if s.publicKey != nil {
+		return nil
+	}
+
+	publicKey, err := s.loadPublicKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	certificate, err := s.systemStub.Redis.Get(ctx, sso_sp_certificate_redis_key).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			return errors.New("error while searching in redis for SP certificate: " + err.Error())
+		}
+
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(time.Now().UnixNano()),
+			Subject:               pkix.Name{Organization: []string{"OpenBP"}, CommonName: "OpenBP SAML Service Provider"},
+			NotBefore:             time.Now(),
+			NotAfter:              time.Now().AddDate(30, 0, 0),
+			KeyUsage:              x509.KeyUsageDigitalSignature,
+			BasicConstraintsValid: true,
+		}
+		signer := &spContextedSigner{systemStub: s.systemStub, publicKey: publicKey, ctx: ctx}
+		certificate, err = x509.CreateCertificate(rand.Reader, template, template, publicKey, signer)
+		if err != nil {
+			return errors.New("failed to sign SP certificate: " + err.Error())
+		}
+
+		// Other instance may create certificate in meantime. Only first one is used.
+		seted, err := s.systemStub.Redis.SetNX(ctx, sso_sp_certificate_redis_key, certificate, 0).Result()
+		if err != nil {
+			return errors.New("failed to save SP certificate in redis: " + err.Error())
+		}
+		if !seted {
+			certificate, err = s.systemStub.Redis.Get(ctx, sso_sp_certificate_redis_key).Bytes()
+			if err != nil {
+				return errors.New("failed to get SP certificate from redis: " + err.Error())
+			}
+		}
+	}
+
+	if _, err := x509.ParseCertificate(certificate); err != nil {
+		return errors.New("error while parsing SP certificate from redis: " + err.Error())
+	}
+
+	s.publicKey = publicKey
+	s.certificate = certificate
+	return nil
+}
+
+// DER encoded SP certificate. EnsureReady must be called before.
+func (s *spSigner) Certificate() []byte {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	return s.certificate
+}
+
+// Signs data using RSA-SHA256
+func (s *spSigner) SignSHA256(ctx context.Context, data []byte) ([]byte, error) {
+	s.mut.RLock()
+	signer := &spContextedSigner{systemStub: s.systemStub, publicKey: s.publicKey, ctx: ctx}
+	s.mut.RUnlock()
+
+	digest := sha256.Sum256(data)
+	return signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
+
+func (s *spContextedSigner) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+// DER1 prefix for SHA256
+var rsaSignerPrefix = []byte{0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20}
+
+func (s *spContextedSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts.HashFunc() != crypto.SHA256 {
+		return nil, errors.New("only SHA256 is supported")
+	}
+
+	// Padding is done by the vault
+	em := make([]byte, len(rsaSignerPrefix)+len(digest))
+	copy(em[:len(rsaSignerPrefix)], rsaSignerPrefix)
+	copy(em[len(rsaSignerPrefix):], digest)
+
+	signResult, err := s.systemStub.Vault.RSASign(s.ctx, &vault.RSASignRequest{
+		KeyName:   sso_sp_key_name,
+		Data:      em,
+		Mechanism: vault.RSASignMechanism_RSA_PKCS,
+	})
+	if err != nil {
+		return nil, wrapVaultError(err)
+	}
+
+	return signResult.Signature, nil
+}
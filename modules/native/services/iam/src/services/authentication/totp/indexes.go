@@ -0,0 +1,41 @@
+package totp
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	unique_totp_identity_index = "unique_identity"
+)
+
+func EnsureIndexesForNamespace(ctx context.Context, namespace string, systemStub *system.SystemStub) error {
+	collection := systemStub.DB.Database("openbp_global").Collection("native_iam_authentication_totp")
+	if namespace != "" {
+		collection = systemStub.DB.Database("openbp_namespace_" + namespace).Collection("native_iam_authentication_totp")
+	}
+
+	// Unique index guarantees that identity can only have one TOTP secret (confirmed or not)
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			bson.E{Key: "identity", Value: 1},
+		},
+		Options: options.Index().
+			SetName(unique_totp_identity_index).
+			SetUnique(true),
+	},
+	)
+	if err != nil {
+		log.WithField("namespace", namespace).Error("Failed to ensure indexes for totp: " + err.Error())
+		return err
+	}
+
+	log.WithField("namespace", namespace).Info("Successfully ensured indexes for namespace.")
+	return nil
+}
@@ -0,0 +1,118 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP parameters (RFC 6238). These are the defaults supported by all the popular authenticator applications.
+const (
+	totp_issuer        = "OpenBP"
+	totp_secret_length = 20
+	totp_digits        = 6
+	totp_period        = 30
+	// Number of periods before and after current one that are accepted to compensate clock drift
+	totp_skew = 1
+
+	recovery_codes_count    = 10
+	recovery_code_length    = 10
+	recovery_codes_alphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func generateSecret() ([]byte, error) {
+	secret := make([]byte, totp_secret_length)
+	_, err := rand.Read(secret)
+	return secret, err
+}
+
+func makeKeyURI(secret []byte, accountName string) string {
+	query := url.Values{}
+	query.Set("secret", secretEncoding.EncodeToString(secret))
+	query.Set("issuer", totp_issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totp_digits))
+	query.Set("period", fmt.Sprint(totp_period))
+
+	return "otpauth://totp/" + url.PathEscape(totp_issuer+":"+accountName) + "?" + query.Encode()
+}
+
+// HOTP value (RFC 4226) for the specified counter
+func generateCode(secret []byte, counter int64) string {
+	counterBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(counterBytes, uint64(counter))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(counterBytes)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totp_digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totp_digits, value%mod)
+}
+
+// Checks the code against current time step and its neighbours. Returns the time step that matched the code.
+func validateCode(secret []byte, code string, now time.Time) (int64, bool) {
+	if len(code) != totp_digits {
+		return 0, false
+	}
+
+	currentStep := now.Unix() / totp_period
+	for step := currentStep - totp_skew; step <= currentStep+totp_skew; step++ {
+		if hmac.Equal([]byte(generateCode(secret, step)), []byte(code)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func generateRecoveryCodes() ([]string, error) {
+	codes := make([]string, recovery_codes_count)
+	max := big.NewInt(int64(len(recovery_codes_alphabet)))
+	for i := range codes {
+		var code strings.Builder
+		for j := 0; j < recovery_code_length; j++ {
+			if j == recovery_code_length/2 {
+				code.WriteByte('-')
+			}
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, err
+			}
+			code.WriteByte(recovery_codes_alphabet[n.Int64()])
+		}
+		codes[i] = code.String()
+	}
+	return codes, nil
+}
+
+// Brings TOTP and recovery codes to the canonical form. Users usually copy codes with spaces and dashes.
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
+
+func isTOTPCodeFormat(code string) bool {
+	if len(code) != totp_digits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
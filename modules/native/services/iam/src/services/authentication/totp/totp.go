@@ -0,0 +1,364 @@
+package totp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"google.golang.org/grpc/status"
+
+	grpccodes "google.golang.org/grpc/codes"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/totp"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	nativeNamespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
+)
+
+type TOTPIdentificationService struct {
+	grpc.UnimplementedIAMAuthenticationTOTPServiceServer
+
+	nativeStub     *native.NativeStub
+	systemStub     *system.SystemStub
+	identityServer nativeIAmIdentityGRPC.IAMIdentityServiceServer
+
+	globalMongoCollection *mongo.Collection
+}
+
+type totpInMongo struct {
+	Identity string `bson:"identity"`
+	// TOTP secret encrypted by the vault
+	Secret    []byte `bson:"secret"`
+	Confirmed bool   `bson:"confirmed"`
+	// Last time step that was successfully used. Codes from this or previous steps are rejected to prevent replay
+	LastUsedStep int64 `bson:"lastUsedStep"`
+	// HMAC signatures of the unused recovery codes
+	RecoveryCodes [][]byte `bson:"recoveryCodes"`
+
+	Created time.Time `bson:"created"`
+	Updated time.Time `bson:"updated"`
+}
+
+func collectionByNamespace(s *TOTPIdentificationService, namespace string) *mongo.Collection {
+	if namespace == "" {
+		return s.globalMongoCollection
+	} else {
+		dbName := fmt.Sprintf("openbp_namespace_%s", namespace)
+		return s.systemStub.DB.Database(dbName).Collection("native_iam_authentication_totp")
+	}
+}
+
+func NewTOTPIdentificationService(ctx context.Context, systemStub *system.SystemStub, nativeStub *native.NativeStub, identityServer nativeIAmIdentityGRPC.IAMIdentityServiceServer) (*TOTPIdentificationService, error) {
+	err := EnsureIndexesForNamespace(ctx, "", systemStub)
+	if err != nil {
+		return nil, errors.New("failed to ensure indexes in global namespace: " + err.Error())
+	}
+
+	return &TOTPIdentificationService{
+		systemStub:            systemStub,
+		globalMongoCollection: systemStub.DB.Database("openbp_global").Collection("native_iam_authentication_totp"),
+		nativeStub:            nativeStub,
+		identityServer:        identityServer,
+	}, nil
+}
+
+func vaultError(err error, action string) error {
+	if st, ok := status.FromError(err); ok {
+		if st.Code() == grpccodes.FailedPrecondition {
+			return status.Error(grpccodes.FailedPrecondition, "The vault is sealed. Message from system_vault: "+err.Error())
+		}
+	}
+	return status.Error(grpccodes.Internal, "Failed to "+action+": "+err.Error())
+}
+
+func (s *TOTPIdentificationService) signRecoveryCode(ctx context.Context, identity string, code string) ([]byte, error) {
+	signResponse, err := s.systemStub.Vault.HMACSign(ctx, &vault.HMACSignRequest{
+		Data: []byte(identity + ":" + normalizeCode(code)),
+	})
+	if err != nil {
+		return nil, vaultError(err, "sign recovery code")
+	}
+	return signResponse.Signature, nil
+}
+
+// Generates new recovery codes and returns them together with theirs signatures
+func (s *TOTPIdentificationService) makeRecoveryCodes(ctx context.Context, identity string) ([]string, [][]byte, error) {
+	codes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, nil, status.Error(grpccodes.Internal, "Failed to generate recovery codes: "+err.Error())
+	}
+
+	signatures := make([][]byte, len(codes))
+	for i, code := range codes {
+		signatures[i], err = s.signRecoveryCode(ctx, identity, code)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return codes, signatures, nil
+}
+
+func (s *TOTPIdentificationService) getEntry(ctx context.Context, namespace string, identity string) (*totpInMongo, error) {
+	collection := collectionByNamespace(s, namespace)
+	var entry totpInMongo
+	err := collection.FindOne(ctx, bson.M{"identity": identity}).Decode(&entry)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return nil, nil
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to fetch TOTP information about identity: "+err.Error())
+	}
+	return &entry, nil
+}
+
+// Validates TOTP code against the secret and marks its time step as used
+func (s *TOTPIdentificationService) consumeTOTPCode(ctx context.Context, namespace string, entry *totpInMongo, code string) (bool, error) {
+	decryptResponse, err := s.systemStub.Vault.Decrypt(ctx, &vault.DecryptRequest{EncryptedData: entry.Secret})
+	if err != nil {
+		return false, vaultError(err, "decrypt TOTP secret")
+	}
+
+	step, valid := validateCode(decryptResponse.PlainData, code, time.Now())
+	if !valid || step <= entry.LastUsedStep {
+		return false, nil
+	}
+
+	// Atomically mark the step as used so the same code cant be used twice
+	collection := collectionByNamespace(s, namespace)
+	updateResult, err := collection.UpdateOne(
+		ctx,
+		bson.M{"identity": entry.Identity, "lastUsedStep": bson.M{"$lt": step}},
+		bson.M{"$set": bson.M{"lastUsedStep": step, "updated": time.Now().UTC()}},
+	)
+	if err != nil {
+		return false, status.Error(grpccodes.Internal, "Failed to update TOTP information in database: "+err.Error())
+	}
+
+	return updateResult.ModifiedCount != 0, nil
+}
+
+func (s *TOTPIdentificationService) Enroll(ctx context.Context, in *grpc.EnrollRequest) (*grpc.EnrollResponse, error) {
+	// Check if namespace exists
+	if in.Namespace != "" {
+		namespaceExistResponse, err := s.nativeStub.Services.Namespace.Exists(ctx, &nativeNamespaceGRPC.IsNamespaceExistRequest{Name: in.Namespace, UseCache: true})
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to check if namespace exist "+err.Error())
+		}
+		if !namespaceExistResponse.Exist {
+			return nil, status.Error(grpccodes.FailedPrecondition, "Namespace doesnt exist")
+		}
+	}
+
+	_, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{Namespace: in.Namespace, Uuid: in.Identity, UseCache: true})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && (st.Code() == grpccodes.NotFound || st.Code() == grpccodes.InvalidArgument) {
+			return nil, status.Error(grpccodes.NotFound, "Identity not found")
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to get identity: "+err.Error())
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to generate secret: "+err.Error())
+	}
+
+	encryptResponse, err := s.systemStub.Vault.Encrypt(ctx, &vault.EncryptRequest{PlainData: secret})
+	if err != nil {
+		return nil, vaultError(err, "encrypt TOTP secret")
+	}
+
+	// Only unconfirmed enrollment can be overridden. If identity already has confirmed TOTP, upsert will fail on unique index.
+	now := time.Now().UTC()
+	collection := collectionByNamespace(s, in.Namespace)
+	_, err = collection.UpdateOne(
+		ctx,
+		bson.M{"identity": in.Identity, "confirmed": false},
+		bson.M{
+			"$set": bson.M{
+				"secret":        encryptResponse.EncryptedData,
+				"lastUsedStep":  int64(0),
+				"recoveryCodes": [][]byte{},
+				"created":       now,
+				"updated":       now,
+			},
+			"$setOnInsert": bson.M{"identity": in.Identity, "confirmed": false},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return &grpc.EnrollResponse{Status: grpc.EnrollResponse_ALREADY_ENABLED}, status.Error(grpccodes.OK, "")
+		}
+		return nil, status.Error(grpccodes.Internal, "Error on saving TOTP secret in database: "+err.Error())
+	}
+
+	accountName := in.AccountName
+	if accountName == "" {
+		accountName = in.Identity
+	}
+
+	return &grpc.EnrollResponse{
+		Status: grpc.EnrollResponse_OK,
+		Secret: secretEncoding.EncodeToString(secret),
+		Uri:    makeKeyURI(secret, accountName),
+	}, status.Error(grpccodes.OK, "")
+}
+
+func (s *TOTPIdentificationService) ConfirmEnrollment(ctx context.Context, in *grpc.ConfirmEnrollmentRequest) (*grpc.ConfirmEnrollmentResponse, error) {
+	entry, err := s.getEntry(ctx, in.Namespace, in.Identity)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return &grpc.ConfirmEnrollmentResponse{Status: grpc.ConfirmEnrollmentResponse_NOT_ENROLLED}, status.Error(grpccodes.OK, "")
+	}
+	if entry.Confirmed {
+		return &grpc.ConfirmEnrollmentResponse{Status: grpc.ConfirmEnrollmentResponse_ALREADY_ENABLED}, status.Error(grpccodes.OK, "")
+	}
+
+	valid, err := s.consumeTOTPCode(ctx, in.Namespace, entry, normalizeCode(in.Code))
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return &grpc.ConfirmEnrollmentResponse{Status: grpc.ConfirmEnrollmentResponse_CODE_INVALID}, status.Error(grpccodes.OK, "")
+	}
+
+	codes, signatures, err := s.makeRecoveryCodes(ctx, in.Identity)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := collectionByNamespace(s, in.Namespace)
+	updateResult, err := collection.UpdateOne(
+		ctx,
+		bson.M{"identity": in.Identity, "confirmed": false, "secret": entry.Secret},
+		bson.M{"$set": bson.M{"confirmed": true, "recoveryCodes": signatures, "updated": time.Now().UTC()}},
+	)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Error on confirming TOTP in database: "+err.Error())
+	}
+	if updateResult.ModifiedCount == 0 {
+		// Enrollment was restarted or confirmed by concurrent request
+		return &grpc.ConfirmEnrollmentResponse{Status: grpc.ConfirmEnrollmentResponse_CODE_INVALID}, status.Error(grpccodes.OK, "")
+	}
+
+	return &grpc.ConfirmEnrollmentResponse{Status: grpc.ConfirmEnrollmentResponse_OK, RecoveryCodes: codes}, status.Error(grpccodes.OK, "")
+}
+
+func (s *TOTPIdentificationService) Verify(ctx context.Context, in *grpc.VerifyRequest) (*grpc.VerifyResponse, error) {
+	entry, err := s.getEntry(ctx, in.Namespace, in.Identity)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil || !entry.Confirmed {
+		return &grpc.VerifyResponse{Status: grpc.VerifyResponse_NOT_ENABLED}, status.Error(grpccodes.OK, "")
+	}
+
+	code := normalizeCode(in.Code)
+	if isTOTPCodeFormat(code) {
+		valid, err := s.consumeTOTPCode(ctx, in.Namespace, entry, code)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return &grpc.VerifyResponse{Status: grpc.VerifyResponse_CODE_INVALID}, status.Error(grpccodes.OK, "")
+		}
+		return &grpc.VerifyResponse{Status: grpc.VerifyResponse_OK}, status.Error(grpccodes.OK, "")
+	}
+
+	if len(code) != recovery_code_length {
+		return &grpc.VerifyResponse{Status: grpc.VerifyResponse_CODE_INVALID}, status.Error(grpccodes.OK, "")
+	}
+
+	signature, err := s.signRecoveryCode(ctx, in.Identity, code)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recovery code is removed in the same operation where it is checked, so it can be used only once
+	collection := collectionByNamespace(s, in.Namespace)
+	updateResult, err := collection.UpdateOne(
+		ctx,
+		bson.M{"identity": in.Identity, "confirmed": true, "recoveryCodes": signature},
+		bson.M{"$pull": bson.M{"recoveryCodes": signature}, "$set": bson.M{"updated": time.Now().UTC()}},
+	)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Error on using recovery code: "+err.Error())
+	}
+	if updateResult.ModifiedCount == 0 {
+		return &grpc.VerifyResponse{Status: grpc.VerifyResponse_CODE_INVALID}, status.Error(grpccodes.OK, "")
+	}
+
+	return &grpc.VerifyResponse{Status: grpc.VerifyResponse_OK, RecoveryCodeUsed: true}, status.Error(grpccodes.OK, "")
+}
+
+func (s *TOTPIdentificationService) RegenerateRecoveryCodes(ctx context.Context, in *grpc.RegenerateRecoveryCodesRequest) (*grpc.RegenerateRecoveryCodesResponse, error) {
+	codes, signatures, err := s.makeRecoveryCodes(ctx, in.Identity)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := collectionByNamespace(s, in.Namespace)
+	updateResult, err := collection.UpdateOne(
+		ctx,
+		bson.M{"identity": in.Identity, "confirmed": true},
+		bson.M{"$set": bson.M{"recoveryCodes": signatures, "updated": time.Now().UTC()}},
+	)
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return &grpc.RegenerateRecoveryCodesResponse{Status: grpc.RegenerateRecoveryCodesResponse_NOT_ENABLED}, status.Error(grpccodes.OK, "")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Error on updating recovery codes in database: "+err.Error())
+	}
+	if updateResult.MatchedCount == 0 {
+		return &grpc.RegenerateRecoveryCodesResponse{Status: grpc.RegenerateRecoveryCodesResponse_NOT_ENABLED}, status.Error(grpccodes.OK, "")
+	}
+
+	return &grpc.RegenerateRecoveryCodesResponse{Status: grpc.RegenerateRecoveryCodesResponse_OK, RecoveryCodes: codes}, status.Error(grpccodes.OK, "")
+}
+
+func (s *TOTPIdentificationService) Disable(ctx context.Context, in *grpc.DisableRequest) (*grpc.DisableResponse, error) {
+	collection := collectionByNamespace(s, in.Namespace)
+	deleteResult, err := collection.DeleteOne(ctx, bson.M{"identity": in.Identity})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return &grpc.DisableResponse{}, status.Error(grpccodes.OK, "")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Error on deleting TOTP in database: "+err.Error())
+	}
+	return &grpc.DisableResponse{Existed: deleteResult.DeletedCount != 0}, status.Error(grpccodes.OK, "")
+}
+
+func (s *TOTPIdentificationService) GetStatus(ctx context.Context, in *grpc.GetStatusRequest) (*grpc.GetStatusResponse, error) {
+	entry, err := s.getEntry(ctx, in.Namespace, in.Identity)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return &grpc.GetStatusResponse{}, status.Error(grpccodes.OK, "")
+	}
+
+	return &grpc.GetStatusResponse{
+		Enabled:             entry.Confirmed,
+		PendingConfirmation: !entry.Confirmed,
+		RecoveryCodesLeft:   uint32(len(entry.RecoveryCodes)),
+	}, status.Error(grpccodes.OK, "")
+}
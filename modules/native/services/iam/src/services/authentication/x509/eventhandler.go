@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
 
 	namespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
@@ -20,3 +21,21 @@ func HandleNamespaceCreationEvent(ctx context.Context, logger *log.Entry, namesp
 	logger.Info("Successfully handled namespace creation event.")
 	return nil
 }
+
+func HandleNamespaceDeletionEvent(ctx context.Context, logger *log.Entry, namespace *namespaceGRPC.Namespace, systemStub *system.SystemStub) error {
+	err := systemStub.Cache.RemoveByPattern(ctx, namespaceCRLCacheKey(namespace.Name))
+	if err != nil {
+		logger.Error("failed to remove cache: " + err.Error())
+		return errors.New("failed to remove cache: " + err.Error())
+	}
+
+	// Intermediate CA will be created again if namespace with the same name will be created
+	_, err = namespaceCACollection(systemStub).DeleteOne(ctx, bson.M{"_id": namespace.Name})
+	if err != nil {
+		logger.Error("failed to delete intermediate CA: " + err.Error())
+		return errors.New("failed to delete intermediate CA: " + err.Error())
+	}
+
+	logger.Info("Successfully handled namespace deletion event.")
+	return nil
+}
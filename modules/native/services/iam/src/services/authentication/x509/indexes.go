@@ -12,20 +12,30 @@ import (
 )
 
 const (
-	fast_search_identity_index = "fast_search_identity"
+	fast_search_identity_index      = "fast_search_identity"
+	fast_search_expiration_index    = "fast_search_expiration"
+	revocation_expiration_ttl_index = "expiration_ttl"
 )
 
 func EnsureIndexesForNamespace(ctx context.Context, namespace string, systemStub *system.SystemStub) error {
 	collection := x509CollectionByNamespace(systemStub, namespace)
 
-	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
-		Keys: bson.D{
-			bson.E{Key: "identity", Value: "hashed"},
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				bson.E{Key: "identity", Value: "hashed"},
+			},
+			Options: options.Index().
+				SetName(fast_search_identity_index),
 		},
-		Options: options.Index().
-			SetName(fast_search_identity_index),
-	},
-	)
+		{
+			Keys: bson.D{
+				bson.E{Key: "expiresAt", Value: 1},
+			},
+			Options: options.Index().
+				SetName(fast_search_expiration_index),
+		},
+	})
 	if err != nil {
 		log.WithField("namespace", namespace).Error("Failed to ensure indexes for x509 service: " + err.Error())
 		return err
@@ -37,3 +47,19 @@ func EnsureIndexesForNamespace(ctx context.Context, namespace string, systemStub
 
 	return err
 }
+
+// Ensures indexes for the global collection of revoked certificates. Revocations are removed automatically after certificate expiration.
+func EnsureRevocationIndexes(ctx context.Context, systemStub *system.SystemStub) error {
+	_, err := revocationCollection(systemStub).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			bson.E{Key: "expiresAt", Value: 1},
+		},
+		Options: options.Index().
+			SetName(revocation_expiration_ttl_index).
+			SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		log.Error("Failed to ensure indexes for revoked x509 certificates: " + err.Error())
+	}
+	return err
+}
@@ -1,15 +1,19 @@
 package x509
 
 import (
-	"context"
+	"crypto"
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
 	"math/big"
+	"net/url"
+	"strings"
 	"time"
 
 	x509GRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/x509"
+	nativeIAmConfigGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/config"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -21,12 +25,21 @@ type X509InMongo struct {
 	Description string             `bson:"description"`
 	PublicKey   []byte             `bson:"publicKey"`
 
+	// Last issued X509 certificate in DER format and its expiration time. Not set for certificates issued before expiration tracking was introduced.
+	Certificate []byte     `bson:"certificate,omitempty"`
+	ExpiresAt   *time.Time `bson:"expiresAt,omitempty"`
+
 	Created time.Time `bson:"created"`
 	Updated time.Time `bson:"updated"`
 	Version uint64    `bson:"version"`
 }
 
 func (c *X509InMongo) ToGRPCCertificate(namespace string) *x509GRPC.Certificate {
+	var expiresAt *timestamppb.Timestamp
+	if c.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*c.ExpiresAt)
+	}
+
 	return &x509GRPC.Certificate{
 		Namespace:   namespace,
 		Uuid:        c.UUID.Hex(),
@@ -34,16 +47,25 @@ func (c *X509InMongo) ToGRPCCertificate(namespace string) *x509GRPC.Certificate
 		Disabled:    c.Disabled,
 		Description: c.Description,
 		PublicKey:   c.PublicKey,
+		ExpiresAt:   expiresAt,
 		Created:     timestamppb.New(c.Created),
 		Updated:     timestamppb.New(c.Updated),
 		Version:     c.Version,
 	}
 }
 
-func (c *X509InMongo) ToSignedX509(ctx context.Context, namespace string, signer *x509Signer) ([]byte, error) {
+// Issues X509 certificate signed by the intermediate CA of the namespace. Returns certificate in DER format and its expiration time.
+// Certificates of the service identities (service is not empty) contain SPIFFE ID of the service and can be used by the service for both sides of mTLS connection.
+func (c *X509InMongo) ToSignedX509(namespace string, service string, issuer *x509.Certificate, signer crypto.Signer, config *nativeIAmConfigGRPC.Configuration_X509) ([]byte, time.Time, error) {
 	publicKey, err := x509.ParsePKCS1PublicKey(c.PublicKey)
 	if err != nil {
-		return nil, errors.New("invalid public key format: DER format expected")
+		return nil, time.Time{}, errors.New("invalid public key format: DER format expected")
+	}
+
+	notBefore := time.Now().UTC()
+	notAfter := notBefore.AddDate(0, 0, int(config.CertificateLifetime))
+	if notAfter.After(issuer.NotAfter) {
+		notAfter = issuer.NotAfter
 	}
 
 	serial := new(big.Int)
@@ -54,11 +76,25 @@ func (c *X509InMongo) ToSignedX509(ctx context.Context, namespace string, signer
 			CommonName:   c.Identity.Hex(),
 			Organization: []string{namespace},
 		},
-		NotBefore:   time.Now(),
-		NotAfter:    time.Now().AddDate(1, 0, 0),
+		NotBefore:   notBefore,
+		NotAfter:    notAfter,
 		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 		KeyUsage:    x509.KeyUsageDigitalSignature,
 	}
+	if service != "" {
+		cert.URIs = []*url.URL{serviceauth.ServiceURI(service)}
+		cert.ExtKeyUsage = append(cert.ExtKeyUsage, x509.ExtKeyUsageServerAuth)
+	}
+	if config.CrlDistributionPoint != "" {
+		cert.CRLDistributionPoints = []string{strings.ReplaceAll(config.CrlDistributionPoint, "{namespace}", url.QueryEscape(namespace))}
+	}
+	if config.OcspServer != "" {
+		cert.OCSPServer = []string{config.OcspServer}
+	}
 
-	return x509.CreateCertificate(rand.Reader, cert, signer.ca, publicKey, signer.GetContextedSigner(ctx))
+	raw, err := x509.CreateCertificate(rand.Reader, cert, issuer, publicKey, signer)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return raw, notAfter, nil
 }
@@ -0,0 +1,231 @@
+package x509
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
+)
+
+const x509_namespace_ca_key_name_prefix = "openbp_native_iam_authentication_x509_namespaceCA"
+
+// Intermediate CA can not live longer than this period (or longer than root CA)
+const namespace_ca_lifetime_years = 20
+
+// Hash algorithms that can be used by OCSP clients to identify the issuer
+var namespaceCAKeyHashAlgorithms = []crypto.Hash{crypto.SHA1, crypto.SHA256, crypto.SHA384, crypto.SHA512}
+
+// Intermediate CA of the namespace. Private key is stored in the system_vault.
+type namespaceCAInMongo struct {
+	Namespace   string `bson:"_id"`
+	Certificate []byte `bson:"certificate"`
+	// Hashes of the public key calculated with all the hash algorithms supported by OCSP. Used to find issuer of the certificate from OCSP request.
+	KeyHashes [][]byte  `bson:"keyHashes"`
+	Created   time.Time `bson:"created"`
+}
+
+type namespaceCA struct {
+	namespace   string
+	certificate *x509.Certificate
+}
+
+func namespaceCACollection(stub *system.SystemStub) *mongo.Collection {
+	return stub.DB.Database("openbp_global").Collection("native_iam_authentication_x509_namespace_ca")
+}
+
+// Name of the key pair in the system_vault used by the intermediate CA of the namespace
+func namespaceCAKeyName(namespace string) string {
+	if namespace == "" {
+		return x509_namespace_ca_key_name_prefix
+	}
+	return x509_namespace_ca_key_name_prefix + "_" + namespace
+}
+
+func EnsureNamespaceCAIndexes(ctx context.Context, systemStub *system.SystemStub) error {
+	_, err := namespaceCACollection(systemStub).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{bson.E{Key: "keyHashes", Value: 1}},
+		Options: options.Index().SetName("keyHashes"),
+	})
+	return err
+}
+
+func (c *namespaceCAInMongo) toNamespaceCA() (*namespaceCA, error) {
+	certificate, err := x509.ParseCertificate(c.Certificate)
+	if err != nil {
+		return nil, errors.New("failed to parse intermediate CA certificate: " + err.Error())
+	}
+	return &namespaceCA{namespace: c.Namespace, certificate: certificate}, nil
+}
+
+func (s *x509Signer) cacheNamespaceCA(ca *namespaceCA) {
+	s.namespaceCAMut.Lock()
+	s.namespaceCAs[ca.namespace] = ca
+	s.namespaceCAMut.Unlock()
+}
+
+// Get intermediate CA of the namespace. If it doesnt exist, it will be created if `create` is set. Otherwise nil is returned.
+// Root CA must be ready (see EnsureReady) before calling this method.
+func (s *x509Signer) GetNamespaceCA(ctx context.Context, namespace string, create bool) (*namespaceCA, error) {
+	s.namespaceCAMut.RLock()
+	ca, ok := s.namespaceCAs[namespace]
+	s.namespaceCAMut.RUnlock()
+	if ok {
+		return ca, nil
+	}
+
+	var caInMongo namespaceCAInMongo
+	err := namespaceCACollection(s.systemStub).FindOne(ctx, bson.M{"_id": namespace}).Decode(&caInMongo)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			return nil, errors.New("error while searching for intermediate CA in the database: " + err.Error())
+		}
+		if !create {
+			return nil, nil
+		}
+
+		created, err := s.createNamespaceCA(ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+		caInMongo = *created
+	}
+
+	ca, err = caInMongo.toNamespaceCA()
+	if err != nil {
+		return nil, err
+	}
+	s.cacheNamespaceCA(ca)
+	return ca, nil
+}
+
+// Searches for intermediate CA using hash of its public key (as it is provided in OCSP requests). Returns nil if CA doesnt exist.
+func (s *x509Signer) findNamespaceCAByKeyHash(ctx context.Context, keyHash []byte) (*namespaceCA, error) {
+	var caInMongo namespaceCAInMongo
+	err := namespaceCACollection(s.systemStub).FindOne(ctx, bson.M{"keyHashes": keyHash}).Decode(&caInMongo)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, errors.New("error while searching for intermediate CA in the database: " + err.Error())
+	}
+
+	ca, err := caInMongo.toNamespaceCA()
+	if err != nil {
+		return nil, err
+	}
+	s.cacheNamespaceCA(ca)
+	return ca, nil
+}
+
+func (s *x509Signer) createNamespaceCA(ctx context.Context, namespace string) (*namespaceCAInMongo, error) {
+	keyName := namespaceCAKeyName(namespace)
+
+	_, err := s.systemStub.Vault.EnsureRSAKeyPair(ctx, &vault.EnsureRSAKeyPairRequest{KeyName: keyName})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			return nil, ErrX509SignerVaultSealed
+		}
+		return nil, errors.New("failed to create key pair for intermediate CA in the system_vault: " + err.Error())
+	}
+	getRSAKeyResponse, err := s.systemStub.Vault.GetRSAPublicKey(ctx, &vault.GetRSAPublicKeyRequest{KeyName: keyName})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			return nil, ErrX509SignerVaultSealed
+		}
+		return nil, errors.New("failed to get public key of the intermediate CA from the system_vault: " + err.Error())
+	}
+	publicKey, err := x509.ParsePKCS1PublicKey(getRSAKeyResponse.PublicKey)
+	if err != nil {
+		return nil, errors.New("failed to parse RSA public key: " + err.Error())
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.New("failed to generate serial number: " + err.Error())
+	}
+
+	notBefore := time.Now().UTC()
+	notAfter := notBefore.AddDate(namespace_ca_lifetime_years, 0, 0)
+	if notAfter.After(s.ca.NotAfter) {
+		notAfter = s.ca.NotAfter
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   "OpenBP namespace CA",
+			Organization: []string{namespace},
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		MaxPathLen:            0,
+		MaxPathLenZero:        true,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, s.ca, publicKey, s.GetContextedSigner(ctx))
+	if err != nil {
+		return nil, errors.New("failed to sign intermediate CA certificate: " + err.Error())
+	}
+	certificate, err := x509.ParseCertificate(raw)
+	if err != nil {
+		return nil, errors.New("failed to parse intermediate CA certificate: " + err.Error())
+	}
+
+	keyHashes := make([][]byte, 0, len(namespaceCAKeyHashAlgorithms))
+	for _, algorithm := range namespaceCAKeyHashAlgorithms {
+		keyHash, err := issuerKeyHash(certificate, algorithm)
+		if err != nil {
+			return nil, errors.New("failed to calculate hash of the intermediate CA public key: " + err.Error())
+		}
+		keyHashes = append(keyHashes, keyHash)
+	}
+
+	caInMongo := &namespaceCAInMongo{
+		Namespace:   namespace,
+		Certificate: raw,
+		KeyHashes:   keyHashes,
+		Created:     notBefore,
+	}
+	_, err = namespaceCACollection(s.systemStub).InsertOne(ctx, caInMongo)
+	if err != nil {
+		if !mongo.IsDuplicateKeyError(err) {
+			return nil, errors.New("failed to insert intermediate CA to the database: " + err.Error())
+		}
+
+		// Other instance created CA in the meantime. Both certificates use the same key, so just use the stored one
+		err = namespaceCACollection(s.systemStub).FindOne(ctx, bson.M{"_id": namespace}).Decode(caInMongo)
+		if err != nil {
+			return nil, errors.New("error while searching for intermediate CA in the database: " + err.Error())
+		}
+	}
+
+	return caInMongo, nil
+}
+
+// Returns signer that uses private key of the namespace intermediate CA
+func (s *x509Signer) GetNamespaceContextedSigner(ctx context.Context, ca *namespaceCA) *x509ContextedSigner {
+	return &x509ContextedSigner{
+		systemStub: s.systemStub,
+		keyName:    namespaceCAKeyName(ca.namespace),
+		publicKey:  ca.certificate.PublicKey.(*rsa.PublicKey),
+		ctx:        ctx,
+	}
+}
@@ -0,0 +1,312 @@
+package x509
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/crypto/ocsp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	x509GRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/x509"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+)
+
+const (
+	// CRL is valid for this period. Clients must download new CRL before it expires.
+	CRL_VALIDITY = time.Hour
+	// Signed CRL is cached for this period. Cache is cleared after every revocation, so new CRL is issued right after the change.
+	CRL_CACHE_PERIOD = time.Minute * 10
+	// Validity of the OCSP responses
+	OCSP_RESPONSE_VALIDITY = time.Hour
+
+	// Certificates issued before expiration tracking was introduced were valid for one year
+	legacy_certificate_lifetime = time.Hour * 24 * 365
+
+	x509_crl_cache_key = "native_iam_authentication_x509_crl"
+)
+
+// Revoked (disabled or deleted) certificate. Revocations are stored globally, because all the certificates are signed by the same CA. Entries are removed by TTL index after certificate expiration.
+type revocationInMongo struct {
+	// Same as UUID of the certificate and serial number of the issued X509 certificates
+	UUID      primitive.ObjectID `bson:"_id"`
+	Namespace string             `bson:"namespace"`
+	RevokedAt time.Time          `bson:"revokedAt"`
+	ExpiresAt time.Time          `bson:"expiresAt"`
+	Reason    int                `bson:"reason"`
+}
+
+func revocationCollection(stub *system.SystemStub) *mongo.Collection {
+	return stub.DB.Database("openbp_global").Collection("native_iam_authentication_x509_revocation")
+}
+
+// Converts X509 serial number to the UUID of the certificate
+func serialToUUID(serial *big.Int) (primitive.ObjectID, error) {
+	return primitive.ObjectIDFromHex(fmt.Sprintf("%024x", serial))
+}
+
+// Adds certificate to the revocation list until the last issued X509 certificate expires
+func (s *X509IdentificationServer) registerRevocation(ctx context.Context, namespace string, certificate *X509InMongo, reason int) error {
+	now := time.Now().UTC()
+	expiresAt := now.Add(legacy_certificate_lifetime)
+	if certificate.ExpiresAt != nil {
+		expiresAt = *certificate.ExpiresAt
+	}
+	if !expiresAt.After(now) {
+		// Certificate already expired and cant be used anyway
+		return nil
+	}
+
+	_, err := revocationCollection(s.systemStub).UpdateOne(
+		ctx,
+		bson.M{"_id": certificate.UUID},
+		bson.M{
+			"$setOnInsert": bson.M{"namespace": namespace, "revokedAt": now, "reason": reason},
+			"$max":         bson.M{"expiresAt": expiresAt},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.systemStub.Cache.Remove(ctx, x509_crl_cache_key, namespaceCRLCacheKey(namespace)); err != nil {
+		log.Warn("Failed to clear cached CRL after certificate revocation: " + err.Error())
+	}
+	return nil
+}
+
+func namespaceCRLCacheKey(namespace string) string {
+	return x509_crl_cache_key + "_namespace_" + namespace
+}
+
+// Issues CRL with not expired revocations that match the filter. CRL is signed by the provided issuer.
+func (s *X509IdentificationServer) createCRL(ctx context.Context, filter bson.M, issuer *x509.Certificate, signer crypto.Signer) ([]byte, time.Time, time.Time, error) {
+	now := time.Now().UTC()
+	filter["expiresAt"] = bson.M{"$gt": now}
+	cursor, err := revocationCollection(s.systemStub).Find(ctx, filter)
+	if err != nil {
+		return nil, now, now, status.Error(codes.Internal, "error while searching for revoked certificates in the database: "+err.Error())
+	}
+	var revocations []revocationInMongo
+	if err := cursor.All(ctx, &revocations); err != nil {
+		return nil, now, now, status.Error(codes.Internal, "error while decoding revoked certificates from the database: "+err.Error())
+	}
+
+	entries := make([]x509.RevocationListEntry, 0, len(revocations))
+	for _, revocation := range revocations {
+		serial := new(big.Int)
+		serial.SetString(revocation.UUID.Hex(), 16)
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: revocation.RevokedAt,
+			ReasonCode:     revocation.Reason,
+		})
+	}
+
+	nextUpdate := now.Add(CRL_VALIDITY)
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(now.UnixNano()),
+		ThisUpdate:                now,
+		NextUpdate:                nextUpdate,
+		RevokedCertificateEntries: entries,
+	}, issuer, signer)
+	if err != nil {
+		if errors.Is(err, ErrX509SignerVaultSealed) {
+			return nil, now, now, status.Error(codes.FailedPrecondition, "The vault is sealed")
+		}
+		return nil, now, now, status.Error(codes.Internal, "error while signing CRL: "+err.Error())
+	}
+
+	return crl, now, nextUpdate, nil
+}
+
+func (s *X509IdentificationServer) GetCRL(ctx context.Context, in *x509GRPC.GetCRLRequest) (*x509GRPC.GetCRLResponse, error) {
+	if cached, _ := s.systemStub.Cache.Get(ctx, x509_crl_cache_key); cached != nil {
+		var response x509GRPC.GetCRLResponse
+		if err := proto.Unmarshal(cached, &response); err == nil {
+			return &response, status.Error(codes.OK, "")
+		}
+	}
+
+	err := s.signer.EnsureReady(ctx)
+	if err != nil {
+		if err == ErrX509SignerVaultSealed {
+			return nil, status.Error(codes.FailedPrecondition, "The vault is sealed")
+		}
+		return nil, status.Error(codes.Internal, "cant prepare X509 certificate signer: "+err.Error())
+	}
+
+	// Root CA CRL includes all the revocations, because certificates issued before namespace CAs were introduced are signed directly by root CA
+	crl, thisUpdate, nextUpdate, err := s.createCRL(ctx, bson.M{}, s.signer.ca, s.signer.GetContextedSigner(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &x509GRPC.GetCRLResponse{
+		Crl:        crl,
+		ThisUpdate: timestamppb.New(thisUpdate),
+		NextUpdate: timestamppb.New(nextUpdate),
+	}
+	if cacheBytes, err := proto.Marshal(response); err == nil {
+		s.systemStub.Cache.Set(ctx, x509_crl_cache_key, cacheBytes, CRL_CACHE_PERIOD)
+	}
+
+	return response, status.Error(codes.OK, "")
+}
+
+func (s *X509IdentificationServer) GetNamespaceCRL(ctx context.Context, in *x509GRPC.GetNamespaceCRLRequest) (*x509GRPC.GetNamespaceCRLResponse, error) {
+	cacheKey := namespaceCRLCacheKey(in.Namespace)
+	if cached, _ := s.systemStub.Cache.Get(ctx, cacheKey); cached != nil {
+		var response x509GRPC.GetNamespaceCRLResponse
+		if err := proto.Unmarshal(cached, &response); err == nil {
+			return &response, status.Error(codes.OK, "")
+		}
+	}
+
+	err := s.signer.EnsureReady(ctx)
+	if err != nil {
+		if err == ErrX509SignerVaultSealed {
+			return nil, status.Error(codes.FailedPrecondition, "The vault is sealed")
+		}
+		return nil, status.Error(codes.Internal, "cant prepare X509 certificate signer: "+err.Error())
+	}
+
+	ca, err := s.signer.GetNamespaceCA(ctx, in.Namespace, false)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get intermediate CA of the namespace: "+err.Error())
+	}
+	if ca == nil {
+		return nil, status.Error(codes.NotFound, "Namespace doesnt have intermediate CA. No certificates were issued in it yet.")
+	}
+
+	crl, thisUpdate, nextUpdate, err := s.createCRL(ctx, bson.M{"namespace": in.Namespace}, ca.certificate, s.signer.GetNamespaceContextedSigner(ctx, ca))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &x509GRPC.GetNamespaceCRLResponse{
+		Crl:        crl,
+		ThisUpdate: timestamppb.New(thisUpdate),
+		NextUpdate: timestamppb.New(nextUpdate),
+	}
+	if cacheBytes, err := proto.Marshal(response); err == nil {
+		s.systemStub.Cache.Set(ctx, cacheKey, cacheBytes, CRL_CACHE_PERIOD)
+	}
+
+	return response, status.Error(codes.OK, "")
+}
+
+// Calculates hash of the issuer public key the same way as it is done in the OCSP requests
+func issuerKeyHash(issuer *x509.Certificate, hash crypto.Hash) ([]byte, error) {
+	var publicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &publicKeyInfo); err != nil {
+		return nil, err
+	}
+
+	keyHash := hash.New()
+	keyHash.Write(publicKeyInfo.PublicKey.RightAlign())
+	return keyHash.Sum(nil), nil
+}
+
+// Checks if OCSP request asks about certificate issued by the CA of the service
+func isOCSPRequestForIssuer(request *ocsp.Request, issuer *x509.Certificate) bool {
+	if !request.HashAlgorithm.Available() {
+		return false
+	}
+
+	keyHash, err := issuerKeyHash(issuer, request.HashAlgorithm)
+	if err != nil {
+		return false
+	}
+
+	nameHash := request.HashAlgorithm.New()
+	nameHash.Write(issuer.RawSubject)
+
+	return bytes.Equal(nameHash.Sum(nil), request.IssuerNameHash) && bytes.Equal(keyHash, request.IssuerKeyHash)
+}
+
+func (s *X509IdentificationServer) OCSP(ctx context.Context, in *x509GRPC.OCSPRequest) (*x509GRPC.OCSPResponse, error) {
+	request, err := ocsp.ParseRequest(in.Request)
+	if err != nil {
+		return &x509GRPC.OCSPResponse{Response: ocsp.MalformedRequestErrorResponse}, status.Error(codes.OK, "")
+	}
+
+	err = s.signer.EnsureReady(ctx)
+	if err != nil {
+		if err == ErrX509SignerVaultSealed {
+			return &x509GRPC.OCSPResponse{Response: ocsp.TryLaterErrorResponse}, status.Error(codes.OK, "")
+		}
+		return nil, status.Error(codes.Internal, "cant prepare X509 certificate signer: "+err.Error())
+	}
+
+	// Certificate may be issued by root CA (before namespace CAs were introduced) or by intermediate CA of the namespace
+	var issuer *x509.Certificate = s.signer.ca
+	var signer crypto.Signer = s.signer.GetContextedSigner(ctx)
+	if !isOCSPRequestForIssuer(request, s.signer.ca) {
+		ca, err := s.signer.findNamespaceCAByKeyHash(ctx, request.IssuerKeyHash)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to find issuer of the certificate: "+err.Error())
+		}
+		if ca == nil || !isOCSPRequestForIssuer(request, ca.certificate) {
+			return &x509GRPC.OCSPResponse{Response: ocsp.UnauthorizedErrorResponse}, status.Error(codes.OK, "")
+		}
+		issuer = ca.certificate
+		signer = s.signer.GetNamespaceContextedSigner(ctx, ca)
+	}
+
+	now := time.Now().UTC()
+	template := ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: request.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(OCSP_RESPONSE_VALIDITY),
+		IssuerHash:   request.HashAlgorithm,
+	}
+
+	uuid, err := serialToUUID(request.SerialNumber)
+	if err != nil {
+		// Service never issues certificates with such serial numbers
+		template.Status = ocsp.Unknown
+	} else {
+		var revocation revocationInMongo
+		err := revocationCollection(s.systemStub).FindOne(ctx, bson.M{"_id": uuid}).Decode(&revocation)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return nil, status.Error(codes.Internal, "error while searching for revoked certificate in the database: "+err.Error())
+		}
+		if err == nil {
+			template.Status = ocsp.Revoked
+			template.RevokedAt = revocation.RevokedAt
+			template.RevocationReason = revocation.Reason
+		}
+	}
+
+	response, err := ocsp.CreateResponse(issuer, issuer, template, signer)
+	if err != nil {
+		if errors.Is(err, ErrX509SignerVaultSealed) {
+			return &x509GRPC.OCSPResponse{Response: ocsp.TryLaterErrorResponse}, status.Error(codes.OK, "")
+		}
+		return nil, status.Error(codes.Internal, "error while signing OCSP response: "+err.Error())
+	}
+
+	return &x509GRPC.OCSPResponse{Response: response}, status.Error(codes.OK, "")
+}
@@ -9,13 +9,19 @@ import (
 
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	x509GRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/x509"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	nativeNamespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/config"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/workload"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/crypto/ocsp"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type X509IdentificationServer struct {
@@ -24,22 +30,50 @@ type X509IdentificationServer struct {
 	nativeStub *native.NativeStub
 	systemStub *system.SystemStub
 
-	signer *x509Signer
+	signer         *x509Signer
+	configServer   *config.IAMConfigServer
+	identityServer nativeIAmIdentityGRPC.IAMIdentityServiceServer
 }
 
-func NewX509IdentificationService(ctx context.Context, systemStub *system.SystemStub, nativeStub *native.NativeStub) (*X509IdentificationServer, error) {
+func NewX509IdentificationService(ctx context.Context, systemStub *system.SystemStub, nativeStub *native.NativeStub, configServer *config.IAMConfigServer, identityServer nativeIAmIdentityGRPC.IAMIdentityServiceServer) (*X509IdentificationServer, error) {
 	err := EnsureIndexesForNamespace(ctx, "", systemStub)
 	if err != nil {
 		return nil, errors.New("failed to ensure indexes for global namespace: " + err.Error())
 	}
+	err = EnsureRevocationIndexes(ctx, systemStub)
+	if err != nil {
+		return nil, errors.New("failed to ensure indexes for revoked certificates: " + err.Error())
+	}
+	err = EnsureNamespaceCAIndexes(ctx, systemStub)
+	if err != nil {
+		return nil, errors.New("failed to ensure indexes for namespace CAs: " + err.Error())
+	}
 
 	return &X509IdentificationServer{
-		systemStub: systemStub,
-		nativeStub: nativeStub,
-		signer:     newX509Signer(systemStub),
+		systemStub:     systemStub,
+		nativeStub:     nativeStub,
+		signer:         newX509Signer(systemStub),
+		configServer:   configServer,
+		identityServer: identityServer,
 	}, nil
 }
 
+// Returns name of the service if identity is the service identity. Certificates of the service identities are used for mTLS between services.
+func (s *X509IdentificationServer) getIdentityService(ctx context.Context, namespace string, identity string) (string, error) {
+	response, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{Namespace: namespace, Uuid: identity, UseCache: true})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && (st.Code() == codes.NotFound || st.Code() == codes.InvalidArgument) {
+			return "", nil
+		}
+		return "", status.Error(codes.Internal, "Failed to get identity of the certificate: "+err.Error())
+	}
+	service, _, err := workload.ServiceOfIdentity(ctx, s.identityServer, namespace, response.Identity, true)
+	if err != nil {
+		return "", status.Error(codes.Internal, "Failed to check service of the certificate identity: "+err.Error())
+	}
+	return service, nil
+}
+
 func x509CollectionByNamespace(stub *system.SystemStub, namespace string) *mongo.Collection {
 	if namespace == "" {
 		return stub.DB.Database("openbp_global").Collection("native_iam_authentication_x509")
@@ -64,6 +98,48 @@ func (s *X509IdentificationServer) GetRootCAInfo(ctx context.Context, in *x509GR
 	}, status.Error(codes.OK, "")
 }
 
+// Gets intermediate CA of the namespace and creates it if necessary. Signer must be ready. Returns GRPC status error.
+func (s *X509IdentificationServer) getNamespaceCAForSigning(ctx context.Context, namespace string) (*namespaceCA, error) {
+	if namespace != "" {
+		namespaceExistResponse, err := s.nativeStub.Services.Namespace.Exists(ctx, &nativeNamespaceGRPC.IsNamespaceExistRequest{Name: namespace, UseCache: true})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to check if namespace exist: "+err.Error())
+		}
+		if !namespaceExistResponse.Exist {
+			return nil, status.Error(codes.NotFound, "Namespace doesnt exist")
+		}
+	}
+
+	ca, err := s.signer.GetNamespaceCA(ctx, namespace, true)
+	if err != nil {
+		if errors.Is(err, ErrX509SignerVaultSealed) {
+			return nil, status.Error(codes.FailedPrecondition, "The vault is sealed")
+		}
+		return nil, status.Error(codes.Internal, "failed to get intermediate CA of the namespace: "+err.Error())
+	}
+	return ca, nil
+}
+
+func (s *X509IdentificationServer) GetNamespaceCAChain(ctx context.Context, in *x509GRPC.GetNamespaceCAChainRequest) (*x509GRPC.GetNamespaceCAChainResponse, error) {
+	err := s.signer.EnsureReady(ctx)
+	if err != nil {
+		if err == ErrX509SignerVaultSealed {
+			return nil, status.Error(codes.FailedPrecondition, "The vault is sealed")
+		}
+
+		return nil, status.Error(codes.Internal, "cant prepare X509 certificate signer: "+err.Error())
+	}
+
+	ca, err := s.getNamespaceCAForSigning(ctx, in.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &x509GRPC.GetNamespaceCAChainResponse{
+		Chain: [][]byte{ca.certificate.Raw, s.signer.ca.Raw},
+	}, status.Error(codes.OK, "")
+}
+
 func (s *X509IdentificationServer) RegisterAndGenerate(ctx context.Context, in *x509GRPC.RegisterAndGenerateRequest) (*x509GRPC.RegisterAndGenerateResponse, error) {
 	_, err := x509.ParsePKCS1PublicKey(in.PublicKey)
 	if err != nil {
@@ -84,8 +160,24 @@ func (s *X509IdentificationServer) RegisterAndGenerate(ctx context.Context, in *
 		return nil, status.Error(codes.NotFound, "Identity doesnt exist. Invalid identity UUID")
 	}
 
+	ca, err := s.getNamespaceCAForSigning(ctx, in.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	x509Config, err := s.configServer.GetX509Config(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to get x509 configuration: "+err.Error())
+	}
+
+	service, err := s.getIdentityService(ctx, in.Namespace, in.Identity)
+	if err != nil {
+		return nil, err
+	}
+
 	creationTime := time.Now().UTC()
 	certificateInfo := &X509InMongo{
+		UUID:        primitive.NewObjectID(),
 		Identity:    identityUUID,
 		Disabled:    false,
 		Description: in.Description,
@@ -94,20 +186,23 @@ func (s *X509IdentificationServer) RegisterAndGenerate(ctx context.Context, in *
 		Updated:     creationTime,
 		Version:     0,
 	}
-	insertResponse, err := x509CollectionByNamespace(s.systemStub, in.Namespace).InsertOne(ctx, certificateInfo)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to insert new x509 certificate to the database: "+err.Error())
-	}
-	certificateInfo.UUID = insertResponse.InsertedID.(primitive.ObjectID)
 
-	signedX509, err := certificateInfo.ToSignedX509(ctx, in.Namespace, s.signer)
+	// UUID is the serial number of the certificate, so it must be known before signing
+	signedX509, expiresAt, err := certificateInfo.ToSignedX509(in.Namespace, service, ca.certificate, s.signer.GetNamespaceContextedSigner(ctx, ca), x509Config)
 	if err != nil {
-		if err == ErrX509SignerVaultSealed {
+		if errors.Is(err, ErrX509SignerVaultSealed) {
 			return nil, status.Error(codes.FailedPrecondition, "The vault is sealed")
 		}
 
 		return nil, status.Error(codes.Internal, "error while signing x509 certificate: "+err.Error())
 	}
+	certificateInfo.Certificate = signedX509
+	certificateInfo.ExpiresAt = &expiresAt
+
+	_, err = x509CollectionByNamespace(s.systemStub, in.Namespace).InsertOne(ctx, certificateInfo)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to insert new x509 certificate to the database: "+err.Error())
+	}
 
 	return &x509GRPC.RegisterAndGenerateResponse{
 		Raw:  signedX509,
@@ -148,17 +243,58 @@ func (s *X509IdentificationServer) Regenerate(ctx context.Context, in *x509GRPC.
 		return nil, status.Error(codes.PermissionDenied, "cannot sign certificate which is disabled")
 	}
 
-	signedX509, err := foundedCertificate.ToSignedX509(ctx, in.Namespace, s.signer)
+	x509Config, err := s.configServer.GetX509Config(ctx)
 	if err != nil {
-		if err == ErrX509SignerVaultSealed {
+		return nil, status.Error(codes.Internal, "Failed to get x509 configuration: "+err.Error())
+	}
+
+	// Current certificate is returned as is until it expires soon
+	renewBefore := time.Duration(x509Config.RenewBefore) * time.Hour * 24
+	if !in.Force && foundedCertificate.Certificate != nil && foundedCertificate.ExpiresAt != nil && time.Until(*foundedCertificate.ExpiresAt) > renewBefore {
+		return &x509GRPC.RegenerateResponse{
+			Certificate: foundedCertificate.Certificate,
+			Renewed:     false,
+			ExpiresAt:   timestamppb.New(*foundedCertificate.ExpiresAt),
+		}, status.Error(codes.OK, "")
+	}
+
+	// Certificates issued by the root CA before namespace CAs were introduced are moved to the namespace CA here
+	ca, err := s.getNamespaceCAForSigning(ctx, in.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := s.getIdentityService(ctx, in.Namespace, foundedCertificate.Identity.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	signedX509, expiresAt, err := foundedCertificate.ToSignedX509(in.Namespace, service, ca.certificate, s.signer.GetNamespaceContextedSigner(ctx, ca), x509Config)
+	if err != nil {
+		if errors.Is(err, ErrX509SignerVaultSealed) {
 			return nil, status.Error(codes.FailedPrecondition, "The vault is sealed")
 		}
 
 		return nil, status.Error(codes.Internal, "error while signing x509 certificate: "+err.Error())
 	}
 
+	_, err = x509CollectionByNamespace(s.systemStub, in.Namespace).UpdateOne(
+		ctx,
+		bson.M{"_id": uuid},
+		bson.M{
+			"$set":         bson.M{"certificate": signedX509, "expiresAt": expiresAt},
+			"$inc":         bson.M{"version": 1},
+			"$currentDate": bson.M{"updated": bson.M{"$type": "timestamp"}},
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error while saving regenerated certificate to the database: "+err.Error())
+	}
+
 	return &x509GRPC.RegenerateResponse{
 		Certificate: signedX509,
+		Renewed:     true,
+		ExpiresAt:   timestamppb.New(expiresAt),
 	}, status.Error(codes.OK, "")
 }
 func (s *X509IdentificationServer) ValidateAndGetFromRawX509(ctx context.Context, in *x509GRPC.ValidateAndGetFromRawX509Request) (*x509GRPC.ValidateAndGetFromRawX509Response, error) {
@@ -178,25 +314,54 @@ func (s *X509IdentificationServer) ValidateAndGetFromRawX509(ctx context.Context
 		}, status.Error(codes.OK, "invalid format: "+err.Error())
 	}
 
+	if len(cert.Subject.Organization) == 0 {
+		return &x509GRPC.ValidateAndGetFromRawX509Response{
+			Status:      x509GRPC.ValidateAndGetFromRawX509Response_INVALID_FORMAT,
+			Certificate: nil,
+		}, status.Error(codes.OK, "invalid format: there is no subject organization in the certificate")
+	}
+	namespace := cert.Subject.Organization[0]
+
+	if in.VerifyNamespace && namespace != in.Namespace {
+		return &x509GRPC.ValidateAndGetFromRawX509Response{
+			Status:      x509GRPC.ValidateAndGetFromRawX509Response_NAMESPACE_MISMATCH,
+			Certificate: nil,
+		}, status.Error(codes.OK, "certificate was issued in other namespace")
+	}
+
+	// Only intermediate CA of the namespace from the certificate is trusted, so certificate cant be signed by CA of the other namespace.
+	// Certificates issued before namespace CAs were introduced are signed directly by root CA.
 	roots := x509.NewCertPool()
 	roots.AddCert(s.signer.ca)
+	intermediates := x509.NewCertPool()
+	ca, err := s.signer.GetNamespaceCA(ctx, namespace, false)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get intermediate CA of the namespace: "+err.Error())
+	}
+	if ca != nil {
+		intermediates.AddCert(ca.certificate)
+	}
 
-	_, err = cert.Verify(x509.VerifyOptions{Roots: roots})
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
 	if err != nil {
+		var invalidErr x509.CertificateInvalidError
+		if errors.As(err, &invalidErr) && invalidErr.Reason == x509.Expired {
+			return &x509GRPC.ValidateAndGetFromRawX509Response{
+				Status:      x509GRPC.ValidateAndGetFromRawX509Response_EXPIRED,
+				Certificate: nil,
+			}, status.Error(codes.OK, "certificate expired or is not valid yet")
+		}
+
 		return &x509GRPC.ValidateAndGetFromRawX509Response{
 			Status:      x509GRPC.ValidateAndGetFromRawX509Response_SIGNATURE_INVALID,
 			Certificate: nil,
 		}, status.Error(codes.OK, "invalid signature: "+err.Error())
 	}
 
-	if len(cert.Subject.Organization) == 0 {
-		return &x509GRPC.ValidateAndGetFromRawX509Response{
-			Status:      x509GRPC.ValidateAndGetFromRawX509Response_INVALID_FORMAT,
-			Certificate: nil,
-		}, status.Error(codes.OK, "invalid format: there is no subject organization in the certificate"+err.Error())
-	}
-	namespace := cert.Subject.Organization[0]
-
 	uuid, err := primitive.ObjectIDFromHex(fmt.Sprintf("%x", cert.SerialNumber))
 	if err != nil {
 		return &x509GRPC.ValidateAndGetFromRawX509Response{
@@ -380,6 +545,53 @@ func (s *X509IdentificationServer) ListForIdentity(in *x509GRPC.ListForIdentityR
 
 	return status.Error(codes.OK, "")
 }
+func (s *X509IdentificationServer) ListExpiring(in *x509GRPC.ListExpiringRequest, out x509GRPC.IAMAuthenticationX509Service_ListExpiringServer) error {
+	ctx := out.Context()
+
+	within := time.Duration(in.Within) * time.Second
+	if in.Within == 0 {
+		x509Config, err := s.configServer.GetX509Config(ctx)
+		if err != nil {
+			return status.Error(codes.Internal, "Failed to get x509 configuration: "+err.Error())
+		}
+		within = time.Duration(x509Config.RenewBefore) * time.Hour * 24
+	}
+
+	now := time.Now().UTC()
+	expiresAtFilter := bson.M{"$lte": now.Add(within)}
+	if !in.IncludeExpired {
+		expiresAtFilter["$gt"] = now
+	}
+
+	collection := x509CollectionByNamespace(s.systemStub, in.Namespace)
+	cur, err := collection.Find(ctx, bson.M{"disabled": false, "expiresAt": expiresAtFilter}, options.Find().SetSort(bson.D{{Key: "expiresAt", Value: 1}}))
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return status.Error(codes.OK, "Namespace doesnt exist")
+			}
+		}
+
+		return status.Error(codes.Internal, "error while searching for expiring certificates in the database: "+err.Error())
+	}
+	defer cur.Close(context.Background())
+
+	for cur.Next(ctx) {
+		var cert X509InMongo
+		if err := cur.Decode(&cert); err != nil {
+			return status.Error(codes.Internal, "error while searching for expiring certificates in the database: error decoding certificate from the stream: "+err.Error())
+		}
+
+		err = out.Send(&x509GRPC.ListExpiringResponse{
+			Certificate: cert.ToGRPCCertificate(in.Namespace),
+		})
+		if err != nil {
+			return status.Error(codes.Internal, "error while sending certificate: "+err.Error())
+		}
+	}
+
+	return status.Error(codes.OK, "")
+}
 func (s *X509IdentificationServer) Update(ctx context.Context, in *x509GRPC.UpdateRequest) (*x509GRPC.UpdateResponse, error) {
 	uuid, err := primitive.ObjectIDFromHex(in.Uuid)
 	if err != nil {
@@ -419,8 +631,13 @@ func (s *X509IdentificationServer) Delete(ctx context.Context, in *x509GRPC.Dele
 		return &x509GRPC.DeleteResponse{Existed: false}, status.Error(codes.OK, "Invalid certificate UUID format.")
 	}
 
-	deleteResponse, err := x509CollectionByNamespace(s.systemStub, in.Namespace).DeleteOne(ctx, bson.M{"_id": uuid})
+	var deletedCertificate X509InMongo
+	err = x509CollectionByNamespace(s.systemStub, in.Namespace).FindOneAndDelete(ctx, bson.M{"_id": uuid}).Decode(&deletedCertificate)
 	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return &x509GRPC.DeleteResponse{Existed: false}, status.Error(codes.OK, "")
+		}
+
 		if err, ok := err.(mongo.WriteException); ok {
 			if err.HasErrorLabel("InvalidNamespace") {
 				return &x509GRPC.DeleteResponse{Existed: false}, status.Error(codes.OK, "Certificate namespace doesnt exist.")
@@ -430,7 +647,12 @@ func (s *X509IdentificationServer) Delete(ctx context.Context, in *x509GRPC.Dele
 		return nil, status.Error(codes.Internal, "error while deleting certificate from the database: "+err.Error())
 	}
 
-	return &x509GRPC.DeleteResponse{Existed: deleteResponse.DeletedCount > 0}, status.Error(codes.OK, "")
+	// Issued X509 certificates still have valid signature
+	if err := s.registerRevocation(ctx, in.Namespace, &deletedCertificate, ocsp.CessationOfOperation); err != nil {
+		return nil, status.Error(codes.Internal, "certificate was deleted, but failed to add it to the revocation list: "+err.Error())
+	}
+
+	return &x509GRPC.DeleteResponse{Existed: true}, status.Error(codes.OK, "")
 }
 func (s *X509IdentificationServer) Disable(ctx context.Context, in *x509GRPC.DisableRequest) (*x509GRPC.DisableResponse, error) {
 	uuid, err := primitive.ObjectIDFromHex(in.Uuid)
@@ -463,5 +685,9 @@ func (s *X509IdentificationServer) Disable(ctx context.Context, in *x509GRPC.Dis
 		return nil, status.Error(codes.Internal, "error while updating certificate in the database: "+err.Error())
 	}
 
+	if err := s.registerRevocation(ctx, in.Namespace, &updatedCertificate, ocsp.Unspecified); err != nil {
+		return nil, status.Error(codes.Internal, "certificate was disabled, but failed to add it to the revocation list: "+err.Error())
+	}
+
 	return &x509GRPC.DisableResponse{WasActive: !updatedCertificate.Disabled}, status.Error(codes.OK, "")
 }
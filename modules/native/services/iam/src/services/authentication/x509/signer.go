@@ -30,11 +30,19 @@ type x509Signer struct {
 	ca        *x509.Certificate
 	mut       *sync.RWMutex
 
+	// Intermediate CAs of the namespaces loaded from the database
+	namespaceCAs   map[string]*namespaceCA
+	namespaceCAMut *sync.RWMutex
+
 	//Public() PublicKey
 	//Sign(rand io.Reader, digest []byte, opts SignerOpts) (signature []byte, err error)
 }
 type x509ContextedSigner struct {
-	x509Signer
+	systemStub *system.SystemStub
+
+	// Name of the key pair in the system_vault used for signing
+	keyName   string
+	publicKey *rsa.PublicKey
 
 	ctx context.Context
 }
@@ -47,6 +55,9 @@ func newX509Signer(systemStub *system.SystemStub) *x509Signer {
 		publicKey:  nil,
 		ca:         nil,
 		mut:        &sync.RWMutex{},
+
+		namespaceCAs:   map[string]*namespaceCA{},
+		namespaceCAMut: &sync.RWMutex{},
 	}
 }
 
@@ -121,7 +132,7 @@ func (s *x509Signer) EnsureReady(ctx context.Context) error {
 			NotAfter:              time.Now().AddDate(30, 0, 0),
 			IsCA:                  true,
 			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
-			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 			BasicConstraintsValid: true,
 		}
 
@@ -166,18 +177,58 @@ func (s *x509Signer) EnsureReady(ctx context.Context) error {
 		s.ca = loadedCA
 	}
 
+	// CA certificates created by the previous versions are not allowed to sign CRLs
+	if s.ca.KeyUsage&x509.KeyUsageCRLSign == 0 {
+		if err := s.reissueCAWithCRLSign(ctx); err != nil {
+			s.publicKey = nil
+			s.ca = nil
+			return errors.New("failed to reissue CA certificate with CRL signing usage: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Reissues CA certificate with the same key and subject, but with the permission to sign CRLs.
+// Certificates signed by the previous CA certificate stay valid, because they are verified using the same name and key.
+func (s *x509Signer) reissueCAWithCRLSign(ctx context.Context) error {
+	ca := &x509.Certificate{
+		SerialNumber:          new(big.Int).Add(s.ca.SerialNumber, big.NewInt(1)),
+		Subject:               s.ca.Subject,
+		NotBefore:             s.ca.NotBefore,
+		NotAfter:              s.ca.NotAfter,
+		IsCA:                  true,
+		ExtKeyUsage:           s.ca.ExtKeyUsage,
+		KeyUsage:              s.ca.KeyUsage | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		SubjectKeyId:          s.ca.SubjectKeyId,
+	}
+
+	caBytes, err := x509.CreateCertificate(rand.Reader, ca, ca, s.publicKey, s.GetContextedSigner(ctx))
+	if err != nil {
+		return errors.New("failed to sign certificate: " + err.Error())
+	}
+	newCA, err := x509.ParseCertificate(caBytes)
+	if err != nil {
+		return errors.New("failed to parse reissued certificate: " + err.Error())
+	}
+
+	// Other instances may reissue CA at the same time. All the reissued certificates are equivalent, so the last write wins.
+	if err := s.systemStub.Redis.Set(ctx, x509_root_ca_redis_key, caBytes, 0).Err(); err != nil {
+		return errors.New("failed to update certificate in redis: " + err.Error())
+	}
+
+	s.ca = newCA
 	return nil
 }
 
+// Returns signer that uses private key of the root CA
 func (s *x509Signer) GetContextedSigner(ctx context.Context) *x509ContextedSigner {
 	return &x509ContextedSigner{
-		x509Signer: x509Signer{
-			publicKey:  s.publicKey,
-			systemStub: s.systemStub,
-			ca:         s.ca,
-			mut:        s.mut,
-		},
-		ctx: ctx,
+		systemStub: s.systemStub,
+		keyName:    x509_root_ca_key_name,
+		publicKey:  s.publicKey,
+		ctx:        ctx,
 	}
 }
 
@@ -217,7 +268,7 @@ func (s *x509ContextedSigner) Sign(rand io.Reader, digest []byte, opts crypto.Si
 	copy(em[len(rsaSignerPrefix):], digest)
 
 	signResult, err := s.systemStub.Vault.RSASign(s.ctx, &vault.RSASignRequest{
-		KeyName:   x509_root_ca_key_name,
+		KeyName:   s.keyName,
 		Data:      em,
 		Mechanism: vault.RSASignMechanism_RSA_PKCS,
 	})
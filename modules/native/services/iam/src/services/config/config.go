@@ -0,0 +1,231 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	nativeIAmConfigGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/config"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
+)
+
+const (
+	CONFIG_CACHE_TIMEOUT = time.Second * 60
+	CONFIG_CACHE_KEY     = "native_iam_config"
+	// Configuration is stored as a single document in the global database
+	CONFIG_DOCUMENT_ID = "iam"
+)
+
+type IAMConfigServer struct {
+	nativeIAmConfigGRPC.UnimplementedIAMConfigServiceServer
+
+	systemStub *system.SystemStub
+
+	collection *mongo.Collection
+}
+
+func NewIAMConfigServer(systemStub *system.SystemStub) *IAMConfigServer {
+	return &IAMConfigServer{
+		systemStub: systemStub,
+		collection: systemStub.DB.Database("openbp_global").Collection("native_iam_config"),
+	}
+}
+
+// Loads configuration with encrypted secrets
+func (s *IAMConfigServer) loadConfig(ctx context.Context, useCache bool) (*configInMongo, error) {
+	if useCache {
+		byteData, _ := s.systemStub.Cache.Get(ctx, CONFIG_CACHE_KEY)
+		if byteData != nil {
+			var config configInMongo
+			if err := bson.Unmarshal(byteData, &config); err != nil {
+				return nil, status.Error(codes.Internal, "Error while unmarshaling configuration from cache: "+err.Error())
+			}
+			return &config, nil
+		}
+	}
+
+	var config configInMongo
+	err := s.collection.FindOne(ctx, bson.M{"_id": CONFIG_DOCUMENT_ID}).Decode(&config)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			return nil, status.Error(codes.Internal, "Error while loading configuration from database: "+err.Error())
+		}
+		config = *newDefaultConfig()
+	}
+
+	if useCache {
+		configBytes, err := bson.Marshal(&config)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Error while marshaling configuration to cache: "+err.Error())
+		}
+		s.systemStub.Cache.Set(ctx, CONFIG_CACHE_KEY, configBytes, CONFIG_CACHE_TIMEOUT)
+	}
+
+	return &config, nil
+}
+
+// Returns password authentication configuration. Used by other IAM services to enforce password policy.
+func (s *IAMConfigServer) GetPasswordAuthConfig(ctx context.Context) (*nativeIAmConfigGRPC.Configuration_PasswordAuth, error) {
+	config, err := s.loadConfig(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return config.PasswordAuth.ToGRPC(), nil
+}
+
+// Returns brute-force protection configuration. Zero values are replaced with defaults.
+func (s *IAMConfigServer) GetBruteForceProtectionConfig(ctx context.Context) (*nativeIAmConfigGRPC.Configuration_BruteForceProtection, error) {
+	config, err := s.loadConfig(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	c := config.BruteForceProtection.ToGRPC()
+	if c.MaxIdentityFailedAttempts == 0 {
+		c.MaxIdentityFailedAttempts = DEFAULT_MAX_IDENTITY_FAILED_ATTEMPTS
+	}
+	if c.MaxSourceFailedAttempts == 0 {
+		c.MaxSourceFailedAttempts = DEFAULT_MAX_SOURCE_FAILED_ATTEMPTS
+	}
+	if c.FailedAttemptsWindow == 0 {
+		c.FailedAttemptsWindow = DEFAULT_FAILED_ATTEMPTS_WINDOW
+	}
+	if c.LockoutDuration == 0 {
+		c.LockoutDuration = DEFAULT_LOCKOUT_DURATION
+	}
+	if c.MaxDelay == 0 {
+		c.MaxDelay = DEFAULT_MAX_DELAY
+	}
+	return c, nil
+}
+
+func (s *IAMConfigServer) oauth2ConfigFromGRPC(ctx context.Context, c *nativeIAmConfigGRPC.Configuration_OAuth2) (oauth2ConfigInMongo, error) {
+	if c == nil {
+		return oauth2ConfigInMongo{}, nil
+	}
+
+	config := oauth2ConfigInMongo{
+		Enabled:           c.Enabled,
+		ClientID:          c.ClientId,
+		AllowRegistration: c.AllowRegistration,
+	}
+	if c.ClientSecret != "" {
+		response, err := s.systemStub.Vault.Encrypt(ctx, &vault.EncryptRequest{PlainData: []byte(c.ClientSecret)})
+		if err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+				return config, status.Error(codes.FailedPrecondition, "failed to encrypt client secret: vault is sealed: "+err.Error())
+			}
+			return config, status.Error(codes.Internal, "failed to encrypt client secret: "+err.Error())
+		}
+		config.ClientSecret = response.EncryptedData
+	}
+	return config, nil
+}
+
+func (s *IAMConfigServer) oauth2ConfigToGRPC(ctx context.Context, c *oauth2ConfigInMongo) (*nativeIAmConfigGRPC.Configuration_OAuth2, error) {
+	config := &nativeIAmConfigGRPC.Configuration_OAuth2{
+		Enabled:           c.Enabled,
+		ClientId:          c.ClientID,
+		AllowRegistration: c.AllowRegistration,
+	}
+	if len(c.ClientSecret) != 0 {
+		response, err := s.systemStub.Vault.Decrypt(ctx, &vault.DecryptRequest{EncryptedData: c.ClientSecret})
+		if err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+				return nil, status.Error(codes.FailedPrecondition, "failed to decrypt client secret: vault is sealed: "+err.Error())
+			}
+			return nil, status.Error(codes.Internal, "failed to decrypt client secret: "+err.Error())
+		}
+		config.ClientSecret = string(response.PlainData)
+	}
+	return config, nil
+}
+
+// Returns X509 certificates configuration. Zero values are replaced with defaults.
+func (s *IAMConfigServer) GetX509Config(ctx context.Context) (*nativeIAmConfigGRPC.Configuration_X509, error) {
+	config, err := s.loadConfig(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	c := config.X509.ToGRPC()
+	if c.CertificateLifetime == 0 {
+		c.CertificateLifetime = DEFAULT_X509_CERTIFICATE_LIFETIME
+	}
+	if c.RenewBefore == 0 {
+		c.RenewBefore = DEFAULT_X509_RENEW_BEFORE
+	}
+	return c, nil
+}
+
+func (s *IAMConfigServer) Get(ctx context.Context, in *nativeIAmConfigGRPC.GetConfigRequest) (*nativeIAmConfigGRPC.GetConfigresponse, error) {
+	config, err := s.loadConfig(ctx, in.UseCache)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcConfig := &nativeIAmConfigGRPC.Configuration{
+		AccessTokenTTL:  config.AccessTokenTTL,
+		RefreshTokenTTL: config.RefreshTokenTTL,
+		PasswordAuth:    config.PasswordAuth.ToGRPC(),
+
+		BruteForceProtection: config.BruteForceProtection.ToGRPC(),
+		X509:                 config.X509.ToGRPC(),
+	}
+	if grpcConfig.GoogleOAuth2, err = s.oauth2ConfigToGRPC(ctx, &config.GoogleOAuth2); err != nil {
+		return nil, err
+	}
+	if grpcConfig.FacebookOAuth2, err = s.oauth2ConfigToGRPC(ctx, &config.FacebookOAuth2); err != nil {
+		return nil, err
+	}
+	if grpcConfig.GithubOAuth2, err = s.oauth2ConfigToGRPC(ctx, &config.GithubOAuth2); err != nil {
+		return nil, err
+	}
+	if grpcConfig.GitlabOAuth2, err = s.oauth2ConfigToGRPC(ctx, &config.GitlabOAuth2); err != nil {
+		return nil, err
+	}
+
+	return &nativeIAmConfigGRPC.GetConfigresponse{Configuration: grpcConfig}, status.Error(codes.OK, "")
+}
+
+func (s *IAMConfigServer) Set(ctx context.Context, in *nativeIAmConfigGRPC.SetConfigRequest) (*nativeIAmConfigGRPC.SetConfigResponse, error) {
+	if in.Configuration == nil {
+		return nil, status.Error(codes.InvalidArgument, "Configuration must be provided")
+	}
+
+	config := configInMongo{
+		AccessTokenTTL:  in.Configuration.AccessTokenTTL,
+		RefreshTokenTTL: in.Configuration.RefreshTokenTTL,
+		PasswordAuth:    passwordAuthConfigFromGRPC(in.Configuration.PasswordAuth),
+
+		BruteForceProtection: bruteForceProtectionConfigFromGRPC(in.Configuration.BruteForceProtection),
+		X509:                 x509ConfigFromGRPC(in.Configuration.X509),
+	}
+	var err error
+	if config.GoogleOAuth2, err = s.oauth2ConfigFromGRPC(ctx, in.Configuration.GoogleOAuth2); err != nil {
+		return nil, err
+	}
+	if config.FacebookOAuth2, err = s.oauth2ConfigFromGRPC(ctx, in.Configuration.FacebookOAuth2); err != nil {
+		return nil, err
+	}
+	if config.GithubOAuth2, err = s.oauth2ConfigFromGRPC(ctx, in.Configuration.GithubOAuth2); err != nil {
+		return nil, err
+	}
+	if config.GitlabOAuth2, err = s.oauth2ConfigFromGRPC(ctx, in.Configuration.GitlabOAuth2); err != nil {
+		return nil, err
+	}
+
+	_, err = s.collection.ReplaceOne(ctx, bson.M{"_id": CONFIG_DOCUMENT_ID}, &config, options.Replace().SetUpsert(true))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error while saving configuration to database: "+err.Error())
+	}
+	s.systemStub.Cache.Remove(ctx, CONFIG_CACHE_KEY)
+
+	return &nativeIAmConfigGRPC.SetConfigResponse{}, status.Error(codes.OK, "")
+}
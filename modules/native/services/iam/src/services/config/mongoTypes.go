@@ -0,0 +1,159 @@
+package config
+
+import (
+	nativeIAmConfigGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/config"
+)
+
+// Default minimal length of the password if it is not configured
+const DEFAULT_MIN_PASSWORD_LENGTH = 8
+
+// Default values of the brute-force protection if they are not configured
+const (
+	DEFAULT_MAX_IDENTITY_FAILED_ATTEMPTS = 5
+	DEFAULT_MAX_SOURCE_FAILED_ATTEMPTS   = 20
+	DEFAULT_FAILED_ATTEMPTS_WINDOW       = 900
+	DEFAULT_LOCKOUT_DURATION             = 900
+	DEFAULT_MAX_DELAY                    = 30
+)
+
+// Default values of the X509 certificates configuration if they are not configured
+const (
+	DEFAULT_X509_CERTIFICATE_LIFETIME = 365
+	DEFAULT_X509_RENEW_BEFORE         = 30
+)
+
+type oauth2ConfigInMongo struct {
+	Enabled           bool   `bson:"enabled"`
+	ClientID          string `bson:"clientId"`
+	ClientSecret      []byte `bson:"clientSecret"`
+	AllowRegistration bool   `bson:"allowRegistration"`
+}
+
+type passwordAuthConfigInMongo struct {
+	Enabled            bool   `bson:"enabled"`
+	AllowRegistration  bool   `bson:"allowRegistration"`
+	MinPasswordLength  uint32 `bson:"minPasswordLength"`
+	Lowercase          bool   `bson:"lowercase"`
+	Uppercase          bool   `bson:"uppercase"`
+	Digits             bool   `bson:"digits"`
+	SpecialCharacters  bool   `bson:"specialCharacters"`
+	PasswordExpiration uint32 `bson:"passwordExpiration"`
+	PasswordHistory    uint32 `bson:"passwordHistory"`
+}
+
+type bruteForceProtectionConfigInMongo struct {
+	Disabled                  bool   `bson:"disabled"`
+	MaxIdentityFailedAttempts uint32 `bson:"maxIdentityFailedAttempts"`
+	MaxSourceFailedAttempts   uint32 `bson:"maxSourceFailedAttempts"`
+	FailedAttemptsWindow      uint32 `bson:"failedAttemptsWindow"`
+	LockoutDuration           uint32 `bson:"lockoutDuration"`
+	MaxDelay                  uint32 `bson:"maxDelay"`
+}
+
+type x509ConfigInMongo struct {
+	CertificateLifetime  uint32 `bson:"certificateLifetime"`
+	RenewBefore          uint32 `bson:"renewBefore"`
+	CRLDistributionPoint string `bson:"crlDistributionPoint"`
+	OCSPServer           string `bson:"ocspServer"`
+}
+
+type configInMongo struct {
+	AccessTokenTTL  uint32 `bson:"accessTokenTTL"`
+	RefreshTokenTTL uint32 `bson:"refreshTokenTTL"`
+
+	PasswordAuth   passwordAuthConfigInMongo `bson:"passwordAuth"`
+	GoogleOAuth2   oauth2ConfigInMongo       `bson:"googleOAuth2"`
+	FacebookOAuth2 oauth2ConfigInMongo       `bson:"facebookOAuth2"`
+	GithubOAuth2   oauth2ConfigInMongo       `bson:"githubOAuth2"`
+	GitlabOAuth2   oauth2ConfigInMongo       `bson:"gitlabOAuth2"`
+
+	BruteForceProtection bruteForceProtectionConfigInMongo `bson:"bruteForceProtection"`
+	X509                 x509ConfigInMongo                 `bson:"x509"`
+}
+
+// Configuration that is used when it was never set
+func newDefaultConfig() *configInMongo {
+	return &configInMongo{
+		PasswordAuth: passwordAuthConfigInMongo{
+			Enabled:           true,
+			MinPasswordLength: DEFAULT_MIN_PASSWORD_LENGTH,
+		},
+	}
+}
+
+func passwordAuthConfigFromGRPC(c *nativeIAmConfigGRPC.Configuration_PasswordAuth) passwordAuthConfigInMongo {
+	if c == nil {
+		return passwordAuthConfigInMongo{}
+	}
+	return passwordAuthConfigInMongo{
+		Enabled:            c.Enabled,
+		AllowRegistration:  c.AllowRegistration,
+		MinPasswordLength:  c.MinPasswordLength,
+		Lowercase:          c.Lowercase,
+		Uppercase:          c.Uppercase,
+		Digits:             c.Digits,
+		SpecialCharacters:  c.SpecialCharacters,
+		PasswordExpiration: c.PasswordExpiration,
+		PasswordHistory:    c.PasswordHistory,
+	}
+}
+
+func (c *passwordAuthConfigInMongo) ToGRPC() *nativeIAmConfigGRPC.Configuration_PasswordAuth {
+	return &nativeIAmConfigGRPC.Configuration_PasswordAuth{
+		Enabled:            c.Enabled,
+		AllowRegistration:  c.AllowRegistration,
+		MinPasswordLength:  c.MinPasswordLength,
+		Lowercase:          c.Lowercase,
+		Uppercase:          c.Uppercase,
+		Digits:             c.Digits,
+		SpecialCharacters:  c.SpecialCharacters,
+		PasswordExpiration: c.PasswordExpiration,
+		PasswordHistory:    c.PasswordHistory,
+	}
+}
+
+func bruteForceProtectionConfigFromGRPC(c *nativeIAmConfigGRPC.Configuration_BruteForceProtection) bruteForceProtectionConfigInMongo {
+	if c == nil {
+		return bruteForceProtectionConfigInMongo{}
+	}
+	return bruteForceProtectionConfigInMongo{
+		Disabled:                  c.Disabled,
+		MaxIdentityFailedAttempts: c.MaxIdentityFailedAttempts,
+		MaxSourceFailedAttempts:   c.MaxSourceFailedAttempts,
+		FailedAttemptsWindow:      c.FailedAttemptsWindow,
+		LockoutDuration:           c.LockoutDuration,
+		MaxDelay:                  c.MaxDelay,
+	}
+}
+
+func (c *bruteForceProtectionConfigInMongo) ToGRPC() *nativeIAmConfigGRPC.Configuration_BruteForceProtection {
+	return &nativeIAmConfigGRPC.Configuration_BruteForceProtection{
+		Disabled:                  c.Disabled,
+		MaxIdentityFailedAttempts: c.MaxIdentityFailedAttempts,
+		MaxSourceFailedAttempts:   c.MaxSourceFailedAttempts,
+		FailedAttemptsWindow:      c.FailedAttemptsWindow,
+		LockoutDuration:           c.LockoutDuration,
+		MaxDelay:                  c.MaxDelay,
+	}
+}
+
+func x509ConfigFromGRPC(c *nativeIAmConfigGRPC.Configuration_X509) x509ConfigInMongo {
+	if c == nil {
+		return x509ConfigInMongo{}
+	}
+	return x509ConfigInMongo{
+		CertificateLifetime:  c.CertificateLifetime,
+		RenewBefore:          c.RenewBefore,
+		CRLDistributionPoint: c.CrlDistributionPoint,
+		OCSPServer:           c.OcspServer,
+	}
+}
+
+func (c *x509ConfigInMongo) ToGRPC() *nativeIAmConfigGRPC.Configuration_X509 {
+	return &nativeIAmConfigGRPC.Configuration_X509{
+		CertificateLifetime:  c.CertificateLifetime,
+		RenewBefore:          c.RenewBefore,
+		CrlDistributionPoint: c.CRLDistributionPoint,
+		OcspServer:           c.OCSPServer,
+	}
+}
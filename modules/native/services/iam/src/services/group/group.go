@@ -0,0 +1,540 @@
+package group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+
+	nativeIAmGroupGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/group"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	nativeIAmRoleGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
+
+	policy_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/policy"
+	role_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/role"
+)
+
+type IAMGroupServer struct {
+	nativeIAmGroupGRPC.UnimplementedIAMGroupServiceServer
+
+	systemStub     *system.SystemStub
+	auditPublisher *audit.Publisher
+
+	// Identity service uses this package to resolve group membership, so the server is referenced by the interface to avoid import cycle
+	identityServer nativeIAmIdentityGRPC.IAMIdentityServiceServer
+	policyServer   *policy_server.IAMPolicyServer
+	roleServer     *role_server.IAMRoleServer
+}
+
+func NewIAMGroupServer(ctx context.Context, systemStub *system.SystemStub, identityServer nativeIAmIdentityGRPC.IAMIdentityServiceServer, policyServer *policy_server.IAMPolicyServer, roleServer *role_server.IAMRoleServer) (*IAMGroupServer, error) {
+	// Ensure indexes for global namespace
+	err := ensureIndexesForNamespace(ctx, "", systemStub)
+	if err != nil {
+		return nil, errors.New("Failed to ensure indexes for global namespace: " + err.Error())
+	}
+
+	auditPublisher, err := audit.NewPublisher(systemStub.Nats, "native_iam")
+	if err != nil {
+		return nil, errors.New("Failed to create audit publisher: " + err.Error())
+	}
+
+	return &IAMGroupServer{
+		systemStub:     systemStub,
+		auditPublisher: auditPublisher,
+		identityServer: identityServer,
+		policyServer:   policyServer,
+		roleServer:     roleServer,
+	}, nil
+}
+
+// Applies update to the group and returns updated group
+func (s *IAMGroupServer) updateGroup(ctx context.Context, namespace string, id primitive.ObjectID, update bson.M) (*groupInMongo, error) {
+	update["$currentDate"] = bson.M{"updated": bson.M{"$type": "timestamp"}}
+	update["$inc"] = bson.M{"version": 1}
+
+	var group groupInMongo
+	err := getCollectionByNamespace(s.systemStub, namespace).FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": id},
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&group)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "Group wasnt founded.")
+		}
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return nil, status.Error(codes.NotFound, "Group wasnt founded. Probably namespace doesnt exist")
+			}
+		}
+		return nil, status.Error(codes.Internal, "Error while updating group in the database. "+err.Error())
+	}
+	return &group, nil
+}
+
+func (s *IAMGroupServer) Create(ctx context.Context, in *nativeIAmGroupGRPC.CreateGroupRequest) (*nativeIAmGroupGRPC.CreateGroupResponse, error) {
+	creationTime := time.Now().UTC()
+	group := &groupInMongo{
+		Name:        in.Name,
+		Description: in.Description,
+		Identities:  []string{},
+		Groups:      []string{},
+		Roles:       []referenceInMongo{},
+		Policies:    []referenceInMongo{},
+		Created:     creationTime,
+		Updated:     creationTime,
+		Version:     0,
+	}
+
+	r, err := getCollectionByNamespace(s.systemStub, in.Namespace).InsertOne(ctx, group)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error while inserting group to the database. "+err.Error())
+	}
+	group.ID = r.InsertedID.(primitive.ObjectID)
+
+	log.Infof("Created new group with UUID [%s] and name [%s] in the [%s] namespace", group.ID.Hex(), in.Name, in.Namespace)
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:    in.Namespace,
+		Resource:     "native.iam.group." + group.ID.Hex(),
+		Action:       "native.iam.group.create",
+		AfterVersion: group.Version,
+	})
+
+	return &nativeIAmGroupGRPC.CreateGroupResponse{Group: group.ToGRPCGroup(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) Get(ctx context.Context, in *nativeIAmGroupGRPC.GetGroupRequest) (*nativeIAmGroupGRPC.GetGroupResponse, error) {
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Group wasnt founded. Group UUID has bad format.")
+	}
+
+	var group groupInMongo
+	err = getCollectionByNamespace(s.systemStub, in.Namespace).FindOne(ctx, bson.M{"_id": id}).Decode(&group)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "Group wasnt founded.")
+		}
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return nil, status.Error(codes.NotFound, "Group wasnt founded. Probably namespace doesnt exist")
+			}
+		}
+		return nil, status.Error(codes.Internal, "Error while searhing for group in database. "+err.Error())
+	}
+
+	return &nativeIAmGroupGRPC.GetGroupResponse{Group: group.ToGRPCGroup(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) List(in *nativeIAmGroupGRPC.ListGroupsRequest, out nativeIAmGroupGRPC.IAMGroupService_ListServer) error {
+	findOptions := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	if in.Skip != 0 {
+		findOptions.SetSkip(int64(in.Skip))
+	}
+	if in.Limit != 0 {
+		findOptions.SetLimit(int64(in.Limit))
+	}
+	cursor, err := getCollectionByNamespace(s.systemStub, in.Namespace).Find(out.Context(), bson.M{}, findOptions)
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return status.Error(codes.OK, "Namespace doesnt exist")
+			}
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer cursor.Close(out.Context())
+
+	for cursor.Next(out.Context()) {
+		var group groupInMongo
+		if err := cursor.Decode(&group); err != nil {
+			return status.Error(codes.Internal, "error while decoding group from mongo:"+err.Error())
+		}
+		if err := out.Send(&nativeIAmGroupGRPC.ListGroupsResponse{Group: group.ToGRPCGroup(in.Namespace)}); err != nil {
+			return status.Error(codes.Internal, "error while sending group:"+err.Error())
+		}
+	}
+
+	return status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) Update(ctx context.Context, in *nativeIAmGroupGRPC.UpdateGroupRequest) (*nativeIAmGroupGRPC.UpdateGroupResponse, error) {
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Group wasnt founded. Group UUID has bad format.")
+	}
+
+	group, err := s.updateGroup(ctx, in.Namespace, id, bson.M{
+		"$set": bson.M{"name": in.NewName, "description": in.NewDescription},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.group." + in.Uuid,
+		Action:        "native.iam.group.update",
+		BeforeVersion: group.Version - 1,
+		AfterVersion:  group.Version,
+	})
+
+	return &nativeIAmGroupGRPC.UpdateGroupResponse{Group: group.ToGRPCGroup(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) Delete(ctx context.Context, in *nativeIAmGroupGRPC.DeleteGroupRequest) (*nativeIAmGroupGRPC.DeleteGroupResponse, error) {
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return &nativeIAmGroupGRPC.DeleteGroupResponse{Existed: false}, status.Error(codes.OK, "Group wasnt founded. Group UUID has bad format")
+	}
+
+	collection := getCollectionByNamespace(s.systemStub, in.Namespace)
+	var deletedGroup groupInMongo
+	err = collection.FindOneAndDelete(ctx, bson.M{"_id": id}).Decode(&deletedGroup)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return &nativeIAmGroupGRPC.DeleteGroupResponse{Existed: false}, status.Error(codes.OK, "")
+		}
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return &nativeIAmGroupGRPC.DeleteGroupResponse{Existed: false}, status.Error(codes.OK, "Group wasnt founded. Probably namespace doesnt exist")
+			}
+		}
+		return nil, status.Error(codes.Internal, "Error while deleting group in database. "+err.Error())
+	}
+
+	// Remove deleted group from the groups where it was nested
+	_, err = collection.UpdateMany(ctx, bson.M{"groups": in.Uuid}, bson.M{
+		"$pull":        bson.M{"groups": in.Uuid},
+		"$currentDate": bson.M{"updated": bson.M{"$type": "timestamp"}},
+		"$inc":         bson.M{"version": 1},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Group was deleted, but failed to remove it from the parent groups. "+err.Error())
+	}
+
+	log.Infof("Deleted group with UUID [%s] from the [%s] namespace", in.Uuid, in.Namespace)
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.group." + in.Uuid,
+		Action:        "native.iam.group.delete",
+		BeforeVersion: deletedGroup.Version,
+	})
+
+	return &nativeIAmGroupGRPC.DeleteGroupResponse{Existed: true}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) AddIdentity(ctx context.Context, in *nativeIAmGroupGRPC.AddIdentityRequest) (*nativeIAmGroupGRPC.AddIdentityResponse, error) {
+	groupID, err := primitive.ObjectIDFromHex(in.GroupUUID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group UUID has bad format.")
+	}
+
+	// Check if identity exists. This is not atomic but helps to avoid some errors
+	existsResponse, err := s.identityServer.Exists(ctx, &nativeIAmIdentityGRPC.ExistsIdentityRequest{
+		Namespace: in.Namespace,
+		Uuid:      in.IdentityUUID,
+		UseCache:  true,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			return nil, status.Error(codes.InvalidArgument, "Identity UUID has bad format.")
+		}
+		return nil, status.Error(codes.Internal, "Failed to check existance of identity: "+err.Error())
+	}
+	if !existsResponse.Exists {
+		return nil, status.Error(codes.FailedPrecondition, "Identity doesnt exist")
+	}
+
+	group, err := s.updateGroup(ctx, in.Namespace, groupID, bson.M{
+		"$addToSet": bson.M{"identities": in.IdentityUUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.group." + in.GroupUUID,
+		Action:        "native.iam.group.identity.add",
+		BeforeVersion: group.Version - 1,
+		AfterVersion:  group.Version,
+		Details:       fmt.Sprintf("identity [%s]", in.IdentityUUID),
+	})
+
+	return &nativeIAmGroupGRPC.AddIdentityResponse{Group: group.ToGRPCGroup(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) RemoveIdentity(ctx context.Context, in *nativeIAmGroupGRPC.RemoveIdentityRequest) (*nativeIAmGroupGRPC.RemoveIdentityResponse, error) {
+	groupID, err := primitive.ObjectIDFromHex(in.GroupUUID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group UUID has bad format.")
+	}
+
+	group, err := s.updateGroup(ctx, in.Namespace, groupID, bson.M{
+		"$pull": bson.M{"identities": in.IdentityUUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.group." + in.GroupUUID,
+		Action:        "native.iam.group.identity.remove",
+		BeforeVersion: group.Version - 1,
+		AfterVersion:  group.Version,
+		Details:       fmt.Sprintf("identity [%s]", in.IdentityUUID),
+	})
+
+	return &nativeIAmGroupGRPC.RemoveIdentityResponse{Group: group.ToGRPCGroup(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) AddGroup(ctx context.Context, in *nativeIAmGroupGRPC.AddGroupRequest) (*nativeIAmGroupGRPC.AddGroupResponse, error) {
+	groupID, err := primitive.ObjectIDFromHex(in.GroupUUID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group UUID has bad format.")
+	}
+	memberID, err := primitive.ObjectIDFromHex(in.MemberGroupUUID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Member group UUID has bad format.")
+	}
+	if groupID == memberID {
+		return nil, status.Error(codes.FailedPrecondition, "Group cant be nested into itself")
+	}
+
+	// Nesting member into the group creates a cycle if member is already one of the parents of the group.
+	// This check is not atomic, but concurrent changes of the same groups are rare. Resolution of the membership is protected from cycles anyway.
+	collection := getCollectionByNamespace(s.systemStub, in.Namespace)
+	members, err := findGroups(ctx, collection, bson.M{"_id": bson.M{"$in": bson.A{groupID, memberID}}})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error while searhing for groups in database. "+err.Error())
+	}
+	var group *groupInMongo
+	memberExists := false
+	for index := range members {
+		if members[index].ID == groupID {
+			group = &members[index]
+		} else {
+			memberExists = true
+		}
+	}
+	if group == nil {
+		return nil, status.Error(codes.NotFound, "Group wasnt founded.")
+	}
+	if !memberExists {
+		return nil, status.Error(codes.FailedPrecondition, "Member group doesnt exist")
+	}
+	parents, err := findParentGroups(ctx, collection, []groupInMongo{*group}, map[primitive.ObjectID]bool{groupID: true})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error while searhing for parent groups in database. "+err.Error())
+	}
+	for _, parent := range parents {
+		if parent.ID == memberID {
+			return nil, status.Error(codes.FailedPrecondition, "Nesting would create a cycle. Group is already nested into the member group")
+		}
+	}
+
+	group, err = s.updateGroup(ctx, in.Namespace, groupID, bson.M{
+		"$addToSet": bson.M{"groups": in.MemberGroupUUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.group." + in.GroupUUID,
+		Action:        "native.iam.group.group.add",
+		BeforeVersion: group.Version - 1,
+		AfterVersion:  group.Version,
+		Details:       fmt.Sprintf("group [%s]", in.MemberGroupUUID),
+	})
+
+	return &nativeIAmGroupGRPC.AddGroupResponse{Group: group.ToGRPCGroup(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) RemoveGroup(ctx context.Context, in *nativeIAmGroupGRPC.RemoveGroupRequest) (*nativeIAmGroupGRPC.RemoveGroupResponse, error) {
+	groupID, err := primitive.ObjectIDFromHex(in.GroupUUID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group UUID has bad format.")
+	}
+
+	group, err := s.updateGroup(ctx, in.Namespace, groupID, bson.M{
+		"$pull": bson.M{"groups": in.MemberGroupUUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.group." + in.GroupUUID,
+		Action:        "native.iam.group.group.remove",
+		BeforeVersion: group.Version - 1,
+		AfterVersion:  group.Version,
+		Details:       fmt.Sprintf("group [%s]", in.MemberGroupUUID),
+	})
+
+	return &nativeIAmGroupGRPC.RemoveGroupResponse{Group: group.ToGRPCGroup(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) AddRole(ctx context.Context, in *nativeIAmGroupGRPC.AddRoleRequest) (*nativeIAmGroupGRPC.AddRoleResponse, error) {
+	groupID, err := primitive.ObjectIDFromHex(in.GroupUUID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group UUID has bad format.")
+	}
+	if _, err := primitive.ObjectIDFromHex(in.RoleUUID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Role UUID has bad format.")
+	}
+
+	// Check if role exists. This is not atomic but helps to avoid some errors
+	existResponse, err := s.roleServer.Exist(ctx, &nativeIAmRoleGRPC.ExistRoleRequest{
+		Namespace: in.RoleNamespace,
+		Uuid:      in.RoleUUID,
+		UseCache:  true,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check existance of role: "+err.Error())
+	}
+	if !existResponse.Exist {
+		return nil, status.Error(codes.FailedPrecondition, "Role doesnt exist")
+	}
+
+	group, err := s.updateGroup(ctx, in.GroupNamespace, groupID, bson.M{
+		"$addToSet": bson.M{"roles": referenceInMongo{Namespace: in.RoleNamespace, UUID: in.RoleUUID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.GroupNamespace,
+		Resource:      "native.iam.group." + in.GroupUUID,
+		Action:        "native.iam.group.role.add",
+		BeforeVersion: group.Version - 1,
+		AfterVersion:  group.Version,
+		Details:       fmt.Sprintf("role [%s] from namespace [%s]", in.RoleUUID, in.RoleNamespace),
+	})
+
+	return &nativeIAmGroupGRPC.AddRoleResponse{Group: group.ToGRPCGroup(in.GroupNamespace)}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) RemoveRole(ctx context.Context, in *nativeIAmGroupGRPC.RemoveRoleRequest) (*nativeIAmGroupGRPC.RemoveRoleResponse, error) {
+	groupID, err := primitive.ObjectIDFromHex(in.GroupUUID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group UUID has bad format.")
+	}
+
+	group, err := s.updateGroup(ctx, in.GroupNamespace, groupID, bson.M{
+		"$pull": bson.M{"roles": referenceInMongo{Namespace: in.RoleNamespace, UUID: in.RoleUUID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.GroupNamespace,
+		Resource:      "native.iam.group." + in.GroupUUID,
+		Action:        "native.iam.group.role.remove",
+		BeforeVersion: group.Version - 1,
+		AfterVersion:  group.Version,
+		Details:       fmt.Sprintf("role [%s] from namespace [%s]", in.RoleUUID, in.RoleNamespace),
+	})
+
+	return &nativeIAmGroupGRPC.RemoveRoleResponse{Group: group.ToGRPCGroup(in.GroupNamespace)}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) AddPolicy(ctx context.Context, in *nativeIAmGroupGRPC.AddPolicyRequest) (*nativeIAmGroupGRPC.AddPolicyResponse, error) {
+	groupID, err := primitive.ObjectIDFromHex(in.GroupUUID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group UUID has bad format.")
+	}
+	if _, err := primitive.ObjectIDFromHex(in.PolicyUUID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Policy UUID has bad format.")
+	}
+
+	// Check if policy exists. This is not atomic but helps to avoid some errors
+	existResponse, err := s.policyServer.Exist(ctx, &nativeIAmPolicyGRPC.ExistPolicyRequest{
+		Namespace: in.PolicyNamespace,
+		Uuid:      in.PolicyUUID,
+		UseCache:  true,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check existance of policy: "+err.Error())
+	}
+	if !existResponse.Exist {
+		return nil, status.Error(codes.FailedPrecondition, "Policy doesnt exist")
+	}
+
+	group, err := s.updateGroup(ctx, in.GroupNamespace, groupID, bson.M{
+		"$addToSet": bson.M{"policies": referenceInMongo{Namespace: in.PolicyNamespace, UUID: in.PolicyUUID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.GroupNamespace,
+		Resource:      "native.iam.group." + in.GroupUUID,
+		Action:        "native.iam.group.policy.add",
+		BeforeVersion: group.Version - 1,
+		AfterVersion:  group.Version,
+		Details:       fmt.Sprintf("policy [%s] from namespace [%s]", in.PolicyUUID, in.PolicyNamespace),
+	})
+
+	return &nativeIAmGroupGRPC.AddPolicyResponse{Group: group.ToGRPCGroup(in.GroupNamespace)}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) RemovePolicy(ctx context.Context, in *nativeIAmGroupGRPC.RemovePolicyRequest) (*nativeIAmGroupGRPC.RemovePolicyResponse, error) {
+	groupID, err := primitive.ObjectIDFromHex(in.GroupUUID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Group UUID has bad format.")
+	}
+
+	group, err := s.updateGroup(ctx, in.GroupNamespace, groupID, bson.M{
+		"$pull": bson.M{"policies": referenceInMongo{Namespace: in.PolicyNamespace, UUID: in.PolicyUUID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.GroupNamespace,
+		Resource:      "native.iam.group." + in.GroupUUID,
+		Action:        "native.iam.group.policy.remove",
+		BeforeVersion: group.Version - 1,
+		AfterVersion:  group.Version,
+		Details:       fmt.Sprintf("policy [%s] from namespace [%s]", in.PolicyUUID, in.PolicyNamespace),
+	})
+
+	return &nativeIAmGroupGRPC.RemovePolicyResponse{Group: group.ToGRPCGroup(in.GroupNamespace)}, status.Error(codes.OK, "")
+}
+
+func (s *IAMGroupServer) GetIdentityGroups(ctx context.Context, in *nativeIAmGroupGRPC.GetIdentityGroupsRequest) (*nativeIAmGroupGRPC.GetIdentityGroupsResponse, error) {
+	groups, err := FindIdentityGroups(ctx, s.systemStub, in.Namespace, in.IdentityUUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Error while searhing for groups of the identity. "+err.Error())
+	}
+
+	response := &nativeIAmGroupGRPC.GetIdentityGroupsResponse{
+		Groups: make([]*nativeIAmGroupGRPC.GetIdentityGroupsResponse_IdentityGroup, len(groups)),
+	}
+	for index, group := range groups {
+		response.Groups[index] = &nativeIAmGroupGRPC.GetIdentityGroupsResponse_IdentityGroup{Group: group.Group, Direct: group.Direct}
+	}
+	return response, status.Error(codes.OK, "")
+}
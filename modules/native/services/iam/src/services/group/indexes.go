@@ -0,0 +1,30 @@
+package group
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func ensureIndexesForNamespace(ctx context.Context, namespace string, systemStub *system.SystemStub) error {
+	_, err := getCollectionByNamespace(systemStub, namespace).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		// Fast search of the groups identity is direct member of
+		{
+			Keys: bson.D{bson.E{Key: "identities", Value: 1}},
+		},
+		// Fast search of the parent groups of the nested group
+		{
+			Keys: bson.D{bson.E{Key: "groups", Value: 1}},
+		},
+	})
+
+	if err == nil {
+		log.WithField("namespace", namespace).Info("Ensured group indexes for namespace.")
+	}
+
+	return err
+}
@@ -0,0 +1,202 @@
+package group
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+
+	nativeIAmGroupGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/group"
+)
+
+// Groups deeper than this are ignored while resolving membership. Protects from very long chains of the nested groups.
+const max_group_nesting_depth = 32
+
+func getCollectionByNamespace(systemStub *system.SystemStub, namespace string) *mongo.Collection {
+	if namespace == "" {
+		return systemStub.DB.Database("openbp_global").Collection("native_iam_group")
+	}
+	return systemStub.DB.Database("openbp_namespace_" + namespace).Collection("native_iam_group")
+}
+
+// Group the identity is member of
+type IdentityGroup struct {
+	Group *nativeIAmGroupGRPC.Group
+	// True if identity is direct member of the group, false if it is member through the nested groups
+	Direct bool
+}
+
+func findGroups(ctx context.Context, collection *mongo.Collection, filter bson.M) ([]groupInMongo, error) {
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return []groupInMongo{}, nil
+			}
+		}
+		return nil, err
+	}
+
+	groups := []groupInMongo{}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// Walks from the provided groups to all the groups they are nested into. Returns parent groups that were not visited before.
+func findParentGroups(ctx context.Context, collection *mongo.Collection, groups []groupInMongo, visited map[primitive.ObjectID]bool) ([]groupInMongo, error) {
+	parents := []groupInMongo{}
+	frontier := groups
+	for depth := 0; len(frontier) != 0 && depth < max_group_nesting_depth; depth++ {
+		frontierUUIDs := make([]string, len(frontier))
+		for index, group := range frontier {
+			frontierUUIDs[index] = group.ID.Hex()
+		}
+		visitedIDs := make([]primitive.ObjectID, 0, len(visited))
+		for id := range visited {
+			visitedIDs = append(visitedIDs, id)
+		}
+
+		found, err := findGroups(ctx, collection, bson.M{"groups": bson.M{"$in": frontierUUIDs}, "_id": bson.M{"$nin": visitedIDs}})
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range found {
+			visited[group.ID] = true
+		}
+		parents = append(parents, found...)
+		frontier = found
+	}
+	return parents, nil
+}
+
+// Finds all the groups identity is member of (directly and through the nested groups). Every group is returned only once.
+func FindIdentityGroups(ctx context.Context, systemStub *system.SystemStub, namespace string, identityUUID string) ([]IdentityGroup, error) {
+	collection := getCollectionByNamespace(systemStub, namespace)
+
+	direct, err := findGroups(ctx, collection, bson.M{"identities": identityUUID})
+	if err != nil {
+		return nil, err
+	}
+	visited := make(map[primitive.ObjectID]bool, len(direct))
+	for _, group := range direct {
+		visited[group.ID] = true
+	}
+	parents, err := findParentGroups(ctx, collection, direct, visited)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]IdentityGroup, 0, len(direct)+len(parents))
+	for _, group := range direct {
+		result = append(result, IdentityGroup{Group: group.ToGRPCGroup(namespace), Direct: true})
+	}
+	for _, group := range parents {
+		result = append(result, IdentityGroup{Group: group.ToGRPCGroup(namespace), Direct: false})
+	}
+	return result, nil
+}
+
+// Reference to the role or policy assigned to the group
+type AssignmentReference struct {
+	Namespace string
+	UUID      string
+}
+
+func makeAssignmentsFilter(field string, references []AssignmentReference) bson.A {
+	filters := make(bson.A, len(references))
+	for index, reference := range references {
+		filters[index] = bson.M{field: bson.M{"$elemMatch": bson.M{"namespace": reference.Namespace, "uuid": reference.UUID}}}
+	}
+	return filters
+}
+
+// Walks from the provided groups to all the groups nested into them. Returns nested groups that were not visited before.
+func findNestedGroups(ctx context.Context, collection *mongo.Collection, groups []groupInMongo, visited map[primitive.ObjectID]bool) ([]groupInMongo, error) {
+	nested := []groupInMongo{}
+	frontier := groups
+	for depth := 0; len(frontier) != 0 && depth < max_group_nesting_depth; depth++ {
+		frontierIDs := []primitive.ObjectID{}
+		for _, group := range frontier {
+			for _, nestedUUID := range group.Groups {
+				id, err := primitive.ObjectIDFromHex(nestedUUID)
+				if err != nil || visited[id] {
+					continue
+				}
+				visited[id] = true
+				frontierIDs = append(frontierIDs, id)
+			}
+		}
+		if len(frontierIDs) == 0 {
+			break
+		}
+
+		found, err := findGroups(ctx, collection, bson.M{"_id": bson.M{"$in": frontierIDs}})
+		if err != nil {
+			return nil, err
+		}
+		nested = append(nested, found...)
+		frontier = found
+	}
+	return nested, nil
+}
+
+// Finds identities that are members (directly or through the nested groups) of the groups that have any of the provided policies or roles assigned. Every identity is returned only once.
+func FindMembersOfGroupsWithAssignments(ctx context.Context, systemStub *system.SystemStub, namespace string, policies []AssignmentReference, roles []AssignmentReference) ([]string, error) {
+	references := append(makeAssignmentsFilter("policies", policies), makeAssignmentsFilter("roles", roles)...)
+	if len(references) == 0 {
+		return []string{}, nil
+	}
+
+	collection := getCollectionByNamespace(systemStub, namespace)
+	assigned, err := findGroups(ctx, collection, bson.M{"$or": references})
+	if err != nil {
+		return nil, err
+	}
+	visited := make(map[primitive.ObjectID]bool, len(assigned))
+	for _, group := range assigned {
+		visited[group.ID] = true
+	}
+	nested, err := findNestedGroups(ctx, collection, assigned, visited)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []string{}
+	added := map[string]bool{}
+	for _, group := range append(assigned, nested...) {
+		for _, identityUUID := range group.Identities {
+			if !added[identityUUID] {
+				added[identityUUID] = true
+				members = append(members, identityUUID)
+			}
+		}
+	}
+	return members, nil
+}
+
+// Removes identity from all the groups of the namespace. Used when identity is deleted.
+func RemoveIdentityFromAllGroups(ctx context.Context, systemStub *system.SystemStub, namespace string, identityUUID string) error {
+	_, err := getCollectionByNamespace(systemStub, namespace).UpdateMany(
+		ctx,
+		bson.M{"identities": identityUUID},
+		bson.M{
+			"$pull":        bson.M{"identities": identityUUID},
+			"$currentDate": bson.M{"updated": bson.M{"$type": "timestamp"}},
+			"$inc":         bson.M{"version": 1},
+		},
+	)
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
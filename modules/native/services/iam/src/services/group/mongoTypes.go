@@ -0,0 +1,67 @@
+package group
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	nativeIAmGroupGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/group"
+)
+
+type referenceInMongo struct {
+	Namespace string `bson:"namespace"`
+	UUID      string `bson:"uuid"`
+}
+
+type groupInMongo struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Name        string             `bson:"name"`
+	Description string             `bson:"description"`
+
+	// Unique identifiers of the member identities
+	Identities []string `bson:"identities"`
+	// Unique identifiers of the nested groups
+	Groups []string `bson:"groups"`
+
+	Roles    []referenceInMongo `bson:"roles"`
+	Policies []referenceInMongo `bson:"policies"`
+
+	Created time.Time `bson:"created"`
+	Updated time.Time `bson:"updated"`
+	Version uint64    `bson:"version"`
+}
+
+func (g *groupInMongo) ToGRPCGroup(namespace string) *nativeIAmGroupGRPC.Group {
+	roles := make([]*nativeIAmGroupGRPC.RoleReference, len(g.Roles))
+	for index, role := range g.Roles {
+		roles[index] = &nativeIAmGroupGRPC.RoleReference{Namespace: role.Namespace, Uuid: role.UUID}
+	}
+	policies := make([]*nativeIAmGroupGRPC.PolicyReference, len(g.Policies))
+	for index, policy := range g.Policies {
+		policies[index] = &nativeIAmGroupGRPC.PolicyReference{Namespace: policy.Namespace, Uuid: policy.UUID}
+	}
+
+	identities := g.Identities
+	if identities == nil {
+		identities = []string{}
+	}
+	groups := g.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+
+	return &nativeIAmGroupGRPC.Group{
+		Namespace:   namespace,
+		Uuid:        g.ID.Hex(),
+		Name:        g.Name,
+		Description: g.Description,
+		Identities:  identities,
+		Groups:      groups,
+		Roles:       roles,
+		Policies:    policies,
+		Created:     timestamppb.New(g.Created),
+		Updated:     timestamppb.New(g.Updated),
+		Version:     g.Version,
+	}
+}
@@ -0,0 +1,161 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	nativeIAmGroupGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/group"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	nativeIAmRoleGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
+
+	group_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/group"
+)
+
+// Policy assigned to the identity. If policy was assigned through the role, role fields are not empty. If policy was assigned through the group, group is not nil.
+type AssignedPolicy struct {
+	Policy *nativeIAmPolicyGRPC.Policy
+
+	RoleNamespace string
+	RoleUUID      string
+	// Role information. Can be nil if policy assignment was made up (for example, during simulation)
+	Role *nativeIAmRoleGRPC.Role
+
+	Group *nativeIAmGroupGRPC.Group
+}
+
+func makePolicyKey(namespace string, uuid string) string {
+	return namespace + "/" + uuid
+}
+
+// Fetches all the policies of the identity (directly assigned, assigned through the roles and through the groups).
+// Policy is returned once for every place it was assigned from. Policies and roles that doesnt exist anymore are skipped.
+// This is the only place where policies of the identity are resolved. Access checks, effective policies and simulation must use it, so they always agree.
+func (s *IAmIdentityServer) FetchAssignedPolicies(ctx context.Context, identity *nativeIAmIdentityGRPC.Identity) ([]AssignedPolicy, error) {
+	// Assignments outside of their validity period are ignored even if sweeper didnt remove them yet
+	now := time.Now()
+	identityPolicies := ActivePolicyReferences(identity, now)
+	identityRoles := ActiveRoleReferences(identity, now)
+
+	groups, err := group_server.FindIdentityGroups(ctx, s.systemStub, identity.Namespace, identity.Uuid)
+	if err != nil {
+		return nil, errors.New("error while fetching groups of the identity: " + err.Error())
+	}
+
+	assigned := make([]AssignedPolicy, 0, len(identityPolicies))
+	searchedPolicies := make([]*nativeIAmPolicyGRPC.GetMultiplePoliciesRequest_RequestedPolicy, 0, len(identityPolicies))
+	addPolicy := func(a AssignedPolicy) {
+		searchedPolicies = append(searchedPolicies, &nativeIAmPolicyGRPC.GetMultiplePoliciesRequest_RequestedPolicy{
+			Namespace: a.Policy.Namespace,
+			Uuid:      a.Policy.Uuid,
+		})
+		assigned = append(assigned, a)
+	}
+
+	// Same role can be assigned directly and through the multiple groups. Group is nil for the direct assignment.
+	roleAssignments := make(map[string][]*nativeIAmGroupGRPC.Group, len(identityRoles))
+	requestedRoles := make([]*nativeIAmRoleGRPC.GetMultipleRolesRequest_RequestedRole, 0, len(identityRoles))
+	addRole := func(roleNamespace string, roleUUID string, group *nativeIAmGroupGRPC.Group) {
+		key := makePolicyKey(roleNamespace, roleUUID)
+		if _, ok := roleAssignments[key]; !ok {
+			requestedRoles = append(requestedRoles, &nativeIAmRoleGRPC.GetMultipleRolesRequest_RequestedRole{
+				Namespace: roleNamespace,
+				Uuid:      roleUUID,
+			})
+		}
+		roleAssignments[key] = append(roleAssignments[key], group)
+	}
+
+	for _, policy := range identityPolicies {
+		addPolicy(AssignedPolicy{Policy: &nativeIAmPolicyGRPC.Policy{Namespace: policy.Namespace, Uuid: policy.Uuid}})
+	}
+	for _, role := range identityRoles {
+		addRole(role.Namespace, role.Uuid, nil)
+	}
+	for _, group := range groups {
+		for _, policy := range group.Group.Policies {
+			addPolicy(AssignedPolicy{Policy: &nativeIAmPolicyGRPC.Policy{Namespace: policy.Namespace, Uuid: policy.Uuid}, Group: group.Group})
+		}
+		for _, role := range group.Group.Roles {
+			addRole(role.Namespace, role.Uuid, group.Group)
+		}
+	}
+
+	if len(requestedRoles) > 0 {
+		var err error = nil
+		for role := range s.roleServer.OpenGetMultipleChannel(ctx, &nativeIAmRoleGRPC.GetMultipleRolesRequest{Roles: requestedRoles}) {
+			if err != nil {
+				continue
+			}
+			if role.Err != nil {
+				err = role.Err
+				continue
+			}
+
+			grpcRole := role.Role.ToGRPCRole(role.Namespace)
+			for _, group := range roleAssignments[makePolicyKey(role.Namespace, grpcRole.Uuid)] {
+				for _, policy := range role.Role.Policies {
+					addPolicy(AssignedPolicy{
+						Policy:        &nativeIAmPolicyGRPC.Policy{Namespace: policy.Namespace, Uuid: policy.UUID},
+						RoleNamespace: role.Namespace,
+						RoleUUID:      grpcRole.Uuid,
+						Role:          grpcRole,
+						Group:         group,
+					})
+				}
+			}
+		}
+		if err != nil {
+			return nil, errors.New("error while fetching roles of the identity: " + err.Error())
+		}
+	}
+
+	if len(searchedPolicies) == 0 {
+		return assigned, nil
+	}
+
+	// Policies are searched with "$in" operator, so every policy will be returned only once even if it was requested multiple times
+	policies := make(map[string]*nativeIAmPolicyGRPC.Policy, len(searchedPolicies))
+	err = nil
+	for policy := range s.policyServer.OpenGetMultipleChannel(ctx, &nativeIAmPolicyGRPC.GetMultiplePoliciesRequest{Policies: searchedPolicies}) {
+		if err != nil {
+			continue
+		}
+		if policy.Err != nil {
+			err = policy.Err
+			continue
+		}
+
+		grpcPolicy := policy.Policy.ToGRPCPolicy(policy.Namespace)
+		policies[makePolicyKey(grpcPolicy.Namespace, grpcPolicy.Uuid)] = grpcPolicy
+	}
+	if err != nil {
+		return nil, errors.New("error while fetching policies of the identity: " + err.Error())
+	}
+
+	existing := make([]AssignedPolicy, 0, len(assigned))
+	for _, a := range assigned {
+		if policy, ok := policies[makePolicyKey(a.Policy.Namespace, a.Policy.Uuid)]; ok {
+			a.Policy = policy
+			existing = append(existing, a)
+		}
+	}
+
+	return existing, nil
+}
+
+// Returns every assigned policy only once
+func UniquePolicies(assigned []AssignedPolicy) []*nativeIAmPolicyGRPC.Policy {
+	policies := make([]*nativeIAmPolicyGRPC.Policy, 0, len(assigned))
+	added := make(map[string]struct{}, len(assigned))
+	for _, a := range assigned {
+		key := makePolicyKey(a.Policy.Namespace, a.Policy.Uuid)
+		if _, ok := added[key]; ok {
+			continue
+		}
+		added[key] = struct{}{}
+		policies = append(policies, a.Policy)
+	}
+	return policies
+}
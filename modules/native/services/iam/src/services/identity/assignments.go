@@ -0,0 +1,279 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	nativeNamespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+)
+
+const (
+	// Maximum length of the justification text of the assignment
+	assignment_max_justification_length = 1024
+	// How often expired assignments are removed from the identities
+	assignment_sweep_interval = time.Minute
+)
+
+// Checks if assignment with provided validity period is active at the given time. Nil values mean unbounded period.
+func IsAssignmentActive(validFrom *timestamppb.Timestamp, validUntil *timestamppb.Timestamp, now time.Time) bool {
+	if validFrom != nil && now.Before(validFrom.AsTime()) {
+		return false
+	}
+	if validUntil != nil && !now.Before(validUntil.AsTime()) {
+		return false
+	}
+	return true
+}
+
+// Returns policies directly assigned to the identity which assignment is active at the given time
+func ActivePolicyReferences(identity *nativeIAmIdentityGRPC.Identity, now time.Time) []*nativeIAmIdentityGRPC.Identity_PolicyReference {
+	result := make([]*nativeIAmIdentityGRPC.Identity_PolicyReference, 0, len(identity.Policies))
+	for _, policy := range identity.Policies {
+		if IsAssignmentActive(policy.ValidFrom, policy.ValidUntil, now) {
+			result = append(result, policy)
+		}
+	}
+	return result
+}
+
+// Returns roles assigned to the identity which assignment is active at the given time
+func ActiveRoleReferences(identity *nativeIAmIdentityGRPC.Identity, now time.Time) []*nativeIAmIdentityGRPC.Identity_RoleReference {
+	result := make([]*nativeIAmIdentityGRPC.Identity_RoleReference, 0, len(identity.Roles))
+	for _, role := range identity.Roles {
+		if IsAssignmentActive(role.ValidFrom, role.ValidUntil, now) {
+			result = append(result, role)
+		}
+	}
+	return result
+}
+
+// Validates assignment parameters and converts validity period to the database format
+func validateAssignmentPeriod(validFrom *timestamppb.Timestamp, validUntil *timestamppb.Timestamp, justification string, now time.Time) (*time.Time, *time.Time, error) {
+	if len(justification) > assignment_max_justification_length {
+		return nil, nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Justification is too long. Maximum length is %d", assignment_max_justification_length))
+	}
+
+	var from *time.Time
+	if validFrom != nil {
+		if err := validFrom.CheckValid(); err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "ValidFrom has bad format: "+err.Error())
+		}
+		t := validFrom.AsTime()
+		from = &t
+	}
+
+	var until *time.Time
+	if validUntil != nil {
+		if err := validUntil.CheckValid(); err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "ValidUntil has bad format: "+err.Error())
+		}
+		t := validUntil.AsTime()
+		if !t.After(now) {
+			return nil, nil, status.Error(codes.InvalidArgument, "ValidUntil must be in the future")
+		}
+		if from != nil && !t.After(*from) {
+			return nil, nil, status.Error(codes.InvalidArgument, "ValidUntil must be after ValidFrom")
+		}
+		until = &t
+	}
+
+	return from, until, nil
+}
+
+func formatAssignmentDetails(resource string, uuid string, namespace string, validFrom *time.Time, validUntil *time.Time, justification string) string {
+	details := fmt.Sprintf("%s [%s] from namespace [%s]", resource, uuid, namespace)
+	if validFrom != nil {
+		details += fmt.Sprintf(", valid from [%s]", validFrom.UTC().Format(time.RFC3339))
+	}
+	if validUntil != nil {
+		details += fmt.Sprintf(", valid until [%s]", validUntil.UTC().Format(time.RFC3339))
+	}
+	if justification != "" {
+		details += fmt.Sprintf(", justification: %s", justification)
+	}
+	return details
+}
+
+// Periodically removes expired role and policy assignments from the identities of all the namespaces. Blocks until context is done.
+func (s *IAmIdentityServer) RunExpiredAssignmentsSweeper(ctx context.Context) {
+	logger := log.WithField("worker", "native_iam_identity_assignments_sweeper")
+	ticker := time.NewTicker(assignment_sweep_interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sweepExpiredAssignments(ctx, logger); err != nil {
+				logger.Error("Failed to remove expired assignments: " + err.Error())
+			}
+		}
+	}
+}
+
+func (s *IAmIdentityServer) sweepExpiredAssignments(ctx context.Context, logger *log.Entry) error {
+	namespaces := []string{""}
+
+	stream, err := s.nativeStub.Services.Namespace.GetAll(ctx, &nativeNamespaceGRPC.GetAllNamespacesRequest{UseCache: true})
+	if err != nil {
+		return fmt.Errorf("failed to list namespaces: %s", err.Error())
+	}
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return fmt.Errorf("failed to list namespaces: %s", err.Error())
+		}
+		namespaces = append(namespaces, response.Namespace.Name)
+	}
+
+	for _, namespace := range namespaces {
+		if err := s.sweepExpiredAssignmentsInNamespace(ctx, logger, namespace, time.Now()); err != nil {
+			logger.Error(fmt.Sprintf("Failed to remove expired assignments in the [%s] namespace: %s", namespace, err.Error()))
+		}
+	}
+	return nil
+}
+
+func (s *IAmIdentityServer) sweepExpiredAssignmentsInNamespace(ctx context.Context, logger *log.Entry, namespace string, now time.Time) error {
+	collection := collectionByNamespace(s, namespace)
+	expiredFilter := bson.M{"$or": bson.A{
+		bson.M{"roles.validUntil": bson.M{"$lte": now}},
+		bson.M{"policies.validUntil": bson.M{"$lte": now}},
+	}}
+
+	cursor, err := collection.Find(ctx, expiredFilter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return nil
+		}
+		return err
+	}
+	var ids []identityInMongo
+	if err := cursor.All(ctx, &ids); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		// Filter is repeated, so multiple instances of the service dont remove the same assignments twice
+		var before identityInMongo
+		err := collection.FindOneAndUpdate(
+			ctx,
+			bson.M{"$and": bson.A{bson.M{"_id": id.ID}, expiredFilter}},
+			bson.M{
+				"$pull": bson.M{
+					"roles":    bson.M{"validUntil": bson.M{"$lte": now}},
+					"policies": bson.M{"validUntil": bson.M{"$lte": now}},
+				},
+				"$currentDate": bson.M{"updated": bson.M{"$type": "timestamp"}},
+				"$inc":         bson.M{"version": 1},
+			},
+			options.FindOneAndUpdate().SetReturnDocument(options.Before),
+		).Decode(&before)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				continue
+			}
+			return err
+		}
+
+		uuid := before.ID.Hex()
+		s.systemStub.Cache.Remove(ctx, makeIndetityCacheKey(namespace, uuid))
+
+		expired := 0
+		for _, role := range before.Roles {
+			if role.ValidUntil != nil && !role.ValidUntil.After(now) {
+				expired += 1
+				s.publishAuditRecord(ctx, audit.Change{
+					Namespace:     namespace,
+					Resource:      "native.iam.identity." + uuid,
+					Action:        "native.iam.identity.role.expire",
+					BeforeVersion: before.Version,
+					AfterVersion:  before.Version + 1,
+					Details:       formatAssignmentDetails("role", role.UUID, role.Namespace, role.ValidFrom, role.ValidUntil, role.Justification),
+				})
+			}
+		}
+		for _, policy := range before.Policies {
+			if policy.ValidUntil != nil && !policy.ValidUntil.After(now) {
+				expired += 1
+				s.publishAuditRecord(ctx, audit.Change{
+					Namespace:     namespace,
+					Resource:      "native.iam.identity." + uuid,
+					Action:        "native.iam.identity.policy.expire",
+					BeforeVersion: before.Version,
+					AfterVersion:  before.Version + 1,
+					Details:       formatAssignmentDetails("policy", policy.UUID, policy.Namespace, policy.ValidFrom, policy.ValidUntil, policy.Justification),
+				})
+			}
+		}
+
+		logger.Info(fmt.Sprintf("Removed %d expired assignments from the identity [%s] in the [%s] namespace", expired, uuid, namespace))
+	}
+
+	return nil
+}
+
+// Replaces assignment with the same namespace and UUID in the field ("roles" or "policies") of the identity with the new one.
+// Replacement is done in one update and only if identity was not changed after its version was read.
+func replaceAssignment(ctx context.Context, collection *mongo.Collection, identityId primitive.ObjectID, field string, assignmentNamespace string, assignmentUUID string, assignment interface{}) (*identityInMongo, error) {
+	var current identityInMongo
+	err := collection.FindOne(ctx, bson.M{"_id": identityId}, options.FindOne().SetProjection(bson.M{"version": 1})).Decode(&current)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "Identity not found")
+		}
+		return nil, status.Error(codes.Internal, "Error on getting identity: "+err.Error())
+	}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			field: bson.M{"$concatArrays": bson.A{
+				bson.M{"$filter": bson.M{
+					"input": bson.M{"$ifNull": bson.A{"$" + field, bson.A{}}},
+					"cond": bson.M{"$or": bson.A{
+						bson.M{"$ne": bson.A{"$$this.namespace", assignmentNamespace}},
+						bson.M{"$ne": bson.A{"$$this.uuid", assignmentUUID}},
+					}},
+				}},
+				// Justification is the user input, so it must not be interpreted as an expression
+				bson.A{bson.M{"$literal": assignment}},
+			}},
+			// $currentDate is not available in the pipeline updates. Timestamp type is used as in other updates of the collection
+			"updated": bson.M{"$literal": primitive.Timestamp{T: uint32(time.Now().Unix())}},
+			"version": bson.M{"$add": bson.A{"$version", 1}},
+		}}},
+	}
+
+	var mongoIdentity identityInMongo
+	err = collection.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": identityId, "version": current.Version},
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&mongoIdentity)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.Aborted, "Identity was changed or deleted during the update. Try again")
+		}
+		return nil, status.Error(codes.Internal, "Error on updating identity: "+err.Error())
+	}
+
+	return &mongoIdentity, nil
+}
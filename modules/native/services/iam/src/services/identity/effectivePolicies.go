@@ -0,0 +1,115 @@
+package identity
+
+import (
+	"context"
+
+	"google.golang.org/grpc/status"
+
+	grpccodes "google.golang.org/grpc/codes"
+
+	nativeIAmGroupGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/group"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	nativeIAmRoleGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
+)
+
+func isPolicyConditional(conditions *nativeIAmPolicyGRPC.PolicyConditions) bool {
+	if conditions == nil {
+		return false
+	}
+	return len(conditions.SourceIPs) != 0 || conditions.TimeWindow != nil || len(conditions.AuthMethods) != 0 || conditions.RequireMFA
+}
+
+func makeEffectivePolicy(policy *nativeIAmPolicyGRPC.Policy) *nativeIAmIdentityGRPC.EffectivePolicy {
+	effective := &nativeIAmIdentityGRPC.EffectivePolicy{
+		Namespace:            policy.Namespace,
+		Uuid:                 policy.Uuid,
+		Name:                 policy.Name,
+		NamespaceIndependent: policy.NamespaceIndependent,
+		Resources:            policy.Resources,
+		Actions:              policy.Actions,
+		Deny:                 policy.Effect == nativeIAmPolicyGRPC.PolicyEffect_DENY,
+		Conditional:          isPolicyConditional(policy.Conditions),
+		Roles:                []*nativeIAmIdentityGRPC.EffectivePolicyRole{},
+		Groups:               []*nativeIAmIdentityGRPC.EffectivePolicyGroup{},
+	}
+	if builtIn, ok := policy.Managed.(*nativeIAmPolicyGRPC.Policy_BuiltIn); ok {
+		effective.BuiltInType = builtIn.BuiltIn.Type.String()
+	}
+	return effective
+}
+
+func makeEffectivePolicyRole(role *nativeIAmRoleGRPC.Role) *nativeIAmIdentityGRPC.EffectivePolicyRole {
+	effectiveRole := &nativeIAmIdentityGRPC.EffectivePolicyRole{
+		Namespace: role.Namespace,
+		Uuid:      role.Uuid,
+		Name:      role.Name,
+	}
+	if builtIn, ok := role.Managed.(*nativeIAmRoleGRPC.Role_BuiltIn); ok {
+		effectiveRole.BuiltInType = builtIn.BuiltIn.Type.String()
+	}
+	return effectiveRole
+}
+
+func hasEffectivePolicyRole(roles []*nativeIAmIdentityGRPC.EffectivePolicyRole, role *nativeIAmRoleGRPC.Role) bool {
+	for _, r := range roles {
+		if r.Namespace == role.Namespace && r.Uuid == role.Uuid {
+			return true
+		}
+	}
+	return false
+}
+
+func hasEffectivePolicyGroup(groups []*nativeIAmIdentityGRPC.EffectivePolicyGroup, group *nativeIAmGroupGRPC.Group) bool {
+	for _, g := range groups {
+		if g.Namespace == group.Namespace && g.Uuid == group.Uuid {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *IAmIdentityServer) GetEffectivePolicies(ctx context.Context, in *nativeIAmIdentityGRPC.GetEffectivePoliciesRequest) (*nativeIAmIdentityGRPC.GetEffectivePoliciesResponse, error) {
+	identityResponse, err := s.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{
+		Namespace: in.Namespace,
+		Uuid:      in.Uuid,
+		UseCache:  false,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assigned, err := s.FetchAssignedPolicies(ctx, identityResponse.Identity)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to get policies of the identity: "+err.Error())
+	}
+
+	// Merge multiple assignments of the same policy into one entry. Order of the first assignment is preserved.
+	policies := make([]*nativeIAmIdentityGRPC.EffectivePolicy, 0, len(assigned))
+	policiesByKey := make(map[string]*nativeIAmIdentityGRPC.EffectivePolicy, len(assigned))
+	for _, a := range assigned {
+		key := makePolicyKey(a.Policy.Namespace, a.Policy.Uuid)
+		effective, ok := policiesByKey[key]
+		if !ok {
+			effective = makeEffectivePolicy(a.Policy)
+			policiesByKey[key] = effective
+			policies = append(policies, effective)
+		}
+
+		if a.RoleUUID == "" && a.Group == nil {
+			effective.Direct = true
+		}
+		if a.Role != nil && !hasEffectivePolicyRole(effective.Roles, a.Role) {
+			effective.Roles = append(effective.Roles, makeEffectivePolicyRole(a.Role))
+		}
+		if a.Group != nil && !hasEffectivePolicyGroup(effective.Groups, a.Group) {
+			effective.Groups = append(effective.Groups, &nativeIAmIdentityGRPC.EffectivePolicyGroup{
+				Namespace: a.Group.Namespace,
+				Uuid:      a.Group.Uuid,
+				Name:      a.Group.Name,
+			})
+		}
+	}
+
+	return &nativeIAmIdentityGRPC.GetEffectivePoliciesResponse{Policies: policies}, status.Error(grpccodes.OK, "")
+}
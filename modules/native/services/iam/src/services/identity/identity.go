@@ -19,12 +19,15 @@ import (
 
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
 
 	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
 	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
 	nativeIAmRoleGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
 	nativeNamespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 
+	group_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/group"
 	policy_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/policy"
 	role_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/role"
 )
@@ -34,8 +37,9 @@ type IAmIdentityServer struct {
 
 	mongoGlobalCollection *mongo.Collection
 
-	systemStub *system.SystemStub
-	nativeStub *native.NativeStub
+	systemStub     *system.SystemStub
+	nativeStub     *native.NativeStub
+	auditPublisher *audit.Publisher
 
 	policyServer *policy_server.IAMPolicyServer
 	roleServer   *role_server.IAMRoleServer
@@ -43,6 +47,9 @@ type IAmIdentityServer struct {
 
 const (
 	IDENTITY_CACHE_TIMEOUT = time.Second * 30
+
+	// Management ID of the service identities created by the workload server. Only the workload server can create identities with it.
+	WORKLOAD_MANAGEMENT_ID = "workload"
 )
 
 func NewIAmIdentityServer(ctx context.Context, systemStub *system.SystemStub, nativeStub *native.NativeStub, policyServer *policy_server.IAMPolicyServer, roleServer *role_server.IAMRoleServer) (*IAmIdentityServer, error) {
@@ -54,10 +61,16 @@ func NewIAmIdentityServer(ctx context.Context, systemStub *system.SystemStub, na
 		return nil, errors.New("Failed to ensure indexes for global namespace: " + err.Error())
 	}
 
+	auditPublisher, err := audit.NewPublisher(systemStub.Nats, "native_iam")
+	if err != nil {
+		return nil, errors.New("Failed to create audit publisher: " + err.Error())
+	}
+
 	return &IAmIdentityServer{
 		mongoGlobalCollection: mongoGlobalCollection,
 		systemStub:            systemStub,
 		nativeStub:            nativeStub,
+		auditPublisher:        auditPublisher,
 		policyServer:          policyServer,
 		roleServer:            roleServer,
 	}, nil
@@ -80,6 +93,18 @@ func collectionByNamespace(s *IAmIdentityServer, namespace string) *mongo.Collec
 }
 
 func (s *IAmIdentityServer) Create(ctx context.Context, in *nativeIAmIdentityGRPC.CreateIdentityRequest) (*nativeIAmIdentityGRPC.CreateIdentityResponse, error) {
+	if t, ok := in.Managed.(*nativeIAmIdentityGRPC.CreateIdentityRequest_Service); ok && t.Service.ManagementId == WORKLOAD_MANAGEMENT_ID {
+		return nil, status.Error(grpccodes.PermissionDenied, "Management ID \""+WORKLOAD_MANAGEMENT_ID+"\" is reserved for the identities of the workloads")
+	}
+	return s.create(ctx, in)
+}
+
+// Creates identity of the workload. Must only be called by the workload server.
+func (s *IAmIdentityServer) CreateWorkloadIdentity(ctx context.Context, in *nativeIAmIdentityGRPC.CreateIdentityRequest) (*nativeIAmIdentityGRPC.CreateIdentityResponse, error) {
+	return s.create(ctx, in)
+}
+
+func (s *IAmIdentityServer) create(ctx context.Context, in *nativeIAmIdentityGRPC.CreateIdentityRequest) (*nativeIAmIdentityGRPC.CreateIdentityResponse, error) {
 	// This will check if namespace exists
 	indexesExist, err := checkIfIndexesCreated(ctx, in.Namespace, s.systemStub)
 	if err != nil {
@@ -146,6 +171,14 @@ func (s *IAmIdentityServer) Create(ctx context.Context, in *nativeIAmIdentityGRP
 
 	collection := collectionByNamespace(s, in.Namespace)
 
+	err = quota.Reserve(ctx, s.systemStub.DB, in.Namespace, quota.IAM_IDENTITIES, 1)
+	if err != nil {
+		if err == quota.ErrQuotaExceeded {
+			return nil, status.Error(grpccodes.ResourceExhausted, "Quota of the identities in the namespace is exceeded")
+		}
+		return nil, status.Error(grpccodes.Internal, "Error while reserving quota. "+err.Error())
+	}
+
 	if insertData.Managed.ManagementType == identity_managed_service && insertData.Managed.ServiceManagementID != "" {
 		r, err := collection.UpdateOne(
 			ctx,
@@ -159,21 +192,30 @@ func (s *IAmIdentityServer) Create(ctx context.Context, in *nativeIAmIdentityGRP
 			options.Update().SetUpsert(true),
 		)
 		if err != nil {
+			quota.Release(ctx, s.systemStub.DB, in.Namespace, quota.IAM_IDENTITIES, 1)
 			return nil, status.Error(grpccodes.Internal, "Error while adding identity to the database. "+err.Error())
 		}
 		if r.UpsertedCount == 0 {
+			quota.Release(ctx, s.systemStub.DB, in.Namespace, quota.IAM_IDENTITIES, 1)
 			return nil, status.Error(grpccodes.AlreadyExists, "Identity managed by this service with same managementId already exists.")
 		}
 		insertData.ID = r.UpsertedID.(primitive.ObjectID)
 	} else {
 		insertResponse, err := collection.InsertOne(ctx, insertData)
 		if err != nil {
+			quota.Release(ctx, s.systemStub.DB, in.Namespace, quota.IAM_IDENTITIES, 1)
 			return nil, status.Error(grpccodes.Internal, "Error on inserting to DB: "+err.Error())
 		}
 		insertData.ID = insertResponse.InsertedID.(primitive.ObjectID)
 	}
 
 	s.systemStub.Cache.Remove(ctx, makeIndetityCountCacheKey(in.Namespace))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:    in.Namespace,
+		Resource:     "native.iam.identity." + insertData.ID.Hex(),
+		Action:       "native.iam.identity.create",
+		AfterVersion: insertData.Version,
+	})
 
 	return &nativeIAmIdentityGRPC.CreateIdentityResponse{
 		Identity: insertData.ToGRPCIdentity(in.Namespace),
@@ -230,16 +272,30 @@ func (s *IAmIdentityServer) Delete(ctx context.Context, in *nativeIAmIdentityGRP
 	}
 
 	collection := collectionByNamespace(s, in.Namespace)
-	result, err := collection.DeleteOne(ctx, bson.M{"_id": identityId})
+	var deletedIdentity identityInMongo
+	err = collection.FindOneAndDelete(ctx, bson.M{"_id": identityId}).Decode(&deletedIdentity)
 	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return &nativeIAmIdentityGRPC.DeleteIdentityResponse{Existed: false}, status.Error(grpccodes.OK, "")
+		}
 		return nil, status.Error(grpccodes.Internal, "Error while deleting data from mongo: "+err.Error())
 	}
 
-	if result.DeletedCount != 0 {
-		s.systemStub.Cache.Remove(ctx, makeIndetityCacheKey(in.Namespace, in.Uuid), makeIndetityCountCacheKey(in.Namespace))
+	s.systemStub.Cache.Remove(ctx, makeIndetityCacheKey(in.Namespace, in.Uuid), makeIndetityCountCacheKey(in.Namespace))
+	if err := quota.Release(ctx, s.systemStub.DB, in.Namespace, quota.IAM_IDENTITIES, 1); err != nil {
+		logrus.Error("Failed to release quota of the deleted identity [" + in.Uuid + "]: " + err.Error())
+	}
+	if err := group_server.RemoveIdentityFromAllGroups(ctx, s.systemStub, in.Namespace, in.Uuid); err != nil {
+		logrus.Error("Failed to remove deleted identity [" + in.Uuid + "] from the groups: " + err.Error())
 	}
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.identity." + in.Uuid,
+		Action:        "native.iam.identity.delete",
+		BeforeVersion: deletedIdentity.Version,
+	})
 
-	return &nativeIAmIdentityGRPC.DeleteIdentityResponse{Existed: result.DeletedCount != 0}, status.Error(grpccodes.OK, "")
+	return &nativeIAmIdentityGRPC.DeleteIdentityResponse{Existed: true}, status.Error(grpccodes.OK, "")
 }
 
 func (s *IAmIdentityServer) Exists(ctx context.Context, in *nativeIAmIdentityGRPC.ExistsIdentityRequest) (*nativeIAmIdentityGRPC.ExistsIdentityResponse, error) {
@@ -432,6 +488,13 @@ func (s *IAmIdentityServer) Update(ctx context.Context, in *nativeIAmIdentityGRP
 	}
 
 	s.systemStub.Cache.Remove(ctx, makeIndetityCacheKey(in.Namespace, in.Uuid))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.identity." + in.Uuid,
+		Action:        "native.iam.identity.update",
+		BeforeVersion: identity.Version - 1,
+		AfterVersion:  identity.Version,
+	})
 
 	return &nativeIAmIdentityGRPC.UpdateIdentityResponse{Identity: identity.ToGRPCIdentity(in.Namespace)}, status.Error(codes.OK, "")
 }
@@ -447,6 +510,11 @@ func (s *IAmIdentityServer) AddPolicy(ctx context.Context, in *nativeIAmIdentity
 		return nil, status.Error(grpccodes.InvalidArgument, "Policy UUID has bad format")
 	}
 
+	validFrom, validUntil, err := validateAssignmentPeriod(in.ValidFrom, in.ValidUntil, in.Justification, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	existResponse, err := s.policyServer.Exist(ctx, &nativeIAmPolicyGRPC.ExistPolicyRequest{
 		Namespace: in.PolicyNamespace,
 		Uuid:      in.PolicyUUID,
@@ -460,31 +528,31 @@ func (s *IAmIdentityServer) AddPolicy(ctx context.Context, in *nativeIAmIdentity
 	}
 
 	policy := &identityPolicyInMongo{
-		Namespace: in.PolicyNamespace,
-		UUID:      in.PolicyUUID,
+		Namespace:     in.PolicyNamespace,
+		UUID:          in.PolicyUUID,
+		ValidFrom:     validFrom,
+		ValidUntil:    validUntil,
+		Justification: in.Justification,
 	}
 	collection := collectionByNamespace(s, in.IdentityNamespace)
-	var mongoIdentity identityInMongo
-	err = collection.FindOneAndUpdate(
-		ctx,
-		bson.M{"_id": identityId},
-		bson.M{
-			"$addToSet":    bson.M{"policies": policy},
-			"$currentDate": bson.M{"updated": bson.M{"$type": "timestamp"}},
-			"$inc":         bson.M{"version": 1},
-		},
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
-	).Decode(&mongoIdentity)
+
+	// Assignment can exist with other validity period. New one replaces it.
+	mongoIdentity, err := replaceAssignment(ctx, collection, identityId, "policies", in.PolicyNamespace, in.PolicyUUID, policy)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, status.Error(grpccodes.NotFound, "Identity not found")
-		}
-		return nil, status.Error(grpccodes.Internal, "Error on updating identity: "+err.Error())
+		return nil, err
 	}
 
 	identity := mongoIdentity.ToGRPCIdentity(in.IdentityNamespace)
 
 	s.systemStub.Cache.Remove(ctx, makeIndetityCacheKey(in.IdentityNamespace, in.IdentityUUID))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.IdentityNamespace,
+		Resource:      "native.iam.identity." + in.IdentityUUID,
+		Action:        "native.iam.identity.policy.add",
+		BeforeVersion: mongoIdentity.Version - 1,
+		AfterVersion:  mongoIdentity.Version,
+		Details:       formatAssignmentDetails("policy", in.PolicyUUID, in.PolicyNamespace, validFrom, validUntil, in.Justification),
+	})
 
 	return &nativeIAmIdentityGRPC.AddPolicyResponse{Identity: identity}, status.Error(grpccodes.OK, "")
 }
@@ -500,17 +568,13 @@ func (s *IAmIdentityServer) RemovePolicy(ctx context.Context, in *nativeIAmIdent
 		return nil, status.Error(grpccodes.InvalidArgument, "Policy UUID has bad format")
 	}
 
-	policy := &identityPolicyInMongo{
-		Namespace: in.PolicyNamespace,
-		UUID:      in.PolicyUUID,
-	}
 	collection := collectionByNamespace(s, in.IdentityNamespace)
 	var mongoIdentity identityInMongo
 	err = collection.FindOneAndUpdate(
 		ctx,
 		bson.M{"_id": identityId},
 		bson.M{
-			"$pull":        bson.M{"policies": policy},
+			"$pull":        bson.M{"policies": bson.M{"namespace": in.PolicyNamespace, "uuid": in.PolicyUUID}},
 			"$currentDate": bson.M{"updated": bson.M{"$type": "timestamp"}},
 			"$inc":         bson.M{"version": 1},
 		},
@@ -526,6 +590,14 @@ func (s *IAmIdentityServer) RemovePolicy(ctx context.Context, in *nativeIAmIdent
 	identity := mongoIdentity.ToGRPCIdentity(in.IdentityNamespace)
 
 	s.systemStub.Cache.Remove(ctx, makeIndetityCacheKey(in.IdentityNamespace, in.IdentityUUID))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.IdentityNamespace,
+		Resource:      "native.iam.identity." + in.IdentityUUID,
+		Action:        "native.iam.identity.policy.remove",
+		BeforeVersion: mongoIdentity.Version - 1,
+		AfterVersion:  mongoIdentity.Version,
+		Details:       fmt.Sprintf("policy [%s] from namespace [%s]", in.PolicyUUID, in.PolicyNamespace),
+	})
 
 	return &nativeIAmIdentityGRPC.RemovePolicyResponse{Identity: identity}, status.Error(grpccodes.OK, "")
 }
@@ -541,6 +613,11 @@ func (s *IAmIdentityServer) AddRole(ctx context.Context, in *nativeIAmIdentityGR
 		return nil, status.Error(grpccodes.InvalidArgument, "Role UUID has bad format")
 	}
 
+	validFrom, validUntil, err := validateAssignmentPeriod(in.ValidFrom, in.ValidUntil, in.Justification, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	// validate if role exist. This flow is not atomic but it will prevent most of the problems
 	existResponse, err := s.roleServer.Exist(ctx, &nativeIAmRoleGRPC.ExistRoleRequest{
 		Namespace: in.RoleNamespace,
@@ -555,31 +632,31 @@ func (s *IAmIdentityServer) AddRole(ctx context.Context, in *nativeIAmIdentityGR
 	}
 
 	role := &identityRoleInMongo{
-		Namespace: in.RoleNamespace,
-		UUID:      in.RoleUUID,
+		Namespace:     in.RoleNamespace,
+		UUID:          in.RoleUUID,
+		ValidFrom:     validFrom,
+		ValidUntil:    validUntil,
+		Justification: in.Justification,
 	}
 	collection := collectionByNamespace(s, in.IdentityNamespace)
-	var mongoIdentity identityInMongo
-	err = collection.FindOneAndUpdate(
-		ctx,
-		bson.M{"_id": identityId},
-		bson.M{
-			"$addToSet":    bson.M{"roles": role},
-			"$currentDate": bson.M{"updated": bson.M{"$type": "timestamp"}},
-			"$inc":         bson.M{"version": 1},
-		},
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
-	).Decode(&mongoIdentity)
+
+	// Assignment can exist with other validity period. New one replaces it.
+	mongoIdentity, err := replaceAssignment(ctx, collection, identityId, "roles", in.RoleNamespace, in.RoleUUID, role)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, status.Error(grpccodes.NotFound, "Identity not found")
-		}
-		return nil, status.Error(grpccodes.Internal, "Error on updating identity: "+err.Error())
+		return nil, err
 	}
 
 	identity := mongoIdentity.ToGRPCIdentity(in.IdentityNamespace)
 
 	s.systemStub.Cache.Remove(ctx, makeIndetityCacheKey(in.IdentityNamespace, in.IdentityUUID))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.IdentityNamespace,
+		Resource:      "native.iam.identity." + in.IdentityUUID,
+		Action:        "native.iam.identity.role.add",
+		BeforeVersion: mongoIdentity.Version - 1,
+		AfterVersion:  mongoIdentity.Version,
+		Details:       formatAssignmentDetails("role", in.RoleUUID, in.RoleNamespace, validFrom, validUntil, in.Justification),
+	})
 
 	return &nativeIAmIdentityGRPC.AddRoleResponse{Identity: identity}, status.Error(grpccodes.OK, "")
 }
@@ -594,17 +671,13 @@ func (s *IAmIdentityServer) RemoveRole(ctx context.Context, in *nativeIAmIdentit
 		return nil, status.Error(grpccodes.InvalidArgument, "Role UUID has bad format")
 	}
 
-	role := &identityRoleInMongo{
-		Namespace: in.RoleNamespace,
-		UUID:      in.RoleUUID,
-	}
 	collection := collectionByNamespace(s, in.IdentityNamespace)
 	var mongoIdentity identityInMongo
 	err = collection.FindOneAndUpdate(
 		ctx,
 		bson.M{"_id": identityId},
 		bson.M{
-			"$pull":        bson.M{"roles": role},
+			"$pull":        bson.M{"roles": bson.M{"namespace": in.RoleNamespace, "uuid": in.RoleUUID}},
 			"$currentDate": bson.M{"updated": bson.M{"$type": "timestamp"}},
 			"$inc":         bson.M{"version": 1},
 		},
@@ -620,6 +693,14 @@ func (s *IAmIdentityServer) RemoveRole(ctx context.Context, in *nativeIAmIdentit
 	identity := mongoIdentity.ToGRPCIdentity(in.IdentityNamespace)
 
 	s.systemStub.Cache.Remove(ctx, makeIndetityCacheKey(in.IdentityNamespace, in.IdentityUUID))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.IdentityNamespace,
+		Resource:      "native.iam.identity." + in.IdentityUUID,
+		Action:        "native.iam.identity.role.remove",
+		BeforeVersion: mongoIdentity.Version - 1,
+		AfterVersion:  mongoIdentity.Version,
+		Details:       fmt.Sprintf("role [%s] from namespace [%s]", in.RoleUUID, in.RoleNamespace),
+	})
 
 	return &nativeIAmIdentityGRPC.RemoveRoleResponse{Identity: identity}, status.Error(grpccodes.OK, "")
 }
@@ -652,6 +733,14 @@ func (s *IAmIdentityServer) SetActive(ctx context.Context, in *nativeIAmIdentity
 	identity := mongoIdentity.ToGRPCIdentity(in.Namespace)
 
 	s.systemStub.Cache.Remove(ctx, makeIndetityCacheKey(in.Namespace, in.Uuid))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.identity." + in.Uuid,
+		Action:        "native.iam.identity.setActive",
+		BeforeVersion: mongoIdentity.Version - 1,
+		AfterVersion:  mongoIdentity.Version,
+		Details:       fmt.Sprintf("active [%t]", in.Active),
+	})
 
 	return &nativeIAmIdentityGRPC.SetIdentityActiveResponse{Identity: identity}, status.Error(grpccodes.OK, "")
 }
@@ -35,6 +35,15 @@ func ensureIndexesForNamespace(ctx context.Context, namespace string, systemStub
 					"managed._managementType": identity_managed_service,
 				}),
 		},
+		// Fast search of the assignments that have to be removed by the sweeper
+		{
+			Keys:    bson.D{bson.E{Key: "roles.validUntil", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			Keys:    bson.D{bson.E{Key: "policies.validUntil", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
 	})
 
 	if err == nil {
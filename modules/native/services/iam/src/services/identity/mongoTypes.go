@@ -18,11 +18,26 @@ const (
 type identityPolicyInMongo struct {
 	Namespace string `bson:"namespace"`
 	UUID      string `bson:"uuid"`
+
+	ValidFrom     *time.Time `bson:"validFrom,omitempty"`
+	ValidUntil    *time.Time `bson:"validUntil,omitempty"`
+	Justification string     `bson:"justification,omitempty"`
 }
 
 type identityRoleInMongo struct {
 	Namespace string `bson:"namespace"`
 	UUID      string `bson:"uuid"`
+
+	ValidFrom     *time.Time `bson:"validFrom,omitempty"`
+	ValidUntil    *time.Time `bson:"validUntil,omitempty"`
+	Justification string     `bson:"justification,omitempty"`
+}
+
+func timeToGRPC(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
 }
 
 type managementTypeInMongo struct {
@@ -63,16 +78,22 @@ func (i *identityInMongo) ToGRPCIdentity(namespace string) *nativeIAmIdentityGRP
 	policies := make([]*nativeIAmIdentityGRPC.Identity_PolicyReference, len(i.Policies))
 	for index, policy := range i.Policies {
 		policies[index] = &nativeIAmIdentityGRPC.Identity_PolicyReference{
-			Namespace: policy.Namespace,
-			Uuid:      policy.UUID,
+			Namespace:     policy.Namespace,
+			Uuid:          policy.UUID,
+			ValidFrom:     timeToGRPC(policy.ValidFrom),
+			ValidUntil:    timeToGRPC(policy.ValidUntil),
+			Justification: policy.Justification,
 		}
 	}
 
 	roles := make([]*nativeIAmIdentityGRPC.Identity_RoleReference, len(i.Roles))
 	for index, role := range i.Roles {
 		roles[index] = &nativeIAmIdentityGRPC.Identity_RoleReference{
-			Namespace: role.Namespace,
-			Uuid:      role.UUID,
+			Namespace:     role.Namespace,
+			Uuid:          role.UUID,
+			ValidFrom:     timeToGRPC(role.ValidFrom),
+			ValidUntil:    timeToGRPC(role.ValidUntil),
+			Justification: role.Justification,
 		}
 	}
 
@@ -0,0 +1,266 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	grpccodes "google.golang.org/grpc/codes"
+
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+
+	group_server "github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/group"
+)
+
+// Flattens policies into the set of resource and action pairs
+func makeEffectivePermissions(assigned []AssignedPolicy) map[string]*nativeIAmIdentityGRPC.EffectivePermission {
+	permissions := map[string]*nativeIAmIdentityGRPC.EffectivePermission{}
+	for _, a := range assigned {
+		deny := a.Policy.Effect == nativeIAmPolicyGRPC.PolicyEffect_DENY
+		for _, resource := range a.Policy.Resources {
+			for _, action := range a.Policy.Actions {
+				key := fmt.Sprintf("%s\x00%t\x00%t\x00%s\x00%s", a.Policy.Namespace, a.Policy.NamespaceIndependent, deny, resource, action)
+				permissions[key] = &nativeIAmIdentityGRPC.EffectivePermission{
+					Namespace:            a.Policy.Namespace,
+					NamespaceIndependent: a.Policy.NamespaceIndependent,
+					Deny:                 deny,
+					Resource:             resource,
+					Action:               action,
+				}
+			}
+		}
+	}
+	return permissions
+}
+
+// Returns permissions that are in the "from" set but not in the "without" set. Result is sorted.
+func subtractEffectivePermissions(from map[string]*nativeIAmIdentityGRPC.EffectivePermission, without map[string]*nativeIAmIdentityGRPC.EffectivePermission) []*nativeIAmIdentityGRPC.EffectivePermission {
+	keys := make([]string, 0, len(from))
+	for key := range from {
+		if _, ok := without[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	permissions := make([]*nativeIAmIdentityGRPC.EffectivePermission, len(keys))
+	for index, key := range keys {
+		permissions[index] = from[key]
+	}
+	return permissions
+}
+
+// Makes mongo filter that matches identities that reference policy directly or through one of the roles from the namespace of identities or global namespace.
+// Identities that get the policy or the role through the groups (including nested groups) are matched too.
+func (s *IAmIdentityServer) makePolicyReferencesFilter(ctx context.Context, namespace string, policyNamespace string, policyUUID string) (bson.M, error) {
+	roleNamespaces := []string{namespace}
+	if namespace != "" {
+		roleNamespaces = append(roleNamespaces, "")
+	}
+
+	// Assignments have additional fields (validity period, justification), so they are matched only by namespace and UUID
+	references := bson.A{
+		bson.M{"policies": bson.M{"$elemMatch": bson.M{"namespace": policyNamespace, "uuid": policyUUID}}},
+	}
+	roles := []group_server.AssignmentReference{}
+	for _, roleNamespace := range roleNamespaces {
+		roleUUIDs, err := s.roleServer.FindRolesWithPolicy(ctx, roleNamespace, policyNamespace, policyUUID)
+		if err != nil {
+			return nil, err
+		}
+		for _, roleUUID := range roleUUIDs {
+			references = append(references, makeRoleReferencesFilter(roleNamespace, roleUUID))
+			roles = append(roles, group_server.AssignmentReference{Namespace: roleNamespace, UUID: roleUUID})
+		}
+	}
+
+	policies := []group_server.AssignmentReference{{Namespace: policyNamespace, UUID: policyUUID}}
+	return s.withGroupMembersFilter(ctx, namespace, references, policies, roles)
+}
+
+// Makes mongo filter that matches identities that have the role assigned directly or through the groups (including nested groups)
+func (s *IAmIdentityServer) makeRoleAssignmentsFilter(ctx context.Context, namespace string, roleNamespace string, roleUUID string) (bson.M, error) {
+	references := bson.A{makeRoleReferencesFilter(roleNamespace, roleUUID)}
+	roles := []group_server.AssignmentReference{{Namespace: roleNamespace, UUID: roleUUID}}
+	return s.withGroupMembersFilter(ctx, namespace, references, []group_server.AssignmentReference{}, roles)
+}
+
+func makeRoleReferencesFilter(roleNamespace string, roleUUID string) bson.M {
+	return bson.M{"roles": bson.M{"$elemMatch": bson.M{"namespace": roleNamespace, "uuid": roleUUID}}}
+}
+
+// Extends references filter with the members of the groups that have any of the policies or roles assigned
+func (s *IAmIdentityServer) withGroupMembersFilter(ctx context.Context, namespace string, references bson.A, policies []group_server.AssignmentReference, roles []group_server.AssignmentReference) (bson.M, error) {
+	members, err := group_server.FindMembersOfGroupsWithAssignments(ctx, s.systemStub, namespace, policies, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := make([]primitive.ObjectID, 0, len(members))
+	for _, member := range members {
+		id, err := primitive.ObjectIDFromHex(member)
+		if err != nil {
+			continue
+		}
+		memberIDs = append(memberIDs, id)
+	}
+	if len(memberIDs) != 0 {
+		references = append(references, bson.M{"_id": bson.M{"$in": memberIDs}})
+	}
+
+	return bson.M{"$or": references}, nil
+}
+
+func (s *IAmIdentityServer) SimulatePolicyChange(ctx context.Context, in *nativeIAmIdentityGRPC.SimulatePolicyChangeRequest) (*nativeIAmIdentityGRPC.SimulatePolicyChangeResponse, error) {
+	var filter bson.M
+	var applyChange func(assigned []AssignedPolicy) []AssignedPolicy
+
+	switch change := in.Change.(type) {
+	case *nativeIAmIdentityGRPC.SimulatePolicyChangeRequest_UpdatePolicy:
+		policyResponse, err := s.policyServer.Get(ctx, &nativeIAmPolicyGRPC.GetPolicyRequest{
+			Namespace: change.UpdatePolicy.Namespace,
+			Uuid:      change.UpdatePolicy.Uuid,
+			UseCache:  true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		updatedPolicy := proto.Clone(policyResponse.Policy).(*nativeIAmPolicyGRPC.Policy)
+		updatedPolicy.NamespaceIndependent = change.UpdatePolicy.NamespaceIndependent
+		updatedPolicy.Resources = change.UpdatePolicy.Resources
+		updatedPolicy.Actions = change.UpdatePolicy.Actions
+		updatedPolicy.Effect = nativeIAmPolicyGRPC.PolicyEffect_ALLOW
+		if change.UpdatePolicy.Deny {
+			updatedPolicy.Effect = nativeIAmPolicyGRPC.PolicyEffect_DENY
+		}
+
+		filter, err = s.makePolicyReferencesFilter(ctx, in.Namespace, updatedPolicy.Namespace, updatedPolicy.Uuid)
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to search for roles and groups that reference Policy: "+err.Error())
+		}
+		applyChange = func(assigned []AssignedPolicy) []AssignedPolicy {
+			changed := make([]AssignedPolicy, len(assigned))
+			for index, a := range assigned {
+				if a.Policy.Namespace == updatedPolicy.Namespace && a.Policy.Uuid == updatedPolicy.Uuid {
+					a.Policy = updatedPolicy
+				}
+				changed[index] = a
+			}
+			return changed
+		}
+	case *nativeIAmIdentityGRPC.SimulatePolicyChangeRequest_DeletePolicy:
+		policyNamespace := change.DeletePolicy.Namespace
+		policyUUID := change.DeletePolicy.Uuid
+
+		var err error
+		filter, err = s.makePolicyReferencesFilter(ctx, in.Namespace, policyNamespace, policyUUID)
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to search for roles and groups that reference Policy: "+err.Error())
+		}
+		applyChange = func(assigned []AssignedPolicy) []AssignedPolicy {
+			changed := make([]AssignedPolicy, 0, len(assigned))
+			for _, a := range assigned {
+				if a.Policy.Namespace != policyNamespace || a.Policy.Uuid != policyUUID {
+					changed = append(changed, a)
+				}
+			}
+			return changed
+		}
+	case *nativeIAmIdentityGRPC.SimulatePolicyChangeRequest_AddRolePolicy:
+		roleChange := change.AddRolePolicy
+		policyResponse, err := s.policyServer.Get(ctx, &nativeIAmPolicyGRPC.GetPolicyRequest{
+			Namespace: roleChange.PolicyNamespace,
+			Uuid:      roleChange.PolicyUUID,
+			UseCache:  true,
+		})
+		if err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() == grpccodes.NotFound {
+				return nil, status.Error(grpccodes.FailedPrecondition, "Policy doesnt exist")
+			}
+			return nil, err
+		}
+
+		filter, err = s.makeRoleAssignmentsFilter(ctx, in.Namespace, roleChange.RoleNamespace, roleChange.RoleUUID)
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to search for groups that reference Role: "+err.Error())
+		}
+		applyChange = func(assigned []AssignedPolicy) []AssignedPolicy {
+			return append(assigned, AssignedPolicy{
+				Policy:        policyResponse.Policy,
+				RoleNamespace: roleChange.RoleNamespace,
+				RoleUUID:      roleChange.RoleUUID,
+			})
+		}
+	case *nativeIAmIdentityGRPC.SimulatePolicyChangeRequest_RemoveRolePolicy:
+		roleChange := change.RemoveRolePolicy
+		var err error
+		filter, err = s.makeRoleAssignmentsFilter(ctx, in.Namespace, roleChange.RoleNamespace, roleChange.RoleUUID)
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to search for groups that reference Role: "+err.Error())
+		}
+		applyChange = func(assigned []AssignedPolicy) []AssignedPolicy {
+			changed := make([]AssignedPolicy, 0, len(assigned))
+			for _, a := range assigned {
+				fromRole := a.RoleNamespace == roleChange.RoleNamespace && a.RoleUUID == roleChange.RoleUUID
+				if !fromRole || a.Policy.Namespace != roleChange.PolicyNamespace || a.Policy.Uuid != roleChange.PolicyUUID {
+					changed = append(changed, a)
+				}
+			}
+			return changed
+		}
+	default:
+		return nil, status.Error(grpccodes.InvalidArgument, "Change to simulate must be provided")
+	}
+
+	response := &nativeIAmIdentityGRPC.SimulatePolicyChangeResponse{
+		Identities: []*nativeIAmIdentityGRPC.IdentityPermissionsDiff{},
+	}
+
+	collection := collectionByNamespace(s, in.Namespace)
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return response, status.Error(grpccodes.OK, "Namespace doesnt exist")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var mongoIdentity identityInMongo
+		if err := cursor.Decode(&mongoIdentity); err != nil {
+			return nil, status.Error(grpccodes.Internal, "Error while decoding identity from mongo: "+err.Error())
+		}
+		identity := mongoIdentity.ToGRPCIdentity(in.Namespace)
+
+		assigned, err := s.FetchAssignedPolicies(ctx, identity)
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to get policies of the identity: "+err.Error())
+		}
+
+		before := makeEffectivePermissions(assigned)
+		after := makeEffectivePermissions(applyChange(assigned))
+
+		response.Identities = append(response.Identities, &nativeIAmIdentityGRPC.IdentityPermissionsDiff{
+			Identity: identity.Uuid,
+			Name:     identity.Name,
+			Gained:   subtractEffectivePermissions(after, before),
+			Lost:     subtractEffectivePermissions(before, after),
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, status.Error(grpccodes.Internal, "Error while reading identities from mongo: "+err.Error())
+	}
+
+	return response, status.Error(grpccodes.OK, "")
+}
@@ -0,0 +1,54 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func clientCollectionByNamespace(systemStub *system.SystemStub, namespace string) *mongo.Collection {
+	if namespace == "" {
+		return systemStub.DB.Database("openbp_global").Collection("native_iam_oidc_client")
+	} else {
+		dbName := fmt.Sprintf("openbp_namespace_%s", namespace)
+		return systemStub.DB.Database(dbName).Collection("native_iam_oidc_client")
+	}
+}
+
+func consentCollectionByNamespace(systemStub *system.SystemStub, namespace string) *mongo.Collection {
+	if namespace == "" {
+		return systemStub.DB.Database("openbp_global").Collection("native_iam_oidc_consent")
+	} else {
+		dbName := fmt.Sprintf("openbp_namespace_%s", namespace)
+		return systemStub.DB.Database(dbName).Collection("native_iam_oidc_consent")
+	}
+}
+
+func EnsureIndexesForNamespace(ctx context.Context, namespace string, systemStub *system.SystemStub) error {
+	collection := consentCollectionByNamespace(systemStub, namespace)
+
+	// Identity has only one consent record per client
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				bson.E{Key: "identity", Value: 1},
+				bson.E{Key: "clientId", Value: 1},
+			},
+			Options: options.Index().SetName("unique_identity_client").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{bson.E{Key: "clientId", Value: 1}},
+			Options: options.Index().SetName("client"),
+		},
+	})
+	if err != nil {
+		return errors.Join(errors.New("failed to ensure indexes for oidc consents"), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,496 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	goJWT "github.com/golang-jwt/jwt"
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	nativeIAmAuthGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/oidc"
+	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	nativeIAmTokenGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/auth"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/token"
+)
+
+const (
+	authorization_code_length = 32
+	authorization_code_ttl    = time.Minute
+
+	pkce_method_plain = "plain"
+	pkce_method_s256  = "S256"
+)
+
+// Descriptions of the reserved scopes for the consent screen
+var reservedScopeDescriptions = map[string]string{
+	SCOPE_PROFILE:        "Read your name",
+	SCOPE_OFFLINE_ACCESS: "Keep access while you are not using the application",
+}
+
+// Metadata of the tokens issued by the authorization server. Used to bind refresh token to the client
+type tokenMetadata struct {
+	OIDCClientID string   `json:"oidcClientId"`
+	OIDCScopes   []string `json:"oidcScopes"`
+}
+
+func makeAuthorizationCodeRedisKey(code string) string {
+	return "native_iam_oidc_code_" + code
+}
+
+func generateRandomString(length int) (string, error) {
+	data := make([]byte, length)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Parses space separated list of scopes. Returns name of the first unknown scope if there is any.
+func parseScopes(client *clientInMongo, scope string) ([]string, string) {
+	scopes := []string{}
+	for _, name := range strings.Fields(scope) {
+		if slices.Contains(scopes, name) {
+			continue
+		}
+		if !isReservedScope(name) && client.findScope(name) == nil {
+			return nil, name
+		}
+		scopes = append(scopes, name)
+	}
+	return scopes, ""
+}
+
+// Converts OAuth scopes to the IAM scopes in the namespace of the client
+func makeAuthScopes(client *clientInMongo, namespace string, scopes []string) []*nativeIAmAuthGRPC.Scope {
+	authScopes := []*nativeIAmAuthGRPC.Scope{}
+	for _, name := range scopes {
+		clientScope := client.findScope(name)
+		if clientScope == nil {
+			continue
+		}
+		authScopes = append(authScopes, &nativeIAmAuthGRPC.Scope{
+			Namespace:            namespace,
+			Resources:            clientScope.Resources,
+			Actions:              clientScope.Actions,
+			NamespaceIndependent: clientScope.NamespaceIndependent,
+		})
+	}
+	return authScopes
+}
+
+func verifyCodeChallenge(challenge string, method string, verifier string) bool {
+	if challenge == "" {
+		return true
+	}
+	if verifier == "" {
+		return false
+	}
+	expected := verifier
+	if method == pkce_method_s256 {
+		hash := sha256.Sum256([]byte(verifier))
+		expected = base64.RawURLEncoding.EncodeToString(hash[:])
+	}
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+func (s *OIDCServer) Authorize(ctx context.Context, in *grpc.AuthorizeRequest) (*grpc.AuthorizeResponse, error) {
+	namespace, uuid := parseClientID(in.ClientId)
+	client, err := s.getClient(ctx, namespace, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil || !client.Enabled {
+		return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_INVALID_CLIENT, Message: "client doesnt exist or disabled"}, status.Error(codes.OK, "")
+	}
+
+	redirectURI := in.RedirectURI
+	if redirectURI == "" && len(client.RedirectURIs) == 1 {
+		redirectURI = client.RedirectURIs[0]
+	}
+	if !slices.Contains(client.RedirectURIs, redirectURI) {
+		return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_INVALID_REDIRECT_URI, Message: "redirect URI is not registered for the client"}, status.Error(codes.OK, "")
+	}
+
+	if in.ResponseType != "code" {
+		return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_UNSUPPORTED_RESPONSE_TYPE, Message: "only \"code\" response type is supported"}, status.Error(codes.OK, "")
+	}
+	if !client.allowsGrantType(grpc.GrantType_AUTHORIZATION_CODE) {
+		return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_UNAUTHORIZED_CLIENT, Message: "client is not allowed to use authorization code grant"}, status.Error(codes.OK, "")
+	}
+
+	codeChallengeMethod := in.CodeChallengeMethod
+	if in.CodeChallenge == "" {
+		if !client.Confidential {
+			return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_INVALID_REQUEST, Message: "PKCE code challenge is required for public clients"}, status.Error(codes.OK, "")
+		}
+		codeChallengeMethod = ""
+	} else {
+		if codeChallengeMethod == "" {
+			codeChallengeMethod = pkce_method_plain
+		}
+		if codeChallengeMethod != pkce_method_plain && codeChallengeMethod != pkce_method_s256 {
+			return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_INVALID_REQUEST, Message: "unsupported code challenge method"}, status.Error(codes.OK, "")
+		}
+	}
+
+	scopes, unknownScope := parseScopes(client, in.Scope)
+	if unknownScope != "" {
+		return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_INVALID_SCOPE, Message: "scope [" + unknownScope + "] is not defined for the client"}, status.Error(codes.OK, "")
+	}
+	if slices.Contains(scopes, SCOPE_OFFLINE_ACCESS) && !client.allowsGrantType(grpc.GrantType_REFRESH_TOKEN) {
+		return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_INVALID_SCOPE, Message: "client is not allowed to use refresh tokens"}, status.Error(codes.OK, "")
+	}
+
+	introspectResponse, err := s.tokenServer.Introspect(ctx, &nativeIAmTokenGRPC.IntrospectRequest{Token: in.AccessToken, UseCache: true})
+	if err != nil {
+		if st, ok := status.FromError(err); !ok || st.Code() != codes.OK {
+			return nil, err
+		}
+	}
+	if !introspectResponse.Active || introspectResponse.Refresh {
+		return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_LOGIN_REQUIRED, Message: "access token is not valid"}, status.Error(codes.OK, "")
+	}
+	tokenData := introspectResponse.TokenData
+	if tokenData.Namespace != namespace {
+		return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_ACCESS_DENIED, Message: "identity is not from the namespace of the client"}, status.Error(codes.OK, "")
+	}
+
+	if !client.SkipConsent {
+		consentScopes, err := s.ensureConsent(ctx, namespace, tokenData.Identity, client, in.ClientId, scopes, in.ConsentGranted)
+		if err != nil {
+			return nil, err
+		}
+		if len(consentScopes) != 0 {
+			return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_CONSENT_REQUIRED, Message: "identity must give consent to the client", ConsentScopes: consentScopes}, status.Error(codes.OK, "")
+		}
+	}
+
+	code, err := generateRandomString(authorization_code_length)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate authorization code: "+err.Error())
+	}
+	codeBytes, err := bson.Marshal(&authorizationCodeInRedis{
+		Namespace:           namespace,
+		ClientID:            in.ClientId,
+		Identity:            tokenData.Identity,
+		RedirectURI:         in.RedirectURI,
+		Scopes:              scopes,
+		CodeChallenge:       in.CodeChallenge,
+		CodeChallengeMethod: codeChallengeMethod,
+		Nonce:               in.Nonce,
+		AuthTime:            tokenData.CreatedAt.AsTime(),
+		AuthMethod:          int32(tokenData.AuthMethod),
+		MFA:                 tokenData.Mfa,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to marshal authorization code: "+err.Error())
+	}
+	if err := s.systemStub.Redis.Set(ctx, makeAuthorizationCodeRedisKey(code), codeBytes, authorization_code_ttl).Err(); err != nil {
+		return nil, status.Error(codes.Internal, "failed to save authorization code: "+err.Error())
+	}
+
+	return &grpc.AuthorizeResponse{Status: grpc.AuthorizeResponse_OK, Code: code}, status.Error(codes.OK, "")
+}
+
+// Checks if identity already gave consent for all the scopes. Saves consent if it was granted with this request.
+// Returns scopes that still need consent.
+func (s *OIDCServer) ensureConsent(ctx context.Context, namespace string, identity string, client *clientInMongo, clientID string, scopes []string, consentGranted bool) ([]*grpc.ClientScope, error) {
+	collection := consentCollectionByNamespace(s.systemStub, namespace)
+	filter := bson.M{"identity": identity, "clientId": clientID}
+
+	var consent consentInMongo
+	if err := collection.FindOne(ctx, filter).Decode(&consent); err != nil && err != mongo.ErrNoDocuments {
+		return nil, status.Error(codes.Internal, "failed to get consent from database: "+err.Error())
+	}
+
+	missingScopes := []string{}
+	for _, scope := range scopes {
+		// "openid" only identifies the user and is implied by the authorization itself
+		if scope != SCOPE_OPENID && !slices.Contains(consent.Scopes, scope) {
+			missingScopes = append(missingScopes, scope)
+		}
+	}
+	// Client was never authorized before. Ask for the consent even if it doesnt request any scopes
+	if len(missingScopes) == 0 && consent.ClientID != "" {
+		return nil, nil
+	}
+
+	if consentGranted {
+		now := time.Now().UTC()
+		_, err := collection.UpdateOne(ctx, filter, bson.M{
+			"$addToSet":    bson.M{"scopes": bson.M{"$each": missingScopes}},
+			"$set":         bson.M{"updated": now},
+			"$setOnInsert": bson.M{"created": now},
+		}, options.Update().SetUpsert(true))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to save consent: "+err.Error())
+		}
+		return nil, nil
+	}
+
+	consentScopes := []*grpc.ClientScope{}
+	for _, name := range missingScopes {
+		if clientScope := client.findScope(name); clientScope != nil {
+			consentScopes = append(consentScopes, clientScope.ToGRPCClientScope())
+		} else {
+			consentScopes = append(consentScopes, &grpc.ClientScope{Name: name, Description: reservedScopeDescriptions[name]})
+		}
+	}
+	if len(consentScopes) == 0 {
+		consentScopes = append(consentScopes, &grpc.ClientScope{Name: SCOPE_OPENID, Description: "Identify you"})
+	}
+	return consentScopes, nil
+}
+
+// Creates token for the identity. Token without client scopes doesnt grant access to anything and only identifies the identity (for example, for userinfo endpoint).
+func (s *OIDCServer) createToken(ctx context.Context, namespace string, identity string, scopes []*nativeIAmAuthGRPC.Scope, metadata *tokenMetadata, authMethod nativeIAmPolicyGRPC.AuthMethod, mfa bool) (string, string, error) {
+	metadataBytes, err := json.Marshal(metadata)
+	if err != nil {
+		return "", "", status.Error(codes.Internal, "failed to marshal token metadata: "+err.Error())
+	}
+
+	if len(scopes) != 0 {
+		return s.authServer.CreateTokenForIdentity(ctx, namespace, identity, scopes, string(metadataBytes), authMethod, mfa)
+	}
+
+	// Empty list of scopes means "all the policies of the identity" for the auth service, so token is created directly
+	err = s.authServer.CheckNamespaceCanCreateTokens(ctx, namespace)
+	if err != nil {
+		return "", "", err
+	}
+	identityResponse, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{Namespace: namespace, Uuid: identity, UseCache: false})
+	if err != nil {
+		return "", "", status.Error(codes.Internal, "failed to get identity information: "+err.Error())
+	}
+	if !identityResponse.Identity.Active {
+		return "", "", auth.ErrCreateTokenIdentityNotActive
+	}
+	tokenResponse, err := s.tokenServer.Create(ctx, &nativeIAmTokenGRPC.CreateRequest{
+		Namespace:  namespace,
+		Identity:   identity,
+		Scopes:     []*nativeIAmTokenGRPC.Scope{},
+		Metadata:   string(metadataBytes),
+		AuthMethod: nativeIAmTokenGRPC.AuthMethod(authMethod),
+		Mfa:        mfa,
+	})
+	if err != nil {
+		return "", "", status.Error(codes.Internal, "failed to create token: "+err.Error())
+	}
+	return tokenResponse.Token, tokenResponse.RefreshToken, nil
+}
+
+func createTokenErrorToResponse(err error) (*grpc.ExchangeTokenResponse, error) {
+	if err == auth.ErrCreateTokenNamespaceSuspended {
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_GRANT, Message: "namespace of the identity is suspended"}, status.Error(codes.OK, "")
+	}
+	if err == auth.ErrCreateTokenIdentityNotActive {
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_GRANT, Message: "identity is not active"}, status.Error(codes.OK, "")
+	}
+	if err == auth.ErrCreateTokenUnauthorized {
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_SCOPE, Message: "identity policies dont allow requested scopes"}, status.Error(codes.OK, "")
+	}
+	return nil, err
+}
+
+func (s *OIDCServer) ExchangeToken(ctx context.Context, in *grpc.ExchangeTokenRequest) (*grpc.ExchangeTokenResponse, error) {
+	namespace, uuid := parseClientID(in.ClientId)
+	client, err := s.getClient(ctx, namespace, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil || !client.Enabled {
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_CLIENT, Message: "client doesnt exist or disabled"}, status.Error(codes.OK, "")
+	}
+	if client.Confidential {
+		valid, err := s.verifyClientSecret(ctx, client, in.ClientSecret)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_CLIENT, Message: "client authentication failed"}, status.Error(codes.OK, "")
+		}
+	}
+
+	switch in.GrantType {
+	case "authorization_code":
+		if !client.allowsGrantType(grpc.GrantType_AUTHORIZATION_CODE) {
+			return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_UNAUTHORIZED_CLIENT, Message: "client is not allowed to use authorization code grant"}, status.Error(codes.OK, "")
+		}
+		return s.exchangeAuthorizationCode(ctx, namespace, client, in)
+	case "client_credentials":
+		if !client.Confidential || !client.allowsGrantType(grpc.GrantType_CLIENT_CREDENTIALS) {
+			return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_UNAUTHORIZED_CLIENT, Message: "client is not allowed to use client credentials grant"}, status.Error(codes.OK, "")
+		}
+		return s.exchangeClientCredentials(ctx, namespace, client, in)
+	case "refresh_token":
+		if !client.allowsGrantType(grpc.GrantType_REFRESH_TOKEN) {
+			return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_UNAUTHORIZED_CLIENT, Message: "client is not allowed to use refresh token grant"}, status.Error(codes.OK, "")
+		}
+		return s.exchangeRefreshToken(ctx, in)
+	default:
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_UNSUPPORTED_GRANT_TYPE, Message: "grant type is not supported"}, status.Error(codes.OK, "")
+	}
+}
+
+func (s *OIDCServer) exchangeAuthorizationCode(ctx context.Context, namespace string, client *clientInMongo, in *grpc.ExchangeTokenRequest) (*grpc.ExchangeTokenResponse, error) {
+	if in.Code == "" {
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_REQUEST, Message: "code is required"}, status.Error(codes.OK, "")
+	}
+
+	// Code can be used only once
+	codeBytes, err := s.systemStub.Redis.GetDel(ctx, makeAuthorizationCodeRedisKey(in.Code)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_GRANT, Message: "code is invalid or expired"}, status.Error(codes.OK, "")
+		}
+		return nil, status.Error(codes.Internal, "failed to get authorization code: "+err.Error())
+	}
+	var code authorizationCodeInRedis
+	if err := bson.Unmarshal(codeBytes, &code); err != nil {
+		return nil, status.Error(codes.Internal, "failed to unmarshal authorization code: "+err.Error())
+	}
+
+	if code.ClientID != in.ClientId || code.RedirectURI != in.RedirectURI {
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_GRANT, Message: "code was issued for another client or redirect URI"}, status.Error(codes.OK, "")
+	}
+	if !verifyCodeChallenge(code.CodeChallenge, code.CodeChallengeMethod, in.CodeVerifier) {
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_GRANT, Message: "code verifier doesnt match code challenge"}, status.Error(codes.OK, "")
+	}
+
+	accessToken, refreshToken, err := s.createToken(ctx, namespace, code.Identity, makeAuthScopes(client, namespace, code.Scopes), &tokenMetadata{
+		OIDCClientID: in.ClientId,
+		OIDCScopes:   code.Scopes,
+	}, nativeIAmPolicyGRPC.AuthMethod(code.AuthMethod), code.MFA)
+	if err != nil {
+		return createTokenErrorToResponse(err)
+	}
+
+	response := &grpc.ExchangeTokenResponse{
+		Status:      grpc.ExchangeTokenResponse_OK,
+		AccessToken: accessToken,
+		ExpiresIn:   uint32(token.TOKEN_EXPITE_TIME / time.Second),
+		Scope:       strings.Join(code.Scopes, " "),
+	}
+	if slices.Contains(code.Scopes, SCOPE_OFFLINE_ACCESS) {
+		response.RefreshToken = refreshToken
+	}
+	if slices.Contains(code.Scopes, SCOPE_OPENID) {
+		response.IdToken, err = s.makeIDToken(ctx, namespace, &code, in.Issuer)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) makeIDToken(ctx context.Context, namespace string, code *authorizationCodeInRedis, issuer string) (string, error) {
+	now := time.Now().UTC()
+	claims := goJWT.MapClaims{
+		"iss":       issuer,
+		"sub":       code.Identity,
+		"aud":       code.ClientID,
+		"iat":       now.Unix(),
+		"exp":       now.Add(token.TOKEN_EXPITE_TIME).Unix(),
+		"auth_time": code.AuthTime.Unix(),
+		"namespace": namespace,
+		"token_use": token.IDTokenUse,
+	}
+	if code.Nonce != "" {
+		claims["nonce"] = code.Nonce
+	}
+	if slices.Contains(code.Scopes, SCOPE_PROFILE) {
+		identityResponse, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{Namespace: namespace, Uuid: code.Identity, UseCache: true})
+		if err != nil {
+			return "", status.Error(codes.Internal, "failed to get identity information: "+err.Error())
+		}
+		claims["name"] = identityResponse.Identity.Name
+	}
+
+	return s.tokenServer.SignClaims(ctx, claims)
+}
+
+func (s *OIDCServer) exchangeClientCredentials(ctx context.Context, namespace string, client *clientInMongo, in *grpc.ExchangeTokenRequest) (*grpc.ExchangeTokenResponse, error) {
+	scopes, unknownScope := parseScopes(client, in.Scope)
+	if unknownScope != "" {
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_SCOPE, Message: "scope [" + unknownScope + "] is not defined for the client"}, status.Error(codes.OK, "")
+	}
+	for _, scope := range scopes {
+		if isReservedScope(scope) {
+			return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_SCOPE, Message: "scope [" + scope + "] cant be used with client credentials grant"}, status.Error(codes.OK, "")
+		}
+	}
+	// Client gets all its scopes by default
+	if len(scopes) == 0 {
+		for _, clientScope := range client.Scopes {
+			scopes = append(scopes, clientScope.Name)
+		}
+	}
+
+	accessToken, _, err := s.createToken(ctx, namespace, client.Identity, makeAuthScopes(client, namespace, scopes), &tokenMetadata{
+		OIDCClientID: in.ClientId,
+		OIDCScopes:   scopes,
+	}, nativeIAmPolicyGRPC.AuthMethod_OAUTH2_CLIENT, false)
+	if err != nil {
+		return createTokenErrorToResponse(err)
+	}
+
+	return &grpc.ExchangeTokenResponse{
+		Status:      grpc.ExchangeTokenResponse_OK,
+		AccessToken: accessToken,
+		ExpiresIn:   uint32(token.TOKEN_EXPITE_TIME / time.Second),
+		Scope:       strings.Join(scopes, " "),
+	}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) exchangeRefreshToken(ctx context.Context, in *grpc.ExchangeTokenRequest) (*grpc.ExchangeTokenResponse, error) {
+	if in.RefreshToken == "" {
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_REQUEST, Message: "refresh token is required"}, status.Error(codes.OK, "")
+	}
+
+	getResponse, err := s.tokenServer.RawGet(ctx, &nativeIAmTokenGRPC.RawGetRequest{Token: in.RefreshToken, UseCache: false})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && (st.Code() == codes.InvalidArgument || st.Code() == codes.NotFound) {
+			return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_GRANT, Message: "refresh token is invalid"}, status.Error(codes.OK, "")
+		}
+		return nil, err
+	}
+
+	// Refresh token can be used only by the client it was issued to
+	var metadata tokenMetadata
+	if err := json.Unmarshal([]byte(getResponse.TokenData.CreationMetadata), &metadata); err != nil || metadata.OIDCClientID != in.ClientId {
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_GRANT, Message: "refresh token was issued to another client"}, status.Error(codes.OK, "")
+	}
+
+	refreshResponse, err := s.authServer.RefreshToken(ctx, &nativeIAmAuthGRPC.RefreshTokenRequest{RefreshToken: in.RefreshToken})
+	if err != nil {
+		if st, ok := status.FromError(err); !ok || st.Code() != codes.OK {
+			return nil, err
+		}
+	}
+	if refreshResponse.Status != nativeIAmAuthGRPC.RefreshTokenResponse_OK {
+		return &grpc.ExchangeTokenResponse{Status: grpc.ExchangeTokenResponse_INVALID_GRANT, Message: "failed to refresh token: " + refreshResponse.Status.String()}, status.Error(codes.OK, "")
+	}
+
+	return &grpc.ExchangeTokenResponse{
+		Status:       grpc.ExchangeTokenResponse_OK,
+		AccessToken:  refreshResponse.AccessToken,
+		RefreshToken: refreshResponse.RefreshToken,
+		ExpiresIn:    uint32(token.TOKEN_EXPITE_TIME / time.Second),
+		Scope:        strings.Join(metadata.OIDCScopes, " "),
+	}, status.Error(codes.OK, "")
+}
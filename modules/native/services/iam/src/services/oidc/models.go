@@ -0,0 +1,148 @@
+package oidc
+
+import (
+	"strings"
+	"time"
+
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/oidc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type clientScopeInMongo struct {
+	Name                 string   `bson:"name"`
+	Description          string   `bson:"description"`
+	Resources            []string `bson:"resources"`
+	Actions              []string `bson:"actions"`
+	NamespaceIndependent bool     `bson:"namespaceIndependent"`
+}
+
+func (s *clientScopeInMongo) ToGRPCClientScope() *grpc.ClientScope {
+	return &grpc.ClientScope{
+		Name:                 s.Name,
+		Description:          s.Description,
+		Resources:            s.Resources,
+		Actions:              s.Actions,
+		NamespaceIndependent: s.NamespaceIndependent,
+	}
+}
+
+type clientInMongo struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Name        string             `bson:"name"`
+	Description string             `bson:"description"`
+	Enabled     bool               `bson:"enabled"`
+
+	Confidential bool `bson:"confidential"`
+	// Salted HMAC of the client secret. Empty for public clients
+	SecretSalt []byte `bson:"secretSalt"`
+	SecretHash []byte `bson:"secretHash"`
+
+	RedirectURIs []string             `bson:"redirectURIs"`
+	GrantTypes   []string             `bson:"grantTypes"`
+	Scopes       []clientScopeInMongo `bson:"scopes"`
+	Identity     string               `bson:"identity"`
+	SkipConsent  bool                 `bson:"skipConsent"`
+
+	Created time.Time `bson:"created"`
+	Updated time.Time `bson:"updated"`
+	Version uint64    `bson:"version"`
+}
+
+func (c *clientInMongo) allowsGrantType(grantType grpc.GrantType) bool {
+	for _, allowed := range c.GrantTypes {
+		if allowed == grantType.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *clientInMongo) findScope(name string) *clientScopeInMongo {
+	for index := range c.Scopes {
+		if c.Scopes[index].Name == name {
+			return &c.Scopes[index]
+		}
+	}
+	return nil
+}
+
+func (c *clientInMongo) ToGRPCClient(namespace string) *grpc.Client {
+	grantTypes := make([]grpc.GrantType, len(c.GrantTypes))
+	for index, grantType := range c.GrantTypes {
+		grantTypes[index] = grpc.GrantType(grpc.GrantType_value[grantType])
+	}
+	scopes := make([]*grpc.ClientScope, len(c.Scopes))
+	for index := range c.Scopes {
+		scopes[index] = c.Scopes[index].ToGRPCClientScope()
+	}
+
+	return &grpc.Client{
+		Namespace:    namespace,
+		Uuid:         c.ID.Hex(),
+		ClientId:     makeClientID(namespace, c.ID.Hex()),
+		Name:         c.Name,
+		Description:  c.Description,
+		Enabled:      c.Enabled,
+		Confidential: c.Confidential,
+		RedirectURIs: c.RedirectURIs,
+		GrantTypes:   grantTypes,
+		Scopes:       scopes,
+		Identity:     c.Identity,
+		SkipConsent:  c.SkipConsent,
+		Created:      timestamppb.New(c.Created),
+		Updated:      timestamppb.New(c.Updated),
+		Version:      c.Version,
+	}
+}
+
+type consentInMongo struct {
+	Identity string    `bson:"identity"`
+	ClientID string    `bson:"clientId"`
+	Scopes   []string  `bson:"scopes"`
+	Created  time.Time `bson:"created"`
+	Updated  time.Time `bson:"updated"`
+}
+
+func (c *consentInMongo) ToGRPCConsent(namespace string) *grpc.Consent {
+	return &grpc.Consent{
+		Namespace: namespace,
+		Identity:  c.Identity,
+		ClientId:  c.ClientID,
+		Scopes:    c.Scopes,
+		Created:   timestamppb.New(c.Created),
+		Updated:   timestamppb.New(c.Updated),
+	}
+}
+
+// Authorization code data. Stored in redis until it is exchanged for the token or expires
+type authorizationCodeInRedis struct {
+	Namespace           string   `bson:"namespace"`
+	ClientID            string   `bson:"clientId"`
+	Identity            string   `bson:"identity"`
+	RedirectURI         string   `bson:"redirectURI"`
+	Scopes              []string `bson:"scopes"`
+	CodeChallenge       string   `bson:"codeChallenge"`
+	CodeChallengeMethod string   `bson:"codeChallengeMethod"`
+	Nonce               string   `bson:"nonce"`
+	// Time when identity authenticated (created token that was used for the authorization)
+	AuthTime   time.Time `bson:"authTime"`
+	AuthMethod int32     `bson:"authMethod"`
+	MFA        bool      `bson:"mfa"`
+}
+
+// Client ID contains namespace of the client, so the client can be found without knowing its namespace
+func makeClientID(namespace string, uuid string) string {
+	if namespace == "" {
+		return uuid
+	}
+	return namespace + "." + uuid
+}
+
+func parseClientID(clientID string) (string, string) {
+	namespace, uuid, found := strings.Cut(clientID, ".")
+	if !found {
+		return "", clientID
+	}
+	return namespace, uuid
+}
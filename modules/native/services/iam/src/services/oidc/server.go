@@ -0,0 +1,440 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/oidc"
+	nativeNamespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/auth"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/identity"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/token"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
+)
+
+const (
+	SCOPE_OPENID         = "openid"
+	SCOPE_PROFILE        = "profile"
+	SCOPE_OFFLINE_ACCESS = "offline_access"
+
+	client_secret_length      = 32
+	client_secret_salt_length = 32
+)
+
+var reservedScopes = []string{SCOPE_OPENID, SCOPE_PROFILE, SCOPE_OFFLINE_ACCESS}
+
+type OIDCServer struct {
+	grpc.UnimplementedIAMOIDCServiceServer
+
+	systemStub     *system.SystemStub
+	nativeStub     *native.NativeStub
+	identityServer *identity.IAmIdentityServer
+	tokenServer    *token.IAmTokenServer
+	authServer     *auth.IAmAuthServer
+}
+
+func NewOIDCServer(ctx context.Context, systemStub *system.SystemStub, nativeStub *native.NativeStub, identityServer *identity.IAmIdentityServer, tokenServer *token.IAmTokenServer, authServer *auth.IAmAuthServer) (*OIDCServer, error) {
+	err := EnsureIndexesForNamespace(ctx, "", systemStub)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to ensure indexes for global namespace"), err)
+	}
+
+	return &OIDCServer{
+		systemStub:     systemStub,
+		nativeStub:     nativeStub,
+		identityServer: identityServer,
+		tokenServer:    tokenServer,
+		authServer:     authServer,
+	}, nil
+}
+
+func isReservedScope(name string) bool {
+	for _, reserved := range reservedScopes {
+		if reserved == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns nil if client doesnt exist
+func (s *OIDCServer) getClient(ctx context.Context, namespace string, uuid string) (*clientInMongo, error) {
+	id, err := primitive.ObjectIDFromHex(uuid)
+	if err != nil {
+		return nil, nil
+	}
+
+	var client clientInMongo
+	if err := clientCollectionByNamespace(s.systemStub, namespace).FindOne(ctx, bson.M{"_id": id}).Decode(&client); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return nil, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load client from database: %v", err)
+	}
+	return &client, nil
+}
+
+func (s *OIDCServer) validateClientParameters(ctx context.Context, namespace string, parameters *grpc.ClientParameters) error {
+	if parameters == nil {
+		return status.Error(codes.InvalidArgument, "parameters must not be empty")
+	}
+	if parameters.Name == "" {
+		return status.Error(codes.InvalidArgument, "name must not be empty")
+	}
+
+	for _, redirectURI := range parameters.RedirectURIs {
+		u, err := url.Parse(redirectURI)
+		if err != nil || !u.IsAbs() || u.Fragment != "" {
+			return status.Errorf(codes.InvalidArgument, "redirect URI [%s] must be absolute URL without fragment", redirectURI)
+		}
+	}
+
+	if len(parameters.GrantTypes) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one grant type must be allowed")
+	}
+	for _, grantType := range parameters.GrantTypes {
+		switch grantType {
+		case grpc.GrantType_AUTHORIZATION_CODE:
+			if len(parameters.RedirectURIs) == 0 {
+				return status.Error(codes.InvalidArgument, "at least one redirect URI is required for the authorization code grant")
+			}
+		case grpc.GrantType_CLIENT_CREDENTIALS:
+			if !parameters.Confidential {
+				return status.Error(codes.InvalidArgument, "only confidential clients can use client credentials grant")
+			}
+			if parameters.Identity == "" {
+				return status.Error(codes.InvalidArgument, "identity is required for the client credentials grant")
+			}
+		case grpc.GrantType_REFRESH_TOKEN:
+		default:
+			return status.Errorf(codes.InvalidArgument, "unknown grant type [%d]", grantType)
+		}
+	}
+
+	names := map[string]struct{}{}
+	for _, scope := range parameters.Scopes {
+		if scope.Name == "" || strings.ContainsAny(scope.Name, " \t\n\"\\") {
+			return status.Errorf(codes.InvalidArgument, "scope name [%s] must not be empty and must not contain whitespaces, quotes or backslashes", scope.Name)
+		}
+		if isReservedScope(scope.Name) {
+			return status.Errorf(codes.InvalidArgument, "scope name [%s] is reserved", scope.Name)
+		}
+		if _, ok := names[scope.Name]; ok {
+			return status.Errorf(codes.InvalidArgument, "scope [%s] is defined multiple times", scope.Name)
+		}
+		names[scope.Name] = struct{}{}
+	}
+
+	if parameters.Identity != "" {
+		_, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{Namespace: namespace, Uuid: parameters.Identity, UseCache: true})
+		if err != nil {
+			if st, ok := status.FromError(err); ok && (st.Code() == codes.NotFound || st.Code() == codes.InvalidArgument) {
+				return status.Error(codes.InvalidArgument, "identity of the client doesnt exist")
+			}
+			return status.Error(codes.Internal, "failed to check if identity exist: "+err.Error())
+		}
+	}
+
+	return nil
+}
+
+func clientFieldsFromParameters(parameters *grpc.ClientParameters) bson.M {
+	grantTypes := make([]string, 0, len(parameters.GrantTypes))
+	for _, grantType := range parameters.GrantTypes {
+		grantTypes = append(grantTypes, grantType.String())
+	}
+	scopes := make([]clientScopeInMongo, 0, len(parameters.Scopes))
+	for _, scope := range parameters.Scopes {
+		scopes = append(scopes, clientScopeInMongo{
+			Name:                 scope.Name,
+			Description:          scope.Description,
+			Resources:            scope.Resources,
+			Actions:              scope.Actions,
+			NamespaceIndependent: scope.NamespaceIndependent,
+		})
+	}
+	redirectURIs := parameters.RedirectURIs
+	if redirectURIs == nil {
+		redirectURIs = []string{}
+	}
+
+	return bson.M{
+		"name":         parameters.Name,
+		"description":  parameters.Description,
+		"enabled":      parameters.Enabled,
+		"confidential": parameters.Confidential,
+		"redirectURIs": redirectURIs,
+		"grantTypes":   grantTypes,
+		"scopes":       scopes,
+		"identity":     parameters.Identity,
+		"skipConsent":  parameters.SkipConsent,
+	}
+}
+
+// Generates new random client secret and its salted hash
+func (s *OIDCServer) generateClientSecret(ctx context.Context) (string, []byte, []byte, error) {
+	secretBytes := make([]byte, client_secret_length)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", nil, nil, status.Error(codes.Internal, "failed to generate client secret: "+err.Error())
+	}
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+
+	salt := make([]byte, client_secret_salt_length)
+	if _, err := rand.Read(salt); err != nil {
+		return "", nil, nil, status.Error(codes.Internal, "failed to generate salt: "+err.Error())
+	}
+
+	signResponse, err := s.systemStub.Vault.HMACSign(ctx, &vault.HMACSignRequest{
+		Data: append(append([]byte{}, salt...), secret...),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			return "", nil, nil, status.Error(codes.FailedPrecondition, "The vault is sealed. Message from system_vault: "+err.Error())
+		}
+		return "", nil, nil, status.Error(codes.Internal, "failed to hash client secret: "+err.Error())
+	}
+
+	return secret, salt, signResponse.Signature, nil
+}
+
+// Checks if client secret matches salted hash of the client
+func (s *OIDCServer) verifyClientSecret(ctx context.Context, client *clientInMongo, secret string) (bool, error) {
+	if len(client.SecretHash) == 0 || secret == "" {
+		return false, nil
+	}
+
+	verifyResponse, err := s.systemStub.Vault.HMACVerify(ctx, &vault.HMACVerifyRequest{
+		Data:      append(append([]byte{}, client.SecretSalt...), secret...),
+		Signature: client.SecretHash,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			return false, status.Error(codes.FailedPrecondition, "The vault is sealed. Message from system_vault: "+err.Error())
+		}
+		return false, status.Error(codes.Internal, "failed to verify client secret: "+err.Error())
+	}
+	return verifyResponse.Valid, nil
+}
+
+func (s *OIDCServer) CreateClient(ctx context.Context, in *grpc.CreateClientRequest) (*grpc.CreateClientResponse, error) {
+	if in.Namespace != "" {
+		namespaceExistResponse, err := s.nativeStub.Services.Namespace.Exists(ctx, &nativeNamespaceGRPC.IsNamespaceExistRequest{Name: in.Namespace, UseCache: true})
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to check if namespace exist: "+err.Error())
+		}
+		if !namespaceExistResponse.Exist {
+			return nil, status.Error(codes.FailedPrecondition, "namespace doesnt exist")
+		}
+	}
+	if err := s.validateClientParameters(ctx, in.Namespace, in.Parameters); err != nil {
+		return nil, err
+	}
+
+	fields := clientFieldsFromParameters(in.Parameters)
+	var secret string
+	if in.Parameters.Confidential {
+		var err error
+		secret, fields["secretSalt"], fields["secretHash"], err = s.generateClientSecret(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	creationTime := time.Now().UTC()
+	fields["created"] = creationTime
+	fields["updated"] = creationTime
+	fields["version"] = 0
+
+	insertResult, err := clientCollectionByNamespace(s.systemStub, in.Namespace).InsertOne(ctx, fields)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to insert client to the database: %v", err)
+	}
+
+	client, err := s.getClient(ctx, in.Namespace, insertResult.InsertedID.(primitive.ObjectID).Hex())
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, status.Error(codes.Internal, "client was deleted right after creation")
+	}
+
+	return &grpc.CreateClientResponse{Client: client.ToGRPCClient(in.Namespace), ClientSecret: secret}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) UpdateClient(ctx context.Context, in *grpc.UpdateClientRequest) (*grpc.UpdateClientResponse, error) {
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "client not found")
+	}
+	if err := s.validateClientParameters(ctx, in.Namespace, in.Parameters); err != nil {
+		return nil, err
+	}
+
+	fields := clientFieldsFromParameters(in.Parameters)
+	fields["updated"] = time.Now().UTC()
+	update := bson.M{"$set": fields, "$inc": bson.M{"version": 1}}
+	if !in.Parameters.Confidential {
+		// Public clients dont have secrets. If client becomes confidential again, secret must be regenerated
+		update["$unset"] = bson.M{"secretSalt": "", "secretHash": ""}
+	}
+
+	var client clientInMongo
+	err = clientCollectionByNamespace(s.systemStub, in.Namespace).FindOneAndUpdate(ctx, bson.M{"_id": id}, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&client)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(codes.NotFound, "client not found")
+		}
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return nil, status.Error(codes.NotFound, "client not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update client: %v", err)
+	}
+
+	return &grpc.UpdateClientResponse{Client: client.ToGRPCClient(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) RegenerateClientSecret(ctx context.Context, in *grpc.RegenerateClientSecretRequest) (*grpc.RegenerateClientSecretResponse, error) {
+	client, err := s.getClient(ctx, in.Namespace, in.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, status.Error(codes.NotFound, "client not found")
+	}
+	if !client.Confidential {
+		return nil, status.Error(codes.FailedPrecondition, "public clients dont have secrets")
+	}
+
+	secret, salt, hash, err := s.generateClientSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := clientCollectionByNamespace(s.systemStub, in.Namespace).UpdateOne(ctx, bson.M{"_id": client.ID}, bson.M{
+		"$set": bson.M{"secretSalt": salt, "secretHash": hash, "updated": time.Now().UTC()},
+		"$inc": bson.M{"version": 1},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update client secret: %v", err)
+	}
+	if result.MatchedCount == 0 {
+		return nil, status.Error(codes.NotFound, "client not found")
+	}
+
+	return &grpc.RegenerateClientSecretResponse{ClientSecret: secret}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) GetClient(ctx context.Context, in *grpc.GetClientRequest) (*grpc.GetClientResponse, error) {
+	client, err := s.getClient(ctx, in.Namespace, in.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, status.Error(codes.NotFound, "client not found")
+	}
+
+	return &grpc.GetClientResponse{Client: client.ToGRPCClient(in.Namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) GetClientByClientId(ctx context.Context, in *grpc.GetClientByClientIdRequest) (*grpc.GetClientByClientIdResponse, error) {
+	namespace, uuid := parseClientID(in.ClientId)
+	client, err := s.getClient(ctx, namespace, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, status.Error(codes.NotFound, "client not found")
+	}
+
+	return &grpc.GetClientByClientIdResponse{Client: client.ToGRPCClient(namespace)}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) ListClients(ctx context.Context, in *grpc.ListClientsRequest) (*grpc.ListClientsResponse, error) {
+	cursor, err := clientCollectionByNamespace(s.systemStub, in.Namespace).Find(ctx, bson.M{})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return &grpc.ListClientsResponse{Clients: []*grpc.Client{}}, status.Error(codes.OK, "")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list clients: %v", err)
+	}
+	var clients []clientInMongo
+	if err := cursor.All(ctx, &clients); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode clients: %v", err)
+	}
+
+	result := make([]*grpc.Client, len(clients))
+	for index := range clients {
+		result[index] = clients[index].ToGRPCClient(in.Namespace)
+	}
+	return &grpc.ListClientsResponse{Clients: result}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) DeleteClient(ctx context.Context, in *grpc.DeleteClientRequest) (*grpc.DeleteClientResponse, error) {
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return &grpc.DeleteClientResponse{Existed: false}, status.Error(codes.OK, "")
+	}
+
+	result, err := clientCollectionByNamespace(s.systemStub, in.Namespace).DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return &grpc.DeleteClientResponse{Existed: false}, status.Error(codes.OK, "")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete client: %v", err)
+	}
+
+	_, err = consentCollectionByNamespace(s.systemStub, in.Namespace).DeleteMany(ctx, bson.M{"clientId": makeClientID(in.Namespace, in.Uuid)})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete consents of the client: %v", err)
+	}
+
+	return &grpc.DeleteClientResponse{Existed: result.DeletedCount != 0}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) ListConsents(ctx context.Context, in *grpc.ListConsentsRequest) (*grpc.ListConsentsResponse, error) {
+	cursor, err := consentCollectionByNamespace(s.systemStub, in.Namespace).Find(ctx, bson.M{"identity": in.Identity})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return &grpc.ListConsentsResponse{Consents: []*grpc.Consent{}}, status.Error(codes.OK, "")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list consents: %v", err)
+	}
+	var consents []consentInMongo
+	if err := cursor.All(ctx, &consents); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode consents: %v", err)
+	}
+
+	result := make([]*grpc.Consent, len(consents))
+	for index := range consents {
+		result[index] = consents[index].ToGRPCConsent(in.Namespace)
+	}
+	return &grpc.ListConsentsResponse{Consents: result}, status.Error(codes.OK, "")
+}
+
+func (s *OIDCServer) RevokeConsent(ctx context.Context, in *grpc.RevokeConsentRequest) (*grpc.RevokeConsentResponse, error) {
+	result, err := consentCollectionByNamespace(s.systemStub, in.Namespace).DeleteOne(ctx, bson.M{"identity": in.Identity, "clientId": in.ClientId})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return &grpc.RevokeConsentResponse{Existed: false}, status.Error(codes.OK, "")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke consent: %v", err)
+	}
+
+	return &grpc.RevokeConsentResponse{Existed: result.DeletedCount != 0}, status.Error(codes.OK, "")
+}
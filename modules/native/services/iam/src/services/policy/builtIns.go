@@ -21,6 +21,7 @@ func newEmptyPolicy() *policyInMongo {
 		NamespaceIndependent: false,
 		Resources:            []string{},
 		Actions:              []string{},
+		Effect:               policy_effect_allow,
 		Tags:                 []string{},
 		Created:              creationTime,
 		Updated:              creationTime,
@@ -37,6 +38,7 @@ func newNamespaceRootPolicy() *policyInMongo {
 		NamespaceIndependent: false,
 		Resources:            []string{"*"},
 		Actions:              []string{"*"},
+		Effect:               policy_effect_allow,
 		Tags:                 []string{},
 		Created:              creationTime,
 		Updated:              creationTime,
@@ -53,6 +55,7 @@ func newGlobalRootPolicy() *policyInMongo {
 		NamespaceIndependent: true,
 		Resources:            []string{"*"},
 		Actions:              []string{"*"},
+		Effect:               policy_effect_allow,
 		Tags:                 []string{},
 		Created:              creationTime,
 		Updated:              creationTime,
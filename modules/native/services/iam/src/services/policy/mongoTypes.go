@@ -15,6 +15,11 @@ const (
 	policy_managed_service  = "SERVICE"
 )
 
+const (
+	policy_effect_allow = "ALLOW"
+	policy_effect_deny  = "DENY"
+)
+
 const (
 	policy_builtin_global_root    = "GLOBAL_ROOT"
 	policy_builtin_namespace_root = "NAMESPACE_ROOT"
@@ -52,6 +57,9 @@ type policyInMongo struct {
 	Resources            []string `bson:"resources"`
 	Actions              []string `bson:"actions"`
 
+	Effect     string                   `bson:"effect"`
+	Conditions *policyConditionsInMongo `bson:"conditions,omitempty"`
+
 	Tags []string `bson:"tags"`
 
 	Created time.Time `bson:"created"`
@@ -59,6 +67,101 @@ type policyInMongo struct {
 	Version uint64    `bson:"version"`
 }
 
+type timeWindowInMongo struct {
+	NotBefore        *time.Time `bson:"notBefore,omitempty"`
+	NotAfter         *time.Time `bson:"notAfter,omitempty"`
+	DailyStartMinute uint32     `bson:"dailyStartMinute"`
+	DailyEndMinute   uint32     `bson:"dailyEndMinute"`
+	Timezone         string     `bson:"timezone"`
+}
+
+type policyConditionsInMongo struct {
+	SourceIPs   []string           `bson:"sourceIPs"`
+	TimeWindow  *timeWindowInMongo `bson:"timeWindow,omitempty"`
+	AuthMethods []string           `bson:"authMethods"`
+	RequireMFA  bool               `bson:"requireMFA"`
+}
+
+func effectFromGRPC(effect nativeIAmPolicyGRPC.PolicyEffect) string {
+	if effect == nativeIAmPolicyGRPC.PolicyEffect_DENY {
+		return policy_effect_deny
+	}
+	return policy_effect_allow
+}
+
+func effectToGRPC(effect string) nativeIAmPolicyGRPC.PolicyEffect {
+	if effect == policy_effect_deny {
+		return nativeIAmPolicyGRPC.PolicyEffect_DENY
+	}
+	// Policies created before effects were introduced dont have this field and are always allowing
+	return nativeIAmPolicyGRPC.PolicyEffect_ALLOW
+}
+
+func conditionsFromGRPC(conditions *nativeIAmPolicyGRPC.PolicyConditions) *policyConditionsInMongo {
+	if conditions == nil {
+		return nil
+	}
+
+	result := &policyConditionsInMongo{
+		SourceIPs:   conditions.SourceIPs,
+		AuthMethods: make([]string, 0, len(conditions.AuthMethods)),
+		RequireMFA:  conditions.RequireMFA,
+	}
+	if result.SourceIPs == nil {
+		result.SourceIPs = []string{}
+	}
+	for _, method := range conditions.AuthMethods {
+		result.AuthMethods = append(result.AuthMethods, method.String())
+	}
+	if window := conditions.TimeWindow; window != nil {
+		result.TimeWindow = &timeWindowInMongo{
+			DailyStartMinute: window.DailyStartMinute,
+			DailyEndMinute:   window.DailyEndMinute,
+			Timezone:         window.Timezone,
+		}
+		if window.NotBefore != nil {
+			notBefore := window.NotBefore.AsTime()
+			result.TimeWindow.NotBefore = &notBefore
+		}
+		if window.NotAfter != nil {
+			notAfter := window.NotAfter.AsTime()
+			result.TimeWindow.NotAfter = &notAfter
+		}
+	}
+
+	return result
+}
+
+func (c *policyConditionsInMongo) ToGRPCConditions() *nativeIAmPolicyGRPC.PolicyConditions {
+	if c == nil {
+		return nil
+	}
+
+	result := &nativeIAmPolicyGRPC.PolicyConditions{
+		SourceIPs:   c.SourceIPs,
+		AuthMethods: make([]nativeIAmPolicyGRPC.AuthMethod, 0, len(c.AuthMethods)),
+		RequireMFA:  c.RequireMFA,
+	}
+	for _, method := range c.AuthMethods {
+		result.AuthMethods = append(result.AuthMethods, nativeIAmPolicyGRPC.AuthMethod(nativeIAmPolicyGRPC.AuthMethod_value[method]))
+	}
+	if window := c.TimeWindow; window != nil {
+		result.TimeWindow = &nativeIAmPolicyGRPC.TimeWindow{
+			DailyStartMinute: window.DailyStartMinute,
+			DailyEndMinute:   window.DailyEndMinute,
+			Timezone:         window.Timezone,
+		}
+		if window.NotBefore != nil {
+			result.TimeWindow.NotBefore = timestamppb.New(*window.NotBefore)
+		}
+		if window.NotAfter != nil {
+			result.TimeWindow.NotAfter = timestamppb.New(*window.NotAfter)
+		}
+	}
+
+	return result
+}
+
 func ManagedTypeToGRPC(roleType string) nativeIAmPolicyGRPC.BuiltInPolicyType {
 	switch roleType {
 	case policy_builtin_global_root:
@@ -82,6 +185,8 @@ func (p *policyInMongo) ToGRPCPolicy(namespace string) *nativeIAmPolicyGRPC.Poli
 		NamespaceIndependent: p.NamespaceIndependent,
 		Resources:            p.Resources,
 		Actions:              p.Actions,
+		Effect:               effectToGRPC(p.Effect),
+		Conditions:           p.Conditions.ToGRPCConditions(),
 		Tags:                 p.Tags,
 		Created:              timestamppb.New(p.Created),
 		Updated:              timestamppb.New(p.Updated),
@@ -18,6 +18,7 @@ import (
 
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
 
 	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
 	nativeNamespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
@@ -28,8 +29,9 @@ type IAMPolicyServer struct {
 
 	mongoGlobalCollection *mongo.Collection
 
-	systemStub *system.SystemStub
-	nativeStub *native.NativeStub
+	systemStub     *system.SystemStub
+	nativeStub     *native.NativeStub
+	auditPublisher *audit.Publisher
 }
 
 const (
@@ -67,11 +69,17 @@ func NewIAMPolicyServer(ctx context.Context, systemStub *system.SystemStub, nati
 		return nil, errors.New("Failed to initialize builtin policies for global namespace. " + err.Error())
 	}
 
+	auditPublisher, err := audit.NewPublisher(systemStub.Nats, "native_iam")
+	if err != nil {
+		return nil, errors.New("Failed to create audit publisher. " + err.Error())
+	}
+
 	mongoGlobalCollection := systemStub.DB.Database("openbp_global").Collection("native_iam_policy")
 	return &IAMPolicyServer{
 		mongoGlobalCollection: mongoGlobalCollection,
 		systemStub:            systemStub,
 		nativeStub:            nativeStub,
+		auditPublisher:        auditPublisher,
 	}, nil
 }
 
@@ -80,6 +88,10 @@ func (s *IAMPolicyServer) Create(ctx context.Context, in *nativeIAmPolicyGRPC.Cr
 	if err != nil {
 		return nil, err
 	}
+	err = validatePolicyConditions(in.Conditions)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check if namespace really exist
 	if in.Namespace != "" {
@@ -118,6 +130,9 @@ func (s *IAMPolicyServer) Create(ctx context.Context, in *nativeIAmPolicyGRPC.Cr
 		Actions:              in.Actions,
 		Resources:            in.Resources,
 
+		Effect:     effectFromGRPC(in.Effect),
+		Conditions: conditionsFromGRPC(in.Conditions),
+
 		Tags: []string{},
 
 		Created: creationTime,
@@ -155,6 +170,12 @@ func (s *IAMPolicyServer) Create(ctx context.Context, in *nativeIAmPolicyGRPC.Cr
 	}
 
 	s.systemStub.Cache.Remove(ctx, makeCountPolicyCacheKey(in.Namespace))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:    in.Namespace,
+		Resource:     "native.iam.policy." + policy.UUID.Hex(),
+		Action:       "native.iam.policy.create",
+		AfterVersion: policy.Version,
+	})
 
 	return &nativeIAmPolicyGRPC.CreatePolicyResponse{
 		Policy: policy.ToGRPCPolicy(in.Namespace),
@@ -382,6 +403,10 @@ func (s *IAMPolicyServer) Update(ctx context.Context, in *nativeIAmPolicyGRPC.Up
 	if err != nil {
 		return nil, status.Error(grpccodes.InvalidArgument, "Bad UUID format")
 	}
+	err = validatePolicyConditions(in.Conditions)
+	if err != nil {
+		return nil, err
+	}
 
 	collection := s.collectionByNamespace(in.Namespace)
 	updateQuery := bson.M{
@@ -391,6 +416,8 @@ func (s *IAMPolicyServer) Update(ctx context.Context, in *nativeIAmPolicyGRPC.Up
 			"namespaceIndependent": in.NamespaceIndependent,
 			"resources":            in.Resources,
 			"actions":              in.Actions,
+			"effect":               effectFromGRPC(in.Effect),
+			"conditions":           conditionsFromGRPC(in.Conditions),
 		},
 		"$currentDate": bson.M{"updated": bson.M{"$type": "timestamp"}},
 		"$inc":         bson.M{"version": 1},
@@ -411,6 +438,13 @@ func (s *IAMPolicyServer) Update(ctx context.Context, in *nativeIAmPolicyGRPC.Up
 	}
 
 	s.systemStub.Cache.Remove(ctx, makePolicyCacheKey(in.Namespace, in.Uuid))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.policy." + in.Uuid,
+		Action:        "native.iam.policy.update",
+		BeforeVersion: policy.Version - 1,
+		AfterVersion:  policy.Version,
+	})
 
 	return &nativeIAmPolicyGRPC.UpdatePolicyResponse{
 		Policy: policy.ToGRPCPolicy(in.Namespace),
@@ -424,16 +458,24 @@ func (s *IAMPolicyServer) Delete(ctx context.Context, in *nativeIAmPolicyGRPC.De
 	}
 
 	collection := s.collectionByNamespace(in.Namespace)
-	r, err := collection.DeleteOne(ctx, bson.M{"_id": id})
+	var deletedPolicy policyInMongo
+	err = collection.FindOneAndDelete(ctx, bson.M{"_id": id}).Decode(&deletedPolicy)
 	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return &nativeIAmPolicyGRPC.DeletePolicyResponse{Existed: false}, status.Error(grpccodes.OK, "")
+		}
 		return nil, status.Error(grpccodes.Internal, err.Error())
 	}
 
-	if r.DeletedCount != 0 {
-		s.systemStub.Cache.Remove(ctx, makePolicyCacheKey(in.Namespace, in.Uuid), makeCountPolicyCacheKey(in.Namespace))
-	}
+	s.systemStub.Cache.Remove(ctx, makePolicyCacheKey(in.Namespace, in.Uuid), makeCountPolicyCacheKey(in.Namespace))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.policy." + in.Uuid,
+		Action:        "native.iam.policy.delete",
+		BeforeVersion: deletedPolicy.Version,
+	})
 
-	return &nativeIAmPolicyGRPC.DeletePolicyResponse{Existed: r.DeletedCount != 0}, status.Error(grpccodes.OK, "")
+	return &nativeIAmPolicyGRPC.DeletePolicyResponse{Existed: true}, status.Error(grpccodes.OK, "")
 }
 
 func (s *IAMPolicyServer) List(in *nativeIAmPolicyGRPC.ListPoliciesRequest, out nativeIAmPolicyGRPC.IAMPolicyService_ListServer) error {
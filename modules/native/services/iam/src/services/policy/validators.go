@@ -1,7 +1,14 @@
 package policy
 
 import (
+	"net"
 	"regexp"
+	"strings"
+	"time"
+	// Service runs in the scratch container without timezone database. Timezones are required for policy time windows.
+	_ "time/tzdata"
+
+	nativeIAmPolicyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -34,3 +41,41 @@ func validatePolicyData(name string, description string, actions []string, resou
 
 	return nil
 }
+
+const minutesInDay = 24 * 60
+
+func validatePolicyConditions(conditions *nativeIAmPolicyGRPC.PolicyConditions) error {
+	if conditions == nil {
+		return nil
+	}
+
+	for _, sourceIP := range conditions.SourceIPs {
+		if strings.Contains(sourceIP, "/") {
+			if _, _, err := net.ParseCIDR(sourceIP); err != nil {
+				return status.Error(codes.InvalidArgument, "Policy condition source IP ["+sourceIP+"] is not a valid CIDR network")
+			}
+		} else if net.ParseIP(sourceIP) == nil {
+			return status.Error(codes.InvalidArgument, "Policy condition source IP ["+sourceIP+"] is not a valid IP address")
+		}
+	}
+
+	for _, method := range conditions.AuthMethods {
+		if _, ok := nativeIAmPolicyGRPC.AuthMethod_name[int32(method)]; !ok || method == nativeIAmPolicyGRPC.AuthMethod_UNKNOWN {
+			return status.Error(codes.InvalidArgument, "Policy condition has unsupported authentication method")
+		}
+	}
+
+	if window := conditions.TimeWindow; window != nil {
+		if window.DailyStartMinute >= minutesInDay || window.DailyEndMinute >= minutesInDay {
+			return status.Error(codes.InvalidArgument, "Policy condition daily window minutes must be in range [0, 1439]")
+		}
+		if window.NotBefore != nil && window.NotAfter != nil && !window.NotBefore.AsTime().Before(window.NotAfter.AsTime()) {
+			return status.Error(codes.InvalidArgument, "Policy condition time window notBefore must be before notAfter")
+		}
+		if _, err := time.LoadLocation(window.Timezone); err != nil {
+			return status.Error(codes.InvalidArgument, "Policy condition time window has unknown timezone ["+window.Timezone+"]")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package role
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+)
+
+// Publishes record about the role change to the audit log. Failure to publish doesnt fail the request.
+func (s *IAMRoleServer) publishAuditRecord(ctx context.Context, change audit.Change) {
+	if err := s.auditPublisher.Publish(ctx, change); err != nil {
+		log.Error("Failed to publish audit record for [" + change.Action + "] on [" + change.Resource + "]: " + err.Error())
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
 	"google.golang.org/grpc/codes"
 
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
@@ -29,8 +30,9 @@ import (
 type IAMRoleServer struct {
 	nativeIAmRoleGRPC.UnimplementedIAMRoleServiceServer
 
-	systemStub *system.SystemStub
-	nativeStub *native.NativeStub
+	systemStub     *system.SystemStub
+	nativeStub     *native.NativeStub
+	auditPublisher *audit.Publisher
 
 	policyServer *policy_server.IAMPolicyServer
 }
@@ -60,10 +62,16 @@ func NewIAMRoleServer(ctx context.Context, systemStub *system.SystemStub, native
 		return nil, errors.New("Failed to ensure builtIns for global namespace: " + err.Error())
 	}
 
+	auditPublisher, err := audit.NewPublisher(systemStub.Nats, "native_iam")
+	if err != nil {
+		return nil, errors.New("Failed to create audit publisher: " + err.Error())
+	}
+
 	return &IAMRoleServer{
-		systemStub:   systemStub,
-		nativeStub:   nativeStub,
-		policyServer: policyServer,
+		systemStub:     systemStub,
+		nativeStub:     nativeStub,
+		auditPublisher: auditPublisher,
+		policyServer:   policyServer,
 	}, nil
 }
 
@@ -134,6 +142,12 @@ func (s *IAMRoleServer) Create(ctx context.Context, in *nativeIAmRoleGRPC.Create
 
 	log.Infof("Created new role with UUID [%s] and name [%s] in the [%s] namespace", role.ID.Hex(), in.Name, in.Namespace)
 	s.systemStub.Cache.Remove(ctx, makeCountRoleCacheKey(in.Namespace))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:    in.Namespace,
+		Resource:     "native.iam.role." + role.ID.Hex(),
+		Action:       "native.iam.role.create",
+		AfterVersion: role.Version,
+	})
 
 	return &nativeIAmRoleGRPC.CreateRoleResponse{Role: role.ToGRPCRole(in.Namespace)}, status.Error(codes.OK, "")
 }
@@ -389,6 +403,13 @@ func (s *IAMRoleServer) Update(ctx context.Context, in *nativeIAmRoleGRPC.Update
 
 	log.Infof("Updated role with UUID [%s] from the [%s] namespace", in.Uuid, in.Namespace)
 	// s.systemStub.Cache.Remove(ctx, makeCountRoleCacheKey(in.Namespace))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.role." + in.Uuid,
+		Action:        "native.iam.role.update",
+		BeforeVersion: updatedRole.Version - 1,
+		AfterVersion:  updatedRole.Version,
+	})
 
 	return &nativeIAmRoleGRPC.UpdateRoleResponse{
 		Role: updatedRole.ToGRPCRole(in.Namespace),
@@ -401,8 +422,12 @@ func (s *IAMRoleServer) Delete(ctx context.Context, in *nativeIAmRoleGRPC.Delete
 	}
 
 	collection := s.getCollectionByNamespace(in.Namespace)
-	r, err := collection.DeleteOne(ctx, bson.M{"_id": id})
+	var deletedRole roleInMongo
+	err = collection.FindOneAndDelete(ctx, bson.M{"_id": id}).Decode(&deletedRole)
 	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return &nativeIAmRoleGRPC.DeleteRoleResponse{Existed: false}, status.Error(codes.OK, "")
+		}
 		if err, ok := err.(mongo.WriteException); ok {
 			if err.HasErrorLabel("InvalidNamespace") {
 				return &nativeIAmRoleGRPC.DeleteRoleResponse{Existed: false}, status.Error(codes.OK, "Role wasnt founded. Probably namespace doesnt exist")
@@ -411,12 +436,16 @@ func (s *IAMRoleServer) Delete(ctx context.Context, in *nativeIAmRoleGRPC.Delete
 		return nil, status.Error(codes.Internal, "Error while deleting role in database. "+err.Error())
 	}
 
-	if r.DeletedCount != 0 {
-		log.Infof("Deleted role with UUID [%s] from the [%s] namespace", in.Uuid, in.Namespace)
-		s.systemStub.Cache.Remove(ctx, makeCountRoleCacheKey(in.Namespace))
-	}
+	log.Infof("Deleted role with UUID [%s] from the [%s] namespace", in.Uuid, in.Namespace)
+	s.systemStub.Cache.Remove(ctx, makeCountRoleCacheKey(in.Namespace))
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.Namespace,
+		Resource:      "native.iam.role." + in.Uuid,
+		Action:        "native.iam.role.delete",
+		BeforeVersion: deletedRole.Version,
+	})
 
-	return &nativeIAmRoleGRPC.DeleteRoleResponse{Existed: r.DeletedCount != 0}, status.Error(codes.OK, "")
+	return &nativeIAmRoleGRPC.DeleteRoleResponse{Existed: true}, status.Error(codes.OK, "")
 }
 func (s *IAMRoleServer) GetServiceManagedRole(ctx context.Context, in *nativeIAmRoleGRPC.GetServiceManagedRoleRequest) (*nativeIAmRoleGRPC.GetServiceManagedRoleResponse, error) {
 	collection := s.getCollectionByNamespace(in.Namespace)
@@ -525,6 +554,15 @@ func (s *IAMRoleServer) AddPolicy(ctx context.Context, in *nativeIAmRoleGRPC.Add
 		return nil, status.Error(codes.Internal, "Error while searhing for role in database. "+err.Error())
 	}
 
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.RoleNamespace,
+		Resource:      "native.iam.role." + in.RoleUUID,
+		Action:        "native.iam.role.policy.add",
+		BeforeVersion: role.Version - 1,
+		AfterVersion:  role.Version,
+		Details:       fmt.Sprintf("policy [%s] from namespace [%s]", in.PolicyUUID, in.PolicyNamespace),
+	})
+
 	return &nativeIAmRoleGRPC.AddPolicyResponse{Role: role.ToGRPCRole(in.RoleNamespace)}, status.Error(codes.OK, "")
 }
 func (s *IAMRoleServer) RemovePolicy(ctx context.Context, in *nativeIAmRoleGRPC.RemovePolicyRequest) (*nativeIAmRoleGRPC.RemovePolicyResponse, error) {
@@ -561,6 +599,15 @@ func (s *IAMRoleServer) RemovePolicy(ctx context.Context, in *nativeIAmRoleGRPC.
 		return nil, status.Error(codes.Internal, "Error while searhing for role in database. "+err.Error())
 	}
 
+	s.publishAuditRecord(ctx, audit.Change{
+		Namespace:     in.RoleNamespace,
+		Resource:      "native.iam.role." + in.RoleUUID,
+		Action:        "native.iam.role.policy.remove",
+		BeforeVersion: role.Version - 1,
+		AfterVersion:  role.Version,
+		Details:       fmt.Sprintf("policy [%s] from namespace [%s]", in.PolicyUUID, in.PolicyNamespace),
+	})
+
 	return &nativeIAmRoleGRPC.RemovePolicyResponse{Role: role.ToGRPCRole(in.RoleNamespace)}, status.Error(codes.OK, "")
 }
 func (s *IAMRoleServer) Exist(ctx context.Context, in *nativeIAmRoleGRPC.ExistRoleRequest) (*nativeIAmRoleGRPC.ExistRoleResponse, error) {
@@ -582,3 +629,36 @@ func (s *IAMRoleServer) Exist(ctx context.Context, in *nativeIAmRoleGRPC.ExistRo
 
 	return &nativeIAmRoleGRPC.ExistRoleResponse{Exist: count != 0}, status.Error(codes.OK, "")
 }
+
+// Finds unique identifiers of all the roles in the namespace that have provided policy assigned
+func (s *IAMRoleServer) FindRolesWithPolicy(ctx context.Context, namespace string, policyNamespace string, policyUUID string) ([]string, error) {
+	collection := s.getCollectionByNamespace(namespace)
+	cursor, err := collection.Find(
+		ctx,
+		bson.M{"policies": bson.M{"$elemMatch": bson.M{"namespace": policyNamespace, "uuid": policyUUID}}},
+		options.Find().SetProjection(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return []string{}, nil
+			}
+		}
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	roles := []string{}
+	for cursor.Next(ctx) {
+		var role roleInMongo
+		if err := cursor.Decode(&role); err != nil {
+			return nil, err
+		}
+		roles = append(roles, role.ID.Hex())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,135 @@
+package token
+
+import (
+	"context"
+	"time"
+
+	goJWT "github.com/golang-jwt/jwt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpccodes "google.golang.org/grpc/codes"
+
+	nativeIAmTokenGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+)
+
+func (s *IAmTokenServer) Introspect(ctx context.Context, in *nativeIAmTokenGRPC.IntrospectRequest) (*nativeIAmTokenGRPC.IntrospectResponse, error) {
+	validateResponse, err := s.Validate(ctx, &nativeIAmTokenGRPC.ValidateRequest{
+		Token:    in.Token,
+		UseCache: in.UseCache,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); !ok || st.Code() != grpccodes.OK {
+			return nil, err
+		}
+	}
+	if validateResponse.Status != nativeIAmTokenGRPC.ValidateResponse_OK {
+		return &nativeIAmTokenGRPC.IntrospectResponse{Active: false}, status.Error(grpccodes.OK, "")
+	}
+
+	// Token was already verified, so this only extracts claims of this exact token
+	jwtData, err := s.jwtService.JWTDataFromString(ctx, in.Token)
+	if err != nil {
+		if err == ErrInvalidToken || err == ErrTokenExpired {
+			return &nativeIAmTokenGRPC.IntrospectResponse{Active: false}, status.Error(grpccodes.OK, "")
+		}
+		if err == ErrVaultSealed {
+			return nil, status.Error(grpccodes.FailedPrecondition, "Cant introspect token. Vault is sealed.")
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to verify token. "+err.Error())
+	}
+
+	return &nativeIAmTokenGRPC.IntrospectResponse{
+		Active:    true,
+		Refresh:   jwtData.Refresh,
+		IssuedAt:  timestamppb.New(time.Unix(jwtData.IssuedAt, 0)),
+		ExpiresAt: timestamppb.New(time.Unix(jwtData.ExpiresAt, 0)),
+		Issuer:    jwtData.Issuer,
+		TokenData: validateResponse.TokenData,
+	}, status.Error(grpccodes.OK, "")
+}
+
+func (s *IAmTokenServer) Revoke(ctx context.Context, in *nativeIAmTokenGRPC.RevokeRequest) (*nativeIAmTokenGRPC.RevokeResponse, error) {
+	jwtData, err := s.jwtService.JWTDataFromString(ctx, in.Token)
+	if err != nil {
+		if err == ErrInvalidToken || err == ErrTokenExpired {
+			return &nativeIAmTokenGRPC.RevokeResponse{Revoked: false}, status.Error(grpccodes.OK, "")
+		}
+		if err == ErrVaultSealed {
+			return nil, status.Error(grpccodes.FailedPrecondition, "Cant revoke token. Vault is sealed.")
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to verify token. "+err.Error())
+	}
+
+	id, err := primitive.ObjectIDFromHex(jwtData.UUID)
+	if err != nil {
+		return &nativeIAmTokenGRPC.RevokeResponse{Revoked: false}, status.Error(grpccodes.OK, "")
+	}
+
+	collection := collectionByNamespace(s, jwtData.Namespace)
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": id, "disabled": false}, bson.M{"$set": bson.M{"disabled": true}})
+	if err != nil {
+		if err, ok := err.(mongo.ServerError); ok {
+			if err.HasErrorCode(73) { // InvalidNamespace
+				return &nativeIAmTokenGRPC.RevokeResponse{Revoked: false}, status.Error(grpccodes.OK, "")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "failed to update token in database: "+err.Error())
+	}
+
+	if result.ModifiedCount != 0 {
+		s.cacheClient.Remove(ctx, makeTokenCacheKey(jwtData.Namespace, jwtData.UUID))
+	}
+
+	return &nativeIAmTokenGRPC.RevokeResponse{Revoked: result.ModifiedCount != 0}, status.Error(grpccodes.OK, "")
+}
+
+func (s *IAmTokenServer) GetJWKS(ctx context.Context, in *nativeIAmTokenGRPC.GetJWKSRequest) (*nativeIAmTokenGRPC.GetJWKSResponse, error) {
+	jwk, err := s.jwtService.GetJWK(ctx)
+	if err != nil {
+		if err == ErrVaultSealed {
+			return nil, status.Error(grpccodes.FailedPrecondition, "Cant get public key. Vault is sealed.")
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to get public key. "+err.Error())
+	}
+
+	return &nativeIAmTokenGRPC.GetJWKSResponse{
+		Keys: []*nativeIAmTokenGRPC.JSONWebKey{
+			{
+				Kty: jwk.KeyType,
+				Kid: jwk.KeyID,
+				Use: jwk.Use,
+				Alg: jwk.Algorithm,
+				N:   jwk.N,
+				E:   jwk.E,
+			},
+		},
+	}, status.Error(grpccodes.OK, "")
+}
+
+// Signs claims with the token signing key. Result can be verified with the keys from GetJWKS. Used by the OIDC authorization server to sign id_token.
+func (s *IAmTokenServer) SignClaims(ctx context.Context, claims goJWT.Claims) (string, error) {
+	signed, err := s.jwtService.ClaimsToSignedString(ctx, claims)
+	if err != nil {
+		if err == ErrVaultSealed {
+			return "", status.Error(grpccodes.FailedPrecondition, "Cant sign claims. Vault is sealed.")
+		}
+		return "", status.Error(grpccodes.Internal, "Failed to sign claims. "+err.Error())
+	}
+	return signed, nil
+}
+
+// Signs claims of the service token with the service token key. Service tokens are verified by the services with the public key from the system_vault.
+func (s *IAmTokenServer) SignServiceClaims(ctx context.Context, claims goJWT.Claims) (string, error) {
+	signed, err := s.serviceJWTService.ClaimsToSignedString(ctx, claims)
+	if err != nil {
+		if err == ErrVaultSealed {
+			return "", status.Error(grpccodes.FailedPrecondition, "Cant sign service token claims. Vault is sealed.")
+		}
+		return "", status.Error(grpccodes.Internal, "Failed to sign service token claims. "+err.Error())
+	}
+	return signed, nil
+}
@@ -3,10 +3,12 @@ package token
 import (
 	"context"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 	"sync"
 	"time"
@@ -14,13 +16,26 @@ import (
 	goJWT "github.com/golang-jwt/jwt"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
 	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 const jwtVaultKeyName = "native_iam_token_jwt"
 
+// Value of the "token_use" claim of the OIDC id_token. Id tokens are signed with the token key, but they are not access or refresh tokens.
+const IDTokenUse = "id"
+
+// Service tokens are signed with separate key, so tokens of the users can not be accepted as service tokens and vice versa.
+// Must match SYSTEM_SERVICE_AUTH_TOKEN_KEY of the services.
+const serviceTokenVaultKeyName = "native_iam_service_token_jwt"
+
+// Algorithm used to sign tokens. Must match "alg" in the JSON Web Key Set
+const jwtSigningAlgorithm = "RS512"
+
 type jwtService struct {
+	keyName string
+
 	rsaKey       *rsa.PublicKey
 	keyLoadMutex *sync.RWMutex
 
@@ -30,6 +45,39 @@ type jwtService struct {
 type JWTService interface {
 	JWTDataFromString(ctx context.Context, input string) (*JWTData, error)
 	JWTDataToSignedString(ctx context.Context, data *JWTData) (string, error)
+	// Signs arbitrary claims with the same key as tokens. Used for OIDC id_token.
+	ClaimsToSignedString(ctx context.Context, claims goJWT.Claims) (string, error)
+	// Returns public key used to verify tokens in the JSON Web Key format
+	GetJWK(ctx context.Context) (*JWK, error)
+}
+
+// Public RSA key in the JSON Web Key format (RFC 7517)
+type JWK struct {
+	KeyType   string
+	KeyID     string
+	Use       string
+	Algorithm string
+	// Base64url encoded modulus
+	N string
+	// Base64url encoded public exponent
+	E string
+}
+
+func newJWK(key *rsa.PublicKey) *JWK {
+	n := base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+	e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+
+	// Key ID is the JWK thumbprint (RFC 7638). It only changes when the key changes.
+	thumbprint := sha256.Sum256([]byte(fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, e, n)))
+
+	return &JWK{
+		KeyType:   "RSA",
+		KeyID:     base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+		Use:       "sig",
+		Algorithm: jwtSigningAlgorithm,
+		N:         n,
+		E:         e,
+	}
 }
 
 var ErrInvalidToken = errors.New("invalid token")
@@ -37,7 +85,12 @@ var ErrTokenExpired = errors.New("token expired")
 var ErrVaultSealed = errors.New("vault is sealed. Its impossible to perform any operations")
 
 func NewJWTService(systemStub *system.SystemStub) JWTService {
+	return newJWTServiceWithKey(systemStub, jwtVaultKeyName)
+}
+
+func newJWTServiceWithKey(systemStub *system.SystemStub, keyName string) JWTService {
 	return &jwtService{
+		keyName:      keyName,
 		rsaKey:       nil,
 		keyLoadMutex: &sync.RWMutex{},
 		systemStub:   systemStub,
@@ -53,7 +106,7 @@ func (s *jwtService) loadPublicKey(ctx context.Context) error {
 	}
 
 	getRSAKeyResponse, err := s.systemStub.Vault.GetRSAPublicKey(ctx, &vault.GetRSAPublicKeyRequest{
-		KeyName: jwtVaultKeyName,
+		KeyName: s.keyName,
 	})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
@@ -61,14 +114,14 @@ func (s *jwtService) loadPublicKey(ctx context.Context) error {
 				return ErrVaultSealed
 			} else if st.Code() == codes.NotFound {
 				_, err = s.systemStub.Vault.EnsureRSAKeyPair(ctx, &vault.EnsureRSAKeyPairRequest{
-					KeyName: jwtVaultKeyName,
+					KeyName: s.keyName,
 				})
 				if err != nil {
 					return errors.New("JWT RSA public key not found in system_vault. Unexpected error while creating it: " + err.Error())
 				}
 
 				getRSAKeyResponse, err := s.systemStub.Vault.GetRSAPublicKey(ctx, &vault.GetRSAPublicKeyRequest{
-					KeyName: jwtVaultKeyName,
+					KeyName: s.keyName,
 				})
 				if err != nil {
 					return errors.New("JWT RSA public key not found in system_vault. Key-pair was created, but still failed to get it: " + err.Error())
@@ -140,15 +193,34 @@ func (s *jwtService) JWTDataFromString(ctx context.Context, input string) (*JWTD
 		}
 		return nil, ErrInvalidToken
 	}
+	// Other tokens signed with the same key dont reference token in the database
+	if data.TokenUse != "" || !primitive.IsValidObjectID(data.UUID) {
+		return nil, ErrInvalidToken
+	}
 
 	return data, nil
 }
 
+func (s *jwtService) GetJWK(ctx context.Context) (*JWK, error) {
+	rsaKey, err := s.getPublicKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newJWK(rsaKey), nil
+}
+
 func (s *jwtService) JWTDataToSignedString(ctx context.Context, data *JWTData) (string, error) {
+	return s.ClaimsToSignedString(ctx, data)
+}
+
+func (s *jwtService) ClaimsToSignedString(ctx context.Context, claims goJWT.Claims) (string, error) {
 	// Make sure Key Pair created. In this case, public key must exist and be loaded.
-	s.getPublicKey(ctx)
+	rsaKey, _ := s.getPublicKey(ctx)
 
-	token := goJWT.NewWithClaims(goJWT.SigningMethodRS512, data)
+	token := goJWT.NewWithClaims(goJWT.SigningMethodRS512, claims)
+	if rsaKey != nil {
+		token.Header["kid"] = newJWK(rsaKey).KeyID
+	}
 	stringToSign, err := token.SigningString()
 	if err != nil {
 		return "", errors.New("failed to generate signing string: " + err.Error())
@@ -156,7 +228,7 @@ func (s *jwtService) JWTDataToSignedString(ctx context.Context, data *JWTData) (
 	bytesToSign := []byte(stringToSign)
 
 	signResponse, err := s.systemStub.Vault.RSASign(ctx, &vault.RSASignRequest{
-		KeyName: jwtVaultKeyName,
+		KeyName: s.keyName,
 		Data:    bytesToSign,
 	})
 	if err != nil {
@@ -0,0 +1,64 @@
+package token
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	nativeIAmTokenGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+)
+
+func refreshGenerationFilter(generation uint32) interface{} {
+	// Tokens created before refresh rotation dont have generation stored
+	if generation == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return generation
+}
+
+// Atomically moves token record from the previous refresh generation to the generation of the token. Returns false if another request already used refresh token of the previous generation.
+func (s *IAmTokenServer) rotateRefreshGeneration(ctx context.Context, collection *mongo.Collection, token *tokenInMongo, previousGeneration uint32, activity *nativeIAmTokenGRPC.ActivityContext) (bool, error) {
+	set := activityUpdate(activity)
+	set["refreshGeneration"] = token.RefreshGeneration
+	result, err := collection.UpdateOne(
+		ctx,
+		bson.M{"_id": token.ID, "disabled": false, "refreshGeneration": refreshGenerationFilter(previousGeneration)},
+		bson.M{"$set": set, "$inc": bson.M{"refreshCount": 1}},
+	)
+	if err != nil {
+		return false, err
+	}
+	if result.MatchedCount == 0 {
+		return false, nil
+	}
+
+	token.RefreshCount += 1
+	return true, nil
+}
+
+// Disables the whole token family (access and refresh tokens of the token record) after refresh token was used more than once and publishes security event to the audit log.
+func (s *IAmTokenServer) handleRefreshTokenReuse(ctx context.Context, collection *mongo.Collection, namespace string, token *tokenInMongo, activity *nativeIAmTokenGRPC.ActivityContext) {
+	uuid := token.ID.Hex()
+	log.Warn("Refresh token reuse detected for token [" + uuid + "] of identity [" + token.Identity + "] in namespace [" + namespace + "]. Disabling token family.")
+
+	if _, err := collection.UpdateByID(ctx, token.ID, bson.M{"$set": bson.M{"disabled": true}}); err != nil {
+		log.Error("Failed to disable token [" + uuid + "] after refresh token reuse: " + err.Error())
+	}
+	s.cacheClient.Remove(ctx, makeTokenCacheKey(namespace, uuid))
+
+	err := s.auditPublisher.Publish(ctx, audit.Change{
+		Namespace:     namespace,
+		Resource:      "native.iam.token." + uuid,
+		Action:        "native.iam.token.refresh_reuse",
+		BeforeVersion: uint64(token.RefreshGeneration),
+		AfterVersion:  uint64(token.RefreshGeneration),
+		Details:       fmt.Sprintf("refresh token of identity [%s] was reused from IP [%s] with user agent [%s]. Token was disabled", token.Identity, activity.GetSourceIP(), activity.GetUserAgent()),
+	})
+	if err != nil {
+		log.Error("Failed to publish audit record about refresh token reuse for token [" + uuid + "]: " + err.Error())
+	}
+}
@@ -0,0 +1,174 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpccodes "google.golang.org/grpc/codes"
+
+	nativeIAmTokenGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+)
+
+const (
+	// Validate is called on every request. Last activity is only written to the database once per this period for every session.
+	SESSION_ACTIVITY_UPDATE_PERIOD = time.Minute
+)
+
+func makeTokenActivityCacheKey(namespace string, uuid string) string {
+	return fmt.Sprintf("native_iam_token_activity_%s_%s", namespace, uuid)
+}
+
+func (t *tokenInMongo) ToProtoSession(namespace string) *nativeIAmTokenGRPC.Session {
+	lastActivity := t.LastActivityAt
+	if lastActivity.IsZero() {
+		lastActivity = t.CreatedAt
+	}
+
+	return &nativeIAmTokenGRPC.Session{
+		TokenData:      t.ToProtoTokenData(namespace),
+		LastActivityAt: timestamppb.New(lastActivity),
+		LastSourceIP:   t.LastSourceIP,
+		LastUserAgent:  t.LastUserAgent,
+		RefreshCount:   t.RefreshCount,
+	}
+}
+
+func activityUpdate(activity *nativeIAmTokenGRPC.ActivityContext) bson.M {
+	update := bson.M{"lastActivityAt": time.Now().UTC()}
+	if activity != nil {
+		update["lastSourceIP"] = activity.SourceIP
+		update["lastUserAgent"] = activity.UserAgent
+	}
+	return update
+}
+
+// Updates last activity of the session. Updates are throttled using cache. Errors are ignored, because activity tracking must not break validation.
+func (s *IAmTokenServer) trackActivity(ctx context.Context, namespace string, uuid string, activity *nativeIAmTokenGRPC.ActivityContext) {
+	cacheKey := makeTokenActivityCacheKey(namespace, uuid)
+	if recentlyUpdated, err := s.cacheClient.Has(ctx, cacheKey); err == nil && recentlyUpdated {
+		return
+	}
+
+	id, err := primitive.ObjectIDFromHex(uuid)
+	if err != nil {
+		return
+	}
+	collection := collectionByNamespace(s, namespace)
+	if _, err := collection.UpdateByID(ctx, id, bson.M{"$set": activityUpdate(activity)}); err != nil {
+		return
+	}
+	s.cacheClient.Set(ctx, cacheKey, []byte{1}, SESSION_ACTIVITY_UPDATE_PERIOD)
+}
+
+func (s *IAmTokenServer) ListSessions(ctx context.Context, in *nativeIAmTokenGRPC.ListSessionsRequest) (*nativeIAmTokenGRPC.ListSessionsResponse, error) {
+	collection := collectionByNamespace(s, in.Namespace)
+	filter := bson.M{
+		"identity": in.Identity,
+		"disabled": false,
+		"expireAt": bson.M{"$gt": time.Now().UTC()},
+	}
+	findOptions := options.Find().SetSort(bson.D{{Key: "lastActivityAt", Value: -1}, {Key: "createdAt", Value: -1}})
+	cursor, err := collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		if err, ok := err.(mongo.ServerError); ok {
+			if err.HasErrorCode(73) { // InvalidNamespace
+				return &nativeIAmTokenGRPC.ListSessionsResponse{Sessions: []*nativeIAmTokenGRPC.Session{}}, status.Error(grpccodes.OK, "")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to fetch sessions from the database: "+err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	sessions := []*nativeIAmTokenGRPC.Session{}
+	for cursor.Next(ctx) {
+		var token tokenInMongo
+		if err := cursor.Decode(&token); err != nil {
+			return nil, status.Error(grpccodes.Internal, "Failed to decode session from the database: "+err.Error())
+		}
+		sessions = append(sessions, token.ToProtoSession(in.Namespace))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to fetch sessions from the database: "+err.Error())
+	}
+
+	return &nativeIAmTokenGRPC.ListSessionsResponse{Sessions: sessions}, status.Error(grpccodes.OK, "")
+}
+
+func (s *IAmTokenServer) RevokeSession(ctx context.Context, in *nativeIAmTokenGRPC.RevokeSessionRequest) (*nativeIAmTokenGRPC.RevokeSessionResponse, error) {
+	id, err := primitive.ObjectIDFromHex(in.Uuid)
+	if err != nil {
+		return nil, status.Error(grpccodes.InvalidArgument, "Session UUID has bad format")
+	}
+
+	collection := collectionByNamespace(s, in.Namespace)
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": id, "identity": in.Identity, "disabled": false}, bson.M{"$set": bson.M{"disabled": true}})
+	if err != nil {
+		if err, ok := err.(mongo.ServerError); ok {
+			if err.HasErrorCode(73) { // InvalidNamespace
+				return &nativeIAmTokenGRPC.RevokeSessionResponse{Revoked: false}, status.Error(grpccodes.OK, "")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to update session in the database: "+err.Error())
+	}
+
+	if result.ModifiedCount != 0 {
+		s.cacheClient.Remove(ctx, makeTokenCacheKey(in.Namespace, in.Uuid))
+	}
+
+	return &nativeIAmTokenGRPC.RevokeSessionResponse{Revoked: result.ModifiedCount != 0}, status.Error(grpccodes.OK, "")
+}
+
+func (s *IAmTokenServer) RevokeAllSessions(ctx context.Context, in *nativeIAmTokenGRPC.RevokeAllSessionsRequest) (*nativeIAmTokenGRPC.RevokeAllSessionsResponse, error) {
+	filter := bson.M{"identity": in.Identity, "disabled": false}
+	if in.ExceptSession != "" {
+		exceptID, err := primitive.ObjectIDFromHex(in.ExceptSession)
+		if err != nil {
+			return nil, status.Error(grpccodes.InvalidArgument, "UUID of the session to keep has bad format")
+		}
+		filter["_id"] = bson.M{"$ne": exceptID}
+	}
+
+	// Sessions must be known before update in order to clean their cache
+	collection := collectionByNamespace(s, in.Namespace)
+	cursor, err := collection.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		if err, ok := err.(mongo.ServerError); ok {
+			if err.HasErrorCode(73) { // InvalidNamespace
+				return &nativeIAmTokenGRPC.RevokeAllSessionsResponse{RevokedCount: 0}, status.Error(grpccodes.OK, "")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to fetch sessions from the database: "+err.Error())
+	}
+	var sessions []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &sessions); err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to fetch sessions from the database: "+err.Error())
+	}
+	if len(sessions) == 0 {
+		return &nativeIAmTokenGRPC.RevokeAllSessionsResponse{RevokedCount: 0}, status.Error(grpccodes.OK, "")
+	}
+
+	ids := make([]primitive.ObjectID, len(sessions))
+	cacheKeys := make([]string, len(sessions))
+	for i, session := range sessions {
+		ids[i] = session.ID
+		cacheKeys[i] = makeTokenCacheKey(in.Namespace, session.ID.Hex())
+	}
+
+	result, err := collection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}, "disabled": false}, bson.M{"$set": bson.M{"disabled": true}})
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to update sessions in the database: "+err.Error())
+	}
+	s.cacheClient.Remove(ctx, cacheKeys...)
+
+	return &nativeIAmTokenGRPC.RevokeAllSessionsResponse{RevokedCount: uint32(result.ModifiedCount)}, status.Error(grpccodes.OK, "")
+}
@@ -2,6 +2,7 @@ package token
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
 	"github.com/slamy-solutions/openbp/modules/system/libs/golang/cache"
 
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
@@ -27,6 +29,9 @@ type IAmTokenServer struct {
 	nativeIAmTokenGRPC.UnimplementedIAMTokenServiceServer
 
 	jwtService JWTService
+	// Signs service tokens issued by the workload server
+	serviceJWTService JWTService
+	auditPublisher    *audit.Publisher
 
 	mongoClient           *mongo.Client
 	mongoGlobalCollection *mongo.Collection
@@ -34,21 +39,48 @@ type IAmTokenServer struct {
 	nativeNamespaceClient nativeNamespaceGRPC.NamespaceServiceClient
 }
 
+type timeWindowInMongo struct {
+	NotBefore        *time.Time `bson:"notBefore,omitempty"`
+	NotAfter         *time.Time `bson:"notAfter,omitempty"`
+	DailyStartMinute uint32     `bson:"dailyStartMinute"`
+	DailyEndMinute   uint32     `bson:"dailyEndMinute"`
+	Timezone         string     `bson:"timezone"`
+}
+
+type scopeConditionsInMongo struct {
+	SourceIPs   []string           `bson:"sourceIPs"`
+	TimeWindow  *timeWindowInMongo `bson:"timeWindow,omitempty"`
+	AuthMethods []string           `bson:"authMethods"`
+	RequireMFA  bool               `bson:"requireMFA"`
+}
+
 type scopeInMongo struct {
-	Namespace            string   `bson:"namespace"`
-	Resources            []string `bson:"resources"`
-	Actions              []string `bson:"actions"`
-	NamespaceIndependent bool     `bson:"namespaceIndependent"`
+	Namespace            string                  `bson:"namespace"`
+	Resources            []string                `bson:"resources"`
+	Actions              []string                `bson:"actions"`
+	NamespaceIndependent bool                    `bson:"namespaceIndependent"`
+	Deny                 bool                    `bson:"deny"`
+	Conditions           *scopeConditionsInMongo `bson:"conditions,omitempty"`
 }
 
 type tokenInMongo struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	Identity  string             `bson:"identity"`
-	Disabled  bool               `bson:"disabled"`
-	Scopes    []scopeInMongo     `bson:"scopes"`
-	CreatedAt time.Time          `bson:"createdAt"`
-	ExpireAt  time.Time          `bson:"expireAt"`
-	Metadata  string             `bson:"metadata"`
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	Identity   string             `bson:"identity"`
+	Disabled   bool               `bson:"disabled"`
+	Scopes     []scopeInMongo     `bson:"scopes"`
+	CreatedAt  time.Time          `bson:"createdAt"`
+	ExpireAt   time.Time          `bson:"expireAt"`
+	Metadata   string             `bson:"metadata"`
+	AuthMethod string             `bson:"authMethod"`
+	MFA        bool               `bson:"mfa"`
+
+	LastActivityAt time.Time `bson:"lastActivityAt"`
+	LastSourceIP   string    `bson:"lastSourceIP"`
+	LastUserAgent  string    `bson:"lastUserAgent"`
+	RefreshCount   uint32    `bson:"refreshCount"`
+
+	// Generation of the only refresh token that is currently valid. Incremented on every refresh.
+	RefreshGeneration uint32 `bson:"refreshGeneration"`
 }
 
 const (
@@ -57,6 +89,71 @@ const (
 	TOKEN_CACHE_EXPIRATION_TIME = time.Second * 30
 )
 
+func scopeConditionsFromProto(conditions *nativeIAmTokenGRPC.ScopeConditions) *scopeConditionsInMongo {
+	if conditions == nil {
+		return nil
+	}
+
+	result := &scopeConditionsInMongo{
+		SourceIPs:   conditions.SourceIPs,
+		AuthMethods: make([]string, 0, len(conditions.AuthMethods)),
+		RequireMFA:  conditions.RequireMFA,
+	}
+	if result.SourceIPs == nil {
+		result.SourceIPs = []string{}
+	}
+	for _, method := range conditions.AuthMethods {
+		result.AuthMethods = append(result.AuthMethods, method.String())
+	}
+	if window := conditions.TimeWindow; window != nil {
+		result.TimeWindow = &timeWindowInMongo{
+			DailyStartMinute: window.DailyStartMinute,
+			DailyEndMinute:   window.DailyEndMinute,
+			Timezone:         window.Timezone,
+		}
+		if window.NotBefore != nil {
+			notBefore := window.NotBefore.AsTime()
+			result.TimeWindow.NotBefore = &notBefore
+		}
+		if window.NotAfter != nil {
+			notAfter := window.NotAfter.AsTime()
+			result.TimeWindow.NotAfter = &notAfter
+		}
+	}
+
+	return result
+}
+
+func (c *scopeConditionsInMongo) ToProtoScopeConditions() *nativeIAmTokenGRPC.ScopeConditions {
+	if c == nil {
+		return nil
+	}
+
+	result := &nativeIAmTokenGRPC.ScopeConditions{
+		SourceIPs:   c.SourceIPs,
+		AuthMethods: make([]nativeIAmTokenGRPC.AuthMethod, 0, len(c.AuthMethods)),
+		RequireMFA:  c.RequireMFA,
+	}
+	for _, method := range c.AuthMethods {
+		result.AuthMethods = append(result.AuthMethods, nativeIAmTokenGRPC.AuthMethod(nativeIAmTokenGRPC.AuthMethod_value[method]))
+	}
+	if window := c.TimeWindow; window != nil {
+		result.TimeWindow = &nativeIAmTokenGRPC.TimeWindow{
+			DailyStartMinute: window.DailyStartMinute,
+			DailyEndMinute:   window.DailyEndMinute,
+			Timezone:         window.Timezone,
+		}
+		if window.NotBefore != nil {
+			result.TimeWindow.NotBefore = timestamppb.New(*window.NotBefore)
+		}
+		if window.NotAfter != nil {
+			result.TimeWindow.NotAfter = timestamppb.New(*window.NotAfter)
+		}
+	}
+
+	return result
+}
+
 func (t *tokenInMongo) ToProtoTokenData(namespace string) *nativeIAmTokenGRPC.TokenData {
 	scopes := make([]*nativeIAmTokenGRPC.Scope, len(t.Scopes))
 	for i, scope := range t.Scopes {
@@ -65,6 +162,8 @@ func (t *tokenInMongo) ToProtoTokenData(namespace string) *nativeIAmTokenGRPC.To
 			Resources:            scope.Resources,
 			Actions:              scope.Actions,
 			NamespaceIndependent: scope.NamespaceIndependent,
+			Deny:                 scope.Deny,
+			Conditions:           scope.Conditions.ToProtoScopeConditions(),
 		}
 	}
 	return &nativeIAmTokenGRPC.TokenData{
@@ -76,6 +175,8 @@ func (t *tokenInMongo) ToProtoTokenData(namespace string) *nativeIAmTokenGRPC.To
 		CreatedAt:        timestamppb.New(t.CreatedAt),
 		CreationMetadata: t.Metadata,
 		Scopes:           scopes,
+		AuthMethod:       nativeIAmTokenGRPC.AuthMethod(nativeIAmTokenGRPC.AuthMethod_value[t.AuthMethod]),
+		Mfa:              t.MFA,
 	}
 }
 
@@ -90,6 +191,7 @@ func (t *tokenInMongo) ToJWTData(namespace string, refresh bool, maxExpiration t
 				Resources:            scope.Resources,
 				Actions:              scope.Actions,
 				NamespaceIndependent: scope.NamespaceIndependent,
+				Deny:                 scope.Deny,
 			}
 		}
 	}
@@ -98,7 +200,11 @@ func (t *tokenInMongo) ToJWTData(namespace string, refresh bool, maxExpiration t
 	if refresh || expirationTime.After(maxExpiration) {
 		expirationTime = maxExpiration
 	}
-	return NewJWTData(t.ID.Hex(), namespace, t.Identity, scopes, refresh, expirationTime)
+	data := NewJWTData(t.ID.Hex(), namespace, t.Identity, scopes, refresh, expirationTime)
+	if refresh {
+		data.RefreshGeneration = t.RefreshGeneration
+	}
+	return data
 }
 
 func collectionByNamespace(s *IAmTokenServer, namespace string) *mongo.Collection {
@@ -114,14 +220,21 @@ func makeTokenCacheKey(namespace string, uuid string) string {
 	return fmt.Sprintf("native_iam_token_data_%s_%s", namespace, uuid)
 }
 
-func NewIAmTokenServer(systemStub *system.SystemStub, nativeStub *native.NativeStub) *IAmTokenServer {
+func NewIAmTokenServer(systemStub *system.SystemStub, nativeStub *native.NativeStub) (*IAmTokenServer, error) {
+	auditPublisher, err := audit.NewPublisher(systemStub.Nats, "native_iam")
+	if err != nil {
+		return nil, errors.New("Failed to create audit publisher. " + err.Error())
+	}
+
 	return &IAmTokenServer{
 		mongoClient:           systemStub.DB,
 		mongoGlobalCollection: systemStub.DB.Database("openbp_global").Collection("native_iam_token"),
 		cacheClient:           systemStub.Cache,
 		nativeNamespaceClient: nativeStub.Services.Namespace,
 		jwtService:            NewJWTService(systemStub),
-	}
+		serviceJWTService:     newJWTServiceWithKey(systemStub, serviceTokenVaultKeyName),
+		auditPublisher:        auditPublisher,
+	}, nil
 }
 
 func (s *IAmTokenServer) Create(ctx context.Context, in *nativeIAmTokenGRPC.CreateRequest) (*nativeIAmTokenGRPC.CreateResponse, error) {
@@ -143,15 +256,21 @@ func (s *IAmTokenServer) Create(ctx context.Context, in *nativeIAmTokenGRPC.Crea
 		scopes[i].Resources = scope.Resources
 		scopes[i].Actions = scope.Actions
 		scopes[i].NamespaceIndependent = scope.NamespaceIndependent
+		scopes[i].Deny = scope.Deny
+		scopes[i].Conditions = scopeConditionsFromProto(scope.Conditions)
 	}
 	creationTime := time.Now().UTC()
 	token := &tokenInMongo{
-		Identity:  in.Identity,
-		Disabled:  false,
-		Scopes:    scopes,
-		CreatedAt: creationTime,
-		ExpireAt:  creationTime.Add(REFERSH_TOKEN_EXPIRE_TIME),
-		Metadata:  in.Metadata,
+		Identity:   in.Identity,
+		Disabled:   false,
+		Scopes:     scopes,
+		CreatedAt:  creationTime,
+		ExpireAt:   creationTime.Add(REFERSH_TOKEN_EXPIRE_TIME),
+		Metadata:   in.Metadata,
+		AuthMethod: in.AuthMethod.String(),
+		MFA:        in.Mfa,
+
+		LastActivityAt: creationTime,
 	}
 
 	// Inserting token to the DB
@@ -357,7 +476,7 @@ func (s *IAmTokenServer) Validate(ctx context.Context, in *nativeIAmTokenGRPC.Va
 	if !loadedFromCache {
 		id, err := primitive.ObjectIDFromHex(jwtData.UUID)
 		if err != nil {
-			return nil, status.Error(grpccodes.Internal, "Token UUID from JWT has bad format")
+			return &nativeIAmTokenGRPC.ValidateResponse{Status: nativeIAmTokenGRPC.ValidateResponse_INVALID, TokenData: nil}, status.Error(grpccodes.OK, "")
 		}
 
 		collection := collectionByNamespace(s, jwtData.Namespace)
@@ -395,30 +514,36 @@ func (s *IAmTokenServer) Validate(ctx context.Context, in *nativeIAmTokenGRPC.Va
 		s.cacheClient.Set(ctx, cacheKey, tokenDataBytes, TOKEN_CACHE_EXPIRATION_TIME)
 	}
 
+	if in.Activity != nil {
+		s.trackActivity(ctx, jwtData.Namespace, jwtData.UUID, in.Activity)
+	}
+
 	return &nativeIAmTokenGRPC.ValidateResponse{Status: nativeIAmTokenGRPC.ValidateResponse_OK, TokenData: &tokenData}, status.Error(grpccodes.OK, "")
 }
-func (s *IAmTokenServer) Refresh(ctx context.Context, in *nativeIAmTokenGRPC.RefreshRequest) (*nativeIAmTokenGRPC.RefreshResponse, error) {
+
+// Decodes refresh token and loads its data from the database. Returns response with the failure status if the token can not be refreshed. Returns GRPC status error.
+func (s *IAmTokenServer) loadRefreshToken(ctx context.Context, in *nativeIAmTokenGRPC.RefreshRequest) (*JWTData, *mongo.Collection, *tokenInMongo, *nativeIAmTokenGRPC.RefreshResponse, error) {
 	// Decoding JWT
 	jwtData, err := s.jwtService.JWTDataFromString(ctx, in.RefreshToken)
 	if err != nil {
 		if err == ErrInvalidToken {
-			return &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_INVALID}, status.Error(grpccodes.OK, "")
+			return nil, nil, nil, &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_INVALID}, nil
 		}
 		if err == ErrTokenExpired {
-			return &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_EXPIRED}, status.Error(grpccodes.OK, "")
+			return nil, nil, nil, &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_EXPIRED}, nil
 		}
 		if err == ErrVaultSealed {
-			return nil, status.Error(grpccodes.FailedPrecondition, "Cant refresh token. Vault is sealed.")
+			return nil, nil, nil, nil, status.Error(grpccodes.FailedPrecondition, "Cant refresh token. Vault is sealed.")
 		}
-		return nil, status.Error(grpccodes.Internal, "Failed to verify token. "+err.Error())
+		return nil, nil, nil, nil, status.Error(grpccodes.Internal, "Failed to verify token. "+err.Error())
 	}
 	if !jwtData.Refresh {
-		return &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_NOT_REFRESH_TOKEN}, status.Error(grpccodes.OK, "")
+		return nil, nil, nil, &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_NOT_REFRESH_TOKEN}, nil
 	}
 
 	id, err := primitive.ObjectIDFromHex(jwtData.UUID)
 	if err != nil {
-		return nil, status.Error(grpccodes.Internal, "Token UUID from JWT has bad format")
+		return nil, nil, nil, &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_INVALID}, nil
 	}
 
 	collection := collectionByNamespace(s, jwtData.Namespace)
@@ -426,24 +551,56 @@ func (s *IAmTokenServer) Refresh(ctx context.Context, in *nativeIAmTokenGRPC.Ref
 	err = collection.FindOne(ctx, bson.M{"_id": id}).Decode(&data)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_NOT_FOUND}, status.Error(grpccodes.OK, "")
+			return nil, nil, nil, &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_NOT_FOUND}, nil
 		}
 		// Handle error in case if namespaces is not valid (not exist)
 		if err, ok := err.(mongo.WriteException); ok {
 			if err.HasErrorCode(73) { // InvalidNamespace
-				return &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_NOT_FOUND}, status.Error(grpccodes.OK, "")
+				return nil, nil, nil, &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_NOT_FOUND}, nil
 			}
 		}
-		return nil, status.Error(grpccodes.Internal, "Failed to get token from database. "+err.Error())
+		return nil, nil, nil, nil, status.Error(grpccodes.Internal, "Failed to get token from database. "+err.Error())
 	}
 
 	if data.Disabled {
-		return &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_DISABLED}, status.Error(grpccodes.OK, "")
+		return nil, nil, nil, &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_DISABLED}, nil
 	}
 	if data.ExpireAt.Before(time.Now().UTC()) {
-		return &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_EXPIRED}, status.Error(grpccodes.OK, "")
+		return nil, nil, nil, &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_EXPIRED}, nil
+	}
+	// Refresh token was already exchanged for the new one. Most probably it was stolen.
+	if jwtData.RefreshGeneration != data.RefreshGeneration {
+		s.handleRefreshTokenReuse(ctx, collection, jwtData.Namespace, &data, in.Activity)
+		return nil, nil, nil, &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_REUSED}, nil
 	}
 
+	return jwtData, collection, &data, nil, nil
+}
+
+// Checks if the refresh token can be exchanged for the new tokens without rotating it. Response has token data, but no tokens.
+// Allows to run authorization checks before the refresh token is rotated, so failed checks will not invalidate it.
+func (s *IAmTokenServer) CheckRefresh(ctx context.Context, in *nativeIAmTokenGRPC.RefreshRequest) (*nativeIAmTokenGRPC.RefreshResponse, error) {
+	jwtData, _, data, failure, err := s.loadRefreshToken(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if failure != nil {
+		return failure, status.Error(grpccodes.OK, "")
+	}
+	return &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_OK, TokenData: data.ToProtoTokenData(jwtData.Namespace)}, status.Error(grpccodes.OK, "")
+}
+
+func (s *IAmTokenServer) Refresh(ctx context.Context, in *nativeIAmTokenGRPC.RefreshRequest) (*nativeIAmTokenGRPC.RefreshResponse, error) {
+	jwtData, collection, data, failure, err := s.loadRefreshToken(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if failure != nil {
+		return failure, status.Error(grpccodes.OK, "")
+	}
+
+	previousGeneration := data.RefreshGeneration
+	data.RefreshGeneration += 1
 	tokenString, err := s.jwtService.JWTDataToSignedString(ctx, data.ToJWTData(jwtData.Namespace, false, data.ExpireAt))
 	if err != nil {
 		if err == ErrVaultSealed {
@@ -451,11 +608,30 @@ func (s *IAmTokenServer) Refresh(ctx context.Context, in *nativeIAmTokenGRPC.Ref
 		}
 		return nil, status.Error(grpccodes.Internal, "Failed to sign token. "+err.Error())
 	}
+	refreshTokenString, err := s.jwtService.JWTDataToSignedString(ctx, data.ToJWTData(jwtData.Namespace, true, data.ExpireAt))
+	if err != nil {
+		if err == ErrVaultSealed {
+			return nil, status.Error(grpccodes.FailedPrecondition, "Cant refresh token. Vault is sealed.")
+		}
+		return nil, status.Error(grpccodes.Internal, "Failed to sign refresh token. "+err.Error())
+	}
+
+	rotated, err := s.rotateRefreshGeneration(ctx, collection, data, previousGeneration, in.Activity)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to rotate refresh token. "+err.Error())
+	}
+	// Same refresh token was used concurrently and another request already rotated it
+	if !rotated {
+		data.RefreshGeneration = previousGeneration
+		s.handleRefreshTokenReuse(ctx, collection, jwtData.Namespace, data, in.Activity)
+		return &nativeIAmTokenGRPC.RefreshResponse{Status: nativeIAmTokenGRPC.RefreshResponse_REUSED}, status.Error(grpccodes.OK, "")
+	}
 
 	return &nativeIAmTokenGRPC.RefreshResponse{
-		Status:    nativeIAmTokenGRPC.RefreshResponse_OK,
-		Token:     tokenString,
-		TokenData: data.ToProtoTokenData(jwtData.Namespace),
+		Status:       nativeIAmTokenGRPC.RefreshResponse_OK,
+		Token:        tokenString,
+		TokenData:    data.ToProtoTokenData(jwtData.Namespace),
+		RefreshToken: refreshTokenString,
 	}, status.Error(grpccodes.OK, "")
 }
 
@@ -11,6 +11,7 @@ type JWTScope struct {
 	Resources            []string `json:"resources"`
 	Actions              []string `json:"actions"`
 	NamespaceIndependent bool     `json:"namespaceIndependent"`
+	Deny                 bool     `json:"deny,omitempty"`
 }
 
 type JWTData struct {
@@ -21,6 +22,10 @@ type JWTData struct {
 	Scopes []JWTScope `json:"scopes"`
 
 	Refresh bool `json:"refresh"`
+	// Generation of the refresh token. Only the refresh token with the current generation of the token record can be used.
+	RefreshGeneration uint32 `json:"refreshGeneration,omitempty"`
+	// Type of the other tokens signed with the same key (for example OIDC id_token). Empty for access and refresh tokens.
+	TokenUse string `json:"token_use,omitempty"`
 
 	goJWT.StandardClaims
 }
@@ -0,0 +1,176 @@
+package workload
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	goJWT "github.com/golang-jwt/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	nativeIAmAPIKeyGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/apikey"
+	nativeIAmIdentityGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/workload"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/authentication/apikey"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/identity"
+	"github.com/slamy-solutions/openbp/modules/native/services/iam/src/services/token"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
+)
+
+// Management ID of the service identities created by this server
+const workload_management_id = identity.WORKLOAD_MANAGEMENT_ID
+
+// Lifetime of the service tokens. Tokens cant be revoked, so it must be short.
+const service_token_lifetime = time.Minute * 5
+
+var serviceNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+type IAMWorkloadServer struct {
+	grpc.UnimplementedIAMWorkloadServiceServer
+
+	identityServer *identity.IAmIdentityServer
+	apiKeyServer   *apikey.APIKeyService
+	tokenServer    *token.IAmTokenServer
+}
+
+func NewIAMWorkloadServer(identityServer *identity.IAmIdentityServer, apiKeyServer *apikey.APIKeyService, tokenServer *token.IAmTokenServer) *IAMWorkloadServer {
+	return &IAMWorkloadServer{
+		identityServer: identityServer,
+		apiKeyServer:   apiKeyServer,
+		tokenServer:    tokenServer,
+	}
+}
+
+// Returns name of the service if identity is the one created by EnsureIdentity. Only the identity from the global namespace that is registered for the service is accepted,
+// so identities that copy the management data of the service are not treated as service identities. Returns GRPC status error.
+func ServiceOfIdentity(ctx context.Context, identityServer nativeIAmIdentityGRPC.IAMIdentityServiceServer, namespace string, identity *nativeIAmIdentityGRPC.Identity, useCache bool) (string, bool, error) {
+	serviceData := identity.GetService()
+	if namespace != "" || serviceData == nil || serviceData.ManagementId != workload_management_id {
+		return "", false, nil
+	}
+
+	serviceIdentity, err := getServiceIdentity(ctx, identityServer, serviceData.Service, useCache)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	if serviceIdentity.Uuid != identity.Uuid {
+		return "", false, nil
+	}
+	return serviceData.Service, true, nil
+}
+
+func getServiceIdentity(ctx context.Context, identityServer nativeIAmIdentityGRPC.IAMIdentityServiceServer, service string, useCache bool) (*nativeIAmIdentityGRPC.Identity, error) {
+	response, err := identityServer.GetServiceManagedIdentity(ctx, &nativeIAmIdentityGRPC.GetServiceManagedIdentityRequest{
+		Namespace: "",
+		Service:   service,
+		ManagedId: workload_management_id,
+		UseCache:  useCache,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Identity, nil
+}
+
+func (s *IAMWorkloadServer) EnsureIdentity(ctx context.Context, in *grpc.EnsureIdentityRequest) (*grpc.EnsureIdentityResponse, error) {
+	if !serviceNameRegex.MatchString(in.Service) {
+		return nil, status.Error(codes.InvalidArgument, "Service name has bad format. Only lowercase letters, digits and underscores are allowed.")
+	}
+
+	existing, err := getServiceIdentity(ctx, s.identityServer, in.Service, false)
+	if err == nil {
+		return &grpc.EnsureIdentityResponse{IdentityUUID: existing.Uuid, Created: false}, status.Error(codes.OK, "")
+	}
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		return nil, err
+	}
+
+	createResponse, err := s.identityServer.CreateWorkloadIdentity(ctx, &nativeIAmIdentityGRPC.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            "Service " + in.Service,
+		InitiallyActive: true,
+		Managed: &nativeIAmIdentityGRPC.CreateIdentityRequest_Service{
+			Service: &nativeIAmIdentityGRPC.ServiceManagedData{
+				Service:      in.Service,
+				Reason:       "Identity of the service used to authenticate calls to the other services",
+				ManagementId: workload_management_id,
+			},
+		},
+	})
+	if err != nil {
+		// Identity was created by concurrent request
+		if st, ok := status.FromError(err); ok && st.Code() == codes.AlreadyExists {
+			existing, err := getServiceIdentity(ctx, s.identityServer, in.Service, false)
+			if err != nil {
+				return nil, err
+			}
+			return &grpc.EnsureIdentityResponse{IdentityUUID: existing.Uuid, Created: false}, status.Error(codes.OK, "")
+		}
+		return nil, err
+	}
+
+	return &grpc.EnsureIdentityResponse{IdentityUUID: createResponse.Identity.Uuid, Created: true}, status.Error(codes.OK, "")
+}
+
+func (s *IAMWorkloadServer) IssueToken(ctx context.Context, in *grpc.IssueTokenRequest) (*grpc.IssueTokenResponse, error) {
+	authenticateResponse, err := s.apiKeyServer.Authenticate(ctx, &nativeIAmAPIKeyGRPC.AuthenticateRequest{Key: in.ApiKey})
+	if err != nil {
+		return nil, err
+	}
+	if authenticateResponse.Status != nativeIAmAPIKeyGRPC.AuthenticateResponse_OK {
+		return &grpc.IssueTokenResponse{Status: grpc.IssueTokenResponse_INVALID_API_KEY}, status.Error(codes.OK, "")
+	}
+	key := authenticateResponse.ApiKey
+
+	identityResponse, err := s.identityServer.Get(ctx, &nativeIAmIdentityGRPC.GetIdentityRequest{
+		Namespace: key.Namespace,
+		Uuid:      key.Identity,
+		UseCache:  false,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return &grpc.IssueTokenResponse{Status: grpc.IssueTokenResponse_INVALID_API_KEY}, status.Error(codes.OK, "")
+		}
+		return nil, err
+	}
+	service, ok, err := ServiceOfIdentity(ctx, s.identityServer, key.Namespace, identityResponse.Identity, false)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &grpc.IssueTokenResponse{Status: grpc.IssueTokenResponse_NOT_SERVICE_IDENTITY}, status.Error(codes.OK, "")
+	}
+	if !identityResponse.Identity.Active {
+		return &grpc.IssueTokenResponse{Status: grpc.IssueTokenResponse_IDENTITY_NOT_ACTIVE}, status.Error(codes.OK, "")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(service_token_lifetime)
+	if key.ExpiresAt != nil && key.ExpiresAt.AsTime().Before(expiresAt) {
+		expiresAt = key.ExpiresAt.AsTime()
+	}
+	signed, err := s.tokenServer.SignServiceClaims(ctx, goJWT.MapClaims{
+		"iss":       "OBP native_iam_workload",
+		"sub":       key.Identity,
+		"namespace": key.Namespace,
+		"service":   service,
+		"token_use": serviceauth.ServiceTokenUse,
+		"iat":       now.Unix(),
+		"exp":       expiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpc.IssueTokenResponse{
+		Status:    grpc.IssueTokenResponse_OK,
+		Token:     signed,
+		ExpiresAt: timestamppb.New(expiresAt),
+		Service:   service,
+	}, status.Error(codes.OK, "")
+}
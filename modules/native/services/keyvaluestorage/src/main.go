@@ -14,8 +14,12 @@ import (
 
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	native_keyvaluestorage_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
+	native_namespace_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	"github.com/slamy-solutions/openbp/modules/native/services/keyvaluestorage/src/services"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
 )
 
 const (
@@ -31,9 +35,15 @@ func getHostname() string {
 }
 
 func main() {
-	systemStub := system.NewSystemStub(
-		system.NewSystemStubConfig().WithCache().WithDB().WithNats().WithOTel(system.NewOTelConfig("native", "keyvaluestorage", VERSION, getHostname())),
-	)
+	serviceAuthConfig := serviceauth.NewConfigFromEnv("native_keyvaluestorage").WithOnViolation(func(fullMethod string, err error) {
+		fmt.Println("Service authentication failed for [" + fullMethod + "]: " + err.Error())
+	})
+
+	systemStubConfig := system.NewSystemStubConfig().WithCache().WithDB().WithNats().WithOTel(system.NewOTelConfig("native", "keyvaluestorage", VERSION, getHostname()))
+	if serviceAuthConfig.Enabled() {
+		systemStubConfig.WithVault()
+	}
+	systemStub := system.NewSystemStub(systemStubConfig)
 	systemConnectionContext, cancel := context.WithTimeout(context.Background(), time.Second*15)
 	defer cancel()
 	err := systemStub.Connect(systemConnectionContext)
@@ -42,7 +52,11 @@ func main() {
 	}
 	defer systemStub.Close(context.Background())
 
-	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService())
+	serviceAuthDialOptions, err := serviceauth.DialOptions(serviceAuthConfig, native.NewServiceTokenIssuer(serviceAuthConfig.APIKey))
+	if err != nil {
+		panic("Failed to setup service authentication for clients: " + err.Error())
+	}
+	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithDialOptions(serviceAuthDialOptions...))
 	err = nativeStub.Connect()
 	if err != nil {
 		panic("Failed to connect to native services: " + err.Error())
@@ -50,25 +64,48 @@ func main() {
 	defer nativeStub.Close()
 
 	// Listen for events from other services
-	eventHandler, err := services.NewEventHandlerService(systemStub.DB, systemStub.Nats)
+	eventHandler, err := services.NewEventHandlerService(systemStub.DB, systemStub.Cache, systemStub.Nats)
 	if err != nil {
 		panic("Failed to setup event handler: " + err.Error())
 	}
 	defer eventHandler.Close()
 
+	serviceAuthenticator, err := serviceauth.NewAuthenticator(serviceAuthConfig, systemStub.Vault, serviceauth.Rules{
+		"/native_namespace.NamespaceSectionExporterService/": {"native_namespace"},
+	})
+	if err != nil {
+		panic("Failed to setup service authentication: " + err.Error())
+	}
+
 	// Creating grpc server
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
-		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
-		grpc.MaxRecvMsgSize(1024*1024*16), // 16 megabytes
-		grpc.MaxSendMsgSize(1024*1024*16), // 16 megabytes
-		grpc.KeepaliveParams(keepalive.ServerParameters{
-			MaxConnectionAge: time.Minute * 5,
-		}),
-	)
+	grpcServer := grpc.NewServer(append(
+		[]grpc.ServerOption{
+			grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+			grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+			grpc.MaxRecvMsgSize(1024 * 1024 * 16), // 16 megabytes
+			grpc.MaxSendMsgSize(1024 * 1024 * 16), // 16 megabytes
+			grpc.KeepaliveParams(keepalive.ServerParameters{
+				MaxConnectionAge: time.Minute * 5,
+			}),
+		},
+		serviceAuthenticator.ServerOptions()...,
+	)...)
 
 	storageServer := services.NewKeyValueStorageServer(systemStub.DB, systemStub.Cache, nativeStub.Services.Namespace)
 	native_keyvaluestorage_grpc.RegisterKeyValueStorageServiceServer(grpcServer, storageServer)
+	native_namespace_grpc.RegisterNamespaceSectionExporterServiceServer(grpcServer, native_namespace_grpc.NewSectionExporterServer("native_keyvaluestorage", db.NewNamespaceArchiver(systemStub.DB, db.ArchiveCollection{Name: "native_keyvaluestorage", Quota: []db.ArchiveQuota{{Resource: quota.KEYVALUESTORAGE_KEYS}}})))
+
+	// Indexes of the existing namespaces are created on startup, indexes of the new namespaces are created by the event handler
+	prepareContext, cancelPrepare := context.WithTimeout(context.Background(), time.Minute)
+	err = storageServer.PrepareCollections(prepareContext)
+	cancelPrepare()
+	if err != nil {
+		fmt.Println("Failed to prepare collections: " + err.Error())
+	}
+
+	collectorContext, stopCollector := context.WithCancel(context.Background())
+	defer stopCollector()
+	go storageServer.RunExpiredKeysCollector(collectorContext)
 
 	fmt.Println("Start listening for gRPC connections")
 	lis, err := net.Listen("tcp", ":80")
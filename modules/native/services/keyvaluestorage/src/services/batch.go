@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keyValueStorageGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
+)
+
+const (
+	MAX_BATCH_SIZE = 1000
+)
+
+func (s *KeyValueStorageServer) BatchGet(ctx context.Context, in *keyValueStorageGRPC.BatchGetRequest) (*keyValueStorageGRPC.BatchGetResponse, error) {
+	if len(in.Keys) > MAX_BATCH_SIZE {
+		return nil, status.Errorf(grpccodes.InvalidArgument, "Too many keys. Maximum number of keys is %d.", MAX_BATCH_SIZE)
+	}
+	if len(in.Keys) == 0 {
+		return &keyValueStorageGRPC.BatchGetResponse{Entries: []*keyValueStorageGRPC.Entry{}}, status.Error(grpccodes.OK, "")
+	}
+
+	collection := getCollectionByNamespace(s, in.Namespace)
+	cursor, err := collection.Find(ctx, bson.M{"key": bson.M{"$in": in.Keys}, "expireAt": notExpiredFilter(time.Now().UTC())})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return &keyValueStorageGRPC.BatchGetResponse{Entries: []*keyValueStorageGRPC.Entry{}}, status.Error(grpccodes.OK, "")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Error while getting keys: "+err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	entries := make([]*keyValueStorageGRPC.Entry, 0, len(in.Keys))
+	for cursor.Next(ctx) {
+		var entry keyInMongo
+		if err := cursor.Decode(&entry); err != nil {
+			return nil, status.Error(grpccodes.Internal, "Error while decoding key from database: "+err.Error())
+		}
+		entries = append(entries, entry.ToGRPCEntry())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, status.Error(grpccodes.Internal, "Error while getting keys: "+err.Error())
+	}
+
+	return &keyValueStorageGRPC.BatchGetResponse{Entries: entries}, status.Error(grpccodes.OK, "")
+}
+
+func (s *KeyValueStorageServer) BatchSet(ctx context.Context, in *keyValueStorageGRPC.BatchSetRequest) (*keyValueStorageGRPC.BatchSetResponse, error) {
+	if len(in.Entries) > MAX_BATCH_SIZE {
+		return nil, status.Errorf(grpccodes.InvalidArgument, "Too many entries. Maximum number of entries is %d.", MAX_BATCH_SIZE)
+	}
+	totalSize := 0
+	for _, entry := range in.Entries {
+		totalSize += len(entry.Key) + len(entry.Value)
+	}
+	if totalSize > MAX_ENTRY_SIZE {
+		return nil, status.Error(grpccodes.InvalidArgument, "Total size of keys and values is too big. It must be less than 15 megabytes.")
+	}
+
+	if err := s.checkNamespace(ctx, in.Namespace); err != nil {
+		return nil, err
+	}
+
+	collection := getCollectionByNamespace(s, in.Namespace)
+	versions := make([]int64, 0, len(in.Entries))
+	for _, entry := range in.Entries {
+		version, err := s.setKey(ctx, collection, in.Namespace, entry.Key, entry.Value, entry.Ttl)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+
+	return &keyValueStorageGRPC.BatchSetResponse{Versions: versions}, status.Error(grpccodes.OK, "")
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
+
+	keyValueStorageGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
+)
+
+// Returns current version of the key or 0 if key doesnt exist
+func (s *KeyValueStorageServer) getKeyVersion(ctx context.Context, collection *mongo.Collection, key string) (int64, error) {
+	var entry keyInMongo
+	err := collection.FindOne(ctx, bson.M{"key": key, "expireAt": notExpiredFilter(time.Now().UTC())}, options.FindOne().SetProjection(bson.M{"version": 1})).Decode(&entry)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return entry.Version, nil
+}
+
+func (s *KeyValueStorageServer) CompareAndSwap(ctx context.Context, in *keyValueStorageGRPC.CompareAndSwapRequest) (*keyValueStorageGRPC.CompareAndSwapResponse, error) {
+	if len(in.Value)+len(in.Key) > MAX_ENTRY_SIZE {
+		return nil, status.Error(grpccodes.InvalidArgument, "Size of key+value is too big. It must be less than 15 megabytes.")
+	}
+	if in.ExpectedVersion < 0 {
+		return nil, status.Error(grpccodes.InvalidArgument, "Expected version can not be negative")
+	}
+
+	if err := s.checkNamespace(ctx, in.Namespace); err != nil {
+		return nil, err
+	}
+
+	collection := getCollectionByNamespace(s, in.Namespace)
+
+	now := time.Now().UTC()
+	if err := s.removeExpiredKey(ctx, collection, in.Namespace, in.Key, now); err != nil {
+		return nil, status.Error(grpccodes.Internal, err.Error())
+	}
+	expireAt := expireAtFromTTL(now, in.Ttl)
+
+	// Version 0 means that key must not exist
+	if in.ExpectedVersion == 0 {
+		err := quota.Reserve(ctx, s.mongoClient, in.Namespace, quota.KEYVALUESTORAGE_KEYS, 1)
+		if err == quota.ErrQuotaExceeded {
+			version, err := s.getKeyVersion(ctx, collection, in.Key)
+			if err != nil {
+				return nil, status.Error(grpccodes.Internal, err.Error())
+			}
+			if version == 0 {
+				return nil, status.Error(grpccodes.ResourceExhausted, "Quota of the keys in the namespace is exceeded")
+			}
+			return &keyValueStorageGRPC.CompareAndSwapResponse{Swapped: false, Version: version}, status.Error(grpccodes.OK, "")
+		}
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, err.Error())
+		}
+
+		newEntry := bson.M{"key": in.Key, "value": in.Value, "version": 1}
+		if expireAt != nil {
+			newEntry["expireAt"] = *expireAt
+		}
+		var existing keyInMongo
+		err = collection.FindOneAndUpdate(ctx, bson.M{"key": in.Key}, bson.M{"$setOnInsert": newEntry}, options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before).SetProjection(bson.M{"version": 1})).Decode(&existing)
+		if err == mongo.ErrNoDocuments {
+			s.cacheClient.Remove(ctx, makeCacheKey(in.Namespace, in.Key))
+			return &keyValueStorageGRPC.CompareAndSwapResponse{Swapped: true, Version: 1}, status.Error(grpccodes.OK, "")
+		}
+		quota.Release(ctx, s.mongoClient, in.Namespace, quota.KEYVALUESTORAGE_KEYS, 1)
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, err.Error())
+		}
+		return &keyValueStorageGRPC.CompareAndSwapResponse{Swapped: false, Version: existing.Version}, status.Error(grpccodes.OK, "")
+	}
+
+	var entry keyInMongo
+	updateFilter := bson.M{"key": in.Key, "version": in.ExpectedVersion, "expireAt": notExpiredFilter(now)}
+	updateData := withExpireAt(bson.M{"$inc": bson.M{"version": 1}}, bson.M{"value": in.Value}, expireAt)
+	err := collection.FindOneAndUpdate(ctx, updateFilter, updateData, options.FindOneAndUpdate().SetReturnDocument(options.After).SetProjection(bson.M{"version": 1})).Decode(&entry)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			version, err := s.getKeyVersion(ctx, collection, in.Key)
+			if err != nil {
+				return nil, status.Error(grpccodes.Internal, err.Error())
+			}
+			return &keyValueStorageGRPC.CompareAndSwapResponse{Swapped: false, Version: version}, status.Error(grpccodes.OK, "")
+		}
+		return nil, status.Error(grpccodes.Internal, err.Error())
+	}
+
+	s.cacheClient.Remove(ctx, makeCacheKey(in.Namespace, in.Key))
+	return &keyValueStorageGRPC.CompareAndSwapResponse{Swapped: true, Version: entry.Version}, status.Error(grpccodes.OK, "")
+}
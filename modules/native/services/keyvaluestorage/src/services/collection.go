@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	namespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+)
+
+const (
+	KEY_ORDER_INDEX_NAME = "key_ascending"
+	EXPIRE_AT_INDEX_NAME = "expireAt"
+)
+
+// Creates indexes and updates keys created by the older versions of the service. Safe to be called multiple times.
+func prepareCollection(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Options: options.Index().SetName(KEY_INDEX_NAME),
+			Keys:    bson.D{bson.E{Key: "key", Value: "hashed"}},
+		},
+		{
+			// Used for listing keys by prefix
+			Options: options.Index().SetName(KEY_ORDER_INDEX_NAME),
+			Keys:    bson.D{bson.E{Key: "key", Value: 1}},
+		},
+		{
+			// Used by the expired keys collector. Keys without TTL are not indexed.
+			Options: options.Index().SetName(EXPIRE_AT_INDEX_NAME).SetPartialFilterExpression(bson.M{"expireAt": bson.M{"$exists": true}}),
+			Keys:    bson.D{bson.E{Key: "expireAt", Value: 1}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create indexes: %s", err.Error())
+	}
+
+	// Keys without version can not be used with CompareAndSwap
+	_, err = collection.UpdateMany(ctx, bson.M{"version": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"version": 1}})
+	if err != nil {
+		return fmt.Errorf("failed to set versions for the keys: %s", err.Error())
+	}
+
+	return nil
+}
+
+// Returns names of all the namespaces including global namespace
+func (s *KeyValueStorageServer) getAllNamespaces(ctx context.Context) ([]string, error) {
+	namespaces := []string{""}
+
+	stream, err := s.namespaceClient.GetAll(ctx, &namespaceGRPC.GetAllNamespacesRequest{UseCache: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %s", err.Error())
+	}
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("failed to list namespaces: %s", err.Error())
+		}
+		namespaces = append(namespaces, response.Namespace.Name)
+	}
+
+	return namespaces, nil
+}
+
+// Prepares collections of the global namespace and of all the existing namespaces. Collections of the new namespaces are prepared on the namespace creation event.
+func (s *KeyValueStorageServer) PrepareCollections(ctx context.Context) error {
+	namespaces, err := s.getAllNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range namespaces {
+		if err := prepareCollection(ctx, getCollectionByNamespace(s, namespace)); err != nil {
+			return fmt.Errorf("failed to prepare collection of the [%s] namespace: %s", namespace, err.Error())
+		}
+	}
+	return nil
+}
@@ -6,14 +6,13 @@ import (
 	"fmt"
 	"time"
 
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/nats-io/nats.go"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/cache"
 	system_nats "github.com/slamy-solutions/openbp/modules/system/libs/golang/nats"
 
 	namespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
@@ -21,23 +20,31 @@ import (
 
 const (
 	NAMESPACE_CREATION_EVENT_CONSUMER_NAME = "native_keyvaluestorage_namespacecreation"
+	NAMESPACE_DELETION_EVENT_CONSUMER_NAME = "native_keyvaluestorage_namespacedeletion"
 )
 
 type eventHandlerService struct {
 	mongoClient                      *mongo.Client
+	cacheClient                      cache.Cache
+	js                               nats.JetStreamContext
 	namespaceCreateEventSubscription *nats.Subscription
+	namespaceDeleteEventSubscription *nats.Subscription
 }
 
-func NewEventHandlerService(mongoClient *mongo.Client, natsClient *nats.Conn) (*eventHandlerService, error) {
+func NewEventHandlerService(mongoClient *mongo.Client, cacheClient cache.Cache, natsClient *nats.Conn) (*eventHandlerService, error) {
 	service := &eventHandlerService{
 		mongoClient:                      mongoClient,
+		cacheClient:                      cacheClient,
 		namespaceCreateEventSubscription: nil,
+		namespaceDeleteEventSubscription: nil,
 	}
 
 	js, err := natsClient.JetStream()
 	if err != nil {
 		return nil, errors.New("Error while opening jetsteram context. " + err.Error())
 	}
+	service.js = js
+
 	_, err = js.AddConsumer("native_namespace_event", &nats.ConsumerConfig{
 		Durable:        NAMESPACE_CREATION_EVENT_CONSUMER_NAME,
 		Name:           NAMESPACE_CREATION_EVENT_CONSUMER_NAME,
@@ -57,6 +64,25 @@ func NewEventHandlerService(mongoClient *mongo.Client, natsClient *nats.Conn) (*
 
 	service.namespaceCreateEventSubscription = subscribtion
 
+	_, err = js.AddConsumer("native_namespace_deletion", &nats.ConsumerConfig{
+		Durable:        NAMESPACE_DELETION_EVENT_CONSUMER_NAME,
+		Name:           NAMESPACE_DELETION_EVENT_CONSUMER_NAME,
+		Description:    "Listens on native_namespace deletion requests for native_keyvaluestorage",
+		AckPolicy:      nats.AckExplicitPolicy,
+		FilterSubject:  "native.namespace.deletion.requested",
+		DeliverSubject: "native.keyvaluestorage.deliver.namespace.delete",
+		DeliverGroup:   "native.keyvaluestorage.deliver.namespace.delete",
+	})
+	if err != nil {
+		return nil, errors.New("Error while creating consumer. " + err.Error())
+	}
+	subscribtion, err = js.QueueSubscribe("native.namespace.deletion.requested", "native.keyvaluestorage.deliver.namespace.delete", service.handleNamespaceDeletionEvent, nats.Bind("native_namespace_deletion", NAMESPACE_DELETION_EVENT_CONSUMER_NAME))
+	if err != nil {
+		return nil, errors.New("Error while creating subscribtion. " + err.Error())
+	}
+
+	service.namespaceDeleteEventSubscription = subscribtion
+
 	return service, nil
 }
 
@@ -65,6 +91,10 @@ func (s *eventHandlerService) Close() error {
 	if err != nil {
 		return errors.New("Error while unsubscribing from namespace events. " + err.Error())
 	}
+	err = s.namespaceDeleteEventSubscription.Unsubscribe()
+	if err != nil {
+		return errors.New("Error while unsubscribing from namespace deletion requests. " + err.Error())
+	}
 	return nil
 }
 
@@ -82,12 +112,9 @@ func (s *eventHandlerService) handleNamespaceCreationEvent(msg *nats.Msg) {
 	}
 
 	collection := s.mongoClient.Database(fmt.Sprintf("openbp_namespace_%s", namespace.Name)).Collection("native_keyvaluestorage")
-	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
-		Options: options.Index().SetName(KEY_INDEX_NAME),
-		Keys:    bson.D{bson.E{Key: "key", Value: "hashed"}},
-	})
+	err = prepareCollection(ctx, collection)
 	if err != nil {
-		span.SetStatus(codes.Error, "Failed to create index: "+err.Error())
+		span.SetStatus(codes.Error, "Failed to prepare collection: "+err.Error())
 		span.RecordError(err)
 		msg.Nak()
 		return
@@ -100,3 +127,49 @@ func (s *eventHandlerService) handleNamespaceCreationEvent(msg *nats.Msg) {
 	})
 	msg.Ack()
 }
+
+func (s *eventHandlerService) handleNamespaceDeletionEvent(msg *nats.Msg) {
+	ctx, span := system_nats.StartTelemetrySpanFromMessage(context.Background(), msg, "Handle namespace deletion event")
+	defer span.End()
+
+	var namespace namespaceGRPC.Namespace
+	err := proto.Unmarshal(msg.Data, &namespace)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to unmarshal namespace from event: "+err.Error())
+		span.RecordError(err)
+		msg.Ack()
+		return
+	}
+	span.SetAttributes(attribute.KeyValue{
+		Key:   "namespace",
+		Value: attribute.StringValue(namespace.Name),
+	})
+
+	// Values are stored in the database of the namespace and will be dropped together with it. Only cache must be cleaned.
+	cleanupErr := s.cacheClient.RemoveByPattern(ctx, fmt.Sprintf("native_keyvaluestorage_entry_%s_*", namespace.Name))
+
+	acknowledgement := &namespaceGRPC.NamespaceDeletionAcknowledgement{
+		Namespace:   namespace.Name,
+		Participant: NAMESPACE_DELETION_EVENT_CONSUMER_NAME,
+		Attempt:     namespace.DeletionAttempt,
+	}
+	if cleanupErr != nil {
+		acknowledgement.Error = "Failed to remove cache: " + cleanupErr.Error()
+	}
+	acknowledgementBytes, _ := proto.Marshal(acknowledgement)
+	_, err = s.js.Publish("native.namespace.deletion.acknowledged", acknowledgementBytes)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to publish acknowledgement: "+err.Error())
+		msg.NakWithDelay(time.Second * 5)
+		return
+	}
+
+	if cleanupErr != nil {
+		span.SetStatus(codes.Error, acknowledgement.Error)
+		msg.NakWithDelay(time.Second * 5)
+		return
+	}
+
+	span.SetStatus(codes.Ok, "")
+	msg.Ack()
+}
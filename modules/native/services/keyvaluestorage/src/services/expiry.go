@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
+)
+
+const (
+	expired_keys_collection_interval = 30 * time.Second
+)
+
+// Periodically removes expired keys from all the namespaces and releases their quota. Blocks until context is done.
+// Expired keys are invisible even before they are removed, so the interval only affects how long they occupy the storage.
+func (s *KeyValueStorageServer) RunExpiredKeysCollector(ctx context.Context) {
+	ticker := time.NewTicker(expired_keys_collection_interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.collectExpiredKeys(ctx); err != nil {
+				fmt.Println("Failed to remove expired keys: " + err.Error())
+			}
+		}
+	}
+}
+
+func (s *KeyValueStorageServer) collectExpiredKeys(ctx context.Context) error {
+	namespaces, err := s.getAllNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range namespaces {
+		if err := s.collectExpiredKeysInNamespace(ctx, namespace, time.Now().UTC()); err != nil {
+			fmt.Printf("Failed to remove expired keys in the [%s] namespace: %s\n", namespace, err.Error())
+		}
+	}
+	return nil
+}
+
+func (s *KeyValueStorageServer) collectExpiredKeysInNamespace(ctx context.Context, namespace string, now time.Time) error {
+	collection := getCollectionByNamespace(s, namespace)
+
+	// Multiple instances of the service may run at the same time. Every document is deleted only once, so quota is released only once.
+	deleteResult, err := collection.DeleteMany(ctx, bson.M{"expireAt": bson.M{"$lte": now}})
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok && err.HasErrorLabel("InvalidNamespace") {
+			return nil
+		}
+		return err
+	}
+
+	// Cached values expire together with the keys, so cache doesnt have to be cleaned
+	if deleteResult.DeletedCount != 0 {
+		return quota.Release(ctx, s.mongoClient, namespace, quota.KEYVALUESTORAGE_KEYS, deleteResult.DeletedCount)
+	}
+	return nil
+}
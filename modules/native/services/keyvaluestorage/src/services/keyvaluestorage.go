@@ -10,8 +10,11 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	grpccodes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/slamy-solutions/openbp/modules/system/libs/golang/cache"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
 
 	keyValueStorageGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
 	namespaceGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
@@ -25,9 +28,24 @@ type KeyValueStorageServer struct {
 	namespaceClient namespaceGRPC.NamespaceServiceClient
 }
 
+// Keys written before versioning was introduced have version 0 until their next change
 type keyInMongo struct {
-	Key   string `bson:"key"`
-	Value []byte `bson:"value"`
+	Key      string     `bson:"key"`
+	Value    []byte     `bson:"value"`
+	Version  int64      `bson:"version"`
+	ExpireAt *time.Time `bson:"expireAt,omitempty"`
+}
+
+func (k *keyInMongo) ToGRPCEntry() *keyValueStorageGRPC.Entry {
+	entry := &keyValueStorageGRPC.Entry{
+		Key:     k.Key,
+		Value:   k.Value,
+		Version: k.Version,
+	}
+	if k.ExpireAt != nil {
+		entry.ExpireAt = timestamppb.New(*k.ExpireAt)
+	}
+	return entry
 }
 
 const (
@@ -37,7 +55,102 @@ const (
 )
 
 func makeCacheKey(namespace string, key string) string {
-	return fmt.Sprintf("native_keyvaluestorage_key_%s_%s", namespace, key)
+	return fmt.Sprintf("native_keyvaluestorage_entry_%s_%s", namespace, key)
+}
+
+// Matches keys that are not expired. Expired keys may still be in the database until the expired keys collector removes them.
+func notExpiredFilter(now time.Time) bson.M {
+	return bson.M{"$not": bson.M{"$lte": now}}
+}
+
+// Calculates expiration time for the TTL in seconds. Returns nil if key must not expire.
+func expireAtFromTTL(now time.Time, ttl uint32) *time.Time {
+	if ttl == 0 {
+		return nil
+	}
+	expireAt := now.Add(time.Duration(ttl) * time.Second).UTC()
+	return &expireAt
+}
+
+// Adds expiration time to the update. Removes previous expiration time if key must not expire.
+func withExpireAt(updateData bson.M, set bson.M, expireAt *time.Time) bson.M {
+	if expireAt != nil {
+		set["expireAt"] = *expireAt
+	} else {
+		updateData["$unset"] = bson.M{"expireAt": ""}
+	}
+	updateData["$set"] = set
+	return updateData
+}
+
+func (s *KeyValueStorageServer) checkNamespace(ctx context.Context, namespace string) error {
+	if namespace == "" {
+		return nil
+	}
+	r, err := s.namespaceClient.Exists(ctx, &namespaceGRPC.IsNamespaceExistRequest{Name: namespace, UseCache: true})
+	if err != nil {
+		return status.Error(grpccodes.Internal, "Error while checking if namespace exists: "+err.Error())
+	}
+	if !r.Exist {
+		return status.Error(grpccodes.FailedPrecondition, "Namespace doesnt exist")
+	}
+	return nil
+}
+
+// Removes the key if it is expired, so it will not block inserts of the new value. Quota is released for removed key.
+func (s *KeyValueStorageServer) removeExpiredKey(ctx context.Context, collection *mongo.Collection, namespace string, key string, now time.Time) error {
+	deleteResult, err := collection.DeleteOne(ctx, bson.M{"key": key, "expireAt": bson.M{"$lte": now}})
+	if err != nil {
+		return err
+	}
+	if deleteResult.DeletedCount != 0 {
+		quota.Release(ctx, s.mongoClient, namespace, quota.KEYVALUESTORAGE_KEYS, 1)
+	}
+	return nil
+}
+
+func (s *KeyValueStorageServer) setKey(ctx context.Context, collection *mongo.Collection, namespace string, key string, value []byte, ttl uint32) (int64, error) {
+	now := time.Now().UTC()
+	if err := s.removeExpiredKey(ctx, collection, namespace, key, now); err != nil {
+		return 0, status.Error(grpccodes.Internal, err.Error())
+	}
+	expireAt := expireAtFromTTL(now, ttl)
+
+	var entry keyInMongo
+	defer s.cacheClient.Remove(ctx, makeCacheKey(namespace, key))
+
+	// Quota is reserved for the case when key doesnt exist. Existing key can be overwritten even if quota is exceeded.
+	err := quota.Reserve(ctx, s.mongoClient, namespace, quota.KEYVALUESTORAGE_KEYS, 1)
+	if err == quota.ErrQuotaExceeded {
+		updateData := withExpireAt(bson.M{"$inc": bson.M{"version": 1}}, bson.M{"value": value}, expireAt)
+		err := collection.FindOneAndUpdate(ctx, bson.M{"key": key}, updateData, options.FindOneAndUpdate().SetReturnDocument(options.After).SetProjection(bson.M{"version": 1})).Decode(&entry)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return 0, status.Error(grpccodes.ResourceExhausted, "Quota of the keys in the namespace is exceeded")
+			}
+			return 0, status.Error(grpccodes.Internal, err.Error())
+		}
+		return entry.Version, nil
+	}
+	if err != nil {
+		return 0, status.Error(grpccodes.Internal, err.Error())
+	}
+
+	// Version of the new key will be 1
+	updateData := withExpireAt(bson.M{"$setOnInsert": bson.M{"key": key}, "$inc": bson.M{"version": 1}}, bson.M{"value": value}, expireAt)
+	var before keyInMongo
+	err = collection.FindOneAndUpdate(ctx, bson.M{"key": key}, updateData, options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before).SetProjection(bson.M{"version": 1})).Decode(&before)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 1, nil
+		}
+		quota.Release(ctx, s.mongoClient, namespace, quota.KEYVALUESTORAGE_KEYS, 1)
+		return 0, status.Error(grpccodes.Internal, err.Error())
+	}
+
+	// Key already existed
+	quota.Release(ctx, s.mongoClient, namespace, quota.KEYVALUESTORAGE_KEYS, 1)
+	return before.Version + 1, nil
 }
 
 func getCollectionByNamespace(server *KeyValueStorageServer, namespace string) *mongo.Collection {
@@ -63,66 +176,89 @@ func (s *KeyValueStorageServer) Set(ctx context.Context, in *keyValueStorageGRPC
 		return nil, status.Error(grpccodes.InvalidArgument, "Size of key+value is too big. It must be less than 15 megabytes.")
 	}
 
-	if in.Namespace != "" {
-		r, err := s.namespaceClient.Exists(ctx, &namespaceGRPC.IsNamespaceExistRequest{Name: in.Namespace, UseCache: true})
-		if err != nil {
-			return nil, status.Error(grpccodes.Internal, "Error while checking if namespace exists: "+err.Error())
-		}
-		if !r.Exist {
-			return nil, status.Error(grpccodes.FailedPrecondition, "Namespace doesnt exist")
-		}
+	if err := s.checkNamespace(ctx, in.Namespace); err != nil {
+		return nil, err
 	}
 
 	collection := getCollectionByNamespace(s, in.Namespace)
-	updateData := bson.M{"$setOnInsert": bson.M{"key": in.Key}, "$set": bson.M{"value": in.Value}}
-	_, err := collection.UpdateOne(ctx, bson.M{"key": in.Key}, updateData, options.Update().SetUpsert(true))
+	version, err := s.setKey(ctx, collection, in.Namespace, in.Key, in.Value, in.Ttl)
 	if err != nil {
-		return nil, status.Error(grpccodes.Internal, err.Error())
+		return nil, err
 	}
 
-	s.cacheClient.Remove(ctx, makeCacheKey(in.Namespace, in.Key))
-	return &keyValueStorageGRPC.SetResponse{}, status.Error(grpccodes.OK, "")
+	return &keyValueStorageGRPC.SetResponse{Version: version}, status.Error(grpccodes.OK, "")
 }
 func (s *KeyValueStorageServer) SetIfNotExist(ctx context.Context, in *keyValueStorageGRPC.SetIfNotExistRequest) (*keyValueStorageGRPC.SetIfNotExistResponse, error) {
 	if len(in.Value)+len(in.Key) > MAX_ENTRY_SIZE {
 		return nil, status.Error(grpccodes.InvalidArgument, "Size of key+value is too big. It must be less than 15 megabytes.")
 	}
 
-	if in.Namespace != "" {
-		r, err := s.namespaceClient.Exists(ctx, &namespaceGRPC.IsNamespaceExistRequest{Name: in.Namespace, UseCache: true})
+	if err := s.checkNamespace(ctx, in.Namespace); err != nil {
+		return nil, err
+	}
+
+	collection := getCollectionByNamespace(s, in.Namespace)
+
+	now := time.Now().UTC()
+	if err := s.removeExpiredKey(ctx, collection, in.Namespace, in.Key, now); err != nil {
+		return nil, status.Error(grpccodes.Internal, err.Error())
+	}
+
+	err := quota.Reserve(ctx, s.mongoClient, in.Namespace, quota.KEYVALUESTORAGE_KEYS, 1)
+	if err == quota.ErrQuotaExceeded {
+		count, err := collection.CountDocuments(ctx, bson.M{"key": in.Key}, options.Count().SetLimit(1))
 		if err != nil {
-			return nil, status.Error(grpccodes.Internal, "Error while checking if namespace exists: "+err.Error())
+			return nil, status.Error(grpccodes.Internal, err.Error())
 		}
-		if !r.Exist {
-			return nil, status.Error(grpccodes.FailedPrecondition, "Namespace doesnt exist")
+		if count == 0 {
+			return nil, status.Error(grpccodes.ResourceExhausted, "Quota of the keys in the namespace is exceeded")
 		}
+		return &keyValueStorageGRPC.SetIfNotExistResponse{Seted: false}, status.Error(grpccodes.OK, "")
+	}
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, err.Error())
 	}
 
-	collection := getCollectionByNamespace(s, in.Namespace)
-	updateData := bson.M{"$setOnInsert": bson.M{"key": in.Key, "value": in.Value}}
-	updateResult, err := collection.UpdateOne(ctx, bson.M{"key": in.Key}, updateData, options.Update().SetUpsert(true))
+	newEntry := bson.M{"key": in.Key, "value": in.Value, "version": 1}
+	if expireAt := expireAtFromTTL(now, in.Ttl); expireAt != nil {
+		newEntry["expireAt"] = *expireAt
+	}
+	updateResult, err := collection.UpdateOne(ctx, bson.M{"key": in.Key}, bson.M{"$setOnInsert": newEntry}, options.Update().SetUpsert(true))
 	if err != nil {
+		quota.Release(ctx, s.mongoClient, in.Namespace, quota.KEYVALUESTORAGE_KEYS, 1)
 		return nil, status.Error(grpccodes.Internal, err.Error())
 	}
+	if updateResult.UpsertedCount == 0 {
+		quota.Release(ctx, s.mongoClient, in.Namespace, quota.KEYVALUESTORAGE_KEYS, 1)
+	} else {
+		s.cacheClient.Remove(ctx, makeCacheKey(in.Namespace, in.Key))
+	}
 
 	return &keyValueStorageGRPC.SetIfNotExistResponse{Seted: updateResult.UpsertedCount != 0}, status.Error(grpccodes.OK, "")
 
 }
 func (s *KeyValueStorageServer) Get(ctx context.Context, in *keyValueStorageGRPC.GetRequest) (*keyValueStorageGRPC.GetResponse, error) {
+	now := time.Now().UTC()
+
 	var cacheKey string
 	if in.UseCache {
 		cacheKey = makeCacheKey(in.Namespace, in.Key)
 		value, _ := s.cacheClient.Get(ctx, cacheKey)
 		if value != nil {
-			return &keyValueStorageGRPC.GetResponse{
-				Value: value,
-			}, status.Error(grpccodes.OK, "")
+			var entry keyValueStorageGRPC.Entry
+			if err := proto.Unmarshal(value, &entry); err == nil && (entry.ExpireAt == nil || entry.ExpireAt.AsTime().After(now)) {
+				return &keyValueStorageGRPC.GetResponse{
+					Value:    entry.Value,
+					Version:  entry.Version,
+					ExpireAt: entry.ExpireAt,
+				}, status.Error(grpccodes.OK, "")
+			}
 		}
 	}
 
 	var entry keyInMongo
 	collection := getCollectionByNamespace(s, in.Namespace)
-	err := collection.FindOne(ctx, bson.M{"key": in.Key}, options.FindOne()).Decode(&entry)
+	err := collection.FindOne(ctx, bson.M{"key": in.Key, "expireAt": notExpiredFilter(now)}, options.FindOne()).Decode(&entry)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, status.Error(grpccodes.NotFound, "Value for specified namespaces and key wasnt founded")
@@ -135,16 +271,29 @@ func (s *KeyValueStorageServer) Get(ctx context.Context, in *keyValueStorageGRPC
 		return nil, status.Error(grpccodes.Internal, err.Error())
 	}
 
+	grpcEntry := entry.ToGRPCEntry()
 	if in.UseCache {
-		s.cacheClient.Set(ctx, cacheKey, entry.Value, CACHE_KEY_EXPIRATION_TIME)
+		// Cached value must not outlive the key
+		expiration := CACHE_KEY_EXPIRATION_TIME
+		if entry.ExpireAt != nil && entry.ExpireAt.Sub(now) < expiration {
+			expiration = entry.ExpireAt.Sub(now)
+		}
+		if entryBytes, err := proto.Marshal(grpcEntry); err == nil {
+			s.cacheClient.Set(ctx, cacheKey, entryBytes, expiration)
+		}
 	}
 
-	return &keyValueStorageGRPC.GetResponse{Value: entry.Value}, status.Error(grpccodes.OK, "")
+	return &keyValueStorageGRPC.GetResponse{Value: grpcEntry.Value, Version: grpcEntry.Version, ExpireAt: grpcEntry.ExpireAt}, status.Error(grpccodes.OK, "")
 }
 func (s *KeyValueStorageServer) Remove(ctx context.Context, in *keyValueStorageGRPC.RemoveRequest) (*keyValueStorageGRPC.RemoveResponse, error) {
 	collection := getCollectionByNamespace(s, in.Namespace)
-	response, err := collection.DeleteOne(ctx, bson.M{"key": in.Key})
+
+	var entry keyInMongo
+	err := collection.FindOneAndDelete(ctx, bson.M{"key": in.Key}, options.FindOneAndDelete().SetProjection(bson.M{"expireAt": 1})).Decode(&entry)
 	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return &keyValueStorageGRPC.RemoveResponse{Removed: false}, status.Error(grpccodes.OK, "")
+		}
 		if err, ok := err.(mongo.WriteException); ok {
 			if err.HasErrorLabel("InvalidNamespace") {
 				return &keyValueStorageGRPC.RemoveResponse{Removed: false}, status.Error(grpccodes.OK, "")
@@ -153,11 +302,12 @@ func (s *KeyValueStorageServer) Remove(ctx context.Context, in *keyValueStorageG
 		return nil, status.Error(grpccodes.Internal, err.Error())
 	}
 
-	if response.DeletedCount != 0 {
-		s.cacheClient.Remove(ctx, makeCacheKey(in.Namespace, in.Key))
-	}
+	s.cacheClient.Remove(ctx, makeCacheKey(in.Namespace, in.Key))
+	quota.Release(ctx, s.mongoClient, in.Namespace, quota.KEYVALUESTORAGE_KEYS, 1)
 
-	return &keyValueStorageGRPC.RemoveResponse{Removed: response.DeletedCount != 0}, status.Error(grpccodes.OK, "")
+	// Expired key was already logically removed
+	removed := entry.ExpireAt == nil || entry.ExpireAt.After(time.Now())
+	return &keyValueStorageGRPC.RemoveResponse{Removed: removed}, status.Error(grpccodes.OK, "")
 }
 func (s *KeyValueStorageServer) Exist(ctx context.Context, in *keyValueStorageGRPC.ExistRequest) (*keyValueStorageGRPC.ExistResponse, error) {
 	var cacheKey string
@@ -182,7 +332,7 @@ func (s *KeyValueStorageServer) Exist(ctx context.Context, in *keyValueStorageGR
 		return &keyValueStorageGRPC.ExistResponse{Exist: true}, status.Error(grpccodes.OK, "")
 	} else {
 		collection := getCollectionByNamespace(s, in.Namespace)
-		count, err := collection.CountDocuments(ctx, bson.M{"key": in.Key}, options.Count().SetLimit(1))
+		count, err := collection.CountDocuments(ctx, bson.M{"key": in.Key, "expireAt": notExpiredFilter(time.Now().UTC())}, options.Count().SetLimit(1))
 		if err != nil {
 			if err, ok := err.(mongo.WriteException); ok {
 				if err.HasErrorLabel("InvalidNamespace") {
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keyValueStorageGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
+)
+
+const (
+	DEFAULT_LIST_LIMIT = 100
+	MAX_LIST_LIMIT     = 1000
+	// Response must fit into the maximum size of the grpc message
+	MAX_LIST_RESPONSE_SIZE = 1024 * 1024 * 8
+)
+
+func (s *KeyValueStorageServer) List(ctx context.Context, in *keyValueStorageGRPC.ListRequest) (*keyValueStorageGRPC.ListResponse, error) {
+	limit := int64(in.Limit)
+	if limit == 0 {
+		limit = DEFAULT_LIST_LIMIT
+	}
+	if limit > MAX_LIST_LIMIT {
+		return nil, status.Errorf(grpccodes.InvalidArgument, "Limit is too big. Maximum limit is %d.", MAX_LIST_LIMIT)
+	}
+
+	filter := bson.M{"expireAt": notExpiredFilter(time.Now().UTC())}
+	keyFilter := bson.M{}
+	if in.Prefix != "" {
+		keyFilter["$regex"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(in.Prefix)}
+	}
+	if in.Cursor != "" {
+		keyFilter["$gt"] = in.Cursor
+	}
+	if len(keyFilter) != 0 {
+		filter["key"] = keyFilter
+	}
+
+	findOptions := options.Find().SetSort(bson.M{"key": 1}).SetLimit(limit + 1)
+	if in.KeysOnly {
+		findOptions.SetProjection(bson.M{"value": 0})
+	}
+
+	collection := getCollectionByNamespace(s, in.Namespace)
+	cursor, err := collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		if err, ok := err.(mongo.WriteException); ok {
+			if err.HasErrorLabel("InvalidNamespace") {
+				return &keyValueStorageGRPC.ListResponse{Entries: []*keyValueStorageGRPC.Entry{}}, status.Error(grpccodes.OK, "")
+			}
+		}
+		return nil, status.Error(grpccodes.Internal, "Error while listing keys: "+err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	entries := make([]*keyValueStorageGRPC.Entry, 0, limit)
+	responseSize := 0
+	nextCursor := ""
+	for cursor.Next(ctx) {
+		var entry keyInMongo
+		if err := cursor.Decode(&entry); err != nil {
+			return nil, status.Error(grpccodes.Internal, "Error while decoding key from database: "+err.Error())
+		}
+
+		// Stop on the limit or when response becomes too big. At least one entry is always returned.
+		entrySize := len(entry.Key) + len(entry.Value)
+		if int64(len(entries)) == limit || (len(entries) != 0 && responseSize+entrySize > MAX_LIST_RESPONSE_SIZE) {
+			nextCursor = entries[len(entries)-1].Key
+			break
+		}
+
+		responseSize += entrySize
+		entries = append(entries, entry.ToGRPCEntry())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, status.Error(grpccodes.Internal, "Error while listing keys: "+err.Error())
+	}
+
+	return &keyValueStorageGRPC.ListResponse{Entries: entries, NextCursor: nextCursor}, status.Error(grpccodes.OK, "")
+}
@@ -13,7 +13,10 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
 
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	native_namespace_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	"github.com/slamy-solutions/openbp/modules/native/services/namespace/src/services"
 )
@@ -30,10 +33,23 @@ func getHostname() string {
 	return name
 }
 
+func getConfigEnv(key string, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+
 func main() {
-	systemStub := system.NewSystemStub(
-		system.NewSystemStubConfig().WithCache().WithDB().WithNats().WithOTel(system.NewOTelConfig("native", "namespace", VERSION, getHostname())),
-	)
+	serviceAuthConfig := serviceauth.NewConfigFromEnv("native_namespace").WithOnViolation(func(fullMethod string, err error) {
+		fmt.Println("Service authentication failed for [" + fullMethod + "]: " + err.Error())
+	})
+
+	systemStubConfig := system.NewSystemStubConfig().WithCache().WithDB().WithNats().WithOTel(system.NewOTelConfig("native", "namespace", VERSION, getHostname()))
+	if serviceAuthConfig.Enabled() {
+		systemStubConfig.WithVault()
+	}
+	systemStub := system.NewSystemStub(systemStubConfig)
 	systemConnectionContext, cancel := context.WithTimeout(context.Background(), time.Second*15)
 	defer cancel()
 	err := systemStub.Connect(systemConnectionContext)
@@ -47,19 +63,51 @@ func main() {
 		panic(err)
 	}
 
-	// Creating grpc server
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
-		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
-		grpc.KeepaliveParams(keepalive.ServerParameters{
-			MaxConnectionAge: time.Minute * 5,
-		}),
+	serviceAuthenticator, err := serviceauth.NewAuthenticator(serviceAuthConfig, systemStub.Vault, makeServiceAuthRules())
+	if err != nil {
+		panic("Failed to setup service authentication: " + err.Error())
+	}
+	serviceAuthDialOptions, err := serviceauth.DialOptions(serviceAuthConfig, native.NewServiceTokenIssuer(serviceAuthConfig.APIKey))
+	if err != nil {
+		panic("Failed to setup service authentication for clients: " + err.Error())
+	}
+
+	sectionExporters, err := services.ConnectToSectionExporters(
+		getConfigEnv("NATIVE_NAMESPACE_EXPORTERS", services.DEFAULT_SECTION_EXPORTERS),
+		append([]grpc.DialOption{
+			grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+			grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1024*1024*16), grpc.MaxCallSendMsgSize(1024*1024*16)),
+		}, serviceAuthDialOptions...)...,
 	)
+	if err != nil {
+		panic("Failed to connect to the modules that export namespace data: " + err.Error())
+	}
+
+	// Creating grpc server
+	grpcServer := grpc.NewServer(append(
+		[]grpc.ServerOption{
+			grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+			grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+			grpc.MaxRecvMsgSize(1024 * 1024 * 16), // 16 megabytes. Records of the namespace archive can be big.
+			grpc.MaxSendMsgSize(1024 * 1024 * 16), // 16 megabytes
+			grpc.KeepaliveParams(keepalive.ServerParameters{
+				MaxConnectionAge: time.Minute * 5,
+			}),
+		},
+		serviceAuthenticator.ServerOptions()...,
+	)...)
+
+	auditPublisher, err := audit.NewPublisher(systemStub.Nats, "native_namespace")
+	if err != nil {
+		panic(err)
+	}
 
-	namespaceServer, err := services.New(context.Background(), systemStub.DB, systemStub.Cache, js)
+	namespaceServer, err := services.New(context.Background(), systemStub.DB, systemStub.Cache, js, auditPublisher, sectionExporters)
 	if err != nil {
 		panic(err)
 	}
+	defer namespaceServer.Close()
 	native_namespace_grpc.RegisterNamespaceServiceServer(grpcServer, namespaceServer)
 
 	fmt.Println("Start listening for gRPC connections")
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
+)
+
+// Services that can create, change and delete namespaces. Changes made by users come through the tools_rest.
+func getNamespaceManagerServices() []string {
+	if value, ok := os.LookupEnv("NATIVE_NAMESPACE_MANAGER_SERVICES"); ok {
+		return strings.Split(value, ",")
+	}
+	return []string{"tools_rest"}
+}
+
+// Returns new list with managers and additional services. Managers list is not modified.
+func withManagers(managers []string, services ...string) []string {
+	return append(append(make([]string, 0, len(managers)+len(services)), managers...), services...)
+}
+
+// Calls are denied by default. Only managers can change namespaces (including state, quota, deletion and import), other services can only read them.
+func makeServiceAuthRules() serviceauth.Rules {
+	managers := getNamespaceManagerServices()
+	readers := withManagers(managers, "native_iam", "native_keyvaluestorage", "crm_core")
+
+	return serviceauth.Rules{
+		serviceauth.DefaultRule: managers,
+
+		"/native_namespace.NamespaceService/Get":    readers,
+		"/native_namespace.NamespaceService/GetAll": readers,
+		"/native_namespace.NamespaceService/Exists": readers,
+	}
+}
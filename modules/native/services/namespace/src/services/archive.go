@@ -0,0 +1,273 @@
+package services
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	grpclib "google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+)
+
+/*
+	Namespace archive is a stream of records. First record is the header with the namespace information and list of the sections.
+	Every section contains data of one module and is provided by the NamespaceSectionExporterService of that module.
+	On import, UUIDs are remapped deterministically, so references between the sections stay valid.
+*/
+
+const ARCHIVE_VERSION = 1
+
+// Default modules that provide sections of the archive. Format is "<section>=<url>,<section>=<url>"
+const DEFAULT_SECTION_EXPORTERS = "native_iam=native_iam:80,native_keyvaluestorage=native_keyvaluestorage:80,native_storage=native_storage:80"
+
+type SectionExporter struct {
+	Name       string
+	connection *grpclib.ClientConn
+	client     grpc.NamespaceSectionExporterServiceClient
+}
+
+// Connects to the modules that provide sections of the archive. Connections are established lazily, so modules dont have to be available on startup.
+func ConnectToSectionExporters(config string, opts ...grpclib.DialOption) ([]*SectionExporter, error) {
+	opts = append([]grpclib.DialOption{
+		grpclib.WithDefaultServiceConfig(`{"loadBalancingPolicy":"round_robin"}`),
+		grpclib.WithInsecure(),
+	}, opts...)
+
+	exporters := []*SectionExporter{}
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, url, found := strings.Cut(entry, "=")
+		if !found || name == "" || url == "" {
+			return nil, errors.New("bad format of the section exporter [" + entry + "]. Expected <section>=<url>")
+		}
+
+		connection, err := grpclib.Dial(url, opts...)
+		if err != nil {
+			return nil, errors.New("failed to connect to the section exporter [" + name + "]: " + err.Error())
+		}
+		exporters = append(exporters, &SectionExporter{
+			Name:       name,
+			connection: connection,
+			client:     grpc.NewNamespaceSectionExporterServiceClient(connection),
+		})
+	}
+	return exporters, nil
+}
+
+func (e *SectionExporter) Close() error {
+	return e.connection.Close()
+}
+
+func (s *NamespaceServer) getSectionExporter(name string) *SectionExporter {
+	for _, exporter := range s.sectionExporters {
+		if exporter.Name == name {
+			return exporter
+		}
+	}
+	return nil
+}
+
+func (s *NamespaceServer) Export(in *grpc.ExportNamespaceRequest, out grpc.NamespaceService_ExportServer) error {
+	ctx := out.Context()
+
+	var data NamespaceInMongo
+	err := s.namespaceCollection.FindOne(ctx, bson.M{"name": in.Name}).Decode(&data)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return status.Error(grpccodes.NotFound, "Namespace not found")
+		}
+		return status.Error(grpccodes.Internal, err.Error())
+	}
+	if !isNamespaceAvailable(data.State) {
+		return status.Error(grpccodes.FailedPrecondition, "Namespace that is being deleted or imported can not be exported")
+	}
+
+	sections := make([]string, 0, len(s.sectionExporters))
+	for _, exporter := range s.sectionExporters {
+		sections = append(sections, exporter.Name)
+	}
+
+	err = out.Send(&grpc.ExportNamespaceResponse{Payload: &grpc.ExportNamespaceResponse_Header{Header: &grpc.NamespaceArchiveHeader{
+		Version:   ARCHIVE_VERSION,
+		Namespace: data.ToGRPCNamespace(),
+		Exported:  timestamppb.Now(),
+		Sections:  sections,
+	}}})
+	if err != nil {
+		return err
+	}
+
+	for _, exporter := range s.sectionExporters {
+		stream, err := exporter.client.ExportSection(ctx, &grpc.ExportNamespaceSectionRequest{Namespace: in.Name})
+		if err != nil {
+			return status.Error(grpccodes.Unavailable, "Failed to export ["+exporter.Name+"] section: "+err.Error())
+		}
+
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return status.Error(grpccodes.Unavailable, "Failed to export ["+exporter.Name+"] section: "+err.Error())
+			}
+
+			response.Record.Section = exporter.Name
+			err = out.Send(&grpc.ExportNamespaceResponse{Payload: &grpc.ExportNamespaceResponse_Record{Record: response.Record}})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *NamespaceServer) Import(in grpc.NamespaceService_ImportServer) error {
+	ctx := in.Context()
+
+	request, err := in.Recv()
+	if err != nil {
+		return err
+	}
+	start := request.GetStart()
+	if start == nil || start.Header == nil || start.Header.Namespace == nil {
+		return status.Error(grpccodes.InvalidArgument, "First message must contain the target namespace and the archive header")
+	}
+	if start.Header.Version != ARCHIVE_VERSION {
+		return status.Errorf(grpccodes.InvalidArgument, "Unsupported archive version [%d]", start.Header.Version)
+	}
+	sourceNamespace := start.Header.Namespace.Name
+	if sourceNamespace == "" {
+		return status.Error(grpccodes.InvalidArgument, "Archive of the global namespace can not be imported")
+	}
+	err = validateNamespaceData(start.Name, start.Header.Namespace.FullName, start.Header.Namespace.Description)
+	if err != nil {
+		return err
+	}
+	err = validateLabels(start.Header.Namespace.Labels)
+	if err != nil {
+		return err
+	}
+	for _, section := range start.Header.Sections {
+		if s.getSectionExporter(section) == nil {
+			return status.Error(grpccodes.FailedPrecondition, "Module for the ["+section+"] section is not available")
+		}
+	}
+
+	now := time.Now().UTC()
+	namespace := NamespaceInMongo{
+		Name:        start.Name,
+		FullName:    start.Header.Namespace.FullName,
+		Description: start.Header.Namespace.Description,
+		Labels:      start.Header.Namespace.Labels,
+		Updated:     now,
+		Created:     now,
+		Version:     0,
+		State:       grpc.Namespace_IMPORTING,
+	}
+	_, err = s.namespaceCollection.InsertOne(ctx, namespace)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return status.Error(grpccodes.AlreadyExists, "Namespace with same name already exist.")
+		}
+		return status.Error(grpccodes.Internal, "Failed to create namespace. "+err.Error())
+	}
+
+	imported, err := s.importSections(in, sourceNamespace, start.Name)
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			err = status.Error(grpccodes.Internal, err.Error())
+		}
+		return status.Error(status.Code(err), "Namespace was partially imported. Delete it and try again. "+status.Convert(err).Message())
+	}
+
+	var activated NamespaceInMongo
+	err = s.namespaceCollection.FindOneAndUpdate(
+		ctx,
+		bson.M{"name": start.Name, "state": grpc.Namespace_IMPORTING},
+		bson.M{"$set": bson.M{"state": grpc.Namespace_ACTIVE, "updated": time.Now().UTC()}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&activated)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return status.Error(grpccodes.Aborted, "Namespace was deleted during the import")
+		}
+		return status.Error(grpccodes.Internal, "Failed to activate imported namespace. "+err.Error())
+	}
+
+	// Modules create indexes and built-in objects for the namespace. Imported data already exists at this moment.
+	s.cache.Remove(ctx, NAMESPACE_LIST_CACHE_KEY, makeNamespaceDataCacheKey(start.Name))
+	namespaceGRPC := activated.ToGRPCNamespace()
+	namespaceBytes, _ := proto.Marshal(namespaceGRPC)
+	s.jetstreamClient.Publish("native.namespace.event.created", namespaceBytes)
+
+	return in.SendAndClose(&grpc.ImportNamespaceResponse{Namespace: namespaceGRPC, Imported: imported})
+}
+
+// Forwards records from the archive to the modules. Returns number of the imported records.
+func (s *NamespaceServer) importSections(in grpc.NamespaceService_ImportServer, sourceNamespace string, namespace string) (uint64, error) {
+	streams := map[string]grpc.NamespaceSectionExporterService_ImportSectionClient{}
+
+	for {
+		request, err := in.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		record := request.GetRecord()
+		if record == nil {
+			return 0, status.Error(grpccodes.InvalidArgument, "Only first message can contain the archive header")
+		}
+
+		stream, ok := streams[record.Section]
+		if !ok {
+			exporter := s.getSectionExporter(record.Section)
+			if exporter == nil {
+				return 0, status.Error(grpccodes.FailedPrecondition, "Module for the ["+record.Section+"] section is not available")
+			}
+			stream, err = exporter.client.ImportSection(in.Context())
+			if err != nil {
+				return 0, status.Error(grpccodes.Unavailable, "Failed to import ["+record.Section+"] section: "+err.Error())
+			}
+			streams[record.Section] = stream
+		}
+
+		err = stream.Send(&grpc.ImportNamespaceSectionRequest{
+			SourceNamespace: sourceNamespace,
+			Namespace:       namespace,
+			Record:          record,
+		})
+		if err != nil {
+			// Real error is returned by the CloseAndRecv
+			_, err = stream.CloseAndRecv()
+			return 0, status.Error(grpccodes.Internal, "Failed to import ["+record.Section+"] section: "+status.Convert(err).Message())
+		}
+	}
+
+	var imported uint64 = 0
+	for section, stream := range streams {
+		response, err := stream.CloseAndRecv()
+		if err != nil {
+			return 0, status.Error(grpccodes.Internal, "Failed to import ["+section+"] section: "+status.Convert(err).Message())
+		}
+		imported += response.Imported
+	}
+
+	return imported, nil
+}
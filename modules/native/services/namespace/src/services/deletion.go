@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/nats-io/nats.go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	system_nats "github.com/slamy-solutions/openbp/modules/system/libs/golang/nats"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
+
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+)
+
+/*
+	Namespace deletion is performed in the following steps:
+	1. Namespace is switched to the DELETING state. Services that listen for the deletion requests at this moment become participants of the deletion.
+	2. "native.namespace.deletion.requested" event is published. Every participant cleans up data of the namespace that is stored outside of the namespace database (global collections, cache, etc.)
+	3. Participants publish the result of the cleanup to the "native.namespace.deletion.acknowledged" subject.
+	4. After all the participants confirm the cleanup, database of the namespace is dropped, namespace is deleted and "native.namespace.event.deleted" event is published.
+*/
+
+const (
+	DELETION_STREAM_NAME                   = "native_namespace_deletion"
+	DELETION_REQUESTED_SUBJECT             = "native.namespace.deletion.requested"
+	DELETION_ACKNOWLEDGED_SUBJECT          = "native.namespace.deletion.acknowledged"
+	DELETION_ACKNOWLEDGEMENT_CONSUMER_NAME = "native_namespace_deletionacknowledgement"
+)
+
+type NamespaceDeletionParticipantInMongo struct {
+	Name    string    `bson:"name"`
+	Done    bool      `bson:"done"`
+	Error   string    `bson:"error"`
+	Updated time.Time `bson:"updated"`
+}
+
+type NamespaceDeletionInMongo struct {
+	Started      time.Time                             `bson:"started"`
+	Attempt      uint32                                `bson:"attempt"`
+	Participants []NamespaceDeletionParticipantInMongo `bson:"participants"`
+}
+
+func (d *NamespaceDeletionInMongo) ToGRPCNamespaceDeletionStatus() *grpc.NamespaceDeletionStatus {
+	participants := make([]*grpc.NamespaceDeletionParticipant, 0, len(d.Participants))
+	for _, participant := range d.Participants {
+		participants = append(participants, &grpc.NamespaceDeletionParticipant{
+			Name:    participant.Name,
+			Done:    participant.Done,
+			Error:   participant.Error,
+			Updated: timestamppb.New(participant.Updated),
+		})
+	}
+
+	return &grpc.NamespaceDeletionStatus{
+		Started:      timestamppb.New(d.Started),
+		Attempt:      d.Attempt,
+		Participants: participants,
+	}
+}
+
+// All the participants confirmed the cleanup
+func (d *NamespaceDeletionInMongo) finished() bool {
+	for _, participant := range d.Participants {
+		if !participant.Done {
+			return false
+		}
+	}
+	return true
+}
+
+func ensureDeletionStream(js nats.JetStreamContext) error {
+	cfg := nats.StreamConfig{
+		Name:      DELETION_STREAM_NAME,
+		Retention: nats.InterestPolicy,
+		Subjects:  []string{DELETION_REQUESTED_SUBJECT, DELETION_ACKNOWLEDGED_SUBJECT},
+		Storage:   nats.FileStorage,
+		Replicas:  1, // TODO: use envirnment variable to enable HA
+	}
+	_, err := js.AddStream(&cfg)
+	return err
+}
+
+// Start handling acknowledgements from the deletion participants. Only one replica of the service will receive each acknowledgement.
+func (s *NamespaceServer) subscribeToDeletionAcknowledgements() error {
+	_, err := s.jetstreamClient.AddConsumer(DELETION_STREAM_NAME, &nats.ConsumerConfig{
+		Durable:        DELETION_ACKNOWLEDGEMENT_CONSUMER_NAME,
+		Name:           DELETION_ACKNOWLEDGEMENT_CONSUMER_NAME,
+		Description:    "Listens on namespace cleanup results from the deletion participants for native_namespace",
+		AckPolicy:      nats.AckExplicitPolicy,
+		FilterSubject:  DELETION_ACKNOWLEDGED_SUBJECT,
+		DeliverSubject: "native.namespace.deliver.deletion.acknowledged",
+		DeliverGroup:   "native.namespace.deliver.deletion.acknowledged",
+	})
+	if err != nil {
+		return errors.New("Error while creating consumer. " + err.Error())
+	}
+	subscribtion, err := s.jetstreamClient.QueueSubscribe(DELETION_ACKNOWLEDGED_SUBJECT, "native.namespace.deliver.deletion.acknowledged", s.handleDeletionAcknowledgement, nats.Bind(DELETION_STREAM_NAME, DELETION_ACKNOWLEDGEMENT_CONSUMER_NAME))
+	if err != nil {
+		return errors.New("Error while creating subscribtion. " + err.Error())
+	}
+
+	s.deletionAcknowledgementSubscription = subscribtion
+	return nil
+}
+
+// Participants are the services that have consumer for the deletion requests
+func (s *NamespaceServer) getDeletionParticipants(ctx context.Context) map[string]struct{} {
+	participants := map[string]struct{}{}
+	for info := range s.jetstreamClient.Consumers(DELETION_STREAM_NAME, nats.Context(ctx)) {
+		if info.Config.FilterSubject == DELETION_REQUESTED_SUBJECT {
+			participants[info.Name] = struct{}{}
+		}
+	}
+	return participants
+}
+
+func (s *NamespaceServer) publishDeletionRequest(namespace *NamespaceInMongo) error {
+	namespaceBytes, err := proto.Marshal(namespace.ToGRPCNamespace())
+	if err != nil {
+		return err
+	}
+	_, err = s.jetstreamClient.Publish(DELETION_REQUESTED_SUBJECT, namespaceBytes)
+	return err
+}
+
+// Drops the database of the namespace and deletes the namespace. Can be called multiple times (for example by different replicas).
+func (s *NamespaceServer) finishDeletion(ctx context.Context, name string) error {
+	err := s.mongoClient.Database("openbp_namespace_" + name).Drop(ctx)
+	if err != nil {
+		return errors.New("failed to drop namespace database: " + err.Error())
+	}
+	err = quota.Remove(ctx, s.mongoClient, name)
+	if err != nil {
+		return err
+	}
+
+	var dataInMongo NamespaceInMongo
+	err = s.namespaceCollection.FindOneAndDelete(ctx, bson.M{"name": name, "state": grpc.Namespace_DELETING}).Decode(&dataInMongo)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// Deletion was already finished by someone else
+			return nil
+		}
+		return errors.New("failed to delete namespace: " + err.Error())
+	}
+
+	s.cache.Remove(ctx, NAMESPACE_LIST_CACHE_KEY, makeNamespaceDataCacheKey(name))
+
+	namespaceBytes, _ := proto.Marshal(dataInMongo.ToGRPCNamespace())
+	s.jetstreamClient.Publish("native.namespace.event.deleted", namespaceBytes)
+
+	return nil
+}
+
+func (s *NamespaceServer) getDeletingNamespace(ctx context.Context, name string) (*NamespaceInMongo, error) {
+	var data NamespaceInMongo
+	err := s.namespaceCollection.FindOne(ctx, bson.M{"name": name}).Decode(&data)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, status.Error(grpccodes.NotFound, "Namespace not found")
+		}
+		return nil, status.Error(grpccodes.Internal, err.Error())
+	}
+	if data.State != grpc.Namespace_DELETING || data.Deletion == nil {
+		return nil, status.Error(grpccodes.FailedPrecondition, "Namespace is not being deleted")
+	}
+	return &data, nil
+}
+
+func (s *NamespaceServer) GetDeletionStatus(ctx context.Context, in *grpc.GetNamespaceDeletionStatusRequest) (*grpc.GetNamespaceDeletionStatusResponse, error) {
+	data, err := s.getDeletingNamespace(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpc.GetNamespaceDeletionStatusResponse{Status: data.Deletion.ToGRPCNamespaceDeletionStatus()}, status.Error(grpccodes.OK, "")
+}
+
+func (s *NamespaceServer) RetryDeletion(ctx context.Context, in *grpc.RetryNamespaceDeletionRequest) (*grpc.RetryNamespaceDeletionResponse, error) {
+	data, err := s.getDeletingNamespace(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Services that no longer listen for the deletion requests will never confirm the cleanup
+	listening := s.getDeletionParticipants(ctx)
+	gone := []string{}
+	for _, participant := range data.Deletion.Participants {
+		if _, ok := listening[participant.Name]; !ok {
+			gone = append(gone, participant.Name)
+		}
+	}
+
+	var updated NamespaceInMongo
+	err = s.namespaceCollection.FindOneAndUpdate(
+		ctx,
+		bson.M{"name": in.Name, "state": grpc.Namespace_DELETING},
+		bson.M{
+			"$inc":  bson.M{"deletion.attempt": 1},
+			"$pull": bson.M{"deletion.participants": bson.M{"name": bson.M{"$in": gone}}},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// Deletion finished while we were preparing the retry
+			return &grpc.RetryNamespaceDeletionResponse{Status: nil, Finished: true}, status.Error(grpccodes.OK, "")
+		}
+		return nil, status.Error(grpccodes.Internal, err.Error())
+	}
+
+	if updated.Deletion.finished() {
+		err = s.finishDeletion(ctx, in.Name)
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, err.Error())
+		}
+		return &grpc.RetryNamespaceDeletionResponse{Status: nil, Finished: true}, status.Error(grpccodes.OK, "")
+	}
+
+	err = s.publishDeletionRequest(&updated)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Failed to publish deletion request: "+err.Error())
+	}
+
+	return &grpc.RetryNamespaceDeletionResponse{Status: updated.Deletion.ToGRPCNamespaceDeletionStatus(), Finished: false}, status.Error(grpccodes.OK, "")
+}
+
+func (s *NamespaceServer) handleDeletionAcknowledgement(msg *nats.Msg) {
+	ctx, span := system_nats.StartTelemetrySpanFromMessage(context.Background(), msg, "Handle namespace deletion acknowledgement")
+	defer span.End()
+
+	var acknowledgement grpc.NamespaceDeletionAcknowledgement
+	err := proto.Unmarshal(msg.Data, &acknowledgement)
+	if err != nil {
+		span.SetStatus(codes.Error, "Failed to unmarshal acknowledgement: "+err.Error())
+		span.RecordError(err)
+		// TODO: Dead leter queue
+		msg.Ack()
+		return
+	}
+	span.SetAttributes(
+		attribute.String("namespace", acknowledgement.Namespace),
+		attribute.String("participant", acknowledgement.Participant),
+		attribute.Int("attempt", int(acknowledgement.Attempt)),
+	)
+
+	var updated NamespaceInMongo
+	err = s.namespaceCollection.FindOneAndUpdate(
+		ctx,
+		// Late acknowledgement of the previous attempt must not override result of the current one
+		bson.M{"name": acknowledgement.Namespace, "state": grpc.Namespace_DELETING, "deletion.attempt": acknowledgement.Attempt, "deletion.participants.name": acknowledgement.Participant},
+		bson.M{"$set": bson.M{
+			"deletion.participants.$.done":    acknowledgement.Error == "",
+			"deletion.participants.$.error":   acknowledgement.Error,
+			"deletion.participants.$.updated": time.Now().UTC(),
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// Namespace is already deleted, service is not a participant of this deletion or acknowledgement is for the previous attempt
+			span.SetStatus(codes.Ok, "")
+			msg.Ack()
+			return
+		}
+		span.SetStatus(codes.Error, err.Error())
+		msg.NakWithDelay(time.Second * 5)
+		return
+	}
+
+	if updated.Deletion.finished() {
+		err = s.finishDeletion(ctx, acknowledgement.Namespace)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			msg.NakWithDelay(time.Second * 5)
+			return
+		}
+	}
+
+	span.SetStatus(codes.Ok, "")
+	msg.Ack()
+}
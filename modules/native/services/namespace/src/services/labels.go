@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/trace"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+)
+
+const (
+	MAX_LABELS             = 64
+	MAX_LABEL_KEY_LENGTH   = 63
+	MAX_LABEL_VALUE_LENGTH = 63
+)
+
+// Keys are stored as the field names in mongo, so "." and "$" are not allowed
+var /* const */ labelKeyValidator = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9_/-]*[A-Za-z0-9])?$`)
+
+func validateLabels(labels map[string]string) error {
+	if len(labels) > MAX_LABELS {
+		return status.Errorf(grpccodes.InvalidArgument, "Too many labels. Max number of labels is %d.", MAX_LABELS)
+	}
+	for key, value := range labels {
+		if len(key) > MAX_LABEL_KEY_LENGTH || !labelKeyValidator.MatchString(key) {
+			return status.Errorf(grpccodes.InvalidArgument, "Invalid label key [%s]. Key must match the regex \"%s\" and be up to %d symbols.", key, labelKeyValidator.String(), MAX_LABEL_KEY_LENGTH)
+		}
+		if len(value) > MAX_LABEL_VALUE_LENGTH {
+			return status.Errorf(grpccodes.InvalidArgument, "Value of the label [%s] is too long. Max length is %d symbols.", key, MAX_LABEL_VALUE_LENGTH)
+		}
+	}
+	return nil
+}
+
+type labelOperator int
+
+const (
+	labelOperatorEquals labelOperator = iota
+	labelOperatorNotEquals
+	labelOperatorExists
+	labelOperatorNotExists
+)
+
+type labelRequirement struct {
+	key      string
+	operator labelOperator
+	value    string
+}
+
+// Set of requirements that all must match the labels of the namespace. Empty selector matches everything.
+type labelSelector []labelRequirement
+
+func parseLabelSelector(selector string) (labelSelector, error) {
+	result := labelSelector{}
+	if strings.TrimSpace(selector) == "" {
+		return result, nil
+	}
+
+	for _, part := range strings.Split(selector, ",") {
+		part = strings.TrimSpace(part)
+		var requirement labelRequirement
+
+		if index := strings.Index(part, "!="); index != -1 {
+			requirement = labelRequirement{key: part[:index], operator: labelOperatorNotEquals, value: part[index+2:]}
+		} else if index := strings.Index(part, "=="); index != -1 {
+			requirement = labelRequirement{key: part[:index], operator: labelOperatorEquals, value: part[index+2:]}
+		} else if index := strings.Index(part, "="); index != -1 {
+			requirement = labelRequirement{key: part[:index], operator: labelOperatorEquals, value: part[index+1:]}
+		} else if strings.HasPrefix(part, "!") {
+			requirement = labelRequirement{key: part[1:], operator: labelOperatorNotExists}
+		} else {
+			requirement = labelRequirement{key: part, operator: labelOperatorExists}
+		}
+
+		requirement.key = strings.TrimSpace(requirement.key)
+		requirement.value = strings.TrimSpace(requirement.value)
+		if !labelKeyValidator.MatchString(requirement.key) {
+			return nil, errors.New("invalid label key in the requirement [" + part + "]")
+		}
+		result = append(result, requirement)
+	}
+
+	return result, nil
+}
+
+func (s labelSelector) Matches(labels map[string]string) bool {
+	for _, requirement := range s {
+		value, ok := labels[requirement.key]
+		switch requirement.operator {
+		case labelOperatorEquals:
+			if !ok || value != requirement.value {
+				return false
+			}
+		case labelOperatorNotEquals:
+			if ok && value == requirement.value {
+				return false
+			}
+		case labelOperatorExists:
+			if !ok {
+				return false
+			}
+		case labelOperatorNotExists:
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (s *NamespaceServer) SetLabels(ctx context.Context, in *grpc.SetNamespaceLabelsRequest) (*grpc.SetNamespaceLabelsResponse, error) {
+	err := validateLabels(in.Labels)
+	if err != nil {
+		return nil, err
+	}
+	labels := in.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	updateFilter := bson.M{"name": in.Name, "state": bson.M{"$nin": bson.A{grpc.Namespace_DELETING, grpc.Namespace_IMPORTING}}}
+	updateData := bson.M{
+		"$set": bson.M{
+			"labels":  labels,
+			"updated": time.Now().UTC(),
+		},
+		"$inc": bson.M{
+			"version": 1,
+		},
+	}
+
+	var newNamespace NamespaceInMongo
+	err = s.namespaceCollection.FindOneAndUpdate(ctx, updateFilter, updateData, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&newNamespace)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			count, countErr := s.namespaceCollection.CountDocuments(ctx, bson.M{"name": in.Name}, options.Count().SetLimit(1))
+			if countErr == nil && count == 1 {
+				return nil, status.Error(grpccodes.FailedPrecondition, "Namespace is being deleted or imported")
+			}
+			return nil, status.Errorf(grpccodes.NotFound, "Namespace not found")
+		}
+		return nil, status.Error(grpccodes.Internal, "Error while updating labels of the namespace: "+err.Error())
+	}
+
+	s.cache.Remove(ctx, NAMESPACE_LIST_CACHE_KEY, makeNamespaceDataCacheKey(in.Name))
+
+	namespaceGRPC := newNamespace.ToGRPCNamespace()
+	namespaceBytes, _ := proto.Marshal(namespaceGRPC)
+	s.jetstreamClient.Publish("native.namespace.event.updated", namespaceBytes)
+
+	err = s.auditPublisher.Publish(ctx, audit.Change{
+		Namespace:     in.Name,
+		Resource:      "native.namespace." + in.Name,
+		Action:        "native.namespace.labels.set",
+		BeforeVersion: newNamespace.Version - 1,
+		AfterVersion:  newNamespace.Version,
+	})
+	if err != nil {
+		trace.SpanFromContext(ctx).RecordError(errors.New("failed to publish audit record: " + err.Error()))
+	}
+
+	return &grpc.SetNamespaceLabelsResponse{Namespace: namespaceGRPC}, status.Error(grpccodes.OK, "")
+}
@@ -22,7 +22,9 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
 	"github.com/slamy-solutions/openbp/modules/system/libs/golang/cache"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
 
 	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 )
@@ -35,6 +37,10 @@ type NamespaceServer struct {
 	cache               cache.Cache
 	tracer              trace.Tracer
 	jetstreamClient     nats.JetStreamContext
+	auditPublisher      *audit.Publisher
+	sectionExporters    []*SectionExporter
+
+	deletionAcknowledgementSubscription *nats.Subscription
 }
 
 const (
@@ -50,7 +56,7 @@ const (
 
 var /* const */ nameValidator = regexp.MustCompile(`^[A-Za-z0-9]+$`)
 
-func New(ctx context.Context, mongoClient *mongo.Client, cache cache.Cache, js nats.JetStreamContext) (*NamespaceServer, error) {
+func New(ctx context.Context, mongoClient *mongo.Client, cache cache.Cache, js nats.JetStreamContext, auditPublisher *audit.Publisher, sectionExporters []*SectionExporter) (*NamespaceServer, error) {
 
 	// Make sure there is stream for events on system_nats
 	cfg := nats.StreamConfig{
@@ -65,6 +71,11 @@ func New(ctx context.Context, mongoClient *mongo.Client, cache cache.Cache, js n
 		return nil, err
 	}
 
+	err = ensureDeletionStream(js)
+	if err != nil {
+		return nil, errors.New("Failed to create stream for the namespace deletion. " + err.Error())
+	}
+
 	// Add namespaces index to ensure unique
 	namespaceCollection := mongoClient.Database(DATABASE_NAME).Collection(COLLECTION_NAME)
 	_, err = namespaceCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
@@ -75,13 +86,39 @@ func New(ctx context.Context, mongoClient *mongo.Client, cache cache.Cache, js n
 		return nil, errors.New("Failed to create index. " + err.Error())
 	}
 
-	return &NamespaceServer{
+	// Quotas of the namespaces are managed by this service
+	err = quota.EnsureIndexes(ctx, mongoClient)
+	if err != nil {
+		return nil, errors.New("Failed to create indexes for quotas. " + err.Error())
+	}
+
+	server := &NamespaceServer{
 		namespaceCollection: mongoClient.Database(DATABASE_NAME).Collection(COLLECTION_NAME),
 		mongoClient:         mongoClient,
 		cache:               cache,
 		tracer:              otel.Tracer("github.com/slamy-solutions/openbp/modules/native/services/namespace"),
 		jetstreamClient:     js,
-	}, nil
+		auditPublisher:      auditPublisher,
+		sectionExporters:    sectionExporters,
+	}
+
+	err = server.subscribeToDeletionAcknowledgements()
+	if err != nil {
+		return nil, err
+	}
+
+	return server, nil
+}
+
+func (s *NamespaceServer) Close() error {
+	err := s.deletionAcknowledgementSubscription.Unsubscribe()
+	if err != nil {
+		return errors.New("Error while unsubscribing from namespace deletion acknowledgements. " + err.Error())
+	}
+	for _, exporter := range s.sectionExporters {
+		exporter.Close()
+	}
+	return nil
 }
 
 func makeNamespaceDataCacheKey(namespaceName string) string {
@@ -102,16 +139,31 @@ type NamespaceInMongo struct {
 	Updated time.Time `bson:"updated"`
 	Created time.Time `bson:"created"`
 	Version uint64    `bson:"version"`
+
+	Labels map[string]string `bson:"labels,omitempty"`
+
+	State       grpc.Namespace_State      `bson:"state"`
+	StateReason string                    `bson:"stateReason,omitempty"`
+	Deletion    *NamespaceDeletionInMongo `bson:"deletion,omitempty"`
 }
 
 func (n *NamespaceInMongo) ToGRPCNamespace() *grpc.Namespace {
+	var deletionAttempt uint32
+	if n.Deletion != nil {
+		deletionAttempt = n.Deletion.Attempt
+	}
+
 	return &grpc.Namespace{
-		Name:        n.Name,
-		FullName:    n.FullName,
-		Description: n.Description,
-		Created:     timestamppb.New(n.Created),
-		Updated:     timestamppb.New(n.Updated),
-		Version:     n.Version,
+		Name:            n.Name,
+		FullName:        n.FullName,
+		Description:     n.Description,
+		Created:         timestamppb.New(n.Created),
+		Updated:         timestamppb.New(n.Updated),
+		Version:         n.Version,
+		State:           n.State,
+		Labels:          n.Labels,
+		StateReason:     n.StateReason,
+		DeletionAttempt: deletionAttempt,
 	}
 }
 
@@ -148,6 +200,10 @@ func (s *NamespaceServer) Ensure(ctx context.Context, in *grpc.EnsureNamespaceRe
 	if err != nil {
 		return nil, err
 	}
+	err = validateLabels(in.Labels)
+	if err != nil {
+		return nil, err
+	}
 
 	filterData := bson.D{
 		bson.E{Key: "name", Value: in.Name},
@@ -158,6 +214,7 @@ func (s *NamespaceServer) Ensure(ctx context.Context, in *grpc.EnsureNamespaceRe
 		Name:        in.Name,
 		FullName:    in.FullName,
 		Description: in.Description,
+		Labels:      in.Labels,
 		Updated:     updateTime,
 		Created:     updateTime,
 		Version:     0,
@@ -174,6 +231,13 @@ func (s *NamespaceServer) Ensure(ctx context.Context, in *grpc.EnsureNamespaceRe
 		return nil, status.Error(grpccodes.Internal, err.Error())
 	}
 
+	if updated.State == grpc.Namespace_DELETING {
+		return nil, status.Error(grpccodes.FailedPrecondition, "Namespace with same name is being deleted.")
+	}
+	if updated.State == grpc.Namespace_IMPORTING {
+		return nil, status.Error(grpccodes.FailedPrecondition, "Namespace with same name is being imported.")
+	}
+
 	namespaceGrpc := updated.ToGRPCNamespace()
 
 	namespaceCreated := updated.Created == updateTime
@@ -191,12 +255,17 @@ func (s *NamespaceServer) Create(ctx context.Context, in *grpc.CreateNamespaceRe
 	if err != nil {
 		return nil, err
 	}
+	err = validateLabels(in.Labels)
+	if err != nil {
+		return nil, err
+	}
 
 	updateTime := time.Now().UTC()
 	insertData := NamespaceInMongo{
 		Name:        in.Name,
 		FullName:    in.FullName,
 		Description: in.Description,
+		Labels:      in.Labels,
 		Updated:     updateTime,
 		Created:     updateTime,
 		Version:     0,
@@ -205,6 +274,14 @@ func (s *NamespaceServer) Create(ctx context.Context, in *grpc.CreateNamespaceRe
 	_, err = s.namespaceCollection.InsertOne(ctx, insertData)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
+			var existing NamespaceInMongo
+			findErr := s.namespaceCollection.FindOne(ctx, bson.M{"name": in.Name}).Decode(&existing)
+			if findErr == nil && existing.State == grpc.Namespace_DELETING {
+				return nil, status.Error(grpccodes.FailedPrecondition, "Namespace with same name is being deleted.")
+			}
+			if findErr == nil && existing.State == grpc.Namespace_IMPORTING {
+				return nil, status.Error(grpccodes.FailedPrecondition, "Namespace with same name is being imported.")
+			}
 			return nil, status.Error(grpccodes.AlreadyExists, "Namespace with same name already exist.")
 		}
 		return nil, status.Error(grpccodes.Internal, "Failed to create namespace. "+err.Error())
@@ -225,7 +302,7 @@ func (s *NamespaceServer) Update(ctx context.Context, in *grpc.UpdateNamespaceRe
 		return nil, err
 	}
 
-	updateFilter := bson.M{"name": in.Name}
+	updateFilter := bson.M{"name": in.Name, "state": bson.M{"$nin": bson.A{grpc.Namespace_DELETING, grpc.Namespace_IMPORTING}}}
 	updateData := bson.M{
 		"$set": bson.M{
 			"fullName":    in.FullName,
@@ -242,6 +319,10 @@ func (s *NamespaceServer) Update(ctx context.Context, in *grpc.UpdateNamespaceRe
 	err = s.namespaceCollection.FindOneAndUpdate(ctx, updateFilter, updateData, updateOptions).Decode(&newNamespace)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
+			count, countErr := s.namespaceCollection.CountDocuments(ctx, bson.M{"name": in.Name}, options.Count().SetLimit(1))
+			if countErr == nil && count == 1 {
+				return nil, status.Error(grpccodes.FailedPrecondition, "Namespace is being deleted or imported")
+			}
 			return nil, status.Errorf(grpccodes.NotFound, "Namespace not found")
 		}
 		return nil, status.Error(grpccodes.Internal, "Error while updating the namespace: "+err.Error())
@@ -257,30 +338,71 @@ func (s *NamespaceServer) Update(ctx context.Context, in *grpc.UpdateNamespaceRe
 }
 
 func (s *NamespaceServer) Delete(ctx context.Context, in *grpc.DeleteNamespaceRequest) (*grpc.DeleteNamespaceResponse, error) {
+	participants := s.getDeletionParticipants(ctx)
+
+	now := time.Now().UTC()
+	deletion := NamespaceDeletionInMongo{
+		Started:      now,
+		Attempt:      1,
+		Participants: make([]NamespaceDeletionParticipantInMongo, 0, len(participants)),
+	}
+	for participant := range participants {
+		deletion.Participants = append(deletion.Participants, NamespaceDeletionParticipantInMongo{Name: participant, Done: false, Error: "", Updated: now})
+	}
+
 	filterData := bson.M{
-		"name": in.Name,
+		"name":  in.Name,
+		"state": bson.M{"$ne": grpc.Namespace_DELETING},
+	}
+	updateData := bson.M{
+		"$set": bson.M{
+			"state":    grpc.Namespace_DELETING,
+			"deletion": deletion,
+			"updated":  now,
+		},
+		"$inc": bson.M{
+			"version": 1,
+		},
 	}
 
 	var dataInMongo NamespaceInMongo
-	err := s.namespaceCollection.FindOneAndDelete(ctx, filterData).Decode(&dataInMongo)
+	err := s.namespaceCollection.FindOneAndUpdate(ctx, filterData, updateData, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&dataInMongo)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return &grpc.DeleteNamespaceResponse{Existed: false}, status.Error(grpccodes.OK, "")
+			return &grpc.DeleteNamespaceResponse{Existed: false, Finished: false}, status.Error(grpccodes.OK, "")
 		}
 		return nil, status.Error(grpccodes.Internal, err.Error())
 	}
 
 	s.cache.Remove(ctx, NAMESPACE_LIST_CACHE_KEY, makeNamespaceDataCacheKey(in.Name))
 
-	err = s.mongoClient.Database("openbp_namespace_" + in.Name).Drop(ctx)
+	// Database of the namespace will be dropped, so record is stored in the global namespace
+	err = s.auditPublisher.Publish(ctx, audit.Change{
+		Namespace:     "",
+		Resource:      "native.namespace." + in.Name,
+		Action:        "native.namespace.delete",
+		BeforeVersion: dataInMongo.Version - 1,
+		AfterVersion:  dataInMongo.Version,
+	})
 	if err != nil {
-		return nil, status.Error(grpccodes.Internal, err.Error())
+		// Deletion is already started. Failure to publish audit record must not fail the request
+		trace.SpanFromContext(ctx).RecordError(errors.New("failed to publish audit record: " + err.Error()))
+	}
+
+	if len(deletion.Participants) == 0 {
+		err = s.finishDeletion(ctx, in.Name)
+		if err != nil {
+			return nil, status.Error(grpccodes.Internal, err.Error())
+		}
+		return &grpc.DeleteNamespaceResponse{Existed: true, Finished: true}, status.Error(grpccodes.OK, "")
 	}
 
-	namespaceBytes, _ := proto.Marshal(dataInMongo.ToGRPCNamespace())
-	s.jetstreamClient.Publish("native.namespace.event.deleted", namespaceBytes)
+	err = s.publishDeletionRequest(&dataInMongo)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, "Namespace was switched to the deleting state, but deletion request wasnt published. Use RetryDeletion. "+err.Error())
+	}
 
-	return &grpc.DeleteNamespaceResponse{Existed: true}, status.Error(grpccodes.OK, "")
+	return &grpc.DeleteNamespaceResponse{Existed: true, Finished: false}, status.Error(grpccodes.OK, "")
 }
 
 func (s *NamespaceServer) Get(ctx context.Context, in *grpc.GetNamespaceRequest) (*grpc.GetNamespaceResponse, error) {
@@ -320,12 +442,21 @@ func (s *NamespaceServer) Get(ctx context.Context, in *grpc.GetNamespaceRequest)
 
 func (s *NamespaceServer) GetAll(in *grpc.GetAllNamespacesRequest, out grpc.NamespaceService_GetAllServer) error {
 	ctx := out.Context()
+	selector, err := parseLabelSelector(in.LabelSelector)
+	if err != nil {
+		return status.Error(grpccodes.InvalidArgument, "Bad label selector: "+err.Error())
+	}
+
+	// List is cached without filtering, so selector is applied after loading the list
 	if in.UseCache {
 		data, err := s.cache.Get(ctx, NAMESPACE_LIST_CACHE_KEY)
 		if err == nil && data != nil {
 			var result NamespacesList
 			bson.Unmarshal(data, &result)
 			for _, namespace := range result.Namespaces {
+				if !selector.Matches(namespace.Labels) {
+					continue
+				}
 				err := out.Send(&grpc.GetAllNamespacesResponse{
 					Namespace: namespace.ToGRPCNamespace(),
 				})
@@ -349,8 +480,10 @@ func (s *NamespaceServer) GetAll(in *grpc.GetAllNamespacesRequest, out grpc.Name
 		if err := cursor.Decode(&result); err != nil {
 			return status.Error(grpccodes.Internal, err.Error())
 		}
-		if err := out.Send(&grpc.GetAllNamespacesResponse{Namespace: result.ToGRPCNamespace()}); err != nil {
-			return status.Error(grpccodes.Internal, err.Error())
+		if selector.Matches(result.Labels) {
+			if err := out.Send(&grpc.GetAllNamespacesResponse{Namespace: result.ToGRPCNamespace()}); err != nil {
+				return status.Error(grpccodes.Internal, err.Error())
+			}
 		}
 		if in.UseCache {
 			namespaces = append(namespaces, result)
@@ -371,14 +504,17 @@ func (s *NamespaceServer) GetAll(in *grpc.GetAllNamespacesRequest, out grpc.Name
 func (s *NamespaceServer) Exists(ctx context.Context, in *grpc.IsNamespaceExistRequest) (*grpc.IsNamespaceExistResponse, error) {
 	if in.UseCache {
 		cacheKey := makeNamespaceDataCacheKey(in.Name)
-		inCache, _ := s.cache.Has(ctx, cacheKey)
-		if inCache {
-			return &grpc.IsNamespaceExistResponse{Exist: true}, nil
+		cached, err := s.cache.Get(ctx, cacheKey)
+		if err == nil && cached != nil {
+			var data NamespaceInMongo
+			if bson.Unmarshal(cached, &data) == nil {
+				return &grpc.IsNamespaceExistResponse{Exist: isNamespaceAvailable(data.State)}, status.Error(grpccodes.OK, "")
+			}
 		}
 
 		// Get entire namespace data and save it to the cache
 		var data NamespaceInMongo
-		err := s.namespaceCollection.FindOne(ctx, bson.M{"name": in.Name}).Decode(&data)
+		err = s.namespaceCollection.FindOne(ctx, bson.M{"name": in.Name}).Decode(&data)
 		if err != nil {
 			if err == mongo.ErrNoDocuments {
 				return &grpc.IsNamespaceExistResponse{Exist: false}, status.Error(grpccodes.OK, "")
@@ -389,10 +525,10 @@ func (s *NamespaceServer) Exists(ctx context.Context, in *grpc.IsNamespaceExistR
 		dataBytes, _ := bson.Marshal(data)
 		s.cache.Set(ctx, cacheKey, dataBytes, NAMESPACE_DATA_CACHE_TIMEOUT)
 
-		return &grpc.IsNamespaceExistResponse{Exist: true}, status.Error(grpccodes.OK, "")
+		return &grpc.IsNamespaceExistResponse{Exist: isNamespaceAvailable(data.State)}, status.Error(grpccodes.OK, "")
 	} else {
-		// Fast check in mongo if namespace exists without getting namespace data
-		count, err := s.namespaceCollection.CountDocuments(ctx, bson.M{"name": in.Name}, options.Count().SetLimit(1))
+		// Fast check in mongo if namespace exists without getting namespace data. Namespace that is being deleted or imported is treated as not existing.
+		count, err := s.namespaceCollection.CountDocuments(ctx, bson.M{"name": in.Name, "state": bson.M{"$nin": bson.A{grpc.Namespace_DELETING, grpc.Namespace_IMPORTING}}}, options.Count().SetLimit(1))
 		if err != nil {
 			return nil, status.Error(grpccodes.Internal, err.Error())
 		}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel/trace"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
+
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+)
+
+/*
+	Namespace service only manages limits of the quotas.
+	Usage is counted by the services that own the resources, so the limits are checked without calls to this service.
+	Resources that existed before the first limits were set are counted by the section exporters of the modules.
+*/
+
+func quotaLimitsFromGRPC(q *grpc.NamespaceQuota) map[quota.Resource]int64 {
+	if q == nil {
+		q = &grpc.NamespaceQuota{}
+	}
+	return map[quota.Resource]int64{
+		quota.STORAGE_BYTES:        int64(q.StorageBytes),
+		quota.STORAGE_FILES:        int64(q.StorageFiles),
+		quota.IAM_IDENTITIES:       int64(q.IamIdentities),
+		quota.IOT_DEVICES:          int64(q.IotDevices),
+		quota.KEYVALUESTORAGE_KEYS: int64(q.KeyvaluestorageKeys),
+		quota.IOT_TELEMETRY_RATE:   int64(q.IotTelemetryRate),
+	}
+}
+
+func quotaToGRPC(q *quota.Quota) (*grpc.NamespaceQuota, *grpc.NamespaceQuotaUsage) {
+	limits := &grpc.NamespaceQuota{
+		StorageBytes:        uint64(q.Limits[quota.STORAGE_BYTES]),
+		StorageFiles:        uint64(q.Limits[quota.STORAGE_FILES]),
+		IamIdentities:       uint64(q.Limits[quota.IAM_IDENTITIES]),
+		IotDevices:          uint64(q.Limits[quota.IOT_DEVICES]),
+		KeyvaluestorageKeys: uint64(q.Limits[quota.KEYVALUESTORAGE_KEYS]),
+		IotTelemetryRate:    uint64(q.Limits[quota.IOT_TELEMETRY_RATE]),
+	}
+	usage := &grpc.NamespaceQuotaUsage{
+		StorageBytes:        uint64(q.Usage[quota.STORAGE_BYTES]),
+		StorageFiles:        uint64(q.Usage[quota.STORAGE_FILES]),
+		IamIdentities:       uint64(q.Usage[quota.IAM_IDENTITIES]),
+		IotDevices:          uint64(q.Usage[quota.IOT_DEVICES]),
+		KeyvaluestorageKeys: uint64(q.Usage[quota.KEYVALUESTORAGE_KEYS]),
+	}
+	return limits, usage
+}
+
+// Checks that namespace exists and is not being deleted
+func (s *NamespaceServer) checkNamespaceForQuota(ctx context.Context, name string) error {
+	var data NamespaceInMongo
+	err := s.namespaceCollection.FindOne(ctx, bson.M{"name": name}).Decode(&data)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return status.Error(grpccodes.NotFound, "Namespace not found")
+		}
+		return status.Error(grpccodes.Internal, err.Error())
+	}
+	if data.State == grpc.Namespace_DELETING {
+		return status.Error(grpccodes.FailedPrecondition, "Namespace is being deleted")
+	}
+	return nil
+}
+
+// Counts usage of the quotas by the existing data of all the modules that export namespace sections
+func (s *NamespaceServer) countQuotaUsage(ctx context.Context, namespace string) (map[quota.Resource]int64, error) {
+	usage := map[quota.Resource]int64{}
+	for _, exporter := range s.sectionExporters {
+		response, err := exporter.client.CountQuotaUsage(ctx, &grpc.CountNamespaceSectionQuotaUsageRequest{Namespace: namespace})
+		if err != nil {
+			return nil, errors.New("failed to count quota usage of the [" + exporter.Name + "] section: " + err.Error())
+		}
+		for resource, amount := range response.Usage {
+			usage[quota.Resource(resource)] += amount
+		}
+	}
+	return usage, nil
+}
+
+func (s *NamespaceServer) SetQuota(ctx context.Context, in *grpc.SetNamespaceQuotaRequest) (*grpc.SetNamespaceQuotaResponse, error) {
+	if in.Name == "" {
+		return nil, status.Error(grpccodes.InvalidArgument, "Global namespace doesnt have quotas")
+	}
+	if err := s.checkNamespaceForQuota(ctx, in.Name); err != nil {
+		return nil, err
+	}
+
+	// Usage must include existing resources before the limits are checked
+	err := quota.SeedUsage(ctx, s.mongoClient, in.Name, func(ctx context.Context) (map[quota.Resource]int64, error) {
+		return s.countQuotaUsage(ctx, in.Name)
+	})
+	if err != nil {
+		return nil, status.Error(grpccodes.Unavailable, "Failed to count usage of the existing resources: "+err.Error())
+	}
+
+	err = quota.SetLimits(ctx, s.mongoClient, in.Name, quotaLimitsFromGRPC(in.Quota))
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, err.Error())
+	}
+
+	err = s.auditPublisher.Publish(ctx, audit.Change{
+		Namespace: in.Name,
+		Resource:  "native.namespace." + in.Name + ".quota",
+		Action:    "native.namespace.quota.set",
+	})
+	if err != nil {
+		trace.SpanFromContext(ctx).RecordError(errors.New("failed to publish audit record: " + err.Error()))
+	}
+
+	return &grpc.SetNamespaceQuotaResponse{}, status.Error(grpccodes.OK, "")
+}
+
+func (s *NamespaceServer) GetQuota(ctx context.Context, in *grpc.GetNamespaceQuotaRequest) (*grpc.GetNamespaceQuotaResponse, error) {
+	if in.Name == "" {
+		return nil, status.Error(grpccodes.InvalidArgument, "Global namespace doesnt have quotas")
+	}
+	if err := s.checkNamespaceForQuota(ctx, in.Name); err != nil {
+		return nil, err
+	}
+
+	q, err := quota.Get(ctx, s.mongoClient, in.Name)
+	if err != nil {
+		return nil, status.Error(grpccodes.Internal, err.Error())
+	}
+
+	limits, usage := quotaToGRPC(q)
+	return &grpc.GetNamespaceQuotaResponse{Quota: limits, Usage: usage}, status.Error(grpccodes.OK, "")
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/trace"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+
+	grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+)
+
+// States that can be set manually. Namespace can be freely switched between them.
+var /* const */ lifecycleStates = bson.A{grpc.Namespace_ACTIVE, grpc.Namespace_SUSPENDED, grpc.Namespace_ARCHIVED}
+
+// Returns true if namespace and its data can be used by the services. Suspended and archived namespaces keep their data, only their identities are blocked.
+func isNamespaceAvailable(state grpc.Namespace_State) bool {
+	return state != grpc.Namespace_DELETING && state != grpc.Namespace_IMPORTING
+}
+
+func (s *NamespaceServer) SetState(ctx context.Context, in *grpc.SetNamespaceStateRequest) (*grpc.SetNamespaceStateResponse, error) {
+	if in.Name == "" {
+		return nil, status.Error(grpccodes.InvalidArgument, "State of the global namespace can not be changed")
+	}
+	if in.State != grpc.Namespace_ACTIVE && in.State != grpc.Namespace_SUSPENDED && in.State != grpc.Namespace_ARCHIVED {
+		return nil, status.Error(grpccodes.InvalidArgument, "Only ACTIVE, SUSPENDED and ARCHIVED states can be set")
+	}
+	if len(in.Reason) > 512 {
+		return nil, status.Error(grpccodes.InvalidArgument, "Reason is too long. Max length is 512 symbols.")
+	}
+
+	updateFilter := bson.M{"name": in.Name, "state": bson.M{"$in": lifecycleStates}}
+	updateData := bson.M{
+		"$set": bson.M{
+			"state":       in.State,
+			"stateReason": in.Reason,
+			"updated":     time.Now().UTC(),
+		},
+		"$inc": bson.M{
+			"version": 1,
+		},
+	}
+
+	var newNamespace NamespaceInMongo
+	err := s.namespaceCollection.FindOneAndUpdate(ctx, updateFilter, updateData, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&newNamespace)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			count, countErr := s.namespaceCollection.CountDocuments(ctx, bson.M{"name": in.Name}, options.Count().SetLimit(1))
+			if countErr == nil && count == 1 {
+				return nil, status.Error(grpccodes.FailedPrecondition, "Namespace is being deleted or imported")
+			}
+			return nil, status.Errorf(grpccodes.NotFound, "Namespace not found")
+		}
+		return nil, status.Error(grpccodes.Internal, "Error while changing state of the namespace: "+err.Error())
+	}
+
+	// Other services (for example native_iam) check the state using cached namespace data, so cache must be cleared immediately
+	s.cache.Remove(ctx, NAMESPACE_LIST_CACHE_KEY, makeNamespaceDataCacheKey(in.Name))
+
+	namespaceGRPC := newNamespace.ToGRPCNamespace()
+	namespaceBytes, _ := proto.Marshal(namespaceGRPC)
+	s.jetstreamClient.Publish("native.namespace.event.updated", namespaceBytes)
+
+	err = s.auditPublisher.Publish(ctx, audit.Change{
+		Namespace:     in.Name,
+		Resource:      "native.namespace." + in.Name,
+		Action:        "native.namespace.state.set",
+		BeforeVersion: newNamespace.Version - 1,
+		AfterVersion:  newNamespace.Version,
+		Details:       fmt.Sprintf("state [%s] reason [%s]", in.State.String(), in.Reason),
+	})
+	if err != nil {
+		trace.SpanFromContext(ctx).RecordError(errors.New("failed to publish audit record: " + err.Error()))
+	}
+
+	return &grpc.SetNamespaceStateResponse{Namespace: namespaceGRPC}, status.Error(grpccodes.OK, "")
+}
@@ -13,11 +13,15 @@ import (
 
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 
+	native_namespace_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
 	native_storage_bucket_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/storage/bucket"
 	native_storage_fs_grpc "github.com/slamy-solutions/openbp/modules/native/libs/golang/storage/fs"
 	"github.com/slamy-solutions/openbp/modules/native/services/storage/src/services/bucket"
 	"github.com/slamy-solutions/openbp/modules/native/services/storage/src/services/fs"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
 )
 
 const (
@@ -33,13 +37,19 @@ func getHostname() string {
 }
 
 func main() {
-	systemStub := system.NewSystemStub(
-		system.NewSystemStubConfig().
-			WithOTel(system.NewOTelConfig("native", "storage", VERSION, getHostname())).
-			WithCache().
-			WithDB().
-			WithNats(),
-	)
+	serviceAuthConfig := serviceauth.NewConfigFromEnv("native_storage").WithOnViolation(func(fullMethod string, err error) {
+		slog.Warn("Service authentication failed", "method", fullMethod, "error", err.Error())
+	})
+
+	systemStubConfig := system.NewSystemStubConfig().
+		WithOTel(system.NewOTelConfig("native", "storage", VERSION, getHostname())).
+		WithCache().
+		WithDB().
+		WithNats()
+	if serviceAuthConfig.Enabled() {
+		systemStubConfig.WithVault()
+	}
+	systemStub := system.NewSystemStub(systemStubConfig)
 	systemConnectionContext, cancel := context.WithTimeout(context.Background(), time.Second*15)
 	defer cancel()
 	err := systemStub.Connect(systemConnectionContext)
@@ -48,14 +58,24 @@ func main() {
 	}
 	defer systemStub.Close(context.Background())
 
+	serviceAuthenticator, err := serviceauth.NewAuthenticator(serviceAuthConfig, systemStub.Vault, serviceauth.Rules{
+		"/native_namespace.NamespaceSectionExporterService/": {"native_namespace"},
+	})
+	if err != nil {
+		panic("Failed to setup service authentication: " + err.Error())
+	}
+
 	// Creating grpc server
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
-		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
-		grpc.KeepaliveParams(keepalive.ServerParameters{
-			MaxConnectionAge: time.Minute * 5,
-		}),
-	)
+	grpcServer := grpc.NewServer(append(
+		[]grpc.ServerOption{
+			grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+			grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+			grpc.KeepaliveParams(keepalive.ServerParameters{
+				MaxConnectionAge: time.Minute * 5,
+			}),
+		},
+		serviceAuthenticator.ServerOptions()...,
+	)...)
 
 	logHandler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelDebug,
@@ -67,7 +87,11 @@ func main() {
 	if err != nil {
 		panic("Failed to create bucket repository: " + err.Error())
 	}
-	bucketService := bucket.NewService(bucketRepository, logger)
+	auditPublisher, err := audit.NewPublisher(systemStub.Nats, "native_storage")
+	if err != nil {
+		panic("Failed to create audit publisher: " + err.Error())
+	}
+	bucketService := bucket.NewService(bucketRepository, auditPublisher, logger)
 	native_storage_bucket_grpc.RegisterBucketServiceServer(grpcServer, bucketService)
 
 	fileRepository, err := fs.NewFSRepository(systemStub, logger)
@@ -77,6 +101,9 @@ func main() {
 	fileService := fs.NewService(fileRepository, logger)
 	native_storage_fs_grpc.RegisterFSServiceServer(grpcServer, fileService)
 
+	namespaceArchiver := db.NewNamespaceArchiver(systemStub.DB, append(bucket.NamespaceArchiveCollections, fs.NamespaceArchiveCollections...)...)
+	native_namespace_grpc.RegisterNamespaceSectionExporterServiceServer(grpcServer, native_namespace_grpc.NewSectionExporterServer("native_storage", namespaceArchiver))
+
 	eventHandler, err := NewEventHandlerService(systemStub, logger)
 	if err != nil {
 		panic("failed to setup event hanle service: " + err.Error())
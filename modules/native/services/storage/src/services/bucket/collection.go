@@ -4,12 +4,18 @@ import (
 	"context"
 
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const bucketCollectionName = "native_storage_buckets"
 
+// Collections of the namespace database that are included in the namespace archive
+var NamespaceArchiveCollections = []db.ArchiveCollection{
+	{Name: bucketCollectionName},
+}
+
 func GetBucketsCollection(systemStub *system.SystemStub, namespace string) *mongo.Collection {
 	dbName := "openbp_global"
 	if namespace != "" {
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 
 	bucketGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/storage/bucket"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -15,14 +16,30 @@ import (
 type service struct {
 	bucketGRPC.UnimplementedBucketServiceServer
 
-	repository *BucketRepository
-	logger     *slog.Logger
+	repository     *BucketRepository
+	auditPublisher *audit.Publisher
+	logger         *slog.Logger
 }
 
-func NewService(repository *BucketRepository, logger *slog.Logger) bucketGRPC.BucketServiceServer {
+func NewService(repository *BucketRepository, auditPublisher *audit.Publisher, logger *slog.Logger) bucketGRPC.BucketServiceServer {
 	return &service{
-		repository: repository,
-		logger:     logger.With("service", "bucket"),
+		repository:     repository,
+		auditPublisher: auditPublisher,
+		logger:         logger.With("service", "bucket"),
+	}
+}
+
+// Publishes record about the deleted bucket to the audit log. Failure to publish doesnt fail the request.
+func (s *service) publishDeleteAuditRecord(ctx context.Context, bucket *Bucket) {
+	err := s.auditPublisher.Publish(ctx, audit.Change{
+		Namespace:     bucket.Namespace,
+		Resource:      "native.storage.bucket." + bucket.UUID.Hex(),
+		Action:        "native.storage.bucket.delete",
+		BeforeVersion: uint64(bucket.Version),
+		Details:       "bucket [" + bucket.Name + "]",
+	})
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to publish audit record", "error", err, bucket.ToSlogAttr(""))
 	}
 }
 
@@ -164,6 +181,7 @@ func (s *service) Delete(ctx context.Context, in *bucketGRPC.DeleteBucketRequest
 		s.logger.ErrorContext(ctx, "failed to delete bucket", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete bucket: "+err.Error())
 	}
+	s.publishDeleteAuditRecord(ctx, bucket)
 
 	return &bucketGRPC.DeleteBucketResponse{
 		Bucket: bucket.ToGRPC(),
@@ -184,6 +202,7 @@ func (s *service) DeleteByUUID(ctx context.Context, in *bucketGRPC.DeleteBucketB
 		s.logger.ErrorContext(ctx, "failed to delete bucket", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete bucket: "+err.Error())
 	}
+	s.publishDeleteAuditRecord(ctx, bucket)
 
 	return &bucketGRPC.DeleteBucketByUUIDResponse{
 		Bucket: bucket.ToGRPC(),
@@ -5,6 +5,8 @@ import (
 	"errors"
 
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -16,6 +18,17 @@ const fileInfoCollectionPrefix = "native_storage_files_info_"
 const fileBucketPrefix = "native_storage_files_data_"
 const directoryPrefix = "native_storage_directories_"
 
+// Collections of the namespace database that are included in the namespace archive
+var NamespaceArchiveCollections = []db.ArchiveCollection{
+	{Name: fileInfoCollectionPrefix + "{namespace}", Quota: []db.ArchiveQuota{
+		{Resource: quota.STORAGE_FILES},
+		{Resource: quota.STORAGE_BYTES, AmountField: "size"},
+	}},
+	{Name: directoryPrefix + "{namespace}"},
+	{Name: fileBucketPrefix + "{namespace}_{uuid}.files"},
+	{Name: fileBucketPrefix + "{namespace}_{uuid}.chunks"},
+}
+
 func GetFileInfoCollection(systemStub *system.SystemStub, namespace string) *mongo.Collection {
 	dbName := "openbp_global"
 	if namespace != "" {
@@ -99,5 +112,43 @@ func DestroyCollectionsForBucket(ctx context.Context, systemStub *system.SystemS
 		return err
 	}
 
+	// Information about the files is removed together with the data, so the quota can be released
+	cursor, err := GetFileInfoCollection(systemStub, namespace).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"bucket": bucketUUID}}},
+		{{Key: "$group", Value: bson.M{"_id": nil, "files": bson.M{"$sum": 1}, "bytes": bson.M{"$sum": "$size"}}}},
+	})
+	if err != nil {
+		err = errors.Join(errors.New("failed to count files of the bucket"), err)
+		return err
+	}
+	var usage []struct {
+		Files int64 `bson:"files"`
+		Bytes int64 `bson:"bytes"`
+	}
+	err = cursor.All(ctx, &usage)
+	if err != nil {
+		err = errors.Join(errors.New("failed to count files of the bucket"), err)
+		return err
+	}
+
+	_, err = GetFileInfoCollection(systemStub, namespace).DeleteMany(ctx, bson.M{"bucket": bucketUUID})
+	if err != nil {
+		err = errors.Join(errors.New("failed to delete files info of the bucket"), err)
+		return err
+	}
+	_, err = GetDirectoryCollection(systemStub, namespace).DeleteMany(ctx, bson.M{"bucket": bucketUUID})
+	if err != nil {
+		err = errors.Join(errors.New("failed to delete directories of the bucket"), err)
+		return err
+	}
+
+	if len(usage) != 0 {
+		err = releaseStorageQuota(ctx, systemStub, namespace, usage[0].Files, usage[0].Bytes)
+		if err != nil {
+			err = errors.Join(errors.New("failed to release quota of the bucket files"), err)
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,39 @@
+package fs
+
+import (
+	"context"
+	"io"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
+)
+
+// Fails the write as soon as the file doesnt fit into the remaining storage quota
+type quotaLimitedWriter struct {
+	writer    io.Writer
+	remaining int64
+}
+
+func (w *quotaLimitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > w.remaining {
+		return 0, quota.ErrQuotaExceeded
+	}
+	w.remaining -= int64(len(p))
+	return w.writer.Write(p)
+}
+
+// Reserves the difference in the size of the file. Negative difference is released.
+func reserveStorageBytes(ctx context.Context, systemStub *system.SystemStub, namespace string, difference int64) error {
+	if difference < 0 {
+		return quota.Release(ctx, systemStub.DB, namespace, quota.STORAGE_BYTES, -difference)
+	}
+	return quota.Reserve(ctx, systemStub.DB, namespace, quota.STORAGE_BYTES, difference)
+}
+
+func releaseStorageQuota(ctx context.Context, systemStub *system.SystemStub, namespace string, files int64, bytes int64) error {
+	err := quota.Release(ctx, systemStub.DB, namespace, quota.STORAGE_FILES, files)
+	if err != nil {
+		return err
+	}
+	return quota.Release(ctx, systemStub.DB, namespace, quota.STORAGE_BYTES, bytes)
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -77,8 +78,14 @@ func (r *FileRepository) Create(ctx context.Context, namespace string, bucket pr
 	collection := GetFileInfoCollection(r.systemStub, namespace)
 	creationTime := time.Now().UTC()
 
+	err = quota.Reserve(ctx, r.systemStub.DB, namespace, quota.STORAGE_FILES, 1)
+	if err != nil {
+		return nil, err
+	}
+
 	downloadSecret, err := generateDownloadSecret(32)
 	if err != nil {
+		quota.Release(ctx, r.systemStub.DB, namespace, quota.STORAGE_FILES, 1)
 		err = errors.Join(errors.New("failed to generate download secret"), err)
 		r.logger.Error("Failed to generate download secret", "error", err.Error())
 		return nil, err
@@ -99,6 +106,7 @@ func (r *FileRepository) Create(ctx context.Context, namespace string, bucket pr
 	}
 	result, err := collection.InsertOne(ctx, file)
 	if err != nil {
+		quota.Release(ctx, r.systemStub.DB, namespace, quota.STORAGE_FILES, 1)
 		if mongo.IsDuplicateKeyError(err) {
 			return nil, ErrFileAlreadyExists
 		}
@@ -132,6 +140,13 @@ func (r *FileRepository) Upload(ctx context.Context, namespace string, fileInfoU
 		return nil, err
 	}
 
+	// Upload is stopped as soon as the file doesnt fit into the quota. Quota is reserved after the upload, when the size of the file is known.
+	storageQuota, err := quota.Get(ctx, r.systemStub.DB, namespace)
+	if err != nil {
+		r.logger.Error("Failed to get storage quota", "error", err.Error())
+		return nil, err
+	}
+
 	uploadStream, err := gridFSBucket.OpenUploadStreamWithID(fileInfoUUID, "file")
 	if err != nil {
 		err = errors.Join(errors.New("failed to open upload stream"), err)
@@ -139,9 +154,17 @@ func (r *FileRepository) Upload(ctx context.Context, namespace string, fileInfoU
 		return nil, err
 	}
 
-	fileSize, err := io.Copy(uploadStream, file)
+	var uploadWriter io.Writer = uploadStream
+	if limit, ok := storageQuota.Limits[quota.STORAGE_BYTES]; ok && namespace != "" {
+		uploadWriter = &quotaLimitedWriter{writer: uploadStream, remaining: limit - storageQuota.Usage[quota.STORAGE_BYTES] + oldFile.Size}
+	}
+
+	fileSize, err := io.Copy(uploadWriter, file)
 	if err != nil {
 		uploadStream.Abort()
+		if errors.Is(err, quota.ErrQuotaExceeded) {
+			return nil, quota.ErrQuotaExceeded
+		}
 
 		err = errors.Join(errors.New("failed to copy file to upload stream"), err)
 		r.logger.Error("Failed to copy file to upload stream", "error", err.Error())
@@ -157,6 +180,19 @@ func (r *FileRepository) Upload(ctx context.Context, namespace string, fileInfoU
 		return nil, err
 	}
 
+	err = reserveStorageBytes(ctx, r.systemStub, namespace, fileSize-oldFile.Size)
+	if err != nil {
+		deleteErr := gridFSBucket.Delete(uploadStream.FileID)
+		if deleteErr != nil {
+			r.logger.Warn("Failed to delete file from gridfs after problems with reserving quota", "error", deleteErr.Error())
+		}
+
+		if err != quota.ErrQuotaExceeded {
+			r.logger.Error("Failed to reserve storage quota", "error", err.Error())
+		}
+		return nil, err
+	}
+
 	//Update file info
 	var fileInfo File
 	err = collection.FindOneAndUpdate(
@@ -174,6 +210,7 @@ func (r *FileRepository) Upload(ctx context.Context, namespace string, fileInfoU
 		if deleteErr != nil {
 			r.logger.Warn("Failed to delete file from gridfs after problems with inserting file info", "error", deleteErr.Error())
 		}
+		reserveStorageBytes(ctx, r.systemStub, namespace, oldFile.Size-fileSize)
 
 		if err == mongo.ErrNoDocuments {
 			return nil, ErrFileNotFound
@@ -288,6 +325,11 @@ func (r *FileRepository) Delete(ctx context.Context, namespace string, uuid prim
 		return nil, err
 	}
 
+	err = releaseStorageQuota(ctx, r.systemStub, namespace, 1, fileInfo.Size)
+	if err != nil {
+		r.logger.Warn("Failed to release quota of the deleted file", "error", err.Error())
+	}
+
 	if fileInfo.GridFSFile != primitive.NilObjectID {
 		gridFSBucket, err := GetFileBucket(r.systemStub, namespace, fileInfo.Bucket)
 		if err != nil {
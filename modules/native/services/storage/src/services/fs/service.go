@@ -6,6 +6,7 @@ import (
 	"log/slog"
 
 	fsGRPC "github.com/slamy-solutions/openbp/modules/native/libs/golang/storage/fs"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -36,6 +37,9 @@ func (s *service) CreateFile(ctx context.Context, in *fsGRPC.CreateFileRequest)
 		if err == ErrFileAlreadyExists {
 			return nil, status.Error(codes.AlreadyExists, "file already exists")
 		}
+		if err == quota.ErrQuotaExceeded {
+			return nil, status.Error(codes.ResourceExhausted, "quota of the files in the namespace is exceeded")
+		}
 
 		s.logger.ErrorContext(ctx, "failed to create file", "error", err)
 		return nil, status.Error(codes.Internal, "failed to create file: "+err.Error())
@@ -121,6 +125,9 @@ func (s *service) UploadFile(srv fsGRPC.FSService_UploadFileServer) error {
 		if err == ErrFileNotFound {
 			return status.Error(codes.NotFound, "file not found")
 		}
+		if err == quota.ErrQuotaExceeded {
+			return status.Error(codes.ResourceExhausted, "storage quota of the namespace is exceeded")
+		}
 
 		s.logger.ErrorContext(ctx, "failed to upload file", "error", err)
 		return status.Error(codes.Internal, "failed to upload file: "+err.Error())
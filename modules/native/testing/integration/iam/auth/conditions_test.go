@@ -0,0 +1,274 @@
+package password
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/password"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/system/testing/tools"
+)
+
+type PolicyConditionsTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *PolicyConditionsTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(
+		native.NewStubConfig().
+			WithNamespaceService().
+			WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *PolicyConditionsTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestPolicyConditionsTestSuite(t *testing.T) {
+	suite.Run(t, new(PolicyConditionsTestSuite))
+}
+
+// Creates namespace and identity with password and provided policies. Returns namespace name, identity UUID and password.
+func (s *PolicyConditionsTestSuite) prepareIdentity(ctx context.Context, policies []*policy.CreatePolicyRequest) (string, string, string) {
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        namespaceName,
+		FullName:    tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+	})
+	require.Nil(s.T(), err)
+
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       namespaceName,
+		Name:            tools.GetRandomString(10),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+
+	pwd := tools.GetRandomString(20)
+	_, err = s.nativeStub.Services.IAM.Authentication.Password.CreateOrUpdate(ctx, &password.CreateOrUpdateRequest{
+		Namespace: namespaceName,
+		Identity:  identityCreateResponse.Identity.Uuid,
+		Password:  pwd,
+	})
+	require.Nil(s.T(), err)
+
+	for _, p := range policies {
+		p.Namespace = namespaceName
+		p.Name = tools.GetRandomString(10)
+		p.Managed = &policy.CreatePolicyRequest_No{No: &policy.NotManagedData{}}
+		createResponse, err := s.nativeStub.Services.IAM.Policy.Create(ctx, p)
+		require.Nil(s.T(), err)
+
+		_, err = s.nativeStub.Services.IAM.Identity.AddPolicy(ctx, &identity.AddPolicyRequest{
+			IdentityNamespace: namespaceName,
+			IdentityUUID:      identityCreateResponse.Identity.Uuid,
+			PolicyNamespace:   namespaceName,
+			PolicyUUID:        createResponse.Policy.Uuid,
+		})
+		require.Nil(s.T(), err)
+	}
+
+	return namespaceName, identityCreateResponse.Identity.Uuid, pwd
+}
+
+func (s *PolicyConditionsTestSuite) TestDenyTakesPrecedence() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	namespaceName, identityUUID, pwd := s.prepareIdentity(ctx, []*policy.CreatePolicyRequest{
+		{Resources: []string{"test.*"}, Actions: []string{"*"}},
+		{Resources: []string{"test.secret"}, Actions: []string{"test.delete"}, Effect: policy.PolicyEffect_DENY},
+	})
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	tests := []struct {
+		testName  string
+		scope     *auth.Scope
+		hasAccess bool
+	}{
+		{"Allowed resource", &auth.Scope{Namespace: namespaceName, Resources: []string{"test.public"}, Actions: []string{"test.delete"}}, true},
+		{"Allowed action on denied resource", &auth.Scope{Namespace: namespaceName, Resources: []string{"test.secret"}, Actions: []string{"test.get"}}, true},
+		{"Denied action", &auth.Scope{Namespace: namespaceName, Resources: []string{"test.secret"}, Actions: []string{"test.delete"}}, false},
+		{"Wildcard that includes denied resource", &auth.Scope{Namespace: namespaceName, Resources: []string{"test.*"}, Actions: []string{"test.delete"}}, false},
+	}
+
+	createTokenResponse, err := s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{
+		Namespace: namespaceName,
+		Identity:  identityUUID,
+		Password:  pwd,
+		Metadata:  "{}",
+		Scopes:    []*auth.Scope{},
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_OK, createTokenResponse.Status)
+
+	for _, tc := range tests {
+		s.Run(tc.testName, func() {
+			checkResponse, err := s.nativeStub.Services.IAM.Auth.CheckAccess(ctx, &auth.CheckAccessRequest{
+				Namespace: namespaceName,
+				Identity:  identityUUID,
+				Scopes:    []*auth.Scope{tc.scope},
+			})
+			require.Nil(s.T(), err)
+			require.Equal(s.T(), tc.hasAccess, checkResponse.Status == auth.CheckAccessResponse_OK)
+
+			accessResponse, err := s.nativeStub.Services.IAM.Auth.CheckAccessWithToken(ctx, &auth.CheckAccessWithTokenRequest{
+				AccessToken: createTokenResponse.AccessToken,
+				Scopes:      []*auth.Scope{tc.scope},
+			})
+			require.Nil(s.T(), err)
+			require.Equal(s.T(), tc.hasAccess, accessResponse.Status == auth.CheckAccessWithTokenResponse_OK)
+
+			// Token with explicitly requested denied scope must not be created
+			scopedTokenResponse, err := s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{
+				Namespace: namespaceName,
+				Identity:  identityUUID,
+				Password:  pwd,
+				Metadata:  "{}",
+				Scopes:    []*auth.Scope{tc.scope},
+			})
+			require.Nil(s.T(), err)
+			require.Equal(s.T(), tc.hasAccess, scopedTokenResponse.Status == auth.CreateTokenWithPasswordResponse_OK)
+		})
+	}
+}
+
+func (s *PolicyConditionsTestSuite) TestSourceIPCondition() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	namespaceName, identityUUID, pwd := s.prepareIdentity(ctx, []*policy.CreatePolicyRequest{
+		{Resources: []string{"test.*"}, Actions: []string{"*"}, Conditions: &policy.PolicyConditions{SourceIPs: []string{"10.0.0.0/8"}}},
+	})
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	scopes := []*auth.Scope{{Namespace: namespaceName, Resources: []string{"test.resource"}, Actions: []string{"test.get"}}}
+
+	// Source IP is not known on token creation, so the condition is copied to the token
+	createTokenResponse, err := s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{
+		Namespace: namespaceName,
+		Identity:  identityUUID,
+		Password:  pwd,
+		Metadata:  "{}",
+		Scopes:    scopes,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_OK, createTokenResponse.Status)
+
+	tests := []struct {
+		testName  string
+		context   *auth.AccessContext
+		hasAccess bool
+	}{
+		{"IP inside of the network", &auth.AccessContext{SourceIP: "10.1.2.3"}, true},
+		{"IP outside of the network", &auth.AccessContext{SourceIP: "192.168.0.1"}, false},
+		{"Unknown IP", nil, false},
+	}
+	for _, tc := range tests {
+		s.Run(tc.testName, func() {
+			accessResponse, err := s.nativeStub.Services.IAM.Auth.CheckAccessWithToken(ctx, &auth.CheckAccessWithTokenRequest{
+				AccessToken: createTokenResponse.AccessToken,
+				Scopes:      scopes,
+				Context:     tc.context,
+			})
+			require.Nil(s.T(), err)
+			require.Equal(s.T(), tc.hasAccess, accessResponse.Status == auth.CheckAccessWithTokenResponse_OK)
+
+			passwordAccessResponse, err := s.nativeStub.Services.IAM.Auth.CheckAccessWithPassword(ctx, &auth.CheckAccessWithPasswordRequest{
+				Namespace: namespaceName,
+				Identity:  identityUUID,
+				Password:  pwd,
+				Scopes:    scopes,
+				Context:   tc.context,
+			})
+			require.Nil(s.T(), err)
+			require.Equal(s.T(), tc.hasAccess, passwordAccessResponse.Status == auth.CheckAccessWithPasswordResponse_OK)
+		})
+	}
+}
+
+func (s *PolicyConditionsTestSuite) TestAuthMethodAndMFAConditions() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	namespaceName, identityUUID, pwd := s.prepareIdentity(ctx, []*policy.CreatePolicyRequest{
+		{Resources: []string{"test.password"}, Actions: []string{"*"}, Conditions: &policy.PolicyConditions{AuthMethods: []policy.AuthMethod{policy.AuthMethod_PASSWORD}}},
+		{Resources: []string{"test.x509"}, Actions: []string{"*"}, Conditions: &policy.PolicyConditions{AuthMethods: []policy.AuthMethod{policy.AuthMethod_X509}}},
+		{Resources: []string{"test.mfa"}, Actions: []string{"*"}, Conditions: &policy.PolicyConditions{RequireMFA: true}},
+	})
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	createTokenResponse, err := s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{
+		Namespace: namespaceName,
+		Identity:  identityUUID,
+		Password:  pwd,
+		Metadata:  "{}",
+		Scopes:    []*auth.Scope{},
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_OK, createTokenResponse.Status)
+
+	tests := []struct {
+		resource  string
+		hasAccess bool
+	}{
+		{"test.password", true},
+		{"test.x509", false},
+		{"test.mfa", false},
+	}
+	for _, tc := range tests {
+		s.Run(tc.resource, func() {
+			accessResponse, err := s.nativeStub.Services.IAM.Auth.CheckAccessWithToken(ctx, &auth.CheckAccessWithTokenRequest{
+				AccessToken: createTokenResponse.AccessToken,
+				Scopes:      []*auth.Scope{{Namespace: namespaceName, Resources: []string{tc.resource}, Actions: []string{"test.get"}}},
+			})
+			require.Nil(s.T(), err)
+			require.Equal(s.T(), tc.hasAccess, accessResponse.Status == auth.CheckAccessWithTokenResponse_OK)
+		})
+	}
+}
+
+func (s *PolicyConditionsTestSuite) TestInvalidConditions() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	tests := []struct {
+		testName   string
+		conditions *policy.PolicyConditions
+	}{
+		{"Bad CIDR", &policy.PolicyConditions{SourceIPs: []string{"10.0.0.0/33"}}},
+		{"Bad IP", &policy.PolicyConditions{SourceIPs: []string{"not-an-ip"}}},
+		{"Bad timezone", &policy.PolicyConditions{TimeWindow: &policy.TimeWindow{Timezone: "Mars/Olympus"}}},
+		{"Bad daily minute", &policy.PolicyConditions{TimeWindow: &policy.TimeWindow{DailyStartMinute: 0, DailyEndMinute: 24 * 60}}},
+	}
+	for _, tc := range tests {
+		s.Run(tc.testName, func() {
+			_, err := s.nativeStub.Services.IAM.Policy.Create(ctx, &policy.CreatePolicyRequest{
+				Namespace:  "",
+				Name:       tools.GetRandomString(10),
+				Managed:    &policy.CreatePolicyRequest_No{No: &policy.NotManagedData{}},
+				Resources:  []string{"test.resource"},
+				Actions:    []string{"test.get"},
+				Conditions: tc.conditions,
+			})
+			require.NotNil(s.T(), err)
+			require.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package password
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/password"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/system/testing/tools"
+)
+
+type ExplainAccessTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *ExplainAccessTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(
+		native.NewStubConfig().
+			WithNamespaceService().
+			WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *ExplainAccessTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestExplainAccessTestSuite(t *testing.T) {
+	suite.Run(t, new(ExplainAccessTestSuite))
+}
+
+func (s *ExplainAccessTestSuite) TestExplain() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        namespaceName,
+		FullName:    tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	createPolicy := func(request *policy.CreatePolicyRequest) *policy.Policy {
+		request.Namespace = namespaceName
+		request.Name = tools.GetRandomString(10)
+		request.Managed = &policy.CreatePolicyRequest_No{No: &policy.NotManagedData{}}
+		response, err := s.nativeStub.Services.IAM.Policy.Create(ctx, request)
+		require.Nil(s.T(), err)
+		return response.Policy
+	}
+	directPolicy := createPolicy(&policy.CreatePolicyRequest{Resources: []string{"test.direct.*"}, Actions: []string{"*"}})
+	rolePolicy := createPolicy(&policy.CreatePolicyRequest{Resources: []string{"test.role"}, Actions: []string{"test.get"}})
+	denyPolicy := createPolicy(&policy.CreatePolicyRequest{Resources: []string{"test.direct.secret"}, Actions: []string{"*"}, Effect: policy.PolicyEffect_DENY})
+	conditionalPolicy := createPolicy(&policy.CreatePolicyRequest{Resources: []string{"test.office"}, Actions: []string{"*"}, Conditions: &policy.PolicyConditions{SourceIPs: []string{"10.0.0.0/8"}}})
+
+	roleResponse, err := s.nativeStub.Services.IAM.Role.Create(ctx, &role.CreateRoleRequest{
+		Namespace:   namespaceName,
+		Name:        tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+		Managed:     &role.CreateRoleRequest_No{No: &role.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	_, err = s.nativeStub.Services.IAM.Role.AddPolicy(ctx, &role.AddPolicyRequest{
+		RoleNamespace:   namespaceName,
+		RoleUUID:        roleResponse.Role.Uuid,
+		PolicyNamespace: namespaceName,
+		PolicyUUID:      rolePolicy.Uuid,
+	})
+	require.Nil(s.T(), err)
+
+	identityResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       namespaceName,
+		Name:            tools.GetRandomString(10),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	identityUUID := identityResponse.Identity.Uuid
+	for _, p := range []*policy.Policy{directPolicy, denyPolicy, conditionalPolicy} {
+		_, err = s.nativeStub.Services.IAM.Identity.AddPolicy(ctx, &identity.AddPolicyRequest{
+			IdentityNamespace: namespaceName,
+			IdentityUUID:      identityUUID,
+			PolicyNamespace:   namespaceName,
+			PolicyUUID:        p.Uuid,
+		})
+		require.Nil(s.T(), err)
+	}
+	_, err = s.nativeStub.Services.IAM.Identity.AddRole(ctx, &identity.AddRoleRequest{
+		IdentityNamespace: namespaceName,
+		IdentityUUID:      identityUUID,
+		RoleNamespace:     namespaceName,
+		RoleUUID:          roleResponse.Role.Uuid,
+	})
+	require.Nil(s.T(), err)
+
+	scope := func(resource string, action string) *auth.Scope {
+		return &auth.Scope{Namespace: namespaceName, Resources: []string{resource}, Actions: []string{action}}
+	}
+
+	s.Run("Identity", func() {
+		response, err := s.nativeStub.Services.IAM.Auth.ExplainAccess(ctx, &auth.ExplainAccessRequest{
+			Subject: &auth.ExplainAccessRequest_Identity{Identity: &auth.ExplainAccessRequest_IdentitySubject{Namespace: namespaceName, Uuid: identityUUID}},
+			Scopes: []*auth.Scope{
+				scope("test.direct.a", "test.get"),
+				scope("test.role", "test.get"),
+				scope("test.direct.secret", "test.get"),
+				scope("test.office", "test.get"),
+				scope("test.other", "test.get"),
+			},
+		})
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), auth.ExplainAccessResponse_OK, response.Status)
+		require.False(s.T(), response.Granted)
+		require.Len(s.T(), response.Scopes, 5)
+
+		direct := response.Scopes[0]
+		require.Equal(s.T(), auth.ScopeExplanation_GRANTED, direct.Decision)
+		require.Len(s.T(), direct.MatchedPolicies, 1)
+		require.Equal(s.T(), directPolicy.Uuid, direct.MatchedPolicies[0].Uuid)
+		require.Empty(s.T(), direct.MatchedPolicies[0].RoleUUID)
+
+		viaRole := response.Scopes[1]
+		require.Equal(s.T(), auth.ScopeExplanation_GRANTED, viaRole.Decision)
+		require.Len(s.T(), viaRole.MatchedPolicies, 1)
+		require.Equal(s.T(), rolePolicy.Uuid, viaRole.MatchedPolicies[0].Uuid)
+		require.Equal(s.T(), roleResponse.Role.Uuid, viaRole.MatchedPolicies[0].RoleUUID)
+
+		denied := response.Scopes[2]
+		require.False(s.T(), denied.Granted)
+		require.Equal(s.T(), auth.ScopeExplanation_DENIED_BY_POLICY, denied.Decision)
+		require.Len(s.T(), denied.DenyingPolicies, 1)
+		require.Equal(s.T(), denyPolicy.Uuid, denied.DenyingPolicies[0].Uuid)
+
+		conditional := response.Scopes[3]
+		require.Equal(s.T(), auth.ScopeExplanation_CONDITIONS_NOT_SATISFIED, conditional.Decision)
+		require.Len(s.T(), conditional.UnsatisfiedConditionPolicies, 1)
+		require.Equal(s.T(), conditionalPolicy.Uuid, conditional.UnsatisfiedConditionPolicies[0].Uuid)
+
+		noMatch := response.Scopes[4]
+		require.Equal(s.T(), auth.ScopeExplanation_NO_MATCHING_POLICY, noMatch.Decision)
+		require.Equal(s.T(), []string{"test.other"}, noMatch.UnsatisfiedResources)
+		require.Empty(s.T(), noMatch.UnsatisfiedActions)
+	})
+
+	s.Run("Token scope narrowing", func() {
+		pwd := tools.GetRandomString(20)
+		_, err = s.nativeStub.Services.IAM.Authentication.Password.CreateOrUpdate(ctx, &password.CreateOrUpdateRequest{
+			Namespace: namespaceName,
+			Identity:  identityUUID,
+			Password:  pwd,
+		})
+		require.Nil(s.T(), err)
+
+		tokenResponse, err := s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{
+			Namespace: namespaceName,
+			Identity:  identityUUID,
+			Password:  pwd,
+			Metadata:  "{}",
+			Scopes:    []*auth.Scope{scope("test.direct.a", "test.get")},
+		})
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_OK, tokenResponse.Status)
+
+		response, err := s.nativeStub.Services.IAM.Auth.ExplainAccess(ctx, &auth.ExplainAccessRequest{
+			Subject: &auth.ExplainAccessRequest_AccessToken{AccessToken: tokenResponse.AccessToken},
+			Scopes: []*auth.Scope{
+				scope("test.direct.a", "test.get"),
+				scope("test.role", "test.get"),
+			},
+		})
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), auth.ExplainAccessResponse_OK, response.Status)
+		require.Equal(s.T(), identityUUID, response.Identity)
+		require.False(s.T(), response.Granted)
+
+		require.Equal(s.T(), auth.ScopeExplanation_GRANTED, response.Scopes[0].Decision)
+		require.Equal(s.T(), auth.ScopeExplanation_TOKEN_SCOPE_NARROWED, response.Scopes[1].Decision)
+		require.Equal(s.T(), []string{"test.role"}, response.Scopes[1].UnsatisfiedResources)
+	})
+
+	s.Run("Identity not found", func() {
+		response, err := s.nativeStub.Services.IAM.Auth.ExplainAccess(ctx, &auth.ExplainAccessRequest{
+			Subject: &auth.ExplainAccessRequest_Identity{Identity: &auth.ExplainAccessRequest_IdentitySubject{Namespace: namespaceName, Uuid: "000000000000000000000000"}},
+			Scopes:  []*auth.Scope{scope("test.direct.a", "test.get")},
+		})
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), auth.ExplainAccessResponse_IDENTITY_NOT_FOUND, response.Status)
+	})
+}
@@ -11,11 +11,12 @@ import (
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/password"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/totp"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
-	"github.com/slamy-solutions/openbp/modules/system/testing/tools"
+	"github.com/slamy-solutions/openbp/modules/native/testing/tools"
 )
 
 type PasswordAuthTestSuite struct {
@@ -467,3 +468,126 @@ func (s *PasswordAuthTestSuite) TestAuth() {
 		})
 	}
 }
+
+func (s *PasswordAuthTestSuite) TestAuthWithTOTP() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	identityUUID := identityCreateResponse.Identity.Uuid
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityUUID})
+
+	identityPassword := tools.GetRandomString(20)
+	_, err = s.nativeStub.Services.IAM.Authentication.Password.CreateOrUpdate(ctx, &password.CreateOrUpdateRequest{Namespace: "", Identity: identityUUID, Password: identityPassword})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Authentication.Password.Delete(context.Background(), &password.DeleteRequest{Namespace: "", Identity: identityUUID})
+
+	enrollResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.Enroll(ctx, &totp.EnrollRequest{Namespace: "", Identity: identityUUID})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Authentication.TOTP.Disable(context.Background(), &totp.DisableRequest{Namespace: "", Identity: identityUUID})
+
+	// Enrollment is not confirmed yet. Password is enough
+	tokenResponse, err := s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{Namespace: "", Identity: identityUUID, Password: identityPassword})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_OK, tokenResponse.Status)
+
+	confirmResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.ConfirmEnrollment(ctx, &totp.ConfirmEnrollmentRequest{
+		Namespace: "",
+		Identity:  identityUUID,
+		Code:      tools.GenerateTOTPCode(enrollResponse.Secret, 0),
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.ConfirmEnrollmentResponse_OK, confirmResponse.Status)
+
+	tokenResponse, err = s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{Namespace: "", Identity: identityUUID, Password: identityPassword})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_TOTP_REQUIRED, tokenResponse.Status)
+	require.Empty(s.T(), tokenResponse.AccessToken)
+
+	tokenResponse, err = s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{Namespace: "", Identity: identityUUID, Password: identityPassword, Totp: tools.GenerateTOTPCode(enrollResponse.Secret, 10)})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_TOTP_INVALID, tokenResponse.Status)
+	require.NotZero(s.T(), tokenResponse.RetryAfter)
+
+	// Invalid TOTP code is a failed attempt. Valid password doesnt reset it, so codes can not be brute-forced.
+	tokenResponse, err = s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{Namespace: "", Identity: identityUUID, Password: identityPassword, Totp: tools.GenerateTOTPCode(enrollResponse.Secret, 10)})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_TOO_MANY_ATTEMPTS, tokenResponse.Status)
+
+	_, err = s.nativeStub.Services.IAM.Authentication.Password.ClearLockout(ctx, &password.ClearLockoutRequest{Type: password.Lockout_IDENTITY, Namespace: "", Identity: identityUUID})
+	require.Nil(s.T(), err)
+
+	tokenResponse, err = s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{Namespace: "", Identity: identityUUID, Password: identityPassword, Totp: tools.GenerateTOTPCode(enrollResponse.Secret, 1)})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_OK, tokenResponse.Status)
+	require.NotEmpty(s.T(), tokenResponse.AccessToken)
+
+	// Wrong password must not be accepted even with valid recovery code
+	tokenResponse, err = s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{Namespace: "", Identity: identityUUID, Password: tools.GetRandomString(20), Totp: confirmResponse.RecoveryCodes[0]})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_CREDENTIALS_INVALID, tokenResponse.Status)
+
+	// Failed attempt delays the next one. Reset it to not wait
+	_, err = s.nativeStub.Services.IAM.Authentication.Password.ClearLockout(ctx, &password.ClearLockoutRequest{Type: password.Lockout_IDENTITY, Namespace: "", Identity: identityUUID})
+	require.Nil(s.T(), err)
+
+	tokenResponse, err = s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{Namespace: "", Identity: identityUUID, Password: identityPassword, Totp: confirmResponse.RecoveryCodes[0]})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_OK, tokenResponse.Status)
+}
+
+func (s *PasswordAuthTestSuite) TestCheckAccessWithPasswordRequiresTOTP() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	identityUUID := identityCreateResponse.Identity.Uuid
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityUUID})
+
+	identityPassword := tools.GetRandomString(20)
+	_, err = s.nativeStub.Services.IAM.Authentication.Password.CreateOrUpdate(ctx, &password.CreateOrUpdateRequest{Namespace: "", Identity: identityUUID, Password: identityPassword})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Authentication.Password.Delete(context.Background(), &password.DeleteRequest{Namespace: "", Identity: identityUUID})
+
+	enrollResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.Enroll(ctx, &totp.EnrollRequest{Namespace: "", Identity: identityUUID})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Authentication.TOTP.Disable(context.Background(), &totp.DisableRequest{Namespace: "", Identity: identityUUID})
+
+	confirmResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.ConfirmEnrollment(ctx, &totp.ConfirmEnrollmentRequest{
+		Namespace: "",
+		Identity:  identityUUID,
+		Code:      tools.GenerateTOTPCode(enrollResponse.Secret, 0),
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.ConfirmEnrollmentResponse_OK, confirmResponse.Status)
+
+	// Password alone is not enough
+	accessResponse, err := s.nativeStub.Services.IAM.Auth.CheckAccessWithPassword(ctx, &auth.CheckAccessWithPasswordRequest{Namespace: "", Identity: identityUUID, Password: identityPassword, Scopes: []*auth.Scope{}})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CheckAccessWithPasswordResponse_TOTP_REQUIRED, accessResponse.Status)
+
+	accessResponse, err = s.nativeStub.Services.IAM.Auth.CheckAccessWithPassword(ctx, &auth.CheckAccessWithPasswordRequest{Namespace: "", Identity: identityUUID, Password: identityPassword, Scopes: []*auth.Scope{}, Totp: tools.GenerateTOTPCode(enrollResponse.Secret, 10)})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CheckAccessWithPasswordResponse_TOTP_INVALID, accessResponse.Status)
+	require.NotZero(s.T(), accessResponse.RetryAfter)
+
+	// Failed attempt delays the next one. Reset it to not wait
+	_, err = s.nativeStub.Services.IAM.Authentication.Password.ClearLockout(ctx, &password.ClearLockoutRequest{Type: password.Lockout_IDENTITY, Namespace: "", Identity: identityUUID})
+	require.Nil(s.T(), err)
+
+	accessResponse, err = s.nativeStub.Services.IAM.Auth.CheckAccessWithPassword(ctx, &auth.CheckAccessWithPasswordRequest{Namespace: "", Identity: identityUUID, Password: identityPassword, Scopes: []*auth.Scope{}, Totp: confirmResponse.RecoveryCodes[0]})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CheckAccessWithPasswordResponse_OK, accessResponse.Status)
+}
@@ -0,0 +1,100 @@
+package password
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/password"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/system/testing/tools"
+)
+
+type SuspensionAuthTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *SuspensionAuthTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(
+		native.NewStubConfig().
+			WithNamespaceService().
+			WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *SuspensionAuthTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestSuspensionAuthTestSuite(t *testing.T) {
+	suite.Run(t, new(SuspensionAuthTestSuite))
+}
+
+func (s *SuspensionAuthTestSuite) TestSuspendedNamespaceBlocksIdentities() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	testNamespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: testNamespaceName})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: testNamespaceName})
+
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       testNamespaceName,
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	identityUUID := identityCreateResponse.Identity.Uuid
+
+	identityPassword := tools.GetRandomString(20)
+	_, err = s.nativeStub.Services.IAM.Authentication.Password.CreateOrUpdate(ctx, &password.CreateOrUpdateRequest{Namespace: testNamespaceName, Identity: identityUUID, Password: identityPassword})
+	require.Nil(s.T(), err)
+
+	tokenResponse, err := s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{Namespace: testNamespaceName, Identity: identityUUID, Password: identityPassword})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_OK, tokenResponse.Status)
+
+	_, err = s.nativeStub.Services.Namespace.SetState(ctx, &namespace.SetNamespaceStateRequest{Name: testNamespaceName, State: namespace.Namespace_SUSPENDED})
+	require.Nil(s.T(), err)
+
+	// New tokens are not created and existing ones dont grant access
+	suspendedTokenResponse, err := s.nativeStub.Services.IAM.Auth.CreateTokenWithPassword(ctx, &auth.CreateTokenWithPasswordRequest{Namespace: testNamespaceName, Identity: identityUUID, Password: identityPassword})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithPasswordResponse_NAMESPACE_SUSPENDED, suspendedTokenResponse.Status)
+
+	accessResponse, err := s.nativeStub.Services.IAM.Auth.CheckAccessWithToken(ctx, &auth.CheckAccessWithTokenRequest{AccessToken: tokenResponse.AccessToken, Scopes: []*auth.Scope{}})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CheckAccessWithTokenResponse_NAMESPACE_SUSPENDED, accessResponse.Status)
+
+	checkAccessResponse, err := s.nativeStub.Services.IAM.Auth.CheckAccess(ctx, &auth.CheckAccessRequest{Namespace: testNamespaceName, Identity: identityUUID, Scopes: []*auth.Scope{}})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CheckAccessResponse_NAMESPACE_SUSPENDED, checkAccessResponse.Status)
+
+	refreshResponse, err := s.nativeStub.Services.IAM.Auth.RefreshToken(ctx, &auth.RefreshTokenRequest{RefreshToken: tokenResponse.RefreshToken})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.RefreshTokenResponse_NAMESPACE_SUSPENDED, refreshResponse.Status)
+
+	// Data is kept, so access is restored after activation
+	_, err = s.nativeStub.Services.Namespace.SetState(ctx, &namespace.SetNamespaceStateRequest{Name: testNamespaceName, State: namespace.Namespace_ACTIVE})
+	require.Nil(s.T(), err)
+
+	accessResponse, err = s.nativeStub.Services.IAM.Auth.CheckAccessWithToken(ctx, &auth.CheckAccessWithTokenRequest{AccessToken: tokenResponse.AccessToken, Scopes: []*auth.Scope{}})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CheckAccessWithTokenResponse_OK, accessResponse.Status)
+
+	// Refresh token is not rotated when refresh was rejected, so it still can be used
+	refreshResponse, err = s.nativeStub.Services.IAM.Auth.RefreshToken(ctx, &auth.RefreshTokenRequest{RefreshToken: tokenResponse.RefreshToken})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.RefreshTokenResponse_OK, refreshResponse.Status)
+}
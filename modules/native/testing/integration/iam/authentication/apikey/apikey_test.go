@@ -0,0 +1,234 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/apikey"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/system/testing/tools"
+)
+
+type APIKeyTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *APIKeyTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *APIKeyTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestAPIKeyTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyTestSuite))
+}
+
+// Creates namespace and identity with policy that allows all the actions on "test.*" resources. Returns namespace name and identity UUID.
+func (s *APIKeyTestSuite) prepareIdentity(ctx context.Context) (string, string) {
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        namespaceName,
+		FullName:    tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+	})
+	require.Nil(s.T(), err)
+
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       namespaceName,
+		Name:            tools.GetRandomString(10),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+
+	policyCreateResponse, err := s.nativeStub.Services.IAM.Policy.Create(ctx, &policy.CreatePolicyRequest{
+		Namespace: namespaceName,
+		Name:      tools.GetRandomString(10),
+		Managed:   &policy.CreatePolicyRequest_No{No: &policy.NotManagedData{}},
+		Resources: []string{"test.*"},
+		Actions:   []string{"*"},
+	})
+	require.Nil(s.T(), err)
+
+	_, err = s.nativeStub.Services.IAM.Identity.AddPolicy(ctx, &identity.AddPolicyRequest{
+		IdentityNamespace: namespaceName,
+		IdentityUUID:      identityCreateResponse.Identity.Uuid,
+		PolicyNamespace:   namespaceName,
+		PolicyUUID:        policyCreateResponse.Policy.Uuid,
+	})
+	require.Nil(s.T(), err)
+
+	return namespaceName, identityCreateResponse.Identity.Uuid
+}
+
+func (s *APIKeyTestSuite) checkAccess(ctx context.Context, key string, scope *auth.Scope) auth.CheckAccessWithAPIKeyResponse_Status {
+	response, err := s.nativeStub.Services.IAM.Auth.CheckAccessWithAPIKey(ctx, &auth.CheckAccessWithAPIKeyRequest{
+		ApiKey: key,
+		Scopes: []*auth.Scope{scope},
+	})
+	require.Nil(s.T(), err)
+	return response.Status
+}
+
+func (s *APIKeyTestSuite) TestCreateAndCheckAccess() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	namespaceName, identityUUID := s.prepareIdentity(ctx)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	createResponse, err := s.nativeStub.Services.IAM.Authentication.APIKey.Create(ctx, &apikey.CreateRequest{
+		Namespace: namespaceName,
+		Identity:  identityUUID,
+		Name:      tools.GetRandomString(10),
+		Scopes:    []*apikey.Scope{{Namespace: namespaceName, Resources: []string{"test.read"}, Actions: []string{"test.get"}}},
+	})
+	require.Nil(s.T(), err)
+	require.NotEmpty(s.T(), createResponse.Key)
+
+	// Key must be usable only within its scopes
+	require.Equal(s.T(), auth.CheckAccessWithAPIKeyResponse_OK, s.checkAccess(ctx, createResponse.Key, &auth.Scope{Namespace: namespaceName, Resources: []string{"test.read"}, Actions: []string{"test.get"}}))
+	require.Equal(s.T(), auth.CheckAccessWithAPIKeyResponse_UNAUTHORIZED, s.checkAccess(ctx, createResponse.Key, &auth.Scope{Namespace: namespaceName, Resources: []string{"test.read"}, Actions: []string{"test.delete"}}))
+
+	// Scopes outside of the identity policies are rejected on creation
+	_, err = s.nativeStub.Services.IAM.Authentication.APIKey.Create(ctx, &apikey.CreateRequest{
+		Namespace: namespaceName,
+		Identity:  identityUUID,
+		Name:      tools.GetRandomString(10),
+		Scopes:    []*apikey.Scope{{Namespace: namespaceName, Resources: []string{"other"}, Actions: []string{"*"}}},
+	})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+
+	// Key can only be created for the existing identity
+	_, err = s.nativeStub.Services.IAM.Authentication.APIKey.Create(ctx, &apikey.CreateRequest{
+		Namespace: namespaceName,
+		Identity:  primitive.NewObjectID().Hex(),
+		Name:      tools.GetRandomString(10),
+		Scopes:    []*apikey.Scope{{Namespace: namespaceName, Resources: []string{"test.read"}, Actions: []string{"test.get"}}},
+	})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+
+	listResponse, err := s.nativeStub.Services.IAM.Authentication.APIKey.List(ctx, &apikey.ListRequest{Namespace: namespaceName, Identity: identityUUID})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), listResponse.ApiKeys, 1)
+	require.Equal(s.T(), createResponse.ApiKey.Uuid, listResponse.ApiKeys[0].Uuid)
+}
+
+func (s *APIKeyTestSuite) TestRotateAfterPolicyRemoved() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	namespaceName, identityUUID := s.prepareIdentity(ctx)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	createResponse, err := s.nativeStub.Services.IAM.Authentication.APIKey.Create(ctx, &apikey.CreateRequest{
+		Namespace: namespaceName,
+		Identity:  identityUUID,
+		Name:      tools.GetRandomString(10),
+		Scopes:    []*apikey.Scope{{Namespace: namespaceName, Resources: []string{"test.read"}, Actions: []string{"test.get"}}},
+	})
+	require.Nil(s.T(), err)
+
+	getResponse, err := s.nativeStub.Services.IAM.Identity.Get(ctx, &identity.GetIdentityRequest{Namespace: namespaceName, Uuid: identityUUID})
+	require.Nil(s.T(), err)
+	for _, policy := range getResponse.Identity.Policies {
+		_, err = s.nativeStub.Services.IAM.Identity.RemovePolicy(ctx, &identity.RemovePolicyRequest{
+			IdentityNamespace: namespaceName,
+			IdentityUUID:      identityUUID,
+			PolicyNamespace:   policy.Namespace,
+			PolicyUUID:        policy.Uuid,
+		})
+		require.Nil(s.T(), err)
+	}
+
+	_, err = s.nativeStub.Services.IAM.Authentication.APIKey.Rotate(ctx, &apikey.RotateRequest{Namespace: namespaceName, Uuid: createResponse.ApiKey.Uuid})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+}
+
+func (s *APIKeyTestSuite) TestRotateAndRevoke() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	namespaceName, identityUUID := s.prepareIdentity(ctx)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	scope := &auth.Scope{Namespace: namespaceName, Resources: []string{"test.read"}, Actions: []string{"test.get"}}
+	createResponse, err := s.nativeStub.Services.IAM.Authentication.APIKey.Create(ctx, &apikey.CreateRequest{
+		Namespace: namespaceName,
+		Identity:  identityUUID,
+		Name:      tools.GetRandomString(10),
+		Scopes:    []*apikey.Scope{{Namespace: namespaceName, Resources: scope.Resources, Actions: scope.Actions}},
+	})
+	require.Nil(s.T(), err)
+
+	rotateResponse, err := s.nativeStub.Services.IAM.Authentication.APIKey.Rotate(ctx, &apikey.RotateRequest{Namespace: namespaceName, Uuid: createResponse.ApiKey.Uuid})
+	require.Nil(s.T(), err)
+	require.NotEqual(s.T(), createResponse.Key, rotateResponse.Key)
+	require.Equal(s.T(), createResponse.ApiKey.Version+1, rotateResponse.ApiKey.Version)
+
+	require.Equal(s.T(), auth.CheckAccessWithAPIKeyResponse_KEY_INVALID, s.checkAccess(ctx, createResponse.Key, scope))
+	require.Equal(s.T(), auth.CheckAccessWithAPIKeyResponse_OK, s.checkAccess(ctx, rotateResponse.Key, scope))
+
+	revokeResponse, err := s.nativeStub.Services.IAM.Authentication.APIKey.Revoke(ctx, &apikey.RevokeRequest{Namespace: namespaceName, Uuid: createResponse.ApiKey.Uuid})
+	require.Nil(s.T(), err)
+	require.True(s.T(), revokeResponse.Revoked)
+
+	require.Equal(s.T(), auth.CheckAccessWithAPIKeyResponse_KEY_REVOKED, s.checkAccess(ctx, rotateResponse.Key, scope))
+
+	revokeResponse, err = s.nativeStub.Services.IAM.Authentication.APIKey.Revoke(ctx, &apikey.RevokeRequest{Namespace: namespaceName, Uuid: createResponse.ApiKey.Uuid})
+	require.Nil(s.T(), err)
+	require.False(s.T(), revokeResponse.Revoked)
+}
+
+func (s *APIKeyTestSuite) TestExpiredKey() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	namespaceName, identityUUID := s.prepareIdentity(ctx)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	createResponse, err := s.nativeStub.Services.IAM.Authentication.APIKey.Create(ctx, &apikey.CreateRequest{
+		Namespace: namespaceName,
+		Identity:  identityUUID,
+		Name:      tools.GetRandomString(10),
+		Scopes:    []*apikey.Scope{{Namespace: namespaceName, Resources: []string{"test.read"}, Actions: []string{"test.get"}}},
+		ExpiresAt: timestamppb.New(time.Now().Add(time.Second)),
+	})
+	require.Nil(s.T(), err)
+
+	time.Sleep(time.Second * 2)
+
+	authenticateResponse, err := s.nativeStub.Services.IAM.Authentication.APIKey.Authenticate(ctx, &apikey.AuthenticateRequest{Key: createResponse.Key})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), apikey.AuthenticateResponse_EXPIRED, authenticateResponse.Status)
+}
+
+func (s *APIKeyTestSuite) TestInvalidKey() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	authenticateResponse, err := s.nativeStub.Services.IAM.Authentication.APIKey.Authenticate(ctx, &apikey.AuthenticateRequest{Key: tools.GetRandomString(40)})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), apikey.AuthenticateResponse_INVALID, authenticateResponse.Status)
+}
@@ -0,0 +1,216 @@
+package oidc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oidc"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/system/testing/tools"
+)
+
+type ProviderTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *ProviderTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *ProviderTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestProviderTestSuite(t *testing.T) {
+	suite.Run(t, new(ProviderTestSuite))
+}
+
+func (s *ProviderTestSuite) createProvider(ctx context.Context) *oidc.OIDCProvider {
+	createResponse, err := s.nativeStub.Services.IAM.Authentication.OIDC.CreateProvider(ctx, &oidc.CreateProviderRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		Enabled:         true,
+		Issuer:          "https://keycloak.example.com/realms/" + tools.GetRandomString(10),
+		ClientId:        tools.GetRandomString(10),
+		ClientSecret:    tools.GetRandomString(32),
+		Scopes:          []string{"email", "profile"},
+		ClaimMapping:    &oidc.ClaimMapping{FullName: "displayName"},
+		JitProvisioning: false,
+	})
+	require.Nil(s.T(), err)
+	return createResponse.Provider
+}
+
+func (s *ProviderTestSuite) TestCreateGetDelete() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	provider := s.createProvider(ctx)
+	defer s.nativeStub.Services.IAM.Authentication.OIDC.DeleteProvider(context.Background(), &oidc.DeleteProviderRequest{Namespace: "", Uuid: provider.Uuid})
+
+	require.True(s.T(), provider.HasClientSecret)
+	require.Equal(s.T(), []string{"openid", "email", "profile"}, provider.Scopes)
+	require.Equal(s.T(), "displayName", provider.ClaimMapping.FullName)
+	require.Equal(s.T(), "sub", provider.ClaimMapping.Subject)
+	require.Equal(s.T(), "preferred_username", provider.ClaimMapping.Login)
+
+	getResponse, err := s.nativeStub.Services.IAM.Authentication.OIDC.GetProvider(ctx, &oidc.GetProviderRequest{Namespace: "", Uuid: provider.Uuid})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), provider.Issuer, getResponse.Provider.Issuer)
+	require.Equal(s.T(), provider.ClientId, getResponse.Provider.ClientId)
+
+	updateResponse, err := s.nativeStub.Services.IAM.Authentication.OIDC.UpdateProvider(ctx, &oidc.UpdateProviderRequest{
+		Namespace:       "",
+		Uuid:            provider.Uuid,
+		Name:            provider.Name,
+		Enabled:         false,
+		Issuer:          provider.Issuer,
+		ClientId:        provider.ClientId,
+		ClientSecret:    "",
+		Scopes:          []string{},
+		ClaimMapping:    provider.ClaimMapping,
+		JitProvisioning: true,
+		RoleAssignmentRules: []*oidc.RoleAssignmentRule{
+			{Group: "admins", RoleNamespace: "", Role: "000000000000000000000000"},
+		},
+	})
+	require.Nil(s.T(), err)
+	require.False(s.T(), updateResponse.Provider.Enabled)
+	require.True(s.T(), updateResponse.Provider.JitProvisioning)
+	require.True(s.T(), updateResponse.Provider.HasClientSecret)
+	require.Equal(s.T(), []string{"openid", "email", "profile"}, updateResponse.Provider.Scopes)
+	require.Len(s.T(), updateResponse.Provider.RoleAssignmentRules, 1)
+	require.Equal(s.T(), provider.Version+1, updateResponse.Provider.Version)
+
+	listResponse, err := s.nativeStub.Services.IAM.Authentication.OIDC.ListProviders(ctx, &oidc.ListProvidersRequest{Namespace: ""})
+	require.Nil(s.T(), err)
+	found := false
+	for _, listed := range listResponse.Providers {
+		if listed.Uuid == provider.Uuid {
+			found = true
+		}
+	}
+	require.True(s.T(), found)
+
+	deleteResponse, err := s.nativeStub.Services.IAM.Authentication.OIDC.DeleteProvider(ctx, &oidc.DeleteProviderRequest{Namespace: "", Uuid: provider.Uuid})
+	require.Nil(s.T(), err)
+	require.True(s.T(), deleteResponse.Existed)
+
+	_, err = s.nativeStub.Services.IAM.Authentication.OIDC.GetProvider(ctx, &oidc.GetProviderRequest{Namespace: "", Uuid: provider.Uuid})
+	require.NotNil(s.T(), err)
+	st, ok := status.FromError(err)
+	require.True(s.T(), ok)
+	require.Equal(s.T(), codes.NotFound, st.Code())
+}
+
+func (s *ProviderTestSuite) TestCreateWithInvalidSettings() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	_, err := s.nativeStub.Services.IAM.Authentication.OIDC.CreateProvider(ctx, &oidc.CreateProviderRequest{
+		Namespace: "",
+		Name:      tools.GetRandomString(20),
+		Enabled:   true,
+		Issuer:    "not an url",
+		ClientId:  tools.GetRandomString(10),
+	})
+	require.NotNil(s.T(), err)
+	st, ok := status.FromError(err)
+	require.True(s.T(), ok)
+	require.Equal(s.T(), codes.InvalidArgument, st.Code())
+
+	_, err = s.nativeStub.Services.IAM.Authentication.OIDC.CreateProvider(ctx, &oidc.CreateProviderRequest{
+		Namespace: "",
+		Name:      tools.GetRandomString(20),
+		Enabled:   true,
+		Issuer:    "https://login.microsoftonline.com/tenant/v2.0",
+		ClientId:  tools.GetRandomString(10),
+		RoleAssignmentRules: []*oidc.RoleAssignmentRule{
+			{Group: "admins", RoleNamespace: "", Role: "bad"},
+		},
+	})
+	require.NotNil(s.T(), err)
+	st, ok = status.FromError(err)
+	require.True(s.T(), ok)
+	require.Equal(s.T(), codes.InvalidArgument, st.Code())
+}
+
+func (s *ProviderTestSuite) TestAuthenticateWithUnknownState() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	provider := s.createProvider(ctx)
+	defer s.nativeStub.Services.IAM.Authentication.OIDC.DeleteProvider(context.Background(), &oidc.DeleteProviderRequest{Namespace: "", Uuid: provider.Uuid})
+
+	authenticateResponse, err := s.nativeStub.Services.IAM.Authentication.OIDC.Authenticate(ctx, &oidc.AuthenticateRequest{
+		Namespace: "",
+		Provider:  provider.Uuid,
+		Code:      tools.GetRandomString(20),
+		State:     tools.GetRandomString(20),
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), oidc.AuthenticateResponse_STATE_INVALID, authenticateResponse.Status)
+
+	tokenResponse, err := s.nativeStub.Services.IAM.Auth.CreateTokenWithOIDC(ctx, &auth.CreateTokenWithOIDCRequest{
+		Namespace: "",
+		Provider:  provider.Uuid,
+		Code:      tools.GetRandomString(20),
+		State:     tools.GetRandomString(20),
+		Metadata:  "",
+		Scopes:    []*auth.Scope{},
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithOIDCResponse_UNAUTHENTICATED, tokenResponse.Status)
+}
+
+func (s *ProviderTestSuite) TestLinkAndUnlinkIdentity() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	provider := s.createProvider(ctx)
+	defer s.nativeStub.Services.IAM.Authentication.OIDC.DeleteProvider(context.Background(), &oidc.DeleteProviderRequest{Namespace: "", Uuid: provider.Uuid})
+
+	createIdentityResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: createIdentityResponse.Identity.Uuid})
+
+	subject := tools.GetRandomString(20)
+	linkResponse, err := s.nativeStub.Services.IAM.Authentication.OIDC.LinkIdentity(ctx, &oidc.LinkIdentityRequest{
+		Namespace: "",
+		Provider:  provider.Uuid,
+		Subject:   subject,
+		Identity:  createIdentityResponse.Identity.Uuid,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), oidc.LinkIdentityResponse_OK, linkResponse.Status)
+
+	linkResponse, err = s.nativeStub.Services.IAM.Authentication.OIDC.LinkIdentity(ctx, &oidc.LinkIdentityRequest{
+		Namespace: "",
+		Provider:  provider.Uuid,
+		Subject:   subject,
+		Identity:  createIdentityResponse.Identity.Uuid,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), oidc.LinkIdentityResponse_ALREADY_LINKED, linkResponse.Status)
+
+	unlinkResponse, err := s.nativeStub.Services.IAM.Authentication.OIDC.UnlinkIdentity(ctx, &oidc.UnlinkIdentityRequest{Namespace: "", Provider: provider.Uuid, Subject: subject})
+	require.Nil(s.T(), err)
+	require.True(s.T(), unlinkResponse.Existed)
+}
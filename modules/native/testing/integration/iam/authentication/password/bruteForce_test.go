@@ -0,0 +1,136 @@
+package password
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/password"
+	config "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/config"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/system/testing/tools"
+)
+
+type BruteForceTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *BruteForceTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *BruteForceTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestBruteForceTestSuite(t *testing.T) {
+	suite.Run(t, new(BruteForceTestSuite))
+}
+
+func (s *BruteForceTestSuite) TestIdentityLockout() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	// Configuration is global. Restore it after the test
+	configResponse, err := s.nativeStub.Services.IAM.Config.Get(ctx, &config.GetConfigRequest{UseCache: false})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Config.Set(context.Background(), &config.SetConfigRequest{Configuration: configResponse.Configuration})
+
+	newConfig := proto.Clone(configResponse.Configuration).(*config.Configuration)
+	newConfig.BruteForceProtection = &config.Configuration_BruteForceProtection{
+		MaxIdentityFailedAttempts: 3,
+		LockoutDuration:           60,
+		MaxDelay:                  1,
+	}
+	_, err = s.nativeStub.Services.IAM.Config.Set(ctx, &config.SetConfigRequest{Configuration: newConfig})
+	require.Nil(s.T(), err)
+
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	identityUUID := identityCreateResponse.Identity.Uuid
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityUUID})
+	defer s.nativeStub.Services.IAM.Authentication.Password.Delete(context.Background(), &password.DeleteRequest{Namespace: "", Identity: identityUUID})
+	defer s.nativeStub.Services.IAM.Authentication.Password.ClearLockout(context.Background(), &password.ClearLockoutRequest{Type: password.Lockout_IDENTITY, Namespace: "", Identity: identityUUID})
+
+	pwd := "bruteForceTestPassword1"
+	_, err = s.nativeStub.Services.IAM.Authentication.Password.CreateOrUpdate(ctx, &password.CreateOrUpdateRequest{
+		Namespace: "",
+		Identity:  identityUUID,
+		Password:  pwd,
+	})
+	require.Nil(s.T(), err)
+
+	authenticate := func(pwd string) *password.AuthenticateResponse {
+		authResponse, err := s.nativeStub.Services.IAM.Authentication.Password.Authenticate(ctx, &password.AuthenticateRequest{
+			Namespace: "",
+			Identity:  identityUUID,
+			Password:  pwd,
+		})
+		require.Nil(s.T(), err)
+		return authResponse
+	}
+
+	s.Run("Failed attempt delays the next one", func() {
+		authResponse := authenticate(tools.GetRandomString(20))
+		require.False(s.T(), authResponse.Authenticated)
+		require.False(s.T(), authResponse.Throttled)
+
+		authResponse = authenticate(pwd)
+		require.False(s.T(), authResponse.Authenticated)
+		require.True(s.T(), authResponse.Throttled)
+		require.NotZero(s.T(), authResponse.RetryAfter)
+	})
+
+	s.Run("Identity is locked after too many failed attempts", func() {
+		for i := 0; i < 2; i++ {
+			time.Sleep(time.Millisecond * 1100)
+			authResponse := authenticate(tools.GetRandomString(20))
+			require.False(s.T(), authResponse.Authenticated)
+			require.False(s.T(), authResponse.Throttled)
+		}
+
+		time.Sleep(time.Millisecond * 1100)
+		authResponse := authenticate(pwd)
+		require.False(s.T(), authResponse.Authenticated)
+		require.True(s.T(), authResponse.Throttled)
+		require.Greater(s.T(), authResponse.RetryAfter, uint32(1))
+
+		listResponse, err := s.nativeStub.Services.IAM.Authentication.Password.ListLockouts(ctx, &password.ListLockoutsRequest{})
+		require.Nil(s.T(), err)
+		found := false
+		for _, lockout := range listResponse.Lockouts {
+			if lockout.Type == password.Lockout_IDENTITY && lockout.Namespace == "" && lockout.Identity == identityUUID {
+				found = true
+				require.Equal(s.T(), uint32(3), lockout.FailedAttempts)
+			}
+		}
+		require.True(s.T(), found)
+	})
+
+	s.Run("Cleared lockout allows authentication", func() {
+		clearResponse, err := s.nativeStub.Services.IAM.Authentication.Password.ClearLockout(ctx, &password.ClearLockoutRequest{
+			Type:      password.Lockout_IDENTITY,
+			Namespace: "",
+			Identity:  identityUUID,
+		})
+		require.Nil(s.T(), err)
+		require.True(s.T(), clearResponse.Existed)
+
+		authResponse := authenticate(pwd)
+		require.True(s.T(), authResponse.Authenticated)
+	})
+}
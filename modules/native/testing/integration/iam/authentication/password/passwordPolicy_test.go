@@ -0,0 +1,129 @@
+package password
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/password"
+	config "github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/config"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/system/testing/tools"
+)
+
+type PasswordPolicyTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *PasswordPolicyTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *PasswordPolicyTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestPasswordPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(PasswordPolicyTestSuite))
+}
+
+func (s *PasswordPolicyTestSuite) requireInvalidArgument(err error) {
+	require.NotNil(s.T(), err)
+	st, ok := status.FromError(err)
+	require.True(s.T(), ok)
+	require.Equal(s.T(), codes.InvalidArgument, st.Code())
+}
+
+func (s *PasswordPolicyTestSuite) TestPolicyEnforcement() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	// Configuration is global. Restore it after the test
+	configResponse, err := s.nativeStub.Services.IAM.Config.Get(ctx, &config.GetConfigRequest{UseCache: false})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Config.Set(context.Background(), &config.SetConfigRequest{Configuration: configResponse.Configuration})
+
+	newConfig := proto.Clone(configResponse.Configuration).(*config.Configuration)
+	newConfig.PasswordAuth = &config.Configuration_PasswordAuth{
+		Enabled:           true,
+		MinPasswordLength: 10,
+		Digits:            true,
+		PasswordHistory:   2,
+	}
+	_, err = s.nativeStub.Services.IAM.Config.Set(ctx, &config.SetConfigRequest{Configuration: newConfig})
+	require.Nil(s.T(), err)
+
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	identityUUID := identityCreateResponse.Identity.Uuid
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityUUID})
+	defer s.nativeStub.Services.IAM.Authentication.Password.Delete(context.Background(), &password.DeleteRequest{Namespace: "", Identity: identityUUID})
+
+	setPassword := func(pwd string, requireChange bool) error {
+		_, err := s.nativeStub.Services.IAM.Authentication.Password.CreateOrUpdate(ctx, &password.CreateOrUpdateRequest{
+			Namespace:     "",
+			Identity:      identityUUID,
+			Password:      pwd,
+			RequireChange: requireChange,
+		})
+		return err
+	}
+
+	s.Run("Weak passwords are rejected", func() {
+		s.requireInvalidArgument(setPassword("a", false))
+		s.requireInvalidArgument(setPassword("1234a", false))
+		s.requireInvalidArgument(setPassword("passwordWithoutDigits", false))
+	})
+
+	pwd1 := tools.GetRandomString(20) + "1"
+	pwd2 := tools.GetRandomString(20) + "2"
+	pwd3 := tools.GetRandomString(20) + "3"
+
+	s.Run("Recent passwords can not be reused", func() {
+		require.Nil(s.T(), setPassword(pwd1, false))
+		require.Nil(s.T(), setPassword(pwd2, false))
+		s.requireInvalidArgument(setPassword(pwd2, false))
+		s.requireInvalidArgument(setPassword(pwd1, false))
+		require.Nil(s.T(), setPassword(pwd3, false))
+		// History has only 2 entries. The oldest password can be used again
+		require.Nil(s.T(), setPassword(pwd1, false))
+	})
+
+	s.Run("Forced password change is reported", func() {
+		require.Nil(s.T(), setPassword(pwd2, true))
+		authResponse, err := s.nativeStub.Services.IAM.Authentication.Password.Authenticate(ctx, &password.AuthenticateRequest{
+			Namespace: "",
+			Identity:  identityUUID,
+			Password:  pwd2,
+		})
+		require.Nil(s.T(), err)
+		require.True(s.T(), authResponse.Authenticated)
+		require.True(s.T(), authResponse.ChangeRequired)
+
+		require.Nil(s.T(), setPassword(pwd3, false))
+		authResponse, err = s.nativeStub.Services.IAM.Authentication.Password.Authenticate(ctx, &password.AuthenticateRequest{
+			Namespace: "",
+			Identity:  identityUUID,
+			Password:  pwd3,
+		})
+		require.Nil(s.T(), err)
+		require.True(s.T(), authResponse.Authenticated)
+		require.False(s.T(), authResponse.ChangeRequired)
+	})
+}
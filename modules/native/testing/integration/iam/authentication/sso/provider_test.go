@@ -0,0 +1,179 @@
+package sso
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/sso"
+	"github.com/slamy-solutions/openbp/modules/system/testing/tools"
+)
+
+type ProviderTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *ProviderTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *ProviderTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestProviderTestSuite(t *testing.T) {
+	suite.Run(t, new(ProviderTestSuite))
+}
+
+func (s *ProviderTestSuite) createProvider(ctx context.Context) (*sso.SAMLProvider, []byte) {
+	metadata, certificate, err := generateIdPMetadata("https://idp.example.com/"+tools.GetRandomString(10), "https://idp.example.com/sso")
+	require.Nil(s.T(), err)
+
+	createResponse, err := s.nativeStub.Services.IAM.Authentication.SSO.CreateProvider(ctx, &sso.CreateProviderRequest{
+		Namespace:                   "",
+		Name:                        tools.GetRandomString(20),
+		Enabled:                     true,
+		IdpMetadata:                 metadata,
+		SpEntityId:                  "https://sp.example.com/" + tools.GetRandomString(10),
+		AssertionConsumerServiceURL: "https://sp.example.com/acs",
+		AttributeMapping:            &sso.AttributeMapping{NameAttribute: "displayName"},
+		JitProvisioning:             false,
+	})
+	require.Nil(s.T(), err)
+	return createResponse.Provider, certificate
+}
+
+func (s *ProviderTestSuite) TestCreateGetDelete() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	provider, certificate := s.createProvider(ctx)
+	defer s.nativeStub.Services.IAM.Authentication.SSO.DeleteProvider(context.Background(), &sso.DeleteProviderRequest{Namespace: "", Uuid: provider.Uuid})
+
+	require.Equal(s.T(), "https://idp.example.com/sso", provider.IdpSSOURL)
+	require.Len(s.T(), provider.IdpCertificates, 1)
+	require.Equal(s.T(), certificate, provider.IdpCertificates[0])
+
+	getResponse, err := s.nativeStub.Services.IAM.Authentication.SSO.GetProvider(ctx, &sso.GetProviderRequest{Namespace: "", Uuid: provider.Uuid})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), provider.IdpEntityId, getResponse.Provider.IdpEntityId)
+	require.Equal(s.T(), "displayName", getResponse.Provider.AttributeMapping.NameAttribute)
+
+	updateResponse, err := s.nativeStub.Services.IAM.Authentication.SSO.UpdateProvider(ctx, &sso.UpdateProviderRequest{
+		Namespace:                   "",
+		Uuid:                        provider.Uuid,
+		Name:                        provider.Name,
+		Enabled:                     false,
+		SpEntityId:                  provider.SpEntityId,
+		AssertionConsumerServiceURL: provider.AssertionConsumerServiceURL,
+		AttributeMapping:            provider.AttributeMapping,
+		JitProvisioning:             true,
+	})
+	require.Nil(s.T(), err)
+	require.False(s.T(), updateResponse.Provider.Enabled)
+	require.True(s.T(), updateResponse.Provider.JitProvisioning)
+	require.Equal(s.T(), provider.IdpEntityId, updateResponse.Provider.IdpEntityId)
+	require.Equal(s.T(), provider.Version+1, updateResponse.Provider.Version)
+
+	deleteResponse, err := s.nativeStub.Services.IAM.Authentication.SSO.DeleteProvider(ctx, &sso.DeleteProviderRequest{Namespace: "", Uuid: provider.Uuid})
+	require.Nil(s.T(), err)
+	require.True(s.T(), deleteResponse.Existed)
+
+	_, err = s.nativeStub.Services.IAM.Authentication.SSO.GetProvider(ctx, &sso.GetProviderRequest{Namespace: "", Uuid: provider.Uuid})
+	require.NotNil(s.T(), err)
+	st, ok := status.FromError(err)
+	require.True(s.T(), ok)
+	require.Equal(s.T(), codes.NotFound, st.Code())
+}
+
+func (s *ProviderTestSuite) TestCreateWithInvalidMetadata() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	_, err := s.nativeStub.Services.IAM.Authentication.SSO.CreateProvider(ctx, &sso.CreateProviderRequest{
+		Namespace:                   "",
+		Name:                        tools.GetRandomString(20),
+		Enabled:                     true,
+		IdpMetadata:                 []byte("<notMetadata/>"),
+		SpEntityId:                  "https://sp.example.com",
+		AssertionConsumerServiceURL: "https://sp.example.com/acs",
+	})
+	require.NotNil(s.T(), err)
+	st, ok := status.FromError(err)
+	require.True(s.T(), ok)
+	require.Equal(s.T(), codes.InvalidArgument, st.Code())
+}
+
+func (s *ProviderTestSuite) TestServiceProviderMetadata() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	provider, _ := s.createProvider(ctx)
+	defer s.nativeStub.Services.IAM.Authentication.SSO.DeleteProvider(context.Background(), &sso.DeleteProviderRequest{Namespace: "", Uuid: provider.Uuid})
+
+	metadataResponse, err := s.nativeStub.Services.IAM.Authentication.SSO.GetServiceProviderMetadata(ctx, &sso.GetServiceProviderMetadataRequest{Namespace: "", Uuid: provider.Uuid})
+	require.Nil(s.T(), err)
+	require.Contains(s.T(), string(metadataResponse.Metadata), provider.SpEntityId)
+	require.Contains(s.T(), string(metadataResponse.Metadata), "X509Certificate")
+}
+
+func (s *ProviderTestSuite) TestStartAuthentication() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	provider, _ := s.createProvider(ctx)
+	defer s.nativeStub.Services.IAM.Authentication.SSO.DeleteProvider(context.Background(), &sso.DeleteProviderRequest{Namespace: "", Uuid: provider.Uuid})
+
+	startResponse, err := s.nativeStub.Services.IAM.Authentication.SSO.StartAuthentication(ctx, &sso.StartAuthenticationRequest{
+		Namespace:  "",
+		Provider:   provider.Uuid,
+		RelayState: "state",
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), sso.StartAuthenticationResponse_OK, startResponse.Status)
+	require.True(s.T(), strings.HasPrefix(startResponse.RedirectURL, provider.IdpSSOURL+"?"))
+
+	redirectURL, err := url.Parse(startResponse.RedirectURL)
+	require.Nil(s.T(), err)
+	require.NotEmpty(s.T(), redirectURL.Query().Get("SAMLRequest"))
+	require.NotEmpty(s.T(), redirectURL.Query().Get("Signature"))
+	require.Equal(s.T(), "state", redirectURL.Query().Get("RelayState"))
+
+	notFoundResponse, err := s.nativeStub.Services.IAM.Authentication.SSO.StartAuthentication(ctx, &sso.StartAuthenticationRequest{
+		Namespace: "",
+		Provider:  "000000000000000000000000",
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), sso.StartAuthenticationResponse_PROVIDER_NOT_FOUND, notFoundResponse.Status)
+}
+
+func (s *ProviderTestSuite) TestCreateTokenWithInvalidResponse() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	provider, _ := s.createProvider(ctx)
+	defer s.nativeStub.Services.IAM.Authentication.SSO.DeleteProvider(context.Background(), &sso.DeleteProviderRequest{Namespace: "", Uuid: provider.Uuid})
+
+	tokenResponse, err := s.nativeStub.Services.IAM.Auth.CreateTokenWithSSO(ctx, &auth.CreateTokenWithSSORequest{
+		Namespace:    "",
+		Provider:     provider.Uuid,
+		SamlResponse: "PHNhbWxwOlJlc3BvbnNlLz4=",
+		Metadata:     "",
+		Scopes:       []*auth.Scope{},
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), auth.CreateTokenWithSSOResponse_UNAUTHENTICATED, tokenResponse.Status)
+}
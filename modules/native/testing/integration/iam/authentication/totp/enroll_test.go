@@ -0,0 +1,136 @@
+package totp
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/totp"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type EnrollTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *EnrollTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *EnrollTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestEnrollTestSuite(t *testing.T) {
+	suite.Run(t, new(EnrollTestSuite))
+}
+
+func (s *EnrollTestSuite) TestEnrollAndConfirm() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{
+		Namespace: "",
+		Uuid:      identityCreateResponse.Identity.Uuid,
+	})
+	defer s.nativeStub.Services.IAM.Authentication.TOTP.Disable(context.Background(), &totp.DisableRequest{
+		Namespace: "",
+		Identity:  identityCreateResponse.Identity.Uuid,
+	})
+
+	enrollResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.Enroll(ctx, &totp.EnrollRequest{
+		Namespace:   "",
+		Identity:    identityCreateResponse.Identity.Uuid,
+		AccountName: "tester",
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.EnrollResponse_OK, enrollResponse.Status)
+	require.NotEmpty(s.T(), enrollResponse.Secret)
+	require.True(s.T(), strings.HasPrefix(enrollResponse.Uri, "otpauth://totp/"))
+
+	statusResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.GetStatus(ctx, &totp.GetStatusRequest{
+		Namespace: "",
+		Identity:  identityCreateResponse.Identity.Uuid,
+	})
+	require.Nil(s.T(), err)
+	require.False(s.T(), statusResponse.Enabled)
+	require.True(s.T(), statusResponse.PendingConfirmation)
+
+	confirmResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.ConfirmEnrollment(ctx, &totp.ConfirmEnrollmentRequest{
+		Namespace: "",
+		Identity:  identityCreateResponse.Identity.Uuid,
+		Code:      tools.GenerateTOTPCode(enrollResponse.Secret, 10),
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.ConfirmEnrollmentResponse_CODE_INVALID, confirmResponse.Status)
+
+	confirmResponse, err = s.nativeStub.Services.IAM.Authentication.TOTP.ConfirmEnrollment(ctx, &totp.ConfirmEnrollmentRequest{
+		Namespace: "",
+		Identity:  identityCreateResponse.Identity.Uuid,
+		Code:      tools.GenerateTOTPCode(enrollResponse.Secret, 0),
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.ConfirmEnrollmentResponse_OK, confirmResponse.Status)
+	require.NotEmpty(s.T(), confirmResponse.RecoveryCodes)
+
+	statusResponse, err = s.nativeStub.Services.IAM.Authentication.TOTP.GetStatus(ctx, &totp.GetStatusRequest{
+		Namespace: "",
+		Identity:  identityCreateResponse.Identity.Uuid,
+	})
+	require.Nil(s.T(), err)
+	require.True(s.T(), statusResponse.Enabled)
+	require.Equal(s.T(), uint32(len(confirmResponse.RecoveryCodes)), statusResponse.RecoveryCodesLeft)
+
+	// Confirmed TOTP cant be overridden by new enrollment
+	enrollResponse, err = s.nativeStub.Services.IAM.Authentication.TOTP.Enroll(ctx, &totp.EnrollRequest{
+		Namespace: "",
+		Identity:  identityCreateResponse.Identity.Uuid,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.EnrollResponse_ALREADY_ENABLED, enrollResponse.Status)
+}
+
+func (s *EnrollTestSuite) TestConfirmNotEnrolled() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	confirmResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.ConfirmEnrollment(ctx, &totp.ConfirmEnrollmentRequest{
+		Namespace: "",
+		Identity:  tools.GetRandomString(20),
+		Code:      "123456",
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.ConfirmEnrollmentResponse_NOT_ENROLLED, confirmResponse.Status)
+}
+
+func (s *EnrollTestSuite) TestEnrollNotExistingIdentity() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	_, err := s.nativeStub.Services.IAM.Authentication.TOTP.Enroll(ctx, &totp.EnrollRequest{
+		Namespace: "",
+		Identity:  primitive.NewObjectID().Hex(),
+	})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,100 @@
+package totp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/totp"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type VerifyTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *VerifyTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *VerifyTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestVerifyTestSuite(t *testing.T) {
+	suite.Run(t, new(VerifyTestSuite))
+}
+
+func (s *VerifyTestSuite) TestVerifyCodeAndRecoveryCode() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	identityUUID := identityCreateResponse.Identity.Uuid
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityUUID})
+	defer s.nativeStub.Services.IAM.Authentication.TOTP.Disable(context.Background(), &totp.DisableRequest{Namespace: "", Identity: identityUUID})
+
+	// Not enabled yet
+	verifyResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.Verify(ctx, &totp.VerifyRequest{Namespace: "", Identity: identityUUID, Code: "123456"})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.VerifyResponse_NOT_ENABLED, verifyResponse.Status)
+
+	enrollResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.Enroll(ctx, &totp.EnrollRequest{Namespace: "", Identity: identityUUID})
+	require.Nil(s.T(), err)
+	confirmResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.ConfirmEnrollment(ctx, &totp.ConfirmEnrollmentRequest{
+		Namespace: "",
+		Identity:  identityUUID,
+		Code:      tools.GenerateTOTPCode(enrollResponse.Secret, 0),
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.ConfirmEnrollmentResponse_OK, confirmResponse.Status)
+
+	// Code from the current step was already used for confirmation. Next step is accepted because of the allowed clock drift.
+	verifyResponse, err = s.nativeStub.Services.IAM.Authentication.TOTP.Verify(ctx, &totp.VerifyRequest{Namespace: "", Identity: identityUUID, Code: tools.GenerateTOTPCode(enrollResponse.Secret, 0)})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.VerifyResponse_CODE_INVALID, verifyResponse.Status)
+
+	verifyResponse, err = s.nativeStub.Services.IAM.Authentication.TOTP.Verify(ctx, &totp.VerifyRequest{Namespace: "", Identity: identityUUID, Code: tools.GenerateTOTPCode(enrollResponse.Secret, 1)})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.VerifyResponse_OK, verifyResponse.Status)
+	require.False(s.T(), verifyResponse.RecoveryCodeUsed)
+
+	// Recovery code can be used only once
+	recoveryCode := confirmResponse.RecoveryCodes[0]
+	verifyResponse, err = s.nativeStub.Services.IAM.Authentication.TOTP.Verify(ctx, &totp.VerifyRequest{Namespace: "", Identity: identityUUID, Code: recoveryCode})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.VerifyResponse_OK, verifyResponse.Status)
+	require.True(s.T(), verifyResponse.RecoveryCodeUsed)
+
+	verifyResponse, err = s.nativeStub.Services.IAM.Authentication.TOTP.Verify(ctx, &totp.VerifyRequest{Namespace: "", Identity: identityUUID, Code: recoveryCode})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.VerifyResponse_CODE_INVALID, verifyResponse.Status)
+
+	// Regenerated codes replace old ones
+	regenerateResponse, err := s.nativeStub.Services.IAM.Authentication.TOTP.RegenerateRecoveryCodes(ctx, &totp.RegenerateRecoveryCodesRequest{Namespace: "", Identity: identityUUID})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.RegenerateRecoveryCodesResponse_OK, regenerateResponse.Status)
+
+	verifyResponse, err = s.nativeStub.Services.IAM.Authentication.TOTP.Verify(ctx, &totp.VerifyRequest{Namespace: "", Identity: identityUUID, Code: confirmResponse.RecoveryCodes[1]})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.VerifyResponse_CODE_INVALID, verifyResponse.Status)
+
+	verifyResponse, err = s.nativeStub.Services.IAM.Authentication.TOTP.Verify(ctx, &totp.VerifyRequest{Namespace: "", Identity: identityUUID, Code: regenerateResponse.RecoveryCodes[0]})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), totp.VerifyResponse_OK, verifyResponse.Status)
+}
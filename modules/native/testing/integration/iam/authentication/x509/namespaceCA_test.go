@@ -0,0 +1,168 @@
+package x509
+
+import (
+	"context"
+	rand "crypto/rand"
+	"crypto/rsa"
+	cryptoX509 "crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/x509"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/tools/testing/tools"
+)
+
+type NamespaceCATestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *NamespaceCATestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithIAMService().WithNamespaceService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *NamespaceCATestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestNamespaceCATestSuite(t *testing.T) {
+	suite.Run(t, new(NamespaceCATestSuite))
+}
+
+func (s *NamespaceCATestSuite) createNamespace(ctx context.Context) string {
+	namespaceName := tools.GetRandomString(32)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        namespaceName,
+		FullName:    tools.GetRandomString(32),
+		Description: tools.GetRandomString(32),
+	})
+	require.Nil(s.T(), err)
+	return namespaceName
+}
+
+// Creates identity with certificate in the namespace. Returns raw X509 certificate.
+func (s *NamespaceCATestSuite) createCertificate(ctx context.Context, namespaceName string) []byte {
+	createIdentityResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       namespaceName,
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(s.T(), err)
+
+	response, err := s.nativeStub.Services.IAM.Authentication.X509.RegisterAndGenerate(ctx, &x509.RegisterAndGenerateRequest{
+		Namespace: namespaceName,
+		Identity:  createIdentityResponse.Identity.Uuid,
+		PublicKey: cryptoX509.MarshalPKCS1PublicKey(&privateKey.PublicKey),
+	})
+	require.Nil(s.T(), err)
+	return response.Raw
+}
+
+func (s *NamespaceCATestSuite) getChain(ctx context.Context, namespaceName string) (*cryptoX509.Certificate, *cryptoX509.Certificate) {
+	chainResponse, err := s.nativeStub.Services.IAM.Authentication.X509.GetNamespaceCAChain(ctx, &x509.GetNamespaceCAChainRequest{Namespace: namespaceName})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), chainResponse.Chain, 2)
+
+	intermediate, err := cryptoX509.ParseCertificate(chainResponse.Chain[0])
+	require.Nil(s.T(), err)
+	root, err := cryptoX509.ParseCertificate(chainResponse.Chain[1])
+	require.Nil(s.T(), err)
+	return intermediate, root
+}
+
+func (s *NamespaceCATestSuite) TestCertificateIsSignedByNamespaceCA() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	namespaceName := s.createNamespace(ctx)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	raw := s.createCertificate(ctx, namespaceName)
+	certificate, err := cryptoX509.ParseCertificate(raw)
+	require.Nil(s.T(), err)
+
+	intermediate, root := s.getChain(ctx, namespaceName)
+	require.True(s.T(), intermediate.IsCA)
+	require.Equal(s.T(), []string{namespaceName}, intermediate.Subject.Organization)
+
+	rootResponse, err := s.nativeStub.Services.IAM.Authentication.X509.GetRootCAInfo(ctx, &x509.GetRootCAInfoRequest{})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), rootResponse.Certificate, root.Raw)
+
+	roots := cryptoX509.NewCertPool()
+	roots.AddCert(root)
+	intermediates := cryptoX509.NewCertPool()
+	intermediates.AddCert(intermediate)
+	_, err = certificate.Verify(cryptoX509.VerifyOptions{Roots: roots, Intermediates: intermediates, KeyUsages: []cryptoX509.ExtKeyUsage{cryptoX509.ExtKeyUsageClientAuth}})
+	require.Nil(s.T(), err)
+	require.Nil(s.T(), certificate.CheckSignatureFrom(intermediate))
+}
+
+func (s *NamespaceCATestSuite) TestNamespacesHaveDifferentCAs() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	firstNamespace := s.createNamespace(ctx)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: firstNamespace})
+	secondNamespace := s.createNamespace(ctx)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: secondNamespace})
+
+	raw := s.createCertificate(ctx, firstNamespace)
+	certificate, err := cryptoX509.ParseCertificate(raw)
+	require.Nil(s.T(), err)
+
+	firstIntermediate, _ := s.getChain(ctx, firstNamespace)
+	secondIntermediate, _ := s.getChain(ctx, secondNamespace)
+	require.NotEqual(s.T(), firstIntermediate.Raw, secondIntermediate.Raw)
+	require.NotNil(s.T(), certificate.CheckSignatureFrom(secondIntermediate))
+}
+
+func (s *NamespaceCATestSuite) TestValidateChecksNamespace() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	firstNamespace := s.createNamespace(ctx)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: firstNamespace})
+	secondNamespace := s.createNamespace(ctx)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: secondNamespace})
+
+	raw := s.createCertificate(ctx, firstNamespace)
+
+	validateResponse, err := s.nativeStub.Services.IAM.Authentication.X509.ValidateAndGetFromRawX509(ctx, &x509.ValidateAndGetFromRawX509Request{
+		Raw:             raw,
+		Namespace:       firstNamespace,
+		VerifyNamespace: true,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), x509.ValidateAndGetFromRawX509Response_OK, validateResponse.Status)
+	require.Equal(s.T(), firstNamespace, validateResponse.Certificate.Namespace)
+
+	validateResponse, err = s.nativeStub.Services.IAM.Authentication.X509.ValidateAndGetFromRawX509(ctx, &x509.ValidateAndGetFromRawX509Request{
+		Raw:             raw,
+		Namespace:       secondNamespace,
+		VerifyNamespace: true,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), x509.ValidateAndGetFromRawX509Response_NAMESPACE_MISMATCH, validateResponse.Status)
+}
+
+func (s *NamespaceCATestSuite) TestGetChainForNotExistingNamespace() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	_, err := s.nativeStub.Services.IAM.Authentication.X509.GetNamespaceCAChain(ctx, &x509.GetNamespaceCAChainRequest{Namespace: tools.GetRandomString(32)})
+	require.NotNil(s.T(), err)
+}
@@ -0,0 +1,181 @@
+package x509
+
+import (
+	"context"
+	"crypto"
+	rand "crypto/rand"
+	"crypto/rsa"
+	cryptoX509 "crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/ocsp"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/x509"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/tools/testing/tools"
+)
+
+type RevocationTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *RevocationTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *RevocationTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestRevocationTestSuite(t *testing.T) {
+	suite.Run(t, new(RevocationTestSuite))
+}
+
+func (s *RevocationTestSuite) createCertificate(ctx context.Context) (*identity.Identity, *x509.RegisterAndGenerateResponse) {
+	createIdentityResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(s.T(), err)
+
+	response, err := s.nativeStub.Services.IAM.Authentication.X509.RegisterAndGenerate(ctx, &x509.RegisterAndGenerateRequest{
+		Namespace: "",
+		Identity:  createIdentityResponse.Identity.Uuid,
+		PublicKey: cryptoX509.MarshalPKCS1PublicKey(&privateKey.PublicKey),
+	})
+	require.Nil(s.T(), err)
+
+	return createIdentityResponse.Identity, response
+}
+
+// Certificates are issued by the intermediate CA of the namespace
+func (s *RevocationTestSuite) getCA(ctx context.Context) *cryptoX509.Certificate {
+	chainResponse, err := s.nativeStub.Services.IAM.Authentication.X509.GetNamespaceCAChain(ctx, &x509.GetNamespaceCAChainRequest{Namespace: ""})
+	require.Nil(s.T(), err)
+	ca, err := cryptoX509.ParseCertificate(chainResponse.Chain[0])
+	require.Nil(s.T(), err)
+	return ca
+}
+
+func (s *RevocationTestSuite) checkOCSP(ctx context.Context, certificate *cryptoX509.Certificate, ca *cryptoX509.Certificate) *ocsp.Response {
+	request, err := ocsp.CreateRequest(certificate, ca, &ocsp.RequestOptions{Hash: crypto.SHA256})
+	require.Nil(s.T(), err)
+
+	ocspResponse, err := s.nativeStub.Services.IAM.Authentication.X509.OCSP(ctx, &x509.OCSPRequest{Request: request})
+	require.Nil(s.T(), err)
+
+	response, err := ocsp.ParseResponseForCert(ocspResponse.Response, certificate, ca)
+	require.Nil(s.T(), err)
+	return response
+}
+
+func (s *RevocationTestSuite) TestCertificateHasExpirationAndRevocationInfo() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	createdIdentity, response := s.createCertificate(ctx)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: createdIdentity.Uuid})
+	defer s.nativeStub.Services.IAM.Authentication.X509.Delete(context.Background(), &x509.DeleteRequest{Namespace: "", Uuid: response.Info.Uuid})
+
+	certificate, err := cryptoX509.ParseCertificate(response.Raw)
+	require.Nil(s.T(), err)
+
+	require.NotNil(s.T(), response.Info.ExpiresAt)
+	require.Equal(s.T(), certificate.NotAfter.Unix(), response.Info.ExpiresAt.AsTime().Unix())
+	require.True(s.T(), certificate.NotAfter.After(time.Now()))
+}
+
+func (s *RevocationTestSuite) TestRegenerateReturnsSameCertificateIfNotExpiringSoon() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	createdIdentity, response := s.createCertificate(ctx)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: createdIdentity.Uuid})
+	defer s.nativeStub.Services.IAM.Authentication.X509.Delete(context.Background(), &x509.DeleteRequest{Namespace: "", Uuid: response.Info.Uuid})
+
+	regenerateResponse, err := s.nativeStub.Services.IAM.Authentication.X509.Regenerate(ctx, &x509.RegenerateRequest{Namespace: "", Uuid: response.Info.Uuid})
+	require.Nil(s.T(), err)
+	require.False(s.T(), regenerateResponse.Renewed)
+	require.Equal(s.T(), response.Raw, regenerateResponse.Certificate)
+
+	forcedResponse, err := s.nativeStub.Services.IAM.Authentication.X509.Regenerate(ctx, &x509.RegenerateRequest{Namespace: "", Uuid: response.Info.Uuid, Force: true})
+	require.Nil(s.T(), err)
+	require.True(s.T(), forcedResponse.Renewed)
+	require.NotEqual(s.T(), response.Raw, forcedResponse.Certificate)
+}
+
+func (s *RevocationTestSuite) TestDisabledCertificateIsRevoked() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	ca := s.getCA(ctx)
+	createdIdentity, response := s.createCertificate(ctx)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: createdIdentity.Uuid})
+	defer s.nativeStub.Services.IAM.Authentication.X509.Delete(context.Background(), &x509.DeleteRequest{Namespace: "", Uuid: response.Info.Uuid})
+
+	certificate, err := cryptoX509.ParseCertificate(response.Raw)
+	require.Nil(s.T(), err)
+
+	ocspResponse := s.checkOCSP(ctx, certificate, ca)
+	require.Equal(s.T(), ocsp.Good, ocspResponse.Status)
+
+	_, err = s.nativeStub.Services.IAM.Authentication.X509.Disable(ctx, &x509.DisableRequest{Namespace: "", Uuid: response.Info.Uuid})
+	require.Nil(s.T(), err)
+
+	ocspResponse = s.checkOCSP(ctx, certificate, ca)
+	require.Equal(s.T(), ocsp.Revoked, ocspResponse.Status)
+
+	crlResponse, err := s.nativeStub.Services.IAM.Authentication.X509.GetNamespaceCRL(ctx, &x509.GetNamespaceCRLRequest{Namespace: ""})
+	require.Nil(s.T(), err)
+	crl, err := cryptoX509.ParseRevocationList(crlResponse.Crl)
+	require.Nil(s.T(), err)
+	require.Nil(s.T(), crl.CheckSignatureFrom(ca))
+
+	found := false
+	for _, entry := range crl.RevokedCertificateEntries {
+		if entry.SerialNumber.Cmp(certificate.SerialNumber) == 0 {
+			found = true
+			break
+		}
+	}
+	require.True(s.T(), found)
+}
+
+func (s *RevocationTestSuite) TestListExpiring() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	createdIdentity, response := s.createCertificate(ctx)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: createdIdentity.Uuid})
+	defer s.nativeStub.Services.IAM.Authentication.X509.Delete(context.Background(), &x509.DeleteRequest{Namespace: "", Uuid: response.Info.Uuid})
+
+	isListed := func(within uint64) bool {
+		stream, err := s.nativeStub.Services.IAM.Authentication.X509.ListExpiring(ctx, &x509.ListExpiringRequest{Namespace: "", Within: within})
+		require.Nil(s.T(), err)
+		for {
+			listResponse, err := stream.Recv()
+			if err != nil {
+				return false
+			}
+			if listResponse.Certificate.Uuid == response.Info.Uuid {
+				return true
+			}
+		}
+	}
+
+	require.False(s.T(), isListed(60))
+	require.True(s.T(), isListed(uint64((time.Hour * 24 * 365 * 10).Seconds())))
+}
@@ -0,0 +1,174 @@
+package group
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/group"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type GroupTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *GroupTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *GroupTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestGroupTestSuite(t *testing.T) {
+	suite.Run(t, new(GroupTestSuite))
+}
+
+func (s *GroupTestSuite) createGroup(ctx context.Context, namespaceName string) *group.Group {
+	response, err := s.nativeStub.Services.IAM.Group.Create(ctx, &group.CreateGroupRequest{
+		Namespace: namespaceName,
+		Name:      tools.GetRandomString(10),
+	})
+	require.Nil(s.T(), err)
+	return response.Group
+}
+
+func (s *GroupTestSuite) TestCreateGetDelete() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: namespaceName})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	created := s.createGroup(ctx, namespaceName)
+
+	getResponse, err := s.nativeStub.Services.IAM.Group.Get(ctx, &group.GetGroupRequest{Namespace: namespaceName, Uuid: created.Uuid})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), created.Name, getResponse.Group.Name)
+
+	deleteResponse, err := s.nativeStub.Services.IAM.Group.Delete(ctx, &group.DeleteGroupRequest{Namespace: namespaceName, Uuid: created.Uuid})
+	require.Nil(s.T(), err)
+	require.True(s.T(), deleteResponse.Existed)
+
+	_, err = s.nativeStub.Services.IAM.Group.Get(ctx, &group.GetGroupRequest{Namespace: namespaceName, Uuid: created.Uuid})
+	require.NotNil(s.T(), err)
+	st, ok := status.FromError(err)
+	require.True(s.T(), ok)
+	require.Equal(s.T(), codes.NotFound, st.Code())
+}
+
+func (s *GroupTestSuite) TestNestedGroupGrantsPolicies() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: namespaceName})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	policyResponse, err := s.nativeStub.Services.IAM.Policy.Create(ctx, &policy.CreatePolicyRequest{
+		Namespace: namespaceName,
+		Name:      tools.GetRandomString(10),
+		Managed:   &policy.CreatePolicyRequest_No{No: &policy.NotManagedData{}},
+		Resources: []string{"test.resource"},
+		Actions:   []string{"test.get"},
+	})
+	require.Nil(s.T(), err)
+
+	identityResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       namespaceName,
+		Name:            tools.GetRandomString(10),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	identityUUID := identityResponse.Identity.Uuid
+
+	parent := s.createGroup(ctx, namespaceName)
+	child := s.createGroup(ctx, namespaceName)
+
+	_, err = s.nativeStub.Services.IAM.Group.AddPolicy(ctx, &group.AddPolicyRequest{
+		GroupNamespace:  namespaceName,
+		GroupUUID:       parent.Uuid,
+		PolicyNamespace: namespaceName,
+		PolicyUUID:      policyResponse.Policy.Uuid,
+	})
+	require.Nil(s.T(), err)
+	_, err = s.nativeStub.Services.IAM.Group.AddGroup(ctx, &group.AddGroupRequest{Namespace: namespaceName, GroupUUID: parent.Uuid, MemberGroupUUID: child.Uuid})
+	require.Nil(s.T(), err)
+	_, err = s.nativeStub.Services.IAM.Group.AddIdentity(ctx, &group.AddIdentityRequest{Namespace: namespaceName, GroupUUID: child.Uuid, IdentityUUID: identityUUID})
+	require.Nil(s.T(), err)
+
+	groupsResponse, err := s.nativeStub.Services.IAM.Group.GetIdentityGroups(ctx, &group.GetIdentityGroupsRequest{Namespace: namespaceName, IdentityUUID: identityUUID})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), groupsResponse.Groups, 2)
+	for _, identityGroup := range groupsResponse.Groups {
+		require.Equal(s.T(), identityGroup.Group.Uuid == child.Uuid, identityGroup.Direct)
+	}
+
+	effectiveResponse, err := s.nativeStub.Services.IAM.Identity.GetEffectivePolicies(ctx, &identity.GetEffectivePoliciesRequest{Namespace: namespaceName, Uuid: identityUUID})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), effectiveResponse.Policies, 1)
+	require.False(s.T(), effectiveResponse.Policies[0].Direct)
+	require.Len(s.T(), effectiveResponse.Policies[0].Groups, 1)
+	require.Equal(s.T(), parent.Uuid, effectiveResponse.Policies[0].Groups[0].Uuid)
+
+	// Removing nested group removes policies granted through it
+	_, err = s.nativeStub.Services.IAM.Group.RemoveGroup(ctx, &group.RemoveGroupRequest{Namespace: namespaceName, GroupUUID: parent.Uuid, MemberGroupUUID: child.Uuid})
+	require.Nil(s.T(), err)
+
+	effectiveResponse, err = s.nativeStub.Services.IAM.Identity.GetEffectivePolicies(ctx, &identity.GetEffectivePoliciesRequest{Namespace: namespaceName, Uuid: identityUUID})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), effectiveResponse.Policies, 0)
+}
+
+func (s *GroupTestSuite) TestNestingCycleIsRejected() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: namespaceName})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	first := s.createGroup(ctx, namespaceName)
+	second := s.createGroup(ctx, namespaceName)
+	third := s.createGroup(ctx, namespaceName)
+
+	_, err = s.nativeStub.Services.IAM.Group.AddGroup(ctx, &group.AddGroupRequest{Namespace: namespaceName, GroupUUID: first.Uuid, MemberGroupUUID: second.Uuid})
+	require.Nil(s.T(), err)
+	_, err = s.nativeStub.Services.IAM.Group.AddGroup(ctx, &group.AddGroupRequest{Namespace: namespaceName, GroupUUID: second.Uuid, MemberGroupUUID: third.Uuid})
+	require.Nil(s.T(), err)
+
+	// Closing the chain and nesting group into itself are both cycles
+	cycles := []struct {
+		groupUUID  string
+		memberUUID string
+	}{
+		{groupUUID: third.Uuid, memberUUID: first.Uuid},
+		{groupUUID: third.Uuid, memberUUID: third.Uuid},
+	}
+	for _, cycle := range cycles {
+		_, err = s.nativeStub.Services.IAM.Group.AddGroup(ctx, &group.AddGroupRequest{Namespace: namespaceName, GroupUUID: cycle.groupUUID, MemberGroupUUID: cycle.memberUUID})
+		require.NotNil(s.T(), err)
+		st, ok := status.FromError(err)
+		require.True(s.T(), ok)
+		require.Equal(s.T(), codes.FailedPrecondition, st.Code())
+	}
+}
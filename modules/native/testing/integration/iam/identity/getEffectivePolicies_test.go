@@ -0,0 +1,156 @@
+package identity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type GetEffectivePoliciesTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *GetEffectivePoliciesTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *GetEffectivePoliciesTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestGetEffectivePoliciesTestSuite(t *testing.T) {
+	suite.Run(t, new(GetEffectivePoliciesTestSuite))
+}
+
+func (s *GetEffectivePoliciesTestSuite) TestDirectAndRolePolicies() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        namespaceName,
+		FullName:    tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	policyResponse, err := s.nativeStub.Services.IAM.Policy.Create(ctx, &policy.CreatePolicyRequest{
+		Namespace: namespaceName,
+		Name:      tools.GetRandomString(10),
+		Managed:   &policy.CreatePolicyRequest_No{No: &policy.NotManagedData{}},
+		Resources: []string{"test.resource"},
+		Actions:   []string{"test.get"},
+	})
+	require.Nil(s.T(), err)
+	policyUUID := policyResponse.Policy.Uuid
+
+	rootRoleResponse, err := s.nativeStub.Services.IAM.Role.GetBuiltInRole(ctx, &role.GetBuiltInRoleRequest{
+		Namespace: namespaceName,
+		Type:      role.BuiltInRoleType_NAMESPACE_ROOT,
+	})
+	require.Nil(s.T(), err)
+	rootRole := rootRoleResponse.Role
+
+	roleResponse, err := s.nativeStub.Services.IAM.Role.Create(ctx, &role.CreateRoleRequest{
+		Namespace:   namespaceName,
+		Name:        tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+		Managed:     &role.CreateRoleRequest_No{No: &role.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	_, err = s.nativeStub.Services.IAM.Role.AddPolicy(ctx, &role.AddPolicyRequest{
+		RoleNamespace:   namespaceName,
+		RoleUUID:        roleResponse.Role.Uuid,
+		PolicyNamespace: namespaceName,
+		PolicyUUID:      policyUUID,
+	})
+	require.Nil(s.T(), err)
+
+	identityResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       namespaceName,
+		Name:            tools.GetRandomString(10),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	identityUUID := identityResponse.Identity.Uuid
+
+	_, err = s.nativeStub.Services.IAM.Identity.AddPolicy(ctx, &identity.AddPolicyRequest{
+		IdentityNamespace: namespaceName,
+		IdentityUUID:      identityUUID,
+		PolicyNamespace:   namespaceName,
+		PolicyUUID:        policyUUID,
+	})
+	require.Nil(s.T(), err)
+	for _, r := range []*role.Role{roleResponse.Role, rootRole} {
+		_, err = s.nativeStub.Services.IAM.Identity.AddRole(ctx, &identity.AddRoleRequest{
+			IdentityNamespace: namespaceName,
+			IdentityUUID:      identityUUID,
+			RoleNamespace:     r.Namespace,
+			RoleUUID:          r.Uuid,
+		})
+		require.Nil(s.T(), err)
+	}
+
+	response, err := s.nativeStub.Services.IAM.Identity.GetEffectivePolicies(ctx, &identity.GetEffectivePoliciesRequest{
+		Namespace: namespaceName,
+		Uuid:      identityUUID,
+	})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), response.Policies, 1+len(rootRole.Policies))
+
+	var created *identity.EffectivePolicy
+	var builtIn *identity.EffectivePolicy
+	for _, p := range response.Policies {
+		if p.Uuid == policyUUID {
+			created = p
+		} else {
+			builtIn = p
+		}
+	}
+
+	require.NotNil(s.T(), created)
+	require.True(s.T(), created.Direct)
+	require.Equal(s.T(), []string{"test.resource"}, created.Resources)
+	require.Len(s.T(), created.Roles, 1)
+	require.Equal(s.T(), roleResponse.Role.Uuid, created.Roles[0].Uuid)
+	require.Empty(s.T(), created.BuiltInType)
+
+	require.NotNil(s.T(), builtIn)
+	require.False(s.T(), builtIn.Direct)
+	require.Equal(s.T(), policy.BuiltInPolicyType_NAMESPACE_ROOT.String(), builtIn.BuiltInType)
+	require.Len(s.T(), builtIn.Roles, 1)
+	require.Equal(s.T(), role.BuiltInRoleType_NAMESPACE_ROOT.String(), builtIn.Roles[0].BuiltInType)
+}
+
+func (s *GetEffectivePoliciesTestSuite) TestNotFound() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	_, err := s.nativeStub.Services.IAM.Identity.GetEffectivePolicies(ctx, &identity.GetEffectivePoliciesRequest{
+		Namespace: "",
+		Uuid:      primitive.NewObjectID().Hex(),
+	})
+	require.NotNil(s.T(), err)
+	st, ok := status.FromError(err)
+	require.True(s.T(), ok)
+	require.Equal(s.T(), codes.NotFound, st.Code())
+}
@@ -0,0 +1,396 @@
+package identity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/group"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/native/testing/tools"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SimulatePolicyChangeTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *SimulatePolicyChangeTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *SimulatePolicyChangeTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestSimulatePolicyChangeTestSuite(t *testing.T) {
+	suite.Run(t, new(SimulatePolicyChangeTestSuite))
+}
+
+func (s *SimulatePolicyChangeTestSuite) TestSimulation() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        namespaceName,
+		FullName:    tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	createPolicy := func(resource string) *policy.Policy {
+		response, err := s.nativeStub.Services.IAM.Policy.Create(ctx, &policy.CreatePolicyRequest{
+			Namespace: namespaceName,
+			Name:      tools.GetRandomString(10),
+			Managed:   &policy.CreatePolicyRequest_No{No: &policy.NotManagedData{}},
+			Resources: []string{resource},
+			Actions:   []string{"test.get"},
+		})
+		require.Nil(s.T(), err)
+		return response.Policy
+	}
+	directPolicy := createPolicy("test.direct")
+	rolePolicy := createPolicy("test.role")
+	newPolicy := createPolicy("test.new")
+
+	roleResponse, err := s.nativeStub.Services.IAM.Role.Create(ctx, &role.CreateRoleRequest{
+		Namespace:   namespaceName,
+		Name:        tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+		Managed:     &role.CreateRoleRequest_No{No: &role.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	roleUUID := roleResponse.Role.Uuid
+	_, err = s.nativeStub.Services.IAM.Role.AddPolicy(ctx, &role.AddPolicyRequest{
+		RoleNamespace:   namespaceName,
+		RoleUUID:        roleUUID,
+		PolicyNamespace: namespaceName,
+		PolicyUUID:      rolePolicy.Uuid,
+	})
+	require.Nil(s.T(), err)
+
+	createIdentity := func() string {
+		response, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+			Namespace:       namespaceName,
+			Name:            tools.GetRandomString(10),
+			InitiallyActive: true,
+			Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+		})
+		require.Nil(s.T(), err)
+		return response.Identity.Uuid
+	}
+	directIdentity := createIdentity()
+	_, err = s.nativeStub.Services.IAM.Identity.AddPolicy(ctx, &identity.AddPolicyRequest{
+		IdentityNamespace: namespaceName,
+		IdentityUUID:      directIdentity,
+		PolicyNamespace:   namespaceName,
+		PolicyUUID:        directPolicy.Uuid,
+	})
+	require.Nil(s.T(), err)
+	roleIdentity := createIdentity()
+	_, err = s.nativeStub.Services.IAM.Identity.AddRole(ctx, &identity.AddRoleRequest{
+		IdentityNamespace: namespaceName,
+		IdentityUUID:      roleIdentity,
+		RoleNamespace:     namespaceName,
+		RoleUUID:          roleUUID,
+	})
+	require.Nil(s.T(), err)
+	createIdentity()
+
+	s.Run("Update policy", func() {
+		response, err := s.nativeStub.Services.IAM.Identity.SimulatePolicyChange(ctx, &identity.SimulatePolicyChangeRequest{
+			Namespace: namespaceName,
+			Change: &identity.SimulatePolicyChangeRequest_UpdatePolicy{UpdatePolicy: &identity.SimulatedPolicyUpdate{
+				Namespace: namespaceName,
+				Uuid:      directPolicy.Uuid,
+				Resources: []string{"test.direct"},
+				Actions:   []string{"test.get", "test.update"},
+			}},
+		})
+		require.Nil(s.T(), err)
+		require.Len(s.T(), response.Identities, 1)
+		require.Equal(s.T(), directIdentity, response.Identities[0].Identity)
+		require.Len(s.T(), response.Identities[0].Gained, 1)
+		require.Equal(s.T(), "test.update", response.Identities[0].Gained[0].Action)
+		require.Empty(s.T(), response.Identities[0].Lost)
+	})
+
+	s.Run("Delete policy assigned through role", func() {
+		response, err := s.nativeStub.Services.IAM.Identity.SimulatePolicyChange(ctx, &identity.SimulatePolicyChangeRequest{
+			Namespace: namespaceName,
+			Change: &identity.SimulatePolicyChangeRequest_DeletePolicy{DeletePolicy: &identity.SimulatedPolicyReference{
+				Namespace: namespaceName,
+				Uuid:      rolePolicy.Uuid,
+			}},
+		})
+		require.Nil(s.T(), err)
+		require.Len(s.T(), response.Identities, 1)
+		require.Equal(s.T(), roleIdentity, response.Identities[0].Identity)
+		require.Empty(s.T(), response.Identities[0].Gained)
+		require.Len(s.T(), response.Identities[0].Lost, 1)
+		require.Equal(s.T(), "test.role", response.Identities[0].Lost[0].Resource)
+	})
+
+	s.Run("Add and remove role policy", func() {
+		response, err := s.nativeStub.Services.IAM.Identity.SimulatePolicyChange(ctx, &identity.SimulatePolicyChangeRequest{
+			Namespace: namespaceName,
+			Change: &identity.SimulatePolicyChangeRequest_AddRolePolicy{AddRolePolicy: &identity.SimulatedRolePolicyChange{
+				RoleNamespace:   namespaceName,
+				RoleUUID:        roleUUID,
+				PolicyNamespace: namespaceName,
+				PolicyUUID:      newPolicy.Uuid,
+			}},
+		})
+		require.Nil(s.T(), err)
+		require.Len(s.T(), response.Identities, 1)
+		require.Len(s.T(), response.Identities[0].Gained, 1)
+		require.Equal(s.T(), "test.new", response.Identities[0].Gained[0].Resource)
+
+		response, err = s.nativeStub.Services.IAM.Identity.SimulatePolicyChange(ctx, &identity.SimulatePolicyChangeRequest{
+			Namespace: namespaceName,
+			Change: &identity.SimulatePolicyChangeRequest_RemoveRolePolicy{RemoveRolePolicy: &identity.SimulatedRolePolicyChange{
+				RoleNamespace:   namespaceName,
+				RoleUUID:        roleUUID,
+				PolicyNamespace: namespaceName,
+				PolicyUUID:      rolePolicy.Uuid,
+			}},
+		})
+		require.Nil(s.T(), err)
+		require.Len(s.T(), response.Identities, 1)
+		require.Len(s.T(), response.Identities[0].Lost, 1)
+	})
+
+	s.Run("Nothing is persisted", func() {
+		getResponse, err := s.nativeStub.Services.IAM.Policy.Get(ctx, &policy.GetPolicyRequest{Namespace: namespaceName, Uuid: directPolicy.Uuid})
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), []string{"test.get"}, getResponse.Policy.Actions)
+
+		roleGetResponse, err := s.nativeStub.Services.IAM.Role.Get(ctx, &role.GetRoleRequest{Namespace: namespaceName, Uuid: roleUUID})
+		require.Nil(s.T(), err)
+		require.Len(s.T(), roleGetResponse.Role.Policies, 1)
+	})
+}
+
+func (s *SimulatePolicyChangeTestSuite) TestSimulationWithTimeBoundRole() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        namespaceName,
+		FullName:    tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	policyResponse, err := s.nativeStub.Services.IAM.Policy.Create(ctx, &policy.CreatePolicyRequest{
+		Namespace: namespaceName,
+		Name:      tools.GetRandomString(10),
+		Managed:   &policy.CreatePolicyRequest_No{No: &policy.NotManagedData{}},
+		Resources: []string{"test.role"},
+		Actions:   []string{"test.get"},
+	})
+	require.Nil(s.T(), err)
+	policyUUID := policyResponse.Policy.Uuid
+
+	roleResponse, err := s.nativeStub.Services.IAM.Role.Create(ctx, &role.CreateRoleRequest{
+		Namespace:   namespaceName,
+		Name:        tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+		Managed:     &role.CreateRoleRequest_No{No: &role.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	roleUUID := roleResponse.Role.Uuid
+	_, err = s.nativeStub.Services.IAM.Role.AddPolicy(ctx, &role.AddPolicyRequest{
+		RoleNamespace:   namespaceName,
+		RoleUUID:        roleUUID,
+		PolicyNamespace: namespaceName,
+		PolicyUUID:      policyUUID,
+	})
+	require.Nil(s.T(), err)
+
+	identityResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       namespaceName,
+		Name:            tools.GetRandomString(10),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	identityUUID := identityResponse.Identity.Uuid
+	_, err = s.nativeStub.Services.IAM.Identity.AddRole(ctx, &identity.AddRoleRequest{
+		IdentityNamespace: namespaceName,
+		IdentityUUID:      identityUUID,
+		RoleNamespace:     namespaceName,
+		RoleUUID:          roleUUID,
+		ValidUntil:        timestamppb.New(time.Now().Add(time.Hour)),
+		Justification:     tools.GetRandomString(10),
+	})
+	require.Nil(s.T(), err)
+
+	response, err := s.nativeStub.Services.IAM.Identity.SimulatePolicyChange(ctx, &identity.SimulatePolicyChangeRequest{
+		Namespace: namespaceName,
+		Change: &identity.SimulatePolicyChangeRequest_DeletePolicy{DeletePolicy: &identity.SimulatedPolicyReference{
+			Namespace: namespaceName,
+			Uuid:      policyUUID,
+		}},
+	})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), response.Identities, 1)
+	require.Equal(s.T(), identityUUID, response.Identities[0].Identity)
+	require.Len(s.T(), response.Identities[0].Lost, 1)
+	require.Equal(s.T(), "test.role", response.Identities[0].Lost[0].Resource)
+}
+
+func (s *SimulatePolicyChangeTestSuite) TestSimulationThroughGroups() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        namespaceName,
+		FullName:    tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	createPolicy := func(resource string) string {
+		response, err := s.nativeStub.Services.IAM.Policy.Create(ctx, &policy.CreatePolicyRequest{
+			Namespace: namespaceName,
+			Name:      tools.GetRandomString(10),
+			Managed:   &policy.CreatePolicyRequest_No{No: &policy.NotManagedData{}},
+			Resources: []string{resource},
+			Actions:   []string{"test.get"},
+		})
+		require.Nil(s.T(), err)
+		return response.Policy.Uuid
+	}
+	groupPolicyUUID := createPolicy("test.group")
+	rolePolicyUUID := createPolicy("test.role")
+
+	roleResponse, err := s.nativeStub.Services.IAM.Role.Create(ctx, &role.CreateRoleRequest{
+		Namespace:   namespaceName,
+		Name:        tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+		Managed:     &role.CreateRoleRequest_No{No: &role.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	roleUUID := roleResponse.Role.Uuid
+	_, err = s.nativeStub.Services.IAM.Role.AddPolicy(ctx, &role.AddPolicyRequest{
+		RoleNamespace:   namespaceName,
+		RoleUUID:        roleUUID,
+		PolicyNamespace: namespaceName,
+		PolicyUUID:      rolePolicyUUID,
+	})
+	require.Nil(s.T(), err)
+
+	createGroup := func() string {
+		response, err := s.nativeStub.Services.IAM.Group.Create(ctx, &group.CreateGroupRequest{
+			Namespace:   namespaceName,
+			Name:        tools.GetRandomString(10),
+			Description: tools.GetRandomString(10),
+		})
+		require.Nil(s.T(), err)
+		return response.Group.Uuid
+	}
+	parentGroupUUID := createGroup()
+	nestedGroupUUID := createGroup()
+	_, err = s.nativeStub.Services.IAM.Group.AddGroup(ctx, &group.AddGroupRequest{Namespace: namespaceName, GroupUUID: parentGroupUUID, MemberGroupUUID: nestedGroupUUID})
+	require.Nil(s.T(), err)
+	_, err = s.nativeStub.Services.IAM.Group.AddPolicy(ctx, &group.AddPolicyRequest{
+		GroupNamespace:  namespaceName,
+		GroupUUID:       parentGroupUUID,
+		PolicyNamespace: namespaceName,
+		PolicyUUID:      groupPolicyUUID,
+	})
+	require.Nil(s.T(), err)
+	_, err = s.nativeStub.Services.IAM.Group.AddRole(ctx, &group.AddRoleRequest{
+		GroupNamespace: namespaceName,
+		GroupUUID:      parentGroupUUID,
+		RoleNamespace:  namespaceName,
+		RoleUUID:       roleUUID,
+	})
+	require.Nil(s.T(), err)
+
+	createIdentity := func() string {
+		response, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+			Namespace:       namespaceName,
+			Name:            tools.GetRandomString(10),
+			InitiallyActive: true,
+			Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+		})
+		require.Nil(s.T(), err)
+		return response.Identity.Uuid
+	}
+	parentMember := createIdentity()
+	_, err = s.nativeStub.Services.IAM.Group.AddIdentity(ctx, &group.AddIdentityRequest{Namespace: namespaceName, GroupUUID: parentGroupUUID, IdentityUUID: parentMember})
+	require.Nil(s.T(), err)
+	nestedMember := createIdentity()
+	_, err = s.nativeStub.Services.IAM.Group.AddIdentity(ctx, &group.AddIdentityRequest{Namespace: namespaceName, GroupUUID: nestedGroupUUID, IdentityUUID: nestedMember})
+	require.Nil(s.T(), err)
+	createIdentity()
+
+	requireAffected := func(response *identity.SimulatePolicyChangeResponse, resource string) {
+		require.Len(s.T(), response.Identities, 2)
+		affected := []string{}
+		for _, diff := range response.Identities {
+			affected = append(affected, diff.Identity)
+			require.Empty(s.T(), diff.Gained)
+			require.Len(s.T(), diff.Lost, 1)
+			require.Equal(s.T(), resource, diff.Lost[0].Resource)
+		}
+		require.ElementsMatch(s.T(), []string{parentMember, nestedMember}, affected)
+	}
+
+	s.Run("Delete policy assigned to group", func() {
+		response, err := s.nativeStub.Services.IAM.Identity.SimulatePolicyChange(ctx, &identity.SimulatePolicyChangeRequest{
+			Namespace: namespaceName,
+			Change: &identity.SimulatePolicyChangeRequest_DeletePolicy{DeletePolicy: &identity.SimulatedPolicyReference{
+				Namespace: namespaceName,
+				Uuid:      groupPolicyUUID,
+			}},
+		})
+		require.Nil(s.T(), err)
+		requireAffected(response, "test.group")
+	})
+
+	s.Run("Delete policy of the role assigned to group", func() {
+		response, err := s.nativeStub.Services.IAM.Identity.SimulatePolicyChange(ctx, &identity.SimulatePolicyChangeRequest{
+			Namespace: namespaceName,
+			Change: &identity.SimulatePolicyChangeRequest_DeletePolicy{DeletePolicy: &identity.SimulatedPolicyReference{
+				Namespace: namespaceName,
+				Uuid:      rolePolicyUUID,
+			}},
+		})
+		require.Nil(s.T(), err)
+		requireAffected(response, "test.role")
+	})
+
+	s.Run("Remove policy from the role assigned to group", func() {
+		response, err := s.nativeStub.Services.IAM.Identity.SimulatePolicyChange(ctx, &identity.SimulatePolicyChangeRequest{
+			Namespace: namespaceName,
+			Change: &identity.SimulatePolicyChangeRequest_RemoveRolePolicy{RemoveRolePolicy: &identity.SimulatedRolePolicyChange{
+				RoleNamespace:   namespaceName,
+				RoleUUID:        roleUUID,
+				PolicyNamespace: namespaceName,
+				PolicyUUID:      rolePolicyUUID,
+			}},
+		})
+		require.Nil(s.T(), err)
+		requireAffected(response, "test.role")
+	})
+}
@@ -0,0 +1,156 @@
+package identity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type TimeBoundAssignmentTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *TimeBoundAssignmentTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *TimeBoundAssignmentTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestTimeBoundAssignmentTestSuite(t *testing.T) {
+	suite.Run(t, new(TimeBoundAssignmentTestSuite))
+}
+
+func (s *TimeBoundAssignmentTestSuite) createIdentityAndPolicy(ctx context.Context, namespaceName string) (string, string) {
+	policyResponse, err := s.nativeStub.Services.IAM.Policy.Create(ctx, &policy.CreatePolicyRequest{
+		Namespace: namespaceName,
+		Name:      tools.GetRandomString(10),
+		Managed:   &policy.CreatePolicyRequest_No{No: &policy.NotManagedData{}},
+		Resources: []string{"test.resource"},
+		Actions:   []string{"test.get"},
+	})
+	require.Nil(s.T(), err)
+
+	identityResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       namespaceName,
+		Name:            tools.GetRandomString(10),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+
+	return identityResponse.Identity.Uuid, policyResponse.Policy.Uuid
+}
+
+func (s *TimeBoundAssignmentTestSuite) TestAssignmentIsActiveOnlyInsideValidityPeriod() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: namespaceName})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	identityUUID, policyUUID := s.createIdentityAndPolicy(ctx, namespaceName)
+
+	validFrom := timestamppb.New(time.Now().Add(time.Hour))
+	validUntil := timestamppb.New(time.Now().Add(time.Hour * 2))
+	addResponse, err := s.nativeStub.Services.IAM.Identity.AddPolicy(ctx, &identity.AddPolicyRequest{
+		IdentityNamespace: namespaceName,
+		IdentityUUID:      identityUUID,
+		PolicyNamespace:   namespaceName,
+		PolicyUUID:        policyUUID,
+		ValidFrom:         validFrom,
+		ValidUntil:        validUntil,
+		Justification:     "incident",
+	})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), addResponse.Identity.Policies, 1)
+	require.Equal(s.T(), validFrom.AsTime().Unix(), addResponse.Identity.Policies[0].ValidFrom.AsTime().Unix())
+	require.Equal(s.T(), validUntil.AsTime().Unix(), addResponse.Identity.Policies[0].ValidUntil.AsTime().Unix())
+	require.Equal(s.T(), "incident", addResponse.Identity.Policies[0].Justification)
+
+	// Not active yet
+	effectiveResponse, err := s.nativeStub.Services.IAM.Identity.GetEffectivePolicies(ctx, &identity.GetEffectivePoliciesRequest{Namespace: namespaceName, Uuid: identityUUID})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), effectiveResponse.Policies, 0)
+
+	// Adding the same policy again replaces validity period
+	addResponse, err = s.nativeStub.Services.IAM.Identity.AddPolicy(ctx, &identity.AddPolicyRequest{
+		IdentityNamespace: namespaceName,
+		IdentityUUID:      identityUUID,
+		PolicyNamespace:   namespaceName,
+		PolicyUUID:        policyUUID,
+		ValidUntil:        timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), addResponse.Identity.Policies, 1)
+	require.Nil(s.T(), addResponse.Identity.Policies[0].ValidFrom)
+	require.Empty(s.T(), addResponse.Identity.Policies[0].Justification)
+
+	effectiveResponse, err = s.nativeStub.Services.IAM.Identity.GetEffectivePolicies(ctx, &identity.GetEffectivePoliciesRequest{Namespace: namespaceName, Uuid: identityUUID})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), effectiveResponse.Policies, 1)
+	require.Equal(s.T(), policyUUID, effectiveResponse.Policies[0].Uuid)
+
+	// Removing works independently of the validity period
+	removeResponse, err := s.nativeStub.Services.IAM.Identity.RemovePolicy(ctx, &identity.RemovePolicyRequest{
+		IdentityNamespace: namespaceName,
+		IdentityUUID:      identityUUID,
+		PolicyNamespace:   namespaceName,
+		PolicyUUID:        policyUUID,
+	})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), removeResponse.Identity.Policies, 0)
+}
+
+func (s *TimeBoundAssignmentTestSuite) TestBadValidityPeriod() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	namespaceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: namespaceName})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: namespaceName})
+
+	identityUUID, policyUUID := s.createIdentityAndPolicy(ctx, namespaceName)
+
+	periods := []struct {
+		validFrom  *timestamppb.Timestamp
+		validUntil *timestamppb.Timestamp
+	}{
+		{validFrom: nil, validUntil: timestamppb.New(time.Now().Add(-time.Minute))},
+		{validFrom: timestamppb.New(time.Now().Add(time.Hour * 2)), validUntil: timestamppb.New(time.Now().Add(time.Hour))},
+	}
+	for _, period := range periods {
+		_, err = s.nativeStub.Services.IAM.Identity.AddPolicy(ctx, &identity.AddPolicyRequest{
+			IdentityNamespace: namespaceName,
+			IdentityUUID:      identityUUID,
+			PolicyNamespace:   namespaceName,
+			PolicyUUID:        policyUUID,
+			ValidFrom:         period.validFrom,
+			ValidUntil:        period.validUntil,
+		})
+		require.NotNil(s.T(), err)
+		st, ok := status.FromError(err)
+		require.True(s.T(), ok)
+		require.Equal(s.T(), codes.InvalidArgument, st.Code())
+	}
+}
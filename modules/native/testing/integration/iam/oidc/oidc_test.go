@@ -0,0 +1,368 @@
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/oidc"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	"github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type OIDCTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+
+	namespaceName string
+	identityUUID  string
+}
+
+func (suite *OIDCTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *OIDCTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestOIDCTestSuite(t *testing.T) {
+	suite.Run(t, new(OIDCTestSuite))
+}
+
+// Creates namespace with identity that is allowed to read "oidc.test.*" resources
+func (s *OIDCTestSuite) SetupTest() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	s.namespaceName = tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        s.namespaceName,
+		FullName:    tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+	})
+	require.Nil(s.T(), err)
+
+	policyResponse, err := s.nativeStub.Services.IAM.Policy.Create(ctx, &policy.CreatePolicyRequest{
+		Namespace:   s.namespaceName,
+		Name:        tools.GetRandomString(10),
+		Description: tools.GetRandomString(10),
+		Managed:     &policy.CreatePolicyRequest_No{No: &policy.NotManagedData{}},
+		Resources:   []string{"oidc.test.*"},
+		Actions:     []string{"oidc.test.read"},
+	})
+	require.Nil(s.T(), err)
+
+	identityResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       s.namespaceName,
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	s.identityUUID = identityResponse.Identity.Uuid
+
+	_, err = s.nativeStub.Services.IAM.Identity.AddPolicy(ctx, &identity.AddPolicyRequest{
+		IdentityNamespace: s.namespaceName,
+		IdentityUUID:      s.identityUUID,
+		PolicyNamespace:   s.namespaceName,
+		PolicyUUID:        policyResponse.Policy.Uuid,
+	})
+	require.Nil(s.T(), err)
+}
+func (s *OIDCTestSuite) TearDownTest() {
+	s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: s.namespaceName})
+}
+
+func (s *OIDCTestSuite) clientParameters(confidential bool) *oidc.ClientParameters {
+	return &oidc.ClientParameters{
+		Name:         tools.GetRandomString(10),
+		Description:  tools.GetRandomString(10),
+		Enabled:      true,
+		Confidential: confidential,
+		RedirectURIs: []string{"https://app.example.com/callback"},
+		GrantTypes:   []oidc.GrantType{oidc.GrantType_AUTHORIZATION_CODE, oidc.GrantType_REFRESH_TOKEN},
+		Scopes: []*oidc.ClientScope{
+			{
+				Name:        "read",
+				Description: "Read test resources",
+				Resources:   []string{"oidc.test.resource"},
+				Actions:     []string{"oidc.test.read"},
+			},
+		},
+	}
+}
+
+func (s *OIDCTestSuite) TestClientCRUD() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	createResponse, err := s.nativeStub.Services.IAM.OIDC.CreateClient(ctx, &oidc.CreateClientRequest{
+		Namespace:  s.namespaceName,
+		Parameters: s.clientParameters(true),
+	})
+	require.Nil(s.T(), err)
+	require.NotEmpty(s.T(), createResponse.ClientSecret)
+	client := createResponse.Client
+	require.True(s.T(), strings.HasPrefix(client.ClientId, s.namespaceName+"."))
+
+	getResponse, err := s.nativeStub.Services.IAM.OIDC.GetClientByClientId(ctx, &oidc.GetClientByClientIdRequest{ClientId: client.ClientId})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), client.Uuid, getResponse.Client.Uuid)
+	require.Equal(s.T(), s.namespaceName, getResponse.Client.Namespace)
+
+	parameters := s.clientParameters(true)
+	parameters.RedirectURIs = []string{"https://app.example.com/other"}
+	updateResponse, err := s.nativeStub.Services.IAM.OIDC.UpdateClient(ctx, &oidc.UpdateClientRequest{Namespace: s.namespaceName, Uuid: client.Uuid, Parameters: parameters})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), parameters.RedirectURIs, updateResponse.Client.RedirectURIs)
+	require.Equal(s.T(), client.Version+1, updateResponse.Client.Version)
+
+	listResponse, err := s.nativeStub.Services.IAM.OIDC.ListClients(ctx, &oidc.ListClientsRequest{Namespace: s.namespaceName})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), listResponse.Clients, 1)
+
+	deleteResponse, err := s.nativeStub.Services.IAM.OIDC.DeleteClient(ctx, &oidc.DeleteClientRequest{Namespace: s.namespaceName, Uuid: client.Uuid})
+	require.Nil(s.T(), err)
+	require.True(s.T(), deleteResponse.Existed)
+
+	_, err = s.nativeStub.Services.IAM.OIDC.GetClient(ctx, &oidc.GetClientRequest{Namespace: s.namespaceName, Uuid: client.Uuid})
+	require.NotNil(s.T(), err)
+}
+
+func (s *OIDCTestSuite) TestInvalidClientParameters() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	s.Run("Public client cant use client credentials", func() {
+		parameters := s.clientParameters(false)
+		parameters.GrantTypes = []oidc.GrantType{oidc.GrantType_CLIENT_CREDENTIALS}
+		parameters.Identity = s.identityUUID
+		_, err := s.nativeStub.Services.IAM.OIDC.CreateClient(ctx, &oidc.CreateClientRequest{Namespace: s.namespaceName, Parameters: parameters})
+		require.NotNil(s.T(), err)
+	})
+	s.Run("Reserved scope name", func() {
+		parameters := s.clientParameters(false)
+		parameters.Scopes[0].Name = "openid"
+		_, err := s.nativeStub.Services.IAM.OIDC.CreateClient(ctx, &oidc.CreateClientRequest{Namespace: s.namespaceName, Parameters: parameters})
+		require.NotNil(s.T(), err)
+	})
+	s.Run("Relative redirect URI", func() {
+		parameters := s.clientParameters(false)
+		parameters.RedirectURIs = []string{"/callback"}
+		_, err := s.nativeStub.Services.IAM.OIDC.CreateClient(ctx, &oidc.CreateClientRequest{Namespace: s.namespaceName, Parameters: parameters})
+		require.NotNil(s.T(), err)
+	})
+}
+
+func (s *OIDCTestSuite) TestClientCredentials() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	parameters := s.clientParameters(true)
+	parameters.GrantTypes = []oidc.GrantType{oidc.GrantType_CLIENT_CREDENTIALS}
+	parameters.RedirectURIs = []string{}
+	parameters.Identity = s.identityUUID
+	createResponse, err := s.nativeStub.Services.IAM.OIDC.CreateClient(ctx, &oidc.CreateClientRequest{Namespace: s.namespaceName, Parameters: parameters})
+	require.Nil(s.T(), err)
+	clientID := createResponse.Client.ClientId
+
+	exchangeResponse, err := s.nativeStub.Services.IAM.OIDC.ExchangeToken(ctx, &oidc.ExchangeTokenRequest{
+		GrantType:    "client_credentials",
+		ClientId:     clientID,
+		ClientSecret: tools.GetRandomString(20),
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), oidc.ExchangeTokenResponse_INVALID_CLIENT, exchangeResponse.Status)
+
+	exchangeResponse, err = s.nativeStub.Services.IAM.OIDC.ExchangeToken(ctx, &oidc.ExchangeTokenRequest{
+		GrantType:    "client_credentials",
+		ClientId:     clientID,
+		ClientSecret: createResponse.ClientSecret,
+		Scope:        "read",
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), oidc.ExchangeTokenResponse_OK, exchangeResponse.Status)
+	require.Empty(s.T(), exchangeResponse.RefreshToken)
+	require.Equal(s.T(), "read", exchangeResponse.Scope)
+
+	validateResponse, err := s.nativeStub.Services.IAM.Token.Validate(ctx, &token.ValidateRequest{Token: exchangeResponse.AccessToken})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), token.ValidateResponse_OK, validateResponse.Status)
+	require.Equal(s.T(), s.identityUUID, validateResponse.TokenData.Identity)
+	require.Equal(s.T(), token.AuthMethod_OAUTH2_CLIENT, validateResponse.TokenData.AuthMethod)
+	require.Len(s.T(), validateResponse.TokenData.Scopes, 1)
+	require.Equal(s.T(), []string{"oidc.test.resource"}, validateResponse.TokenData.Scopes[0].Resources)
+
+	// Old secret stops working after regeneration
+	_, err = s.nativeStub.Services.IAM.OIDC.RegenerateClientSecret(ctx, &oidc.RegenerateClientSecretRequest{Namespace: s.namespaceName, Uuid: createResponse.Client.Uuid})
+	require.Nil(s.T(), err)
+	exchangeResponse, err = s.nativeStub.Services.IAM.OIDC.ExchangeToken(ctx, &oidc.ExchangeTokenRequest{
+		GrantType:    "client_credentials",
+		ClientId:     clientID,
+		ClientSecret: createResponse.ClientSecret,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), oidc.ExchangeTokenResponse_INVALID_CLIENT, exchangeResponse.Status)
+}
+
+func (s *OIDCTestSuite) TestAuthorizationCodeWithPKCE() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	createResponse, err := s.nativeStub.Services.IAM.OIDC.CreateClient(ctx, &oidc.CreateClientRequest{Namespace: s.namespaceName, Parameters: s.clientParameters(false)})
+	require.Nil(s.T(), err)
+	clientID := createResponse.Client.ClientId
+	redirectURI := createResponse.Client.RedirectURIs[0]
+
+	loginResponse, err := s.nativeStub.Services.IAM.Token.Create(ctx, &token.CreateRequest{
+		Namespace:  s.namespaceName,
+		Identity:   s.identityUUID,
+		Scopes:     []*token.Scope{},
+		Metadata:   "{}",
+		AuthMethod: token.AuthMethod_PASSWORD,
+	})
+	require.Nil(s.T(), err)
+
+	verifier := tools.GetRandomString(50)
+	challengeHash := sha256.Sum256([]byte(verifier))
+	authorizeRequest := &oidc.AuthorizeRequest{
+		AccessToken:         loginResponse.Token,
+		ClientId:            clientID,
+		RedirectURI:         redirectURI,
+		ResponseType:        "code",
+		Scope:               "openid profile offline_access read",
+		CodeChallenge:       base64.RawURLEncoding.EncodeToString(challengeHash[:]),
+		CodeChallengeMethod: "S256",
+		Nonce:               tools.GetRandomString(10),
+	}
+
+	s.Run("Public client must use PKCE", func() {
+		authorizeResponse, err := s.nativeStub.Services.IAM.OIDC.Authorize(ctx, &oidc.AuthorizeRequest{
+			AccessToken:  loginResponse.Token,
+			ClientId:     clientID,
+			RedirectURI:  redirectURI,
+			ResponseType: "code",
+			Scope:        "openid",
+		})
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), oidc.AuthorizeResponse_INVALID_REQUEST, authorizeResponse.Status)
+	})
+
+	s.Run("Consent is required for the first authorization", func() {
+		authorizeResponse, err := s.nativeStub.Services.IAM.OIDC.Authorize(ctx, authorizeRequest)
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), oidc.AuthorizeResponse_CONSENT_REQUIRED, authorizeResponse.Status)
+		require.Len(s.T(), authorizeResponse.ConsentScopes, 3)
+	})
+
+	var code string
+	s.Run("Consent is remembered", func() {
+		authorizeRequest.ConsentGranted = true
+		authorizeResponse, err := s.nativeStub.Services.IAM.OIDC.Authorize(ctx, authorizeRequest)
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), oidc.AuthorizeResponse_OK, authorizeResponse.Status)
+
+		authorizeRequest.ConsentGranted = false
+		authorizeResponse, err = s.nativeStub.Services.IAM.OIDC.Authorize(ctx, authorizeRequest)
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), oidc.AuthorizeResponse_OK, authorizeResponse.Status)
+		code = authorizeResponse.Code
+	})
+
+	s.Run("Code verifier must match", func() {
+		authorizeResponse, err := s.nativeStub.Services.IAM.OIDC.Authorize(ctx, authorizeRequest)
+		require.Nil(s.T(), err)
+		exchangeResponse, err := s.nativeStub.Services.IAM.OIDC.ExchangeToken(ctx, &oidc.ExchangeTokenRequest{
+			GrantType:    "authorization_code",
+			ClientId:     clientID,
+			Code:         authorizeResponse.Code,
+			RedirectURI:  redirectURI,
+			CodeVerifier: tools.GetRandomString(50),
+		})
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), oidc.ExchangeTokenResponse_INVALID_GRANT, exchangeResponse.Status)
+	})
+
+	var refreshToken string
+	s.Run("Code is exchanged for tokens only once", func() {
+		exchangeRequest := &oidc.ExchangeTokenRequest{
+			GrantType:    "authorization_code",
+			ClientId:     clientID,
+			Code:         code,
+			RedirectURI:  redirectURI,
+			CodeVerifier: verifier,
+			Issuer:       "https://openbp.example.com/api/auth/oidc",
+		}
+		exchangeResponse, err := s.nativeStub.Services.IAM.OIDC.ExchangeToken(ctx, exchangeRequest)
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), oidc.ExchangeTokenResponse_OK, exchangeResponse.Status)
+		require.NotEmpty(s.T(), exchangeResponse.AccessToken)
+		require.NotEmpty(s.T(), exchangeResponse.RefreshToken)
+		require.NotEmpty(s.T(), exchangeResponse.IdToken)
+		refreshToken = exchangeResponse.RefreshToken
+
+		parts := strings.Split(exchangeResponse.IdToken, ".")
+		require.Len(s.T(), parts, 3)
+		claimsBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+		require.Nil(s.T(), err)
+		var claims map[string]interface{}
+		require.Nil(s.T(), json.Unmarshal(claimsBytes, &claims))
+		require.Equal(s.T(), exchangeRequest.Issuer, claims["iss"])
+		require.Equal(s.T(), s.identityUUID, claims["sub"])
+		require.Equal(s.T(), clientID, claims["aud"])
+		require.Equal(s.T(), authorizeRequest.Nonce, claims["nonce"])
+		require.NotEmpty(s.T(), claims["name"])
+		require.Equal(s.T(), "id", claims["token_use"])
+
+		// Id token is signed with the same key, but it is not accepted as access token
+		validateResponse, err := s.nativeStub.Services.IAM.Token.Validate(ctx, &token.ValidateRequest{Token: exchangeResponse.IdToken, UseCache: false})
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), token.ValidateResponse_INVALID, validateResponse.Status)
+		introspectResponse, err := s.nativeStub.Services.IAM.Token.Introspect(ctx, &token.IntrospectRequest{Token: exchangeResponse.IdToken})
+		require.Nil(s.T(), err)
+		require.False(s.T(), introspectResponse.Active)
+		revokeResponse, err := s.nativeStub.Services.IAM.Token.Revoke(ctx, &token.RevokeRequest{Token: exchangeResponse.IdToken})
+		require.Nil(s.T(), err)
+		require.False(s.T(), revokeResponse.Revoked)
+
+		exchangeResponse, err = s.nativeStub.Services.IAM.OIDC.ExchangeToken(ctx, exchangeRequest)
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), oidc.ExchangeTokenResponse_INVALID_GRANT, exchangeResponse.Status)
+	})
+
+	s.Run("Refresh token", func() {
+		exchangeResponse, err := s.nativeStub.Services.IAM.OIDC.ExchangeToken(ctx, &oidc.ExchangeTokenRequest{
+			GrantType:    "refresh_token",
+			ClientId:     clientID,
+			RefreshToken: refreshToken,
+		})
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), oidc.ExchangeTokenResponse_OK, exchangeResponse.Status)
+		require.NotEmpty(s.T(), exchangeResponse.AccessToken)
+	})
+
+	s.Run("Revoked consent must be given again", func() {
+		revokeResponse, err := s.nativeStub.Services.IAM.OIDC.RevokeConsent(ctx, &oidc.RevokeConsentRequest{Namespace: s.namespaceName, Identity: s.identityUUID, ClientId: clientID})
+		require.Nil(s.T(), err)
+		require.True(s.T(), revokeResponse.Existed)
+
+		authorizeResponse, err := s.nativeStub.Services.IAM.OIDC.Authorize(ctx, authorizeRequest)
+		require.Nil(s.T(), err)
+		require.Equal(s.T(), oidc.AuthorizeResponse_CONSENT_REQUIRED, authorizeResponse.Status)
+	})
+}
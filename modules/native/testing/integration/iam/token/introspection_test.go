@@ -0,0 +1,149 @@
+package token
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+	"github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type IntrospectionTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *IntrospectionTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *IntrospectionTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestIntrospectionTestSuite(t *testing.T) {
+	suite.Run(t, new(IntrospectionTestSuite))
+}
+
+func (s *IntrospectionTestSuite) createToken(ctx context.Context) *token.CreateResponse {
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	s.T().Cleanup(func() {
+		s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityCreateResponse.Identity.Uuid})
+	})
+
+	tokenCreateResponse, err := s.nativeStub.Services.IAM.Token.Create(ctx, &token.CreateRequest{
+		Namespace: "",
+		Identity:  identityCreateResponse.Identity.Uuid,
+		Scopes:    []*token.Scope{},
+		Metadata:  tools.GetRandomString(20),
+	})
+	require.Nil(s.T(), err)
+	s.T().Cleanup(func() {
+		s.nativeStub.Services.IAM.Token.Delete(context.Background(), &token.DeleteRequest{Namespace: "", Uuid: tokenCreateResponse.TokenData.Uuid})
+	})
+
+	return tokenCreateResponse
+}
+
+func (s *IntrospectionTestSuite) TestIntrospectAndRevoke() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tokenCreateResponse := s.createToken(ctx)
+
+	introspectResponse, err := s.nativeStub.Services.IAM.Token.Introspect(ctx, &token.IntrospectRequest{Token: tokenCreateResponse.Token, UseCache: false})
+	require.Nil(s.T(), err)
+	require.True(s.T(), introspectResponse.Active)
+	require.False(s.T(), introspectResponse.Refresh)
+	require.Equal(s.T(), tokenCreateResponse.TokenData.Uuid, introspectResponse.TokenData.Uuid)
+	require.Equal(s.T(), tokenCreateResponse.TokenData.Identity, introspectResponse.TokenData.Identity)
+	require.True(s.T(), introspectResponse.ExpiresAt.AsTime().After(time.Now()))
+
+	introspectRefreshResponse, err := s.nativeStub.Services.IAM.Token.Introspect(ctx, &token.IntrospectRequest{Token: tokenCreateResponse.RefreshToken, UseCache: false})
+	require.Nil(s.T(), err)
+	require.True(s.T(), introspectRefreshResponse.Active)
+	require.True(s.T(), introspectRefreshResponse.Refresh)
+
+	revokeResponse, err := s.nativeStub.Services.IAM.Token.Revoke(ctx, &token.RevokeRequest{Token: tokenCreateResponse.RefreshToken})
+	require.Nil(s.T(), err)
+	require.True(s.T(), revokeResponse.Revoked)
+
+	// Access and refresh tokens share the same token record
+	introspectResponse, err = s.nativeStub.Services.IAM.Token.Introspect(ctx, &token.IntrospectRequest{Token: tokenCreateResponse.Token, UseCache: false})
+	require.Nil(s.T(), err)
+	require.False(s.T(), introspectResponse.Active)
+	require.Nil(s.T(), introspectResponse.TokenData)
+
+	revokeResponse, err = s.nativeStub.Services.IAM.Token.Revoke(ctx, &token.RevokeRequest{Token: tokenCreateResponse.Token})
+	require.Nil(s.T(), err)
+	require.False(s.T(), revokeResponse.Revoked)
+}
+
+func (s *IntrospectionTestSuite) TestInvalidToken() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	introspectResponse, err := s.nativeStub.Services.IAM.Token.Introspect(ctx, &token.IntrospectRequest{Token: tools.GetRandomString(50), UseCache: false})
+	require.Nil(s.T(), err)
+	require.False(s.T(), introspectResponse.Active)
+
+	revokeResponse, err := s.nativeStub.Services.IAM.Token.Revoke(ctx, &token.RevokeRequest{Token: tools.GetRandomString(50)})
+	require.Nil(s.T(), err)
+	require.False(s.T(), revokeResponse.Revoked)
+}
+
+func (s *IntrospectionTestSuite) TestTokenCanBeVerifiedWithJWKS() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	tokenCreateResponse := s.createToken(ctx)
+
+	jwksResponse, err := s.nativeStub.Services.IAM.Token.GetJWKS(ctx, &token.GetJWKSRequest{})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), jwksResponse.Keys, 1)
+	jwk := jwksResponse.Keys[0]
+	require.Equal(s.T(), "RSA", jwk.Kty)
+	require.Equal(s.T(), "RS512", jwk.Alg)
+
+	parts := strings.Split(tokenCreateResponse.Token, ".")
+	require.Len(s.T(), parts, 3)
+
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.Nil(s.T(), err)
+	var header map[string]string
+	require.Nil(s.T(), json.Unmarshal(headerBytes, &header))
+	require.Equal(s.T(), jwk.Kid, header["kid"])
+
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	require.Nil(s.T(), err)
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	require.Nil(s.T(), err)
+	publicKey := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.Nil(s.T(), err)
+	hash := sha512.Sum512([]byte(parts[0] + "." + parts[1]))
+	require.Nil(s.T(), rsa.VerifyPKCS1v15(publicKey, crypto.SHA512, hash[:], signature))
+}
@@ -351,3 +351,97 @@ func (s *RefreshTestSuite) TestRefreshInvalidTokenString() {
 	require.Nil(s.T(), err)
 	require.Equal(s.T(), token.RefreshResponse_INVALID, tokenGetResponse.Status)
 }
+
+func (s *RefreshTestSuite) TestRefreshRotatesRefreshToken() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Identity.Delete(ctx, &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityCreateResponse.Identity.Uuid})
+
+	tokenCreateResponse, err := s.nativeStub.Services.IAM.Token.Create(ctx, &token.CreateRequest{
+		Namespace: "",
+		Identity:  identityCreateResponse.Identity.Uuid,
+		Scopes:    []*token.Scope{},
+		Metadata:  tools.GetRandomString(20),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Token.Delete(context.Background(), &token.DeleteRequest{Namespace: "", Uuid: tokenCreateResponse.TokenData.Uuid})
+
+	refreshResponse, err := s.nativeStub.Services.IAM.Token.Refresh(ctx, &token.RefreshRequest{
+		RefreshToken: tokenCreateResponse.RefreshToken,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), token.RefreshResponse_OK, refreshResponse.Status)
+	require.NotEmpty(s.T(), refreshResponse.RefreshToken)
+	require.NotEqual(s.T(), tokenCreateResponse.RefreshToken, refreshResponse.RefreshToken)
+
+	// New refresh token can be used for the next refresh
+	secondRefreshResponse, err := s.nativeStub.Services.IAM.Token.Refresh(ctx, &token.RefreshRequest{
+		RefreshToken: refreshResponse.RefreshToken,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), token.RefreshResponse_OK, secondRefreshResponse.Status)
+
+	validateResponse, err := s.nativeStub.Services.IAM.Token.Validate(ctx, &token.ValidateRequest{
+		Token:    secondRefreshResponse.Token,
+		UseCache: false,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), token.ValidateResponse_OK, validateResponse.Status)
+}
+
+func (s *RefreshTestSuite) TestRefreshTokenReuseDisablesToken() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Identity.Delete(ctx, &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityCreateResponse.Identity.Uuid})
+
+	tokenCreateResponse, err := s.nativeStub.Services.IAM.Token.Create(ctx, &token.CreateRequest{
+		Namespace: "",
+		Identity:  identityCreateResponse.Identity.Uuid,
+		Scopes:    []*token.Scope{},
+		Metadata:  tools.GetRandomString(20),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Token.Delete(context.Background(), &token.DeleteRequest{Namespace: "", Uuid: tokenCreateResponse.TokenData.Uuid})
+
+	refreshResponse, err := s.nativeStub.Services.IAM.Token.Refresh(ctx, &token.RefreshRequest{
+		RefreshToken: tokenCreateResponse.RefreshToken,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), token.RefreshResponse_OK, refreshResponse.Status)
+
+	// Using the same refresh token again must disable the whole token family
+	reuseResponse, err := s.nativeStub.Services.IAM.Token.Refresh(ctx, &token.RefreshRequest{
+		RefreshToken: tokenCreateResponse.RefreshToken,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), token.RefreshResponse_REUSED, reuseResponse.Status)
+
+	validateResponse, err := s.nativeStub.Services.IAM.Token.Validate(ctx, &token.ValidateRequest{
+		Token:    refreshResponse.Token,
+		UseCache: false,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), token.ValidateResponse_DISABLED, validateResponse.Status)
+
+	newRefreshResponse, err := s.nativeStub.Services.IAM.Token.Refresh(ctx, &token.RefreshRequest{
+		RefreshToken: refreshResponse.RefreshToken,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), token.RefreshResponse_DISABLED, newRefreshResponse.Status)
+}
@@ -0,0 +1,148 @@
+package token
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+	"github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type SessionsTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *SessionsTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *SessionsTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestSessionsTestSuite(t *testing.T) {
+	suite.Run(t, new(SessionsTestSuite))
+}
+
+func (s *SessionsTestSuite) createIdentity(ctx context.Context) string {
+	identityCreateResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(20),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	return identityCreateResponse.Identity.Uuid
+}
+
+func (s *SessionsTestSuite) createToken(ctx context.Context, identityUUID string) *token.CreateResponse {
+	tokenCreateResponse, err := s.nativeStub.Services.IAM.Token.Create(ctx, &token.CreateRequest{
+		Namespace: "",
+		Identity:  identityUUID,
+		Scopes:    []*token.Scope{},
+		Metadata:  tools.GetRandomString(20),
+	})
+	require.Nil(s.T(), err)
+	return tokenCreateResponse
+}
+
+func (s *SessionsTestSuite) TestListSessionsTracksActivity() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	identityUUID := s.createIdentity(ctx)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityUUID})
+
+	tokenCreateResponse := s.createToken(ctx, identityUUID)
+	defer s.nativeStub.Services.IAM.Token.Delete(context.Background(), &token.DeleteRequest{Namespace: "", Uuid: tokenCreateResponse.TokenData.Uuid})
+
+	refreshResponse, err := s.nativeStub.Services.IAM.Token.Refresh(ctx, &token.RefreshRequest{
+		RefreshToken: tokenCreateResponse.RefreshToken,
+		Activity:     &token.ActivityContext{SourceIP: "10.0.0.1", UserAgent: "sessions-test"},
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), token.RefreshResponse_OK, refreshResponse.Status)
+
+	listResponse, err := s.nativeStub.Services.IAM.Token.ListSessions(ctx, &token.ListSessionsRequest{Namespace: "", Identity: identityUUID})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), listResponse.Sessions, 1)
+
+	session := listResponse.Sessions[0]
+	require.Equal(s.T(), tokenCreateResponse.TokenData.Uuid, session.TokenData.Uuid)
+	require.Equal(s.T(), tokenCreateResponse.TokenData.CreationMetadata, session.TokenData.CreationMetadata)
+	require.Equal(s.T(), "10.0.0.1", session.LastSourceIP)
+	require.Equal(s.T(), "sessions-test", session.LastUserAgent)
+	require.Equal(s.T(), uint32(1), session.RefreshCount)
+	require.False(s.T(), session.LastActivityAt.AsTime().Before(session.TokenData.CreatedAt.AsTime()))
+}
+
+func (s *SessionsTestSuite) TestRevokeSession() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	identityUUID := s.createIdentity(ctx)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityUUID})
+	otherIdentityUUID := s.createIdentity(ctx)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: otherIdentityUUID})
+
+	tokenCreateResponse := s.createToken(ctx, identityUUID)
+	defer s.nativeStub.Services.IAM.Token.Delete(context.Background(), &token.DeleteRequest{Namespace: "", Uuid: tokenCreateResponse.TokenData.Uuid})
+
+	// Session of another identity must not be revoked
+	revokeResponse, err := s.nativeStub.Services.IAM.Token.RevokeSession(ctx, &token.RevokeSessionRequest{Namespace: "", Identity: otherIdentityUUID, Uuid: tokenCreateResponse.TokenData.Uuid})
+	require.Nil(s.T(), err)
+	require.False(s.T(), revokeResponse.Revoked)
+
+	revokeResponse, err = s.nativeStub.Services.IAM.Token.RevokeSession(ctx, &token.RevokeSessionRequest{Namespace: "", Identity: identityUUID, Uuid: tokenCreateResponse.TokenData.Uuid})
+	require.Nil(s.T(), err)
+	require.True(s.T(), revokeResponse.Revoked)
+
+	validateResponse, err := s.nativeStub.Services.IAM.Token.Validate(ctx, &token.ValidateRequest{Token: tokenCreateResponse.Token, UseCache: true})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), token.ValidateResponse_DISABLED, validateResponse.Status)
+
+	listResponse, err := s.nativeStub.Services.IAM.Token.ListSessions(ctx, &token.ListSessionsRequest{Namespace: "", Identity: identityUUID})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), listResponse.Sessions, 0)
+}
+
+func (s *SessionsTestSuite) TestRevokeAllSessionsExceptCurrent() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	identityUUID := s.createIdentity(ctx)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityUUID})
+
+	tokens := make([]*token.CreateResponse, 3)
+	for i := range tokens {
+		tokens[i] = s.createToken(ctx, identityUUID)
+		defer s.nativeStub.Services.IAM.Token.Delete(context.Background(), &token.DeleteRequest{Namespace: "", Uuid: tokens[i].TokenData.Uuid})
+	}
+
+	revokeResponse, err := s.nativeStub.Services.IAM.Token.RevokeAllSessions(ctx, &token.RevokeAllSessionsRequest{
+		Namespace:     "",
+		Identity:      identityUUID,
+		ExceptSession: tokens[0].TokenData.Uuid,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), uint32(2), revokeResponse.RevokedCount)
+
+	listResponse, err := s.nativeStub.Services.IAM.Token.ListSessions(ctx, &token.ListSessionsRequest{Namespace: "", Identity: identityUUID})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), listResponse.Sessions, 1)
+	require.Equal(s.T(), tokens[0].TokenData.Uuid, listResponse.Sessions[0].TokenData.Uuid)
+
+	validateResponse, err := s.nativeStub.Services.IAM.Token.Validate(ctx, &token.ValidateRequest{Token: tokens[1].Token, UseCache: true})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), token.ValidateResponse_DISABLED, validateResponse.Status)
+}
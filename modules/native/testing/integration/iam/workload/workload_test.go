@@ -0,0 +1,116 @@
+package workload
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/apikey"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/workload"
+	"github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type WorkloadTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *WorkloadTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithIAMService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *WorkloadTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestWorkloadTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkloadTestSuite))
+}
+
+func (s *WorkloadTestSuite) TestEnsureIdentityAndIssueToken() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	service := "test_" + tools.GetRandomString(10)
+	ensureResponse, err := s.nativeStub.Services.IAM.Workload.EnsureIdentity(ctx, &workload.EnsureIdentityRequest{Service: service})
+	require.Nil(s.T(), err)
+	require.True(s.T(), ensureResponse.Created)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: ensureResponse.IdentityUUID})
+
+	ensureAgainResponse, err := s.nativeStub.Services.IAM.Workload.EnsureIdentity(ctx, &workload.EnsureIdentityRequest{Service: service})
+	require.Nil(s.T(), err)
+	require.False(s.T(), ensureAgainResponse.Created)
+	require.Equal(s.T(), ensureResponse.IdentityUUID, ensureAgainResponse.IdentityUUID)
+
+	keyResponse, err := s.nativeStub.Services.IAM.Authentication.APIKey.Create(ctx, &apikey.CreateRequest{
+		Namespace: "",
+		Identity:  ensureResponse.IdentityUUID,
+		Name:      "workload",
+	})
+	require.Nil(s.T(), err)
+
+	tokenResponse, err := s.nativeStub.Services.IAM.Workload.IssueToken(ctx, &workload.IssueTokenRequest{ApiKey: keyResponse.Key})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), workload.IssueTokenResponse_OK, tokenResponse.Status)
+	require.Equal(s.T(), service, tokenResponse.Service)
+	require.NotEmpty(s.T(), tokenResponse.Token)
+	require.True(s.T(), tokenResponse.ExpiresAt.AsTime().After(time.Now()))
+}
+
+func (s *WorkloadTestSuite) TestIssueTokenRequiresServiceIdentity() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	identityResponse, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(10),
+		InitiallyActive: true,
+		Managed:         &identity.CreateIdentityRequest_No{No: &identity.NotManagedData{}},
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.IAM.Identity.Delete(context.Background(), &identity.DeleteIdentityRequest{Namespace: "", Uuid: identityResponse.Identity.Uuid})
+
+	keyResponse, err := s.nativeStub.Services.IAM.Authentication.APIKey.Create(ctx, &apikey.CreateRequest{
+		Namespace: "",
+		Identity:  identityResponse.Identity.Uuid,
+		Name:      "not a workload",
+	})
+	require.Nil(s.T(), err)
+
+	tokenResponse, err := s.nativeStub.Services.IAM.Workload.IssueToken(ctx, &workload.IssueTokenRequest{ApiKey: keyResponse.Key})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), workload.IssueTokenResponse_NOT_SERVICE_IDENTITY, tokenResponse.Status)
+	require.Empty(s.T(), tokenResponse.Token)
+
+	tokenResponse, err = s.nativeStub.Services.IAM.Workload.IssueToken(ctx, &workload.IssueTokenRequest{ApiKey: "obp..bad.key"})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), workload.IssueTokenResponse_INVALID_API_KEY, tokenResponse.Status)
+}
+
+func (s *WorkloadTestSuite) TestCreateIdentityWithWorkloadManagementIsDenied() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	_, err := s.nativeStub.Services.IAM.Identity.Create(ctx, &identity.CreateIdentityRequest{
+		Namespace:       "",
+		Name:            tools.GetRandomString(10),
+		InitiallyActive: true,
+		Managed: &identity.CreateIdentityRequest_Service{Service: &identity.ServiceManagedData{
+			Service:      "test_" + tools.GetRandomString(10),
+			Reason:       "spoofing",
+			ManagementId: "workload",
+		}},
+	})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+}
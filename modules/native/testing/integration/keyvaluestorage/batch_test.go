@@ -0,0 +1,65 @@
+package namespace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
+	tools "github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type BatchKeysTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *BatchKeysTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithKeyValueStorageService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *BatchKeysTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestBatchKeysTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchKeysTestSuite))
+}
+
+func (s *BatchKeysTestSuite) TestBatchSetAndGet() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	key1 := tools.GetRandomString(20)
+	key2 := tools.GetRandomString(20)
+	defer s.nativeStub.Services.Keyvaluestorage.Remove(context.Background(), &keyvaluestorage.RemoveRequest{Namespace: "", Key: key1})
+	defer s.nativeStub.Services.Keyvaluestorage.Remove(context.Background(), &keyvaluestorage.RemoveRequest{Namespace: "", Key: key2})
+
+	_, err := s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{Namespace: "", Key: key1, Value: []byte("old")})
+	require.Nil(s.T(), err)
+
+	setResponse, err := s.nativeStub.Services.Keyvaluestorage.BatchSet(ctx, &keyvaluestorage.BatchSetRequest{Namespace: "", Entries: []*keyvaluestorage.BatchSetRequest_Entry{
+		{Key: key1, Value: []byte("value1")},
+		{Key: key2, Value: []byte("value2")},
+	}})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), []int64{2, 1}, setResponse.Versions)
+
+	getResponse, err := s.nativeStub.Services.Keyvaluestorage.BatchGet(ctx, &keyvaluestorage.BatchGetRequest{Namespace: "", Keys: []string{key1, key2, tools.GetRandomString(20)}})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), getResponse.Entries, 2)
+
+	values := map[string]string{}
+	for _, entry := range getResponse.Entries {
+		values[entry.Key] = string(entry.Value)
+	}
+	require.Equal(s.T(), "value1", values[key1])
+	require.Equal(s.T(), "value2", values[key2])
+}
@@ -0,0 +1,69 @@
+package namespace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
+	tools "github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type CompareAndSwapTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *CompareAndSwapTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithKeyValueStorageService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *CompareAndSwapTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestCompareAndSwapTestSuite(t *testing.T) {
+	suite.Run(t, new(CompareAndSwapTestSuite))
+}
+
+func (s *CompareAndSwapTestSuite) TestSwapsOnlyExpectedVersion() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	key := tools.GetRandomString(20)
+	defer s.nativeStub.Services.Keyvaluestorage.Remove(context.Background(), &keyvaluestorage.RemoveRequest{Namespace: "", Key: key})
+
+	// Version 0 creates the key only if it doesnt exist
+	r, err := s.nativeStub.Services.Keyvaluestorage.CompareAndSwap(ctx, &keyvaluestorage.CompareAndSwapRequest{Namespace: "", Key: key, ExpectedVersion: 0, Value: []byte("1")})
+	require.Nil(s.T(), err)
+	require.True(s.T(), r.Swapped)
+	require.Equal(s.T(), int64(1), r.Version)
+
+	r, err = s.nativeStub.Services.Keyvaluestorage.CompareAndSwap(ctx, &keyvaluestorage.CompareAndSwapRequest{Namespace: "", Key: key, ExpectedVersion: 0, Value: []byte("other")})
+	require.Nil(s.T(), err)
+	require.False(s.T(), r.Swapped)
+	require.Equal(s.T(), int64(1), r.Version)
+
+	r, err = s.nativeStub.Services.Keyvaluestorage.CompareAndSwap(ctx, &keyvaluestorage.CompareAndSwapRequest{Namespace: "", Key: key, ExpectedVersion: 1, Value: []byte("2")})
+	require.Nil(s.T(), err)
+	require.True(s.T(), r.Swapped)
+	require.Equal(s.T(), int64(2), r.Version)
+
+	// Stale version is rejected
+	r, err = s.nativeStub.Services.Keyvaluestorage.CompareAndSwap(ctx, &keyvaluestorage.CompareAndSwapRequest{Namespace: "", Key: key, ExpectedVersion: 1, Value: []byte("3")})
+	require.Nil(s.T(), err)
+	require.False(s.T(), r.Swapped)
+	require.Equal(s.T(), int64(2), r.Version)
+
+	getResponse, err := s.nativeStub.Services.Keyvaluestorage.Get(ctx, &keyvaluestorage.GetRequest{Namespace: "", Key: key, UseCache: true})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), "2", string(getResponse.Value))
+	require.Equal(s.T(), int64(2), getResponse.Version)
+}
@@ -0,0 +1,69 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
+	tools "github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type ListKeysTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *ListKeysTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithKeyValueStorageService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *ListKeysTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestListKeysTestSuite(t *testing.T) {
+	suite.Run(t, new(ListKeysTestSuite))
+}
+
+func (s *ListKeysTestSuite) TestListsByPrefixWithPagination() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	// Prefix contains regex symbols to make sure they are escaped
+	prefix := tools.GetRandomString(10) + ".*/"
+	entries := []*keyvaluestorage.BatchSetRequest_Entry{}
+	for i := 0; i < 5; i++ {
+		key := fmt.Sprintf("%s%d", prefix, i)
+		entries = append(entries, &keyvaluestorage.BatchSetRequest_Entry{Key: key, Value: []byte(key)})
+		defer s.nativeStub.Services.Keyvaluestorage.Remove(context.Background(), &keyvaluestorage.RemoveRequest{Namespace: "", Key: key})
+	}
+	otherKey := tools.GetRandomString(10) + "_other"
+	entries = append(entries, &keyvaluestorage.BatchSetRequest_Entry{Key: otherKey, Value: []byte("other")})
+	defer s.nativeStub.Services.Keyvaluestorage.Remove(context.Background(), &keyvaluestorage.RemoveRequest{Namespace: "", Key: otherKey})
+
+	_, err := s.nativeStub.Services.Keyvaluestorage.BatchSet(ctx, &keyvaluestorage.BatchSetRequest{Namespace: "", Entries: entries})
+	require.Nil(s.T(), err)
+
+	firstPage, err := s.nativeStub.Services.Keyvaluestorage.List(ctx, &keyvaluestorage.ListRequest{Namespace: "", Prefix: prefix, Limit: 3})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), firstPage.Entries, 3)
+	require.Equal(s.T(), prefix+"0", firstPage.Entries[0].Key)
+	require.Equal(s.T(), []byte(prefix+"0"), firstPage.Entries[0].Value)
+	require.NotEmpty(s.T(), firstPage.NextCursor)
+
+	secondPage, err := s.nativeStub.Services.Keyvaluestorage.List(ctx, &keyvaluestorage.ListRequest{Namespace: "", Prefix: prefix, Limit: 3, Cursor: firstPage.NextCursor, KeysOnly: true})
+	require.Nil(s.T(), err)
+	require.Len(s.T(), secondPage.Entries, 2)
+	require.Equal(s.T(), prefix+"3", secondPage.Entries[0].Key)
+	require.Empty(s.T(), secondPage.Entries[0].Value)
+	require.Empty(s.T(), secondPage.NextCursor)
+}
@@ -0,0 +1,84 @@
+package namespace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
+	tools "github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type TTLKeyTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *TTLKeyTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithKeyValueStorageService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *TTLKeyTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestTTLKeyTestSuite(t *testing.T) {
+	suite.Run(t, new(TTLKeyTestSuite))
+}
+
+func (s *TTLKeyTestSuite) TestKeyExpires() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	key := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{Namespace: "", Key: key, Value: []byte("value"), Ttl: 1})
+	defer s.nativeStub.Services.Keyvaluestorage.Remove(context.Background(), &keyvaluestorage.RemoveRequest{Namespace: "", Key: key})
+	require.Nil(s.T(), err)
+
+	r, err := s.nativeStub.Services.Keyvaluestorage.Get(ctx, &keyvaluestorage.GetRequest{Namespace: "", Key: key, UseCache: true})
+	require.Nil(s.T(), err)
+	require.NotNil(s.T(), r.ExpireAt)
+
+	time.Sleep(time.Millisecond * 1500)
+
+	_, err = s.nativeStub.Services.Keyvaluestorage.Get(ctx, &keyvaluestorage.GetRequest{Namespace: "", Key: key, UseCache: true})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.NotFound, status.Code(err))
+
+	existResponse, err := s.nativeStub.Services.Keyvaluestorage.Exist(ctx, &keyvaluestorage.ExistRequest{Namespace: "", Key: key, UseCache: false})
+	require.Nil(s.T(), err)
+	require.False(s.T(), existResponse.Exist)
+
+	// Expired key doesnt block new one
+	setResponse, err := s.nativeStub.Services.Keyvaluestorage.SetIfNotExist(ctx, &keyvaluestorage.SetIfNotExistRequest{Namespace: "", Key: key, Value: []byte("new")})
+	require.Nil(s.T(), err)
+	require.True(s.T(), setResponse.Seted)
+}
+
+func (s *TTLKeyTestSuite) TestSetWithoutTTLRemovesExpiration() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	key := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{Namespace: "", Key: key, Value: []byte("value"), Ttl: 1})
+	defer s.nativeStub.Services.Keyvaluestorage.Remove(context.Background(), &keyvaluestorage.RemoveRequest{Namespace: "", Key: key})
+	require.Nil(s.T(), err)
+
+	_, err = s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{Namespace: "", Key: key, Value: []byte("value")})
+	require.Nil(s.T(), err)
+
+	time.Sleep(time.Millisecond * 1500)
+
+	r, err := s.nativeStub.Services.Keyvaluestorage.Get(ctx, &keyvaluestorage.GetRequest{Namespace: "", Key: key})
+	require.Nil(s.T(), err)
+	require.Nil(s.T(), r.ExpireAt)
+}
@@ -0,0 +1,176 @@
+package namespace
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	tools "github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type ArchiveNamespaceTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *ArchiveNamespaceTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithKeyValueStorageService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *ArchiveNamespaceTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestArchiveNamespaceTestSuite(t *testing.T) {
+	suite.Run(t, new(ArchiveNamespaceTestSuite))
+}
+
+func (s *ArchiveNamespaceTestSuite) export(ctx context.Context, name string) (*namespace.NamespaceArchiveHeader, []*namespace.NamespaceArchiveRecord) {
+	stream, err := s.nativeStub.Services.Namespace.Export(ctx, &namespace.ExportNamespaceRequest{Name: name})
+	require.Nil(s.T(), err)
+
+	var header *namespace.NamespaceArchiveHeader
+	records := []*namespace.NamespaceArchiveRecord{}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(s.T(), err)
+		if response.GetHeader() != nil {
+			header = response.GetHeader()
+		} else {
+			records = append(records, response.GetRecord())
+		}
+	}
+	require.NotNil(s.T(), header)
+	return header, records
+}
+
+func (s *ArchiveNamespaceTestSuite) importArchive(ctx context.Context, name string, header *namespace.NamespaceArchiveHeader, records []*namespace.NamespaceArchiveRecord) (*namespace.ImportNamespaceResponse, error) {
+	stream, err := s.nativeStub.Services.Namespace.Import(ctx)
+	require.Nil(s.T(), err)
+
+	err = stream.Send(&namespace.ImportNamespaceRequest{Payload: &namespace.ImportNamespaceRequest_Start{Start: &namespace.ImportNamespaceStart{
+		Name:   name,
+		Header: header,
+	}}})
+	if err != nil {
+		return stream.CloseAndRecv()
+	}
+	for _, record := range records {
+		err = stream.Send(&namespace.ImportNamespaceRequest{Payload: &namespace.ImportNamespaceRequest_Record{Record: record}})
+		if err != nil {
+			return stream.CloseAndRecv()
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+func (s *ArchiveNamespaceTestSuite) TestImportRestoresExportedData() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	sourceName := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        sourceName,
+		FullName:    tools.GetRandomString(30),
+		Description: tools.GetRandomString(30),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: sourceName})
+
+	key := tools.GetRandomString(10)
+	value := []byte(tools.GetRandomString(50))
+	_, err = s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{
+		Namespace: sourceName,
+		Key:       key,
+		Value:     value,
+	})
+	require.Nil(s.T(), err)
+
+	header, records := s.export(ctx, sourceName)
+	require.Equal(s.T(), sourceName, header.Namespace.Name)
+	require.Contains(s.T(), header.Sections, "native_keyvaluestorage")
+	require.NotEmpty(s.T(), records)
+
+	targetName := tools.GetRandomString(20)
+	response, err := s.importArchive(ctx, targetName, header, records)
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: targetName})
+	require.Equal(s.T(), targetName, response.Namespace.Name)
+	require.Equal(s.T(), header.Namespace.FullName, response.Namespace.FullName)
+	require.Equal(s.T(), namespace.Namespace_ACTIVE, response.Namespace.State)
+	require.Equal(s.T(), uint64(len(records)), response.Imported)
+
+	existsResponse, err := s.nativeStub.Services.Namespace.Exists(ctx, &namespace.IsNamespaceExistRequest{Name: targetName})
+	require.Nil(s.T(), err)
+	require.True(s.T(), existsResponse.Exist)
+
+	getResponse, err := s.nativeStub.Services.Keyvaluestorage.Get(ctx, &keyvaluestorage.GetRequest{
+		Namespace: targetName,
+		Key:       key,
+		UseCache:  false,
+	})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), value, getResponse.Value)
+}
+
+func (s *ArchiveNamespaceTestSuite) TestImportFailsForExistingNamespace() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	name := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        name,
+		FullName:    tools.GetRandomString(30),
+		Description: tools.GetRandomString(30),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: name})
+
+	header, records := s.export(ctx, name)
+	_, err = s.importArchive(ctx, name, header, records)
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.AlreadyExists, status.Code(err))
+}
+
+func (s *ArchiveNamespaceTestSuite) TestImportValidatesArchiveVersion() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	name := tools.GetRandomString(20)
+	_, err := s.importArchive(ctx, name, &namespace.NamespaceArchiveHeader{
+		Version:   0,
+		Namespace: &namespace.Namespace{Name: tools.GetRandomString(20)},
+	}, nil)
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+
+	existsResponse, err := s.nativeStub.Services.Namespace.Exists(ctx, &namespace.IsNamespaceExistRequest{Name: name})
+	require.Nil(s.T(), err)
+	require.False(s.T(), existsResponse.Exist)
+}
+
+func (s *ArchiveNamespaceTestSuite) TestExportFailsForNonExistingNamespace() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	stream, err := s.nativeStub.Services.Namespace.Export(ctx, &namespace.ExportNamespaceRequest{Name: tools.GetRandomString(20)})
+	require.Nil(s.T(), err)
+	_, err = stream.Recv()
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.NotFound, status.Code(err))
+}
@@ -134,3 +134,59 @@ func (s *DeleteNamespaceTestSuite) TestRaisesEventOnDeletion() {
 
 	require.Fail(s.T(), "Event wasnt received")
 }
+
+func (s *DeleteNamespaceTestSuite) TestDeletionFinishesAfterCleanup() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	name := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: name, FullName: "", Description: ""})
+	require.Nil(s.T(), err)
+
+	deleteResponse, err := s.nativeStub.Services.Namespace.Delete(ctx, &namespace.DeleteNamespaceRequest{Name: name})
+	require.Nil(s.T(), err)
+	require.True(s.T(), deleteResponse.Existed)
+
+	if !deleteResponse.Finished {
+		existsResponse, err := s.nativeStub.Services.Namespace.Exists(ctx, &namespace.IsNamespaceExistRequest{Name: name, UseCache: false})
+		require.Nil(s.T(), err)
+		require.False(s.T(), existsResponse.Exist)
+
+		_, err = s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: name, FullName: "", Description: ""})
+		require.NotNil(s.T(), err)
+	}
+
+	for {
+		_, err = s.nativeStub.Services.Namespace.Get(ctx, &namespace.GetNamespaceRequest{Name: name, UseCache: false})
+		if err != nil {
+			require.Equal(s.T(), codes.NotFound, status.Code(err))
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			require.Fail(s.T(), "Deletion wasnt finished")
+			return
+		case <-time.After(time.Millisecond * 200):
+		}
+	}
+}
+
+func (s *DeleteNamespaceTestSuite) TestDeletionStatusRequiresDeletingNamespace() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	name := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: name, FullName: "", Description: ""})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: name})
+
+	_, err = s.nativeStub.Services.Namespace.GetDeletionStatus(ctx, &namespace.GetNamespaceDeletionStatusRequest{Name: name})
+	require.Equal(s.T(), codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.nativeStub.Services.Namespace.RetryDeletion(ctx, &namespace.RetryNamespaceDeletionRequest{Name: name})
+	require.Equal(s.T(), codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.nativeStub.Services.Namespace.GetDeletionStatus(ctx, &namespace.GetNamespaceDeletionStatusRequest{Name: tools.GetRandomString(20)})
+	require.Equal(s.T(), codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,140 @@
+package namespace
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	tools "github.com/slamy-solutions/openbp/modules/native/testing/tools"
+)
+
+type LifecycleNamespaceTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+}
+
+func (suite *LifecycleNamespaceTestSuite) SetupSuite() {
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *LifecycleNamespaceTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+}
+func TestLifecycleNamespaceTestSuite(t *testing.T) {
+	suite.Run(t, new(LifecycleNamespaceTestSuite))
+}
+
+func (s *LifecycleNamespaceTestSuite) listNames(ctx context.Context, selector string) map[string]struct{} {
+	names := map[string]struct{}{}
+	stream, err := s.nativeStub.Services.Namespace.GetAll(ctx, &namespace.GetAllNamespacesRequest{LabelSelector: selector})
+	require.Nil(s.T(), err)
+	for {
+		data, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			require.Fail(s.T(), "Failed to get namespaces from service: "+err.Error())
+		}
+		names[data.Namespace.Name] = struct{}{}
+	}
+	return names
+}
+
+func (s *LifecycleNamespaceTestSuite) TestLabelSelectorFiltersNamespaces() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	team := tools.GetRandomString(10)
+	goldName := tools.GetRandomString(20)
+	silverName := tools.GetRandomString(20)
+
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: goldName, Labels: map[string]string{"team": team, "tier": "gold"}})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: goldName})
+	_, err = s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: silverName, Labels: map[string]string{"team": team}})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: silverName})
+
+	names := s.listNames(ctx, "team="+team)
+	require.Len(s.T(), names, 2)
+
+	names = s.listNames(ctx, "team=="+team+",tier=gold")
+	require.Len(s.T(), names, 1)
+	require.Contains(s.T(), names, goldName)
+
+	names = s.listNames(ctx, "team="+team+",!tier")
+	require.Len(s.T(), names, 1)
+	require.Contains(s.T(), names, silverName)
+
+	names = s.listNames(ctx, "team="+team+",tier!=gold")
+	require.Len(s.T(), names, 1)
+	require.Contains(s.T(), names, silverName)
+
+	// Labels are replaced
+	setResponse, err := s.nativeStub.Services.Namespace.SetLabels(ctx, &namespace.SetNamespaceLabelsRequest{Name: silverName, Labels: map[string]string{"team": team, "tier": "gold"}})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), "gold", setResponse.Namespace.Labels["tier"])
+
+	names = s.listNames(ctx, "team="+team+",tier")
+	require.Len(s.T(), names, 2)
+}
+
+func (s *LifecycleNamespaceTestSuite) TestInvalidLabelsAreRejected() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	name := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: name, Labels: map[string]string{"bad.key": "value"}})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+
+	stream, err := s.nativeStub.Services.Namespace.GetAll(ctx, &namespace.GetAllNamespacesRequest{LabelSelector: "bad$key=1"})
+	require.Nil(s.T(), err)
+	_, err = stream.Recv()
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+}
+
+func (s *LifecycleNamespaceTestSuite) TestSuspendedNamespaceStillExists() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+
+	name := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{Name: name})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: name})
+
+	stateResponse, err := s.nativeStub.Services.Namespace.SetState(ctx, &namespace.SetNamespaceStateRequest{Name: name, State: namespace.Namespace_SUSPENDED, Reason: "not paid"})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), namespace.Namespace_SUSPENDED, stateResponse.Namespace.State)
+	require.Equal(s.T(), "not paid", stateResponse.Namespace.StateReason)
+
+	existsResponse, err := s.nativeStub.Services.Namespace.Exists(ctx, &namespace.IsNamespaceExistRequest{Name: name, UseCache: true})
+	require.Nil(s.T(), err)
+	require.True(s.T(), existsResponse.Exist)
+
+	getResponse, err := s.nativeStub.Services.Namespace.Get(ctx, &namespace.GetNamespaceRequest{Name: name, UseCache: true})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), namespace.Namespace_SUSPENDED, getResponse.Namespace.State)
+
+	_, err = s.nativeStub.Services.Namespace.SetState(ctx, &namespace.SetNamespaceStateRequest{Name: name, State: namespace.Namespace_DELETING})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+
+	stateResponse, err = s.nativeStub.Services.Namespace.SetState(ctx, &namespace.SetNamespaceStateRequest{Name: name, State: namespace.Namespace_ACTIVE})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), namespace.Namespace_ACTIVE, stateResponse.Namespace.State)
+}
@@ -0,0 +1,178 @@
+package namespace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/keyvaluestorage"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/namespace"
+	tools "github.com/slamy-solutions/openbp/modules/native/testing/tools"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
+)
+
+type QuotaNamespaceTestSuite struct {
+	suite.Suite
+
+	nativeStub *native.NativeStub
+	systemStub *system.SystemStub
+}
+
+func (suite *QuotaNamespaceTestSuite) SetupSuite() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	suite.nativeStub = native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithKeyValueStorageService())
+	err := suite.nativeStub.Connect()
+	if err != nil {
+		panic(err)
+	}
+
+	suite.systemStub = system.NewSystemStub(system.NewSystemStubConfig().WithDB())
+	err = suite.systemStub.Connect(ctx)
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *QuotaNamespaceTestSuite) TearDownSuite() {
+	suite.nativeStub.Close()
+	suite.systemStub.Close(context.Background())
+}
+func TestQuotaNamespaceTestSuite(t *testing.T) {
+	suite.Run(t, new(QuotaNamespaceTestSuite))
+}
+
+func (s *QuotaNamespaceTestSuite) TestKeysQuotaIsEnforced() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	name := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        name,
+		FullName:    tools.GetRandomString(30),
+		Description: tools.GetRandomString(30),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: name})
+
+	_, err = s.nativeStub.Services.Namespace.SetQuota(ctx, &namespace.SetNamespaceQuotaRequest{
+		Name:  name,
+		Quota: &namespace.NamespaceQuota{KeyvaluestorageKeys: 1},
+	})
+	require.Nil(s.T(), err)
+
+	firstKey := tools.GetRandomString(10)
+	secondKey := tools.GetRandomString(10)
+	_, err = s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{Namespace: name, Key: firstKey, Value: []byte("1")})
+	require.Nil(s.T(), err)
+
+	_, err = s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{Namespace: name, Key: secondKey, Value: []byte("2")})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.ResourceExhausted, status.Code(err))
+
+	// Existing key can be overwritten
+	_, err = s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{Namespace: name, Key: firstKey, Value: []byte("3")})
+	require.Nil(s.T(), err)
+
+	quotaResponse, err := s.nativeStub.Services.Namespace.GetQuota(ctx, &namespace.GetNamespaceQuotaRequest{Name: name})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), uint64(1), quotaResponse.Quota.KeyvaluestorageKeys)
+	require.Equal(s.T(), uint64(1), quotaResponse.Usage.KeyvaluestorageKeys)
+
+	_, err = s.nativeStub.Services.Keyvaluestorage.Remove(ctx, &keyvaluestorage.RemoveRequest{Namespace: name, Key: firstKey})
+	require.Nil(s.T(), err)
+
+	_, err = s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{Namespace: name, Key: secondKey, Value: []byte("2")})
+	require.Nil(s.T(), err)
+}
+
+func (s *QuotaNamespaceTestSuite) TestExistingResourcesAreCounted() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	name := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        name,
+		FullName:    tools.GetRandomString(30),
+		Description: tools.GetRandomString(30),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: name})
+
+	for i := 0; i < 2; i++ {
+		_, err = s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{Namespace: name, Key: tools.GetRandomString(10), Value: []byte("1")})
+		require.Nil(s.T(), err)
+	}
+	// Keys were created before the quotas were introduced, so their usage was never reserved
+	require.Nil(s.T(), quota.Remove(ctx, s.systemStub.DB, name))
+
+	_, err = s.nativeStub.Services.Namespace.SetQuota(ctx, &namespace.SetNamespaceQuotaRequest{
+		Name:  name,
+		Quota: &namespace.NamespaceQuota{KeyvaluestorageKeys: 2},
+	})
+	require.Nil(s.T(), err)
+
+	quotaResponse, err := s.nativeStub.Services.Namespace.GetQuota(ctx, &namespace.GetNamespaceQuotaRequest{Name: name})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), uint64(2), quotaResponse.Usage.KeyvaluestorageKeys)
+
+	_, err = s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{Namespace: name, Key: tools.GetRandomString(10), Value: []byte("3")})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.ResourceExhausted, status.Code(err))
+}
+
+func (s *QuotaNamespaceTestSuite) TestZeroLimitRemovesQuota() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	name := tools.GetRandomString(20)
+	_, err := s.nativeStub.Services.Namespace.Create(ctx, &namespace.CreateNamespaceRequest{
+		Name:        name,
+		FullName:    tools.GetRandomString(30),
+		Description: tools.GetRandomString(30),
+	})
+	require.Nil(s.T(), err)
+	defer s.nativeStub.Services.Namespace.Delete(context.Background(), &namespace.DeleteNamespaceRequest{Name: name})
+
+	_, err = s.nativeStub.Services.Namespace.SetQuota(ctx, &namespace.SetNamespaceQuotaRequest{
+		Name:  name,
+		Quota: &namespace.NamespaceQuota{KeyvaluestorageKeys: 1, IamIdentities: 5},
+	})
+	require.Nil(s.T(), err)
+	_, err = s.nativeStub.Services.Namespace.SetQuota(ctx, &namespace.SetNamespaceQuotaRequest{
+		Name:  name,
+		Quota: &namespace.NamespaceQuota{IamIdentities: 5},
+	})
+	require.Nil(s.T(), err)
+
+	for i := 0; i < 3; i++ {
+		_, err = s.nativeStub.Services.Keyvaluestorage.Set(ctx, &keyvaluestorage.SetRequest{Namespace: name, Key: tools.GetRandomString(10), Value: []byte("1")})
+		require.Nil(s.T(), err)
+	}
+
+	quotaResponse, err := s.nativeStub.Services.Namespace.GetQuota(ctx, &namespace.GetNamespaceQuotaRequest{Name: name})
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), uint64(0), quotaResponse.Quota.KeyvaluestorageKeys)
+	require.Equal(s.T(), uint64(5), quotaResponse.Quota.IamIdentities)
+	require.Equal(s.T(), uint64(3), quotaResponse.Usage.KeyvaluestorageKeys)
+}
+
+func (s *QuotaNamespaceTestSuite) TestQuotaOfNonExistingNamespace() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	_, err := s.nativeStub.Services.Namespace.GetQuota(ctx, &namespace.GetNamespaceQuotaRequest{Name: tools.GetRandomString(20)})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.NotFound, status.Code(err))
+
+	_, err = s.nativeStub.Services.Namespace.SetQuota(ctx, &namespace.SetNamespaceQuotaRequest{Name: tools.GetRandomString(20), Quota: &namespace.NamespaceQuota{}})
+	require.NotNil(s.T(), err)
+	require.Equal(s.T(), codes.NotFound, status.Code(err))
+}
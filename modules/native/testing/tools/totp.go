@@ -0,0 +1,28 @@
+package tools
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// Generates TOTP code the same way authenticator applications do. Offset shifts the time step relatively to the current one.
+func GenerateTOTPCode(secret string, offset int64) string {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		panic(err)
+	}
+
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(time.Now().Unix()/30+offset))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	position := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[position:position+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", value%1000000)
+}
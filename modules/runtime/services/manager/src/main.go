@@ -65,6 +65,12 @@ func main() {
 	rpc := rpcServer.NewManagerRPCServer(logger.With(slog.String("server", "rpc")), systemStub)
 	rpcRPC.RegisterRPCServiceServer(grpcServer, rpc)
 
+	eventHandler, err := NewEventHandler(logger.With(slog.String("service", "event_handler")), systemStub)
+	if err != nil {
+		panic("Failed to create event handler: " + err.Error())
+	}
+	defer eventHandler.Close()
+
 	fmt.Println("Start listening for gRPC connections")
 	lis, err := net.Listen("tcp", ":80")
 	if err != nil {
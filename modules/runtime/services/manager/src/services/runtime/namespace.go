@@ -0,0 +1,54 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/golang/protobuf/proto"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Deletes all the runtimes of the deleted namespace together with their binaries
+func DeleteRuntimesOfNamespace(ctx context.Context, logger *slog.Logger, systemStub *system.SystemStub, namespace string) error {
+	collection := GetRuntimeCollection(systemStub)
+
+	cursor, err := collection.Find(ctx, bson.M{"namespace": namespace})
+	if err != nil {
+		return errors.Join(errors.New("failed to search for runtimes of the namespace"), err)
+	}
+	var runtimes []RuntimeInMongo
+	if err := cursor.All(ctx, &runtimes); err != nil {
+		return errors.Join(errors.New("failed to read runtimes of the namespace"), err)
+	}
+
+	bucket, err := GetRuntimeDataBucket(namespace, systemStub)
+	if err != nil {
+		return errors.Join(errors.New("failed to get runtime data bucket"), err)
+	}
+	err = bucket.Drop()
+	if err != nil {
+		return errors.Join(errors.New("failed to drop runtime data bucket"), err)
+	}
+
+	_, err = collection.DeleteMany(ctx, bson.M{"namespace": namespace})
+	if err != nil {
+		return errors.Join(errors.New("failed to delete runtimes of the namespace"), err)
+	}
+
+	// Running instances of the runtimes must be stopped
+	for _, runtime := range runtimes {
+		runtimeAsBinary, err := proto.Marshal(runtime.ToGRPCRuntime())
+		if err != nil {
+			logger.Error(errors.Join(errors.New("failed to publish runtime deleted event. failed to marshal runtime"), err).Error())
+			continue
+		}
+		err = systemStub.Nats.Publish(runtimeDeletedEventName, runtimeAsBinary)
+		if err != nil {
+			logger.Error(errors.Join(errors.New("failed to publish runtime deleted event"), err).Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	system_nats "github.com/slamy-solutions/openbp/modules/system/libs/golang/nats"
+)
+
+const (
+	// JetStream stream where all the audit records are published
+	STREAM_NAME = "system_audit"
+	// Records are published to "<prefix><service>"
+	SUBJECT_PREFIX = "system.audit.record."
+
+	// Records are kept in the stream until they are stored by the audit service. This is only upper limit for the case when audit service is down
+	stream_max_age = time.Hour * 24 * 7
+)
+
+// gRPC metadata keys used to pass information about the actor from the API gateways to the services
+const (
+	METADATA_ACTOR_NAMESPACE = "openbp-actor-namespace"
+	METADATA_ACTOR_IDENTITY  = "openbp-actor-identity"
+	METADATA_ACTOR_TOKEN     = "openbp-actor-token"
+	METADATA_SOURCE_IP       = "openbp-source-ip"
+	METADATA_USER_AGENT      = "openbp-user-agent"
+	METADATA_REQUEST_ID      = "openbp-request-id"
+)
+
+// Adds actor and request information to the outgoing gRPC metadata. All the gRPC calls made with returned context will be attributed to this actor.
+func WithActor(ctx context.Context, actor *Actor, requestMetadata *RequestMetadata) context.Context {
+	pairs := []string{}
+	if actor != nil {
+		pairs = append(pairs,
+			METADATA_ACTOR_NAMESPACE, actor.Namespace,
+			METADATA_ACTOR_IDENTITY, actor.Identity,
+			METADATA_ACTOR_TOKEN, actor.Token,
+		)
+	}
+	if requestMetadata != nil {
+		pairs = append(pairs,
+			METADATA_SOURCE_IP, requestMetadata.SourceIP,
+			METADATA_USER_AGENT, requestMetadata.UserAgent,
+			METADATA_REQUEST_ID, requestMetadata.RequestId,
+		)
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Extracts actor and request information from the incoming gRPC metadata. Actor is empty if the call was not made on behalf of the identity.
+func ActorFromIncomingContext(ctx context.Context) (*Actor, *RequestMetadata) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return &Actor{}, &RequestMetadata{}
+	}
+
+	actor := &Actor{
+		Namespace: firstMetadataValue(md, METADATA_ACTOR_NAMESPACE),
+		Identity:  firstMetadataValue(md, METADATA_ACTOR_IDENTITY),
+		Token:     firstMetadataValue(md, METADATA_ACTOR_TOKEN),
+	}
+	requestMetadata := &RequestMetadata{
+		SourceIP:  firstMetadataValue(md, METADATA_SOURCE_IP),
+		UserAgent: firstMetadataValue(md, METADATA_USER_AGENT),
+		RequestId: firstMetadataValue(md, METADATA_REQUEST_ID),
+	}
+	return actor, requestMetadata
+}
+
+// Creates audit stream if it doesnt exist
+func EnsureStream(jetstream nats.JetStreamContext) error {
+	_, err := jetstream.AddStream(&nats.StreamConfig{
+		Name:      STREAM_NAME,
+		Subjects:  []string{SUBJECT_PREFIX + ">"},
+		Retention: nats.LimitsPolicy,
+		Storage:   nats.FileStorage,
+		MaxAge:    stream_max_age,
+	})
+	if err != nil && !errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+		return err
+	}
+	return nil
+}
+
+// Publishes audit records of the service
+type Publisher struct {
+	service   string
+	jetstream nats.JetStreamContext
+}
+
+// Creates publisher for the service. Service name will be added to all the records.
+func NewPublisher(natsClient *nats.Conn, service string) (*Publisher, error) {
+	jetstream, err := natsClient.JetStream()
+	if err != nil {
+		return nil, errors.New("failed to create jetstream context: " + err.Error())
+	}
+	if err := EnsureStream(jetstream); err != nil {
+		return nil, errors.New("failed to ensure audit stream: " + err.Error())
+	}
+
+	return &Publisher{service: service, jetstream: jetstream}, nil
+}
+
+// Change of the resource that will be recorded
+type Change struct {
+	Namespace     string
+	Resource      string
+	Action        string
+	BeforeVersion uint64
+	AfterVersion  uint64
+	Details       string
+}
+
+// Publishes record about the change. Actor and request information are taken from the incoming gRPC metadata of the context.
+// Waits until JetStream stores the record, so the record is not lost after successful return.
+func (p *Publisher) Publish(ctx context.Context, change Change) error {
+	idBytes := make([]byte, 16)
+	if _, err := rand.Read(idBytes); err != nil {
+		return errors.New("failed to generate record UUID: " + err.Error())
+	}
+
+	actor, requestMetadata := ActorFromIncomingContext(ctx)
+	record := &Record{
+		Uuid:            hex.EncodeToString(idBytes),
+		Namespace:       change.Namespace,
+		Timestamp:       timestamppb.New(time.Now().UTC()),
+		Service:         p.service,
+		Actor:           actor,
+		Resource:        change.Resource,
+		Action:          change.Action,
+		BeforeVersion:   change.BeforeVersion,
+		AfterVersion:    change.AfterVersion,
+		RequestMetadata: requestMetadata,
+		Details:         change.Details,
+	}
+	data, err := proto.Marshal(record)
+	if err != nil {
+		return errors.New("failed to marshal record: " + err.Error())
+	}
+
+	msg := nats.NewMsg(SUBJECT_PREFIX + p.service)
+	msg.Data = data
+	system_nats.InjectTelemetryContext(ctx, msg)
+	// Message ID allows JetStream to deduplicate records if publishing is retried
+	if _, err := p.jetstream.PublishMsg(msg, nats.MsgId(record.Uuid), nats.Context(ctx)); err != nil {
+		return errors.New("failed to publish record: " + err.Error())
+	}
+	return nil
+}
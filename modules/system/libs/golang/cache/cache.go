@@ -20,6 +20,7 @@ type Cache interface {
 	Set(ctx context.Context, key string, value []byte, expiration time.Duration) error
 	BulkSet(ctx context.Context, entries ...CacheEntry) error
 	Remove(ctx context.Context, keys ...string) error
+	RemoveByPattern(ctx context.Context, patterns ...string) error
 	Get(ctx context.Context, key string) ([]byte, error)
 	BulkGet(ctx context.Context, keys ...string) ([][]byte, error)
 	Has(ctx context.Context, key string) (bool, error)
@@ -108,6 +109,42 @@ func (c cache) Remove(ctx context.Context, keys ...string) error {
 	return err
 }
 
+// Remove cache for all the keys that match glob-style patterns (for example "native_iam_identity_data_mynamespace_*").
+// Keys are searched with SCAN, so this is slow on big caches. Use it only for rare operations like namespace deletion.
+func (c cache) RemoveByPattern(ctx context.Context, patterns ...string) error {
+	ctx, span := c.tracer.Start(ctx, "cache.removeByPattern")
+	defer span.End()
+	span.SetAttributes(attribute.String("db.type", "redis"))
+
+	for _, pattern := range patterns {
+		iter := c.rdb.Scan(ctx, 0, pattern, 1000).Iterator()
+		keys := make([]string, 0, 100)
+		for iter.Next(ctx) {
+			keys = append(keys, iter.Val())
+			if len(keys) == cap(keys) {
+				if err := c.rdb.Unlink(ctx, keys...).Err(); err != nil {
+					span.SetStatus(codes.Error, err.Error())
+					return err
+				}
+				keys = keys[:0]
+			}
+		}
+		if err := iter.Err(); err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+		if len(keys) != 0 {
+			if err := c.rdb.Unlink(ctx, keys...).Err(); err != nil {
+				span.SetStatus(codes.Error, err.Error())
+				return err
+			}
+		}
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
 // Tries to get cache for key
 func (c cache) Get(ctx context.Context, key string) ([]byte, error) {
 	ctx, span := c.tracer.Start(ctx, "cache.get")
@@ -0,0 +1,418 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/quota"
+)
+
+// Collection of the namespace database that is included in the namespace archive
+type ArchiveCollection struct {
+	// Name of the collection. Can contain placeholders:
+	// "{namespace}" - name of the namespace, "{uuid}" - hex encoded ObjectID (remapped on import), "{name}" - any other text.
+	Name string
+	// Fields that store UUIDs of the objects from the same namespace as hex strings (or arrays of hex strings). Fields are matched by name on any depth.
+	HexReferenceFields []string
+	// Quota usage that is added for every imported document of the collection
+	Quota []ArchiveQuota
+}
+
+type ArchiveQuota struct {
+	Resource quota.Resource
+	// Numeric field of the document with the used amount of the resource. If empty, every document counts as 1.
+	AmountField string
+}
+
+var collectionNamePlaceholderRegex = regexp.MustCompile(`\{(namespace|uuid|name)\}`)
+
+// Returns values of the placeholders in the order they appear in the template
+func (c *ArchiveCollection) match(namespace string, name string) ([]string, bool) {
+	pattern := "^"
+	last := 0
+	for _, loc := range collectionNamePlaceholderRegex.FindAllStringSubmatchIndex(c.Name, -1) {
+		pattern += regexp.QuoteMeta(c.Name[last:loc[0]])
+		switch c.Name[loc[2]:loc[3]] {
+		case "namespace":
+			pattern += "(" + regexp.QuoteMeta(namespace) + ")"
+		case "uuid":
+			pattern += "([0-9a-f]{24})"
+		default:
+			pattern += "(.+)"
+		}
+		last = loc[1]
+	}
+	pattern += regexp.QuoteMeta(c.Name[last:]) + "$"
+
+	matches := regexp.MustCompile(pattern).FindStringSubmatch(name)
+	if matches == nil {
+		return nil, false
+	}
+	return matches[1:], true
+}
+
+// Builds name of the collection in the target namespace
+func (c *ArchiveCollection) remapName(sourceNamespace string, namespace string, name string) (string, bool) {
+	values, ok := c.match(sourceNamespace, name)
+	if !ok {
+		return "", false
+	}
+
+	index := 0
+	result := collectionNamePlaceholderRegex.ReplaceAllStringFunc(c.Name, func(placeholder string) string {
+		value := values[index]
+		index++
+		switch placeholder {
+		case "{namespace}":
+			return namespace
+		case "{uuid}":
+			id, _ := primitive.ObjectIDFromHex(value)
+			return RemapObjectID(sourceNamespace, namespace, id).Hex()
+		default:
+			return value
+		}
+	})
+	return result, true
+}
+
+// Remaps UUID of the object from the source namespace to the UUID of the object in the target namespace.
+// Result is deterministic, so references between the objects (even from the different modules) stay valid without sharing the mapping.
+// Timestamp part of the UUID is preserved. UUID is not changed if namespaces are the same.
+func RemapObjectID(sourceNamespace string, namespace string, id primitive.ObjectID) primitive.ObjectID {
+	if sourceNamespace == namespace {
+		return id
+	}
+
+	hash := sha256.Sum256([]byte(sourceNamespace + "/" + namespace + "/" + id.Hex()))
+	var result primitive.ObjectID
+	copy(result[:4], id[:4])
+	copy(result[4:], hash[:8])
+	return result
+}
+
+func remapHex(sourceNamespace string, namespace string, value string) string {
+	id, err := primitive.ObjectIDFromHex(value)
+	if err != nil {
+		return value
+	}
+	return RemapObjectID(sourceNamespace, namespace, id).Hex()
+}
+
+type documentRemapper struct {
+	sourceNamespace    string
+	namespace          string
+	hexReferenceFields map[string]bool
+}
+
+func (r *documentRemapper) remapValue(key string, value interface{}, remapHexValue bool) interface{} {
+	switch v := value.(type) {
+	case primitive.ObjectID:
+		return RemapObjectID(r.sourceNamespace, r.namespace, v)
+	case string:
+		if remapHexValue {
+			return remapHex(r.sourceNamespace, r.namespace, v)
+		}
+		return v
+	case primitive.D:
+		return r.remapDocument(v)
+	case primitive.A:
+		result := make(primitive.A, len(v))
+		for i, item := range v {
+			result[i] = r.remapValue(key, item, remapHexValue)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// Namespace references are stored as pairs of fields: "namespace" + "uuid" or "<prefix>Namespace" + "<prefix>UUID".
+// Pairs that point to the source namespace are moved to the target namespace. UUIDs of the other pairs are kept.
+func (r *documentRemapper) remapDocument(document primitive.D) primitive.D {
+	uuidFields := map[string]bool{}
+	for _, element := range document {
+		var prefix string
+		if element.Key == "namespace" {
+			prefix = ""
+		} else if strings.HasSuffix(element.Key, "Namespace") {
+			prefix = strings.TrimSuffix(element.Key, "Namespace")
+		} else {
+			continue
+		}
+		namespace, ok := element.Value.(string)
+		if !ok {
+			continue
+		}
+		uuidFields[prefix+"uuid"] = namespace == r.sourceNamespace
+		uuidFields[prefix+"UUID"] = namespace == r.sourceNamespace
+	}
+
+	result := make(primitive.D, 0, len(document))
+	for _, element := range document {
+		remapHexValue, isPairUUID := uuidFields[element.Key]
+		if !isPairUUID {
+			remapHexValue = r.hexReferenceFields[element.Key]
+		}
+
+		isNamespaceField := element.Key == "namespace" || strings.HasSuffix(element.Key, "Namespace")
+		value := element.Value
+		if namespace, ok := value.(string); ok && isNamespaceField {
+			if namespace == r.sourceNamespace {
+				value = r.namespace
+			}
+		} else if !isPairUUID || remapHexValue {
+			// UUIDs of the objects from the other namespaces are kept
+			value = r.remapValue(element.Key, value, remapHexValue)
+		}
+		result = append(result, primitive.E{Key: element.Key, Value: value})
+	}
+	return result
+}
+
+// Dumps and restores collections of the namespace database. Used to implement sections of the namespace archive.
+type NamespaceArchiver struct {
+	client         *mongo.Client
+	databasePrefix string
+	collections    []ArchiveCollection
+
+	collectionNames map[string]string
+	mut             sync.Mutex
+}
+
+func NewNamespaceArchiver(client *mongo.Client, collections ...ArchiveCollection) *NamespaceArchiver {
+	return &NamespaceArchiver{
+		client:          client,
+		databasePrefix:  "openbp_namespace_",
+		collections:     collections,
+		collectionNames: map[string]string{},
+	}
+}
+
+// Use database with another prefix for the namespace data
+func (a *NamespaceArchiver) WithDatabasePrefix(prefix string) *NamespaceArchiver {
+	a.databasePrefix = prefix
+	return a
+}
+
+func (a *NamespaceArchiver) findCollection(namespace string, name string) *ArchiveCollection {
+	for i := range a.collections {
+		if _, ok := a.collections[i].match(namespace, name); ok {
+			return &a.collections[i]
+		}
+	}
+	return nil
+}
+
+// Calls write for every index and document of the archived collections. Indexes of the collection are written before its documents.
+func (a *NamespaceArchiver) Export(ctx context.Context, namespace string, write func(collection string, index bool, data []byte) error) error {
+	db := a.client.Database(a.databasePrefix + namespace)
+
+	names, err := db.ListCollectionNames(ctx, bson.M{"type": "collection"})
+	if err != nil {
+		return errors.New("failed to list collections: " + err.Error())
+	}
+
+	for _, name := range names {
+		if a.findCollection(namespace, name) == nil {
+			continue
+		}
+
+		collection := db.Collection(name)
+		indexCursor, err := collection.Indexes().List(ctx)
+		if err != nil {
+			return errors.New("failed to list indexes of the [" + name + "] collection: " + err.Error())
+		}
+		for indexCursor.Next(ctx) {
+			if indexCursor.Current.Lookup("name").StringValue() == "_id_" {
+				continue
+			}
+			if err := write(name, true, indexCursor.Current); err != nil {
+				indexCursor.Close(ctx)
+				return err
+			}
+		}
+		indexCursor.Close(ctx)
+
+		cursor, err := collection.Find(ctx, bson.M{})
+		if err != nil {
+			return errors.New("failed to read the [" + name + "] collection: " + err.Error())
+		}
+		for cursor.Next(ctx) {
+			if err := write(name, false, cursor.Current); err != nil {
+				cursor.Close(ctx)
+				return err
+			}
+		}
+		err = cursor.Err()
+		cursor.Close(ctx)
+		if err != nil {
+			return errors.New("failed to read the [" + name + "] collection: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Counts quota usage of the existing documents of the archived collections. Amounts are counted the same way as on import.
+// Every resource of the archived collections is present in the result, even if there are no documents.
+func (a *NamespaceArchiver) CountQuotaUsage(ctx context.Context, namespace string) (map[string]int64, error) {
+	usage := map[string]int64{}
+	for _, archiveCollection := range a.collections {
+		for _, q := range archiveCollection.Quota {
+			usage[string(q.Resource)] = 0
+		}
+	}
+
+	db := a.client.Database(a.databasePrefix + namespace)
+	names, err := db.ListCollectionNames(ctx, bson.M{"type": "collection"})
+	if err != nil {
+		return nil, errors.New("failed to list collections: " + err.Error())
+	}
+
+	for _, name := range names {
+		archiveCollection := a.findCollection(namespace, name)
+		if archiveCollection == nil || len(archiveCollection.Quota) == 0 {
+			continue
+		}
+
+		group := bson.M{"_id": nil}
+		for i, q := range archiveCollection.Quota {
+			var amount interface{} = 1
+			if q.AmountField != "" {
+				// Only integer amounts are counted, as on import
+				field := "$" + q.AmountField
+				amount = bson.M{"$cond": bson.A{bson.M{"$in": bson.A{bson.M{"$type": field}, bson.A{"int", "long"}}}, field, 0}}
+			}
+			group["amount"+strconv.Itoa(i)] = bson.M{"$sum": amount}
+		}
+		cursor, err := db.Collection(name).Aggregate(ctx, mongo.Pipeline{{{Key: "$group", Value: group}}})
+		if err != nil {
+			return nil, errors.New("failed to count quota usage of the [" + name + "] collection: " + err.Error())
+		}
+		var results []bson.M
+		if err := cursor.All(ctx, &results); err != nil {
+			return nil, errors.New("failed to count quota usage of the [" + name + "] collection: " + err.Error())
+		}
+		if len(results) == 0 {
+			continue
+		}
+
+		for i, q := range archiveCollection.Quota {
+			switch v := results[0]["amount"+strconv.Itoa(i)].(type) {
+			case int64:
+				usage[string(q.Resource)] += v
+			case int32:
+				usage[string(q.Resource)] += int64(v)
+			}
+		}
+	}
+
+	return usage, nil
+}
+
+// Imports index or document that was exported from the source namespace. UUIDs are remapped if namespaces are different.
+func (a *NamespaceArchiver) Import(ctx context.Context, sourceNamespace string, namespace string, collection string, index bool, data []byte) error {
+	archiveCollection := a.findCollection(sourceNamespace, collection)
+	if archiveCollection == nil {
+		return errors.New("collection [" + collection + "] is not part of the archive")
+	}
+
+	a.mut.Lock()
+	cacheKey := sourceNamespace + "/" + namespace + "/" + collection
+	name, ok := a.collectionNames[cacheKey]
+	if !ok {
+		name, _ = archiveCollection.remapName(sourceNamespace, namespace, collection)
+		a.collectionNames[cacheKey] = name
+	}
+	a.mut.Unlock()
+
+	var document primitive.D
+	if err := bson.Unmarshal(data, &document); err != nil {
+		return errors.New("failed to decode record: " + err.Error())
+	}
+
+	db := a.client.Database(a.databasePrefix + namespace)
+
+	if index {
+		spec := make(primitive.D, 0, len(document))
+		for _, element := range document {
+			if element.Key == "v" || element.Key == "ns" {
+				continue
+			}
+			spec = append(spec, element)
+		}
+		err := db.RunCommand(ctx, bson.D{
+			{Key: "createIndexes", Value: name},
+			{Key: "indexes", Value: bson.A{spec}},
+		}).Err()
+		var commandErr mongo.CommandError
+		if errors.As(err, &commandErr) && (commandErr.Code == 85 || commandErr.Code == 86) {
+			// Index already exists with other options. Index created by the module has priority.
+			return nil
+		}
+		if err != nil {
+			return errors.New("failed to create index: " + err.Error())
+		}
+		return nil
+	}
+
+	hexReferenceFields := map[string]bool{}
+	for _, field := range archiveCollection.HexReferenceFields {
+		hexReferenceFields[field] = true
+	}
+	remapper := &documentRemapper{sourceNamespace: sourceNamespace, namespace: namespace, hexReferenceFields: hexReferenceFields}
+	document = remapper.remapDocument(document)
+
+	var id interface{}
+	for _, element := range document {
+		if element.Key == "_id" {
+			id = element.Value
+		}
+	}
+	if id == nil {
+		_, err := db.Collection(name).InsertOne(ctx, document)
+		if err != nil {
+			return err
+		}
+	} else {
+		result, err := db.Collection(name).ReplaceOne(ctx, bson.M{"_id": id}, document, options.Replace().SetUpsert(true))
+		if err != nil {
+			return err
+		}
+		if result.UpsertedCount == 0 {
+			// Document was imported before. Its usage is already counted
+			return nil
+		}
+	}
+
+	for _, q := range archiveCollection.Quota {
+		amount := int64(1)
+		if q.AmountField != "" {
+			amount = 0
+			for _, element := range document {
+				if element.Key == q.AmountField {
+					switch v := element.Value.(type) {
+					case int64:
+						amount = v
+					case int32:
+						amount = int64(v)
+					}
+				}
+			}
+		}
+		if err := quota.Add(ctx, a.client, namespace, q.Resource, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,3 +4,8 @@ export PATH="$PATH:$(go env GOPATH)/bin"
 echo "Generating proto for vault service"
 mkdir -p ./vault
 protoc --go_out=./vault --go_opt=paths=source_relative --go-grpc_out=./vault --go-grpc_opt=paths=source_relative -I ../../proto vault.proto
+
+# audit
+echo "Generating proto for audit service"
+mkdir -p ./audit
+protoc --go_out=./audit --go_opt=paths=source_relative --go-grpc_out=./audit --go-grpc_opt=paths=source_relative -I ../../proto audit.proto
@@ -3,6 +3,7 @@ package system
 import (
 	"time"
 
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
 	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -35,3 +36,8 @@ func makeGrpcClient[T interface{}](clientFunction func(grpc.ClientConnInterface)
 func NewVaultConnection(address string, opts ...grpc.DialOption) (*grpc.ClientConn, vault.VaultServiceClient, error) {
 	return makeGrpcClient(vault.NewVaultServiceClient, address, opts...)
 }
+
+// Connect to Audit service
+func NewAuditConnection(address string, opts ...grpc.DialOption) (*grpc.ClientConn, audit.AuditServiceClient, error) {
+	return makeGrpcClient(audit.NewAuditServiceClient, address, opts...)
+}
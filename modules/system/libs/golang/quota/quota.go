@@ -0,0 +1,300 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+/*
+	Quotas limit resources that can be used by the namespace. Limits are set by the native_namespace service.
+	Usage counters are maintained by the services that own the resources: usage is reserved before the resource is created and released after it is deleted.
+	Resources that existed before the first limits were set are counted once with SeedUsage.
+	Global namespace ("") doesnt have quotas.
+*/
+
+const (
+	DATABASE_NAME        = "openbp_global"
+	COLLECTION_NAME      = "native_namespace_quota"
+	RATE_COLLECTION_NAME = "native_namespace_quota_rate"
+
+	// Rate windows are stored only for a short time
+	rate_window_ttl = time.Minute
+	// Maximum number of the usage counting attempts when resources are changed concurrently
+	seed_attempts = 5
+)
+
+type Resource string
+
+const (
+	// Total size of the files in the native_storage (in bytes)
+	STORAGE_BYTES Resource = "storageBytes"
+	// Number of the files in the native_storage
+	STORAGE_FILES Resource = "storageFiles"
+	// Number of the identities in the native_iam
+	IAM_IDENTITIES Resource = "iamIdentities"
+	// Number of the devices in the iot_core
+	IOT_DEVICES Resource = "iotDevices"
+	// Number of the keys in the native_keyvaluestorage
+	KEYVALUESTORAGE_KEYS Resource = "keyvaluestorageKeys"
+	// Number of the telemetry entries (metrics, logs and events) submitted to the iot_core per second. Usage of this resource is not counted.
+	IOT_TELEMETRY_RATE Resource = "iotTelemetryRate"
+)
+
+var ErrQuotaExceeded = errors.New("namespace quota exceeded")
+var ErrUsageChanging = errors.New("usage of the namespace quota is changing too often to be counted")
+
+// Limits and usage of the namespace resources. Resources without limit are not present in the Limits.
+type Quota struct {
+	Limits map[Resource]int64 `bson:"limits"`
+	Usage  map[Resource]int64 `bson:"usage"`
+}
+
+func getCollection(client *mongo.Client) *mongo.Collection {
+	return client.Database(DATABASE_NAME).Collection(COLLECTION_NAME)
+}
+
+func getRateCollection(client *mongo.Client) *mongo.Collection {
+	return client.Database(DATABASE_NAME).Collection(RATE_COLLECTION_NAME)
+}
+
+// Creates indexes required for quota checks. Must be called by the owner of the quotas (native_namespace) on startup.
+func EnsureIndexes(ctx context.Context, client *mongo.Client) error {
+	_, err := getCollection(client).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "namespace", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("unique_namespace"),
+	})
+	if err != nil {
+		return errors.New("failed to create index for quotas: " + err.Error())
+	}
+
+	_, err = getRateCollection(client).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "namespace", Value: 1}, {Key: "resource", Value: 1}, {Key: "window", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("unique_window"),
+		},
+		{
+			Keys:    bson.D{{Key: "expireAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0).SetName("expiration"),
+		},
+	})
+	if err != nil {
+		return errors.New("failed to create indexes for rate quotas: " + err.Error())
+	}
+
+	return nil
+}
+
+// Returns limits and usage of the namespace
+func Get(ctx context.Context, client *mongo.Client, namespace string) (*Quota, error) {
+	quota := Quota{}
+	err := getCollection(client).FindOne(ctx, bson.M{"namespace": namespace}).Decode(&quota)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, errors.New("failed to get quota: " + err.Error())
+	}
+	if quota.Limits == nil {
+		quota.Limits = map[Resource]int64{}
+	}
+	if quota.Usage == nil {
+		quota.Usage = map[Resource]int64{}
+	}
+	return &quota, nil
+}
+
+// Replaces limits of the namespace. Resources with limit 0 are unlimited. Usage is not changed, even if it is bigger than the new limit.
+func SetLimits(ctx context.Context, client *mongo.Client, namespace string, limits map[Resource]int64) error {
+	set := bson.M{}
+	unset := bson.M{}
+	for resource, limit := range limits {
+		if limit > 0 {
+			set["limits."+string(resource)] = limit
+		} else {
+			unset["limits."+string(resource)] = ""
+		}
+	}
+
+	update := bson.M{"$setOnInsert": bson.M{"namespace": namespace}}
+	if len(set) != 0 {
+		update["$set"] = set
+	}
+	if len(unset) != 0 {
+		update["$unset"] = unset
+	}
+	_, err := getCollection(client).UpdateOne(ctx, bson.M{"namespace": namespace}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return errors.New("failed to set quota limits: " + err.Error())
+	}
+	return nil
+}
+
+// Replaces usage with the amounts counted from the existing resources, if usage of the namespace wasnt counted before.
+// Resources created before the quotas were introduced were never reserved, so usage must be counted before the limits are checked.
+// Counting is repeated if usage was changed while counting.
+func SeedUsage(ctx context.Context, client *mongo.Client, namespace string, count func(ctx context.Context) (map[Resource]int64, error)) error {
+	if namespace == "" {
+		return nil
+	}
+
+	collection := getCollection(client)
+	for attempt := 0; attempt < seed_attempts; attempt++ {
+		var current struct {
+			Usage        map[Resource]int64 `bson:"usage"`
+			UsageCounted bool               `bson:"usageCounted"`
+		}
+		err := collection.FindOne(ctx, bson.M{"namespace": namespace}).Decode(&current)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return errors.New("failed to get quota: " + err.Error())
+		}
+		if current.UsageCounted {
+			return nil
+		}
+
+		counted, err := count(ctx)
+		if err != nil {
+			return err
+		}
+
+		unchanged := bson.A{}
+		set := bson.M{"usageCounted": true}
+		for resource, amount := range counted {
+			usageField := "usage." + string(resource)
+			unchanged = append(unchanged, bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$" + usageField, 0}}, current.Usage[resource]}})
+			set[usageField] = amount
+		}
+
+		// If document was changed, upsert fails on the unique index and counting is repeated
+		_, err = collection.UpdateOne(ctx, bson.M{
+			"namespace":    namespace,
+			"usageCounted": bson.M{"$ne": true},
+			"$expr":        bson.M{"$and": unchanged},
+		}, bson.M{"$set": set}, options.Update().SetUpsert(true))
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				continue
+			}
+			return errors.New("failed to set counted usage: " + err.Error())
+		}
+		return nil
+	}
+	return ErrUsageChanging
+}
+
+// Removes limits and usage of the namespace. Called after the namespace is deleted.
+func Remove(ctx context.Context, client *mongo.Client, namespace string) error {
+	_, err := getCollection(client).DeleteOne(ctx, bson.M{"namespace": namespace})
+	if err != nil {
+		return errors.New("failed to remove quota: " + err.Error())
+	}
+	_, err = getRateCollection(client).DeleteMany(ctx, bson.M{"namespace": namespace})
+	if err != nil {
+		return errors.New("failed to remove rate quota windows: " + err.Error())
+	}
+	return nil
+}
+
+// Atomically increases usage of the resource if it will not exceed the limit. Returns ErrQuotaExceeded otherwise.
+func Reserve(ctx context.Context, client *mongo.Client, namespace string, resource Resource, amount int64) error {
+	if namespace == "" || amount <= 0 {
+		return nil
+	}
+
+	usageField := "usage." + string(resource)
+	limitField := "limits." + string(resource)
+	update := bson.M{"$inc": bson.M{usageField: amount}}
+
+	collection := getCollection(client)
+	result, err := collection.UpdateOne(ctx, bson.M{
+		"namespace": namespace,
+		"$or": bson.A{
+			bson.M{limitField: bson.M{"$exists": false}},
+			bson.M{"$expr": bson.M{"$lte": bson.A{bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$" + usageField, 0}}, amount}}, "$" + limitField}}},
+		},
+	}, update)
+	if err != nil {
+		return errors.New("failed to reserve quota: " + err.Error())
+	}
+	if result.MatchedCount != 0 {
+		return nil
+	}
+
+	// Quota document is created on the first usage. If document already exists, unique index will fail the insertion, so the limit was exceeded.
+	_, err = collection.UpdateOne(ctx, bson.M{"namespace": namespace, "limits": bson.M{"$exists": false}}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrQuotaExceeded
+		}
+		return errors.New("failed to reserve quota: " + err.Error())
+	}
+	return nil
+}
+
+// Increases usage of the resource without checking the limit. Used when the data is restored (for example, on import).
+func Add(ctx context.Context, client *mongo.Client, namespace string, resource Resource, amount int64) error {
+	if namespace == "" || amount <= 0 {
+		return nil
+	}
+
+	_, err := getCollection(client).UpdateOne(ctx, bson.M{"namespace": namespace}, bson.M{"$inc": bson.M{"usage." + string(resource): amount}}, options.Update().SetUpsert(true))
+	if err != nil {
+		return errors.New("failed to add quota usage: " + err.Error())
+	}
+	return nil
+}
+
+// Decreases usage of the resource. Usage never becomes negative.
+func Release(ctx context.Context, client *mongo.Client, namespace string, resource Resource, amount int64) error {
+	if namespace == "" || amount <= 0 {
+		return nil
+	}
+
+	usageField := "usage." + string(resource)
+	_, err := getCollection(client).UpdateOne(ctx, bson.M{"namespace": namespace}, mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{usageField: bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{bson.M{"$ifNull": bson.A{"$" + usageField, 0}}, amount}}}}}}},
+	})
+	if err != nil {
+		return errors.New("failed to release quota: " + err.Error())
+	}
+	return nil
+}
+
+// Counts amount in the current second for the rate limited resource. Returns ErrQuotaExceeded if the rate limit was reached.
+func Consume(ctx context.Context, client *mongo.Client, namespace string, resource Resource, amount int64) error {
+	if namespace == "" || amount <= 0 {
+		return nil
+	}
+
+	var quota Quota
+	err := getCollection(client).FindOne(ctx, bson.M{"namespace": namespace}, options.FindOne().SetProjection(bson.M{"limits." + string(resource): 1})).Decode(&quota)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil
+		}
+		return errors.New("failed to get quota: " + err.Error())
+	}
+	limit, ok := quota.Limits[resource]
+	if !ok {
+		return nil
+	}
+	if amount > limit {
+		return ErrQuotaExceeded
+	}
+
+	now := time.Now().UTC()
+	_, err = getRateCollection(client).UpdateOne(
+		ctx,
+		bson.M{"namespace": namespace, "resource": resource, "window": now.Unix(), "count": bson.M{"$lte": limit - amount}},
+		bson.M{"$inc": bson.M{"count": amount}, "$setOnInsert": bson.M{"expireAt": now.Add(rate_window_ttl)}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrQuotaExceeded
+		}
+		return errors.New("failed to consume rate quota: " + err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package serviceauth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// Token is renewed when it expires sooner than this
+const token_renew_before = time.Minute
+
+// Issues new service token. Returns token and its expiration time.
+type IssueTokenFunc func(ctx context.Context) (string, time.Time, error)
+
+type cachedTokenSource struct {
+	issue IssueTokenFunc
+
+	token     string
+	expiresAt time.Time
+	mut       sync.Mutex
+}
+
+func (s *cachedTokenSource) Token(ctx context.Context) (string, error) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.token != "" && time.Until(s.expiresAt) > token_renew_before {
+		return s.token, nil
+	}
+
+	token, expiresAt, err := s.issue(withoutToken(ctx))
+	if err != nil {
+		return "", errors.New("failed to issue service token: " + err.Error())
+	}
+	s.token = token
+	s.expiresAt = expiresAt
+	return token, nil
+}
+
+type withoutTokenContextKey struct{}
+
+// Calls with this context are made without service token. Used to issue the token itself.
+func withoutToken(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutTokenContextKey{}, true)
+}
+
+func (s *cachedTokenSource) appendToken(ctx context.Context) (context.Context, error) {
+	if skip, _ := ctx.Value(withoutTokenContextKey{}).(bool); skip {
+		return ctx, nil
+	}
+	token, err := s.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), nil
+}
+
+// Options for the gRPC clients of the service. When service certificate is configured, connections use mTLS.
+// Otherwise short-lived service tokens are issued with `issueToken` and sent with every call. `issueToken` can be nil if tokens are not used.
+func DialOptions(config *Config, issueToken IssueTokenFunc) ([]grpc.DialOption, error) {
+	if config.Mode == ModeDisabled {
+		return []grpc.DialOption{}, nil
+	}
+
+	if config.mtlsEnabled() {
+		certificate, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, errors.New("failed to load service certificate: " + err.Error())
+		}
+		pool, err := loadCAPool(config.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig := &tls.Config{
+			Certificates: []tls.Certificate{certificate},
+			MinVersion:   tls.VersionTLS12,
+			// Service certificates dont have DNS names. Chain and SPIFFE ID are verified manually.
+			InsecureSkipVerify: true,
+			VerifyConnection: func(state tls.ConnectionState) error {
+				return verifyServerCertificate(state, pool)
+			},
+		}
+		return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}, nil
+	}
+
+	if issueToken == nil {
+		return []grpc.DialOption{}, nil
+	}
+	source := &cachedTokenSource{issue: issueToken}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			ctx, err := source.appendToken(ctx)
+			if err != nil {
+				return err
+			}
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			ctx, err := source.appendToken(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return streamer(ctx, desc, cc, method, opts...)
+		}),
+	}, nil
+}
+
+func verifyServerCertificate(state tls.ConnectionState, pool *x509.CertPool) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server didnt present certificate")
+	}
+	leaf := state.PeerCertificates[0]
+	intermediates := x509.NewCertPool()
+	for _, certificate := range state.PeerCertificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+	_, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	if err != nil {
+		return errors.New("failed to verify server certificate: " + err.Error())
+	}
+	for _, uri := range leaf.URIs {
+		if _, ok := ServiceFromURI(uri); ok {
+			return nil
+		}
+	}
+	return errors.New("server certificate doesnt belong to the service")
+}
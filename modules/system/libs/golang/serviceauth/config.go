@@ -0,0 +1,71 @@
+package serviceauth
+
+import (
+	"os"
+)
+
+type Mode string
+
+const (
+	// Credentials of the calling services are not checked
+	ModeDisabled Mode = "disabled"
+	// Credentials are checked, but calls without valid credentials are only reported
+	ModePermissive Mode = "permissive"
+	// Calls without valid credentials are rejected
+	ModeEnforce Mode = "enforce"
+)
+
+type Config struct {
+	Mode Mode
+	// Name of the current service, for example "native_iam". Services are identified by this name in the rules.
+	Service string
+
+	// PEM file with trusted CA certificates (root CA of the native_iam). Used to verify certificates of the other services.
+	CAFile string
+	// PEM file with the certificate of the current service issued by the native_iam CA. Intermediate CA certificates must follow the service certificate.
+	CertFile string
+	// PEM file with the private key of the current service certificate
+	KeyFile string
+
+	// API key of the service identity. Used to obtain short-lived service tokens when certificates are not configured.
+	APIKey string
+	// Name of the RSA key pair in the system_vault used to verify service tokens
+	TokenKeyName string
+
+	// Called for every call that was rejected (or would be rejected in the permissive mode). Can be used for logging.
+	OnViolation func(fullMethod string, err error)
+}
+
+func getConfigEnv(key string, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+
+// Loads configuration from the environment variables. Service authentication is disabled by default.
+func NewConfigFromEnv(service string) *Config {
+	return &Config{
+		Mode:         Mode(getConfigEnv("SYSTEM_SERVICE_AUTH_MODE", string(ModeDisabled))),
+		Service:      service,
+		CAFile:       getConfigEnv("SYSTEM_SERVICE_AUTH_CA_FILE", ""),
+		CertFile:     getConfigEnv("SYSTEM_SERVICE_AUTH_CERT_FILE", ""),
+		KeyFile:      getConfigEnv("SYSTEM_SERVICE_AUTH_KEY_FILE", ""),
+		APIKey:       getConfigEnv("SYSTEM_SERVICE_AUTH_API_KEY", ""),
+		TokenKeyName: getConfigEnv("SYSTEM_SERVICE_AUTH_TOKEN_KEY", "native_iam_service_token_jwt"),
+	}
+}
+
+func (c *Config) WithOnViolation(handler func(fullMethod string, err error)) *Config {
+	c.OnViolation = handler
+	return c
+}
+
+// Services that accept service tokens must be connected to the system_vault when authentication is enabled
+func (c *Config) Enabled() bool {
+	return c.Mode != ModeDisabled
+}
+
+func (c *Config) mtlsEnabled() bool {
+	return c.CertFile != "" && c.KeyFile != ""
+}
@@ -0,0 +1,247 @@
+package serviceauth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Limits which services can call the methods. Key is a full gRPC method name ("/package.Service/Method"), its prefix ending with "/" ("/package.Service/") or DefaultRule.
+// Value is a list of the services allowed to call it. The most specific key is used. Methods without rules can be called by any authenticated service.
+type Rules map[string][]string
+
+// Rule key that applies to all the methods without more specific rule. Use it with empty list of services to deny calls by default.
+const DefaultRule = "/"
+
+func (r Rules) allowedServices(fullMethod string) ([]string, bool) {
+	if services, ok := r[fullMethod]; ok {
+		return services, true
+	}
+	if index := strings.LastIndex(fullMethod, "/"); index > 0 {
+		if services, ok := r[fullMethod[:index+1]]; ok {
+			return services, true
+		}
+	}
+	if services, ok := r[DefaultRule]; ok {
+		return services, true
+	}
+	return nil, false
+}
+
+// Authenticates services that call the gRPC server and enforces the rules
+type Authenticator struct {
+	config *Config
+	rules  Rules
+	// Methods that can be called without credentials
+	publicMethods map[string]bool
+
+	tokens    *tokenVerifier
+	tlsConfig *tls.Config
+}
+
+// Creates authenticator for the gRPC server. Vault client is used to verify service tokens and can be nil if only mTLS is used.
+func NewAuthenticator(config *Config, vaultClient vault.VaultServiceClient, rules Rules, publicMethods ...string) (*Authenticator, error) {
+	authenticator := &Authenticator{
+		config:        config,
+		rules:         rules,
+		publicMethods: make(map[string]bool, len(publicMethods)),
+	}
+	for _, method := range publicMethods {
+		authenticator.publicMethods[method] = true
+	}
+
+	if config.Mode == ModeDisabled {
+		return authenticator, nil
+	}
+	if config.Mode != ModePermissive && config.Mode != ModeEnforce {
+		return nil, errors.New("unknown service authentication mode: " + string(config.Mode))
+	}
+
+	if vaultClient != nil {
+		authenticator.tokens = newTokenVerifier(vaultClient, config.TokenKeyName)
+	}
+
+	if config.mtlsEnabled() {
+		certificate, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, errors.New("failed to load service certificate: " + err.Error())
+		}
+		pool, err := loadCAPool(config.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		authenticator.tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{certificate},
+			ClientCAs:    pool,
+			// Services that use tokens connect without client certificate
+			ClientAuth: tls.VerifyClientCertIfGiven,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return authenticator, nil
+}
+
+func loadCAPool(caFile string) (*x509.CertPool, error) {
+	if caFile == "" {
+		return nil, errors.New("CA file is required to use service certificates")
+	}
+	pemData, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, errors.New("failed to read CA file: " + err.Error())
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemData) {
+		return nil, errors.New("CA file doesnt contain any valid PEM certificate")
+	}
+	return pool, nil
+}
+
+// Options for the gRPC server: TLS credentials (if service certificate is configured) and interceptors.
+// Interceptors are chained after the interceptors set with grpc.UnaryInterceptor and grpc.StreamInterceptor.
+func (a *Authenticator) ServerOptions() []grpc.ServerOption {
+	if a.config.Mode == ModeDisabled {
+		return []grpc.ServerOption{}
+	}
+
+	options := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(a.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(a.StreamServerInterceptor()),
+	}
+	if a.tlsConfig != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(a.tlsConfig)))
+	}
+	return options
+}
+
+func (a *Authenticator) workloadFromCertificate(ctx context.Context) (*Workload, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+
+	leaf := tlsInfo.State.VerifiedChains[0][0]
+	for _, uri := range leaf.URIs {
+		if service, ok := ServiceFromURI(uri); ok {
+			namespace := ""
+			if len(leaf.Subject.Organization) != 0 {
+				namespace = leaf.Subject.Organization[0]
+			}
+			return &Workload{Service: service, Namespace: namespace, Identity: leaf.Subject.CommonName, Method: AuthMethodMTLS}, nil
+		}
+	}
+	return nil, status.Error(codes.Unauthenticated, "client certificate doesnt belong to the service")
+}
+
+func (a *Authenticator) workloadFromToken(ctx context.Context) (*Workload, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, nil
+	}
+	raw, found := strings.CutPrefix(values[0], "Bearer ")
+	if !found {
+		return nil, status.Error(codes.Unauthenticated, "authorization metadata has bad format")
+	}
+	if a.tokens == nil {
+		return nil, status.Error(codes.Unauthenticated, "service tokens are not accepted by this service")
+	}
+
+	claims, err := a.tokens.Verify(ctx, raw)
+	if err != nil {
+		if err == ErrInvalidToken || err == ErrTokenExpired {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, status.Error(codes.Unavailable, "failed to verify service token: "+err.Error())
+	}
+	return &Workload{Service: claims.Service, Namespace: claims.Namespace, Identity: claims.Subject, Method: AuthMethodToken}, nil
+}
+
+// Authenticates the caller and checks the rules. Returns context with the workload information.
+func (a *Authenticator) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	if a.config.Mode == ModeDisabled || a.publicMethods[fullMethod] {
+		return ctx, nil
+	}
+
+	workload, err := a.workloadFromCertificate(ctx)
+	if err == nil && workload == nil {
+		workload, err = a.workloadFromToken(ctx)
+	}
+	if err == nil && workload == nil {
+		err = status.Error(codes.Unauthenticated, "service credentials are required")
+	}
+	if err == nil {
+		if services, ok := a.rules.allowedServices(fullMethod); ok && !containsService(services, workload.Service) {
+			err = status.Error(codes.PermissionDenied, "service ["+workload.Service+"] is not allowed to call this method")
+		}
+	}
+
+	if workload != nil {
+		ctx = withWorkload(ctx, workload)
+	}
+	if err != nil {
+		if a.config.OnViolation != nil {
+			a.config.OnViolation(fullMethod, err)
+		}
+		if a.config.Mode == ModeEnforce {
+			return nil, err
+		}
+	}
+	return ctx, nil
+}
+
+func containsService(services []string, service string) bool {
+	for _, s := range services {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+type workloadServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *workloadServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &workloadServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
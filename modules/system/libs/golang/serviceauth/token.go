@@ -0,0 +1,131 @@
+package serviceauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
+)
+
+// Value of the "token_use" claim of the service tokens. Prevents usage of the access tokens of the users as service tokens.
+const ServiceTokenUse = "service"
+
+// How long the public key loaded from the vault is used before loading it again. Allows key rotation without restarting services.
+const token_key_refresh_interval = time.Minute * 10
+
+var ErrInvalidToken = errors.New("invalid service token")
+var ErrTokenExpired = errors.New("service token expired")
+
+// Claims of the service token. Tokens are issued by the native_iam and signed with RS512.
+type TokenClaims struct {
+	// Unique identifier of the service identity
+	Subject   string `json:"sub"`
+	Namespace string `json:"namespace"`
+	Service   string `json:"service"`
+	TokenUse  string `json:"token_use"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+type tokenHeader struct {
+	Algorithm string `json:"alg"`
+}
+
+type tokenVerifier struct {
+	vaultClient vault.VaultServiceClient
+	keyName     string
+
+	key      *rsa.PublicKey
+	loadedAt time.Time
+	mut      sync.Mutex
+}
+
+func newTokenVerifier(vaultClient vault.VaultServiceClient, keyName string) *tokenVerifier {
+	return &tokenVerifier{
+		vaultClient: vaultClient,
+		keyName:     keyName,
+	}
+}
+
+func (v *tokenVerifier) getKey(ctx context.Context) (*rsa.PublicKey, error) {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	if v.key != nil && time.Since(v.loadedAt) < token_key_refresh_interval {
+		return v.key, nil
+	}
+
+	response, err := v.vaultClient.GetRSAPublicKey(ctx, &vault.GetRSAPublicKeyRequest{KeyName: v.keyName})
+	if err != nil {
+		// Keep using previous key if vault is temporary unavailable
+		if v.key != nil {
+			return v.key, nil
+		}
+		return nil, errors.New("failed to get service token public key from the system_vault: " + err.Error())
+	}
+	key, err := x509.ParsePKCS1PublicKey(response.PublicKey)
+	if err != nil {
+		return nil, errors.New("failed to parse service token public key: " + err.Error())
+	}
+
+	v.key = key
+	v.loadedAt = time.Now()
+	return key, nil
+}
+
+// Checks signature and expiration of the token and returns its claims
+func (v *tokenVerifier) Verify(ctx context.Context, raw string) (*TokenClaims, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var header tokenHeader
+	if err := json.Unmarshal(headerBytes, &header); err != nil || header.Algorithm != "RS512" {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	key, err := v.getKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha512.Sum512([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA512, digest[:], signature); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	claimsBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var claims TokenClaims
+	if err := json.Unmarshal(claimsBytes, &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if claims.TokenUse != ServiceTokenUse || claims.Service == "" {
+		return nil, ErrInvalidToken
+	}
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	return &claims, nil
+}
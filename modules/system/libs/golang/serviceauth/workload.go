@@ -0,0 +1,56 @@
+package serviceauth
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+const (
+	AuthMethodMTLS  = "mtls"
+	AuthMethodToken = "token"
+)
+
+// Trust domain used in the SPIFFE IDs of the services
+const spiffe_trust_domain = "openbp"
+
+// Service that made the call
+type Workload struct {
+	// Name of the service, for example "tools_rest"
+	Service string
+	// Namespace of the service identity in the native_iam. Empty for global identities.
+	Namespace string
+	// Unique identifier of the service identity in the native_iam
+	Identity string
+	// How the service was authenticated. One of AuthMethodMTLS or AuthMethodToken
+	Method string
+}
+
+type workloadContextKey struct{}
+
+// Returns the service that made the call. Only available on the servers that use Authenticator interceptors.
+func FromContext(ctx context.Context) (*Workload, bool) {
+	workload, ok := ctx.Value(workloadContextKey{}).(*Workload)
+	return workload, ok && workload != nil
+}
+
+func withWorkload(ctx context.Context, workload *Workload) context.Context {
+	return context.WithValue(ctx, workloadContextKey{}, workload)
+}
+
+// SPIFFE ID of the service. It is added to the certificates of the service identities as URI SAN.
+func ServiceURI(service string) *url.URL {
+	return &url.URL{Scheme: "spiffe", Host: spiffe_trust_domain, Path: "/service/" + service}
+}
+
+// Extracts service name from its SPIFFE ID
+func ServiceFromURI(uri *url.URL) (string, bool) {
+	if uri == nil || uri.Scheme != "spiffe" || uri.Host != spiffe_trust_domain {
+		return "", false
+	}
+	service, found := strings.CutPrefix(uri.Path, "/service/")
+	if !found || service == "" || strings.Contains(service, "/") {
+		return "", false
+	}
+	return service, true
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/nats-io/nats.go"
 	goredis "github.com/redis/go-redis/v9"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
 	cache "github.com/slamy-solutions/openbp/modules/system/libs/golang/cache"
 	"github.com/slamy-solutions/openbp/modules/system/libs/golang/db"
 	system_nats "github.com/slamy-solutions/openbp/modules/system/libs/golang/nats"
@@ -79,6 +80,7 @@ type SystemStubConfig struct {
 	Db    DBConfig
 	OTel  OTelConfig
 	Vault GrpcServiceConfig
+	Audit GrpcServiceConfig
 }
 
 func (s *SystemStubConfig) WithCache(config ...CacheConfig) *SystemStubConfig {
@@ -153,6 +155,20 @@ func (s *SystemStubConfig) WithVault(config ...GrpcServiceConfig) *SystemStubCon
 	return s
 }
 
+func (s *SystemStubConfig) WithAudit(config ...GrpcServiceConfig) *SystemStubConfig {
+	cfg := &GrpcServiceConfig{
+		Enabled: true,
+		Url:     getConfigEnv("SYSTEM_AUDIT_URL", "system_audit:80"),
+	}
+
+	if len(config) > 0 {
+		cfg = &config[0]
+	}
+
+	s.Audit = *cfg
+	return s
+}
+
 func NewSystemStubConfig() *SystemStubConfig {
 	return &SystemStubConfig{
 		Redis: RedisConfig{
@@ -184,6 +200,10 @@ func NewSystemStubConfig() *SystemStubConfig {
 			Enabled: false,
 			Url:     "",
 		},
+		Audit: GrpcServiceConfig{
+			Enabled: false,
+			Url:     "",
+		},
 	}
 }
 
@@ -194,6 +214,7 @@ type SystemStub struct {
 	OTel  otel.Telemetry
 	Nats  *nats.Conn
 	Vault vault.VaultServiceClient
+	Audit audit.AuditServiceClient
 
 	config    *SystemStubConfig
 	grpcDials []*grpc.ClientConn
@@ -217,6 +238,17 @@ func (s *SystemStub) Connect(ctx context.Context) error {
 		s.grpcDials = append(s.grpcDials, dial)
 	}
 
+	if s.config.Audit.Enabled {
+		dial, client, err := NewAuditConnection(s.config.Audit.Url)
+		if err != nil {
+			s.closeGRPCConnections()
+			return errors.New("failed to initialize connection to the audit service: " + err.Error())
+		}
+
+		s.Audit = client
+		s.grpcDials = append(s.grpcDials, dial)
+	}
+
 	if s.config.OTel.Enabled {
 		tel, err := otel.Register(ctx, s.config.OTel.URL, s.config.OTel.ServiceModule, s.config.OTel.ServiceName, s.config.OTel.ServiceVersion, s.config.OTel.ServiceInstanceID)
 		if err != nil {
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"net"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	system_audit_grpc "github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+	"github.com/slamy-solutions/openbp/modules/system/services/audit/src/service"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	VERSION = "1.0.0"
+)
+
+func getHostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return "unknown"
+	}
+	return name
+}
+
+func main() {
+	systemStub := system.NewSystemStub(
+		system.NewSystemStubConfig().WithDB().WithNats().WithOTel(system.NewOTelConfig("system", "audit", VERSION, getHostname())),
+	)
+	systemConnectionContext, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+	err := systemStub.Connect(systemConnectionContext)
+	if err != nil {
+		panic("Failed to connect to the system services: " + err.Error())
+	}
+	defer systemStub.Close(context.Background())
+
+	auditService := service.NewAuditGRPCService(systemStub.DB)
+
+	// Store records published by the services
+	consumer, err := service.NewRecordConsumer(auditService.Storage(), systemStub.Nats)
+	if err != nil {
+		panic("Failed to setup record consumer: " + err.Error())
+	}
+	defer consumer.Close()
+
+	// Creating grpc server
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionAge: time.Minute * 5,
+		}),
+	)
+
+	system_audit_grpc.RegisterAuditServiceServer(grpcServer, auditService)
+
+	log.Info("Start listening for gRPC connections")
+	lis, err := net.Listen("tcp", ":80")
+	if err != nil {
+		panic("Failed to create listener: " + err.Error())
+	}
+	if err := grpcServer.Serve(lis); err != nil {
+		panic("Error while serving gRPC connections: " + err.Error())
+	}
+}
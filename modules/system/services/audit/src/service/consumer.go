@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/proto"
+
+	system_audit_grpc "github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+	system_nats "github.com/slamy-solutions/openbp/modules/system/libs/golang/nats"
+)
+
+const (
+	RECORD_CONSUMER_NAME = "system_audit_storage"
+
+	record_deliver_subject = "system.audit.deliver.record"
+)
+
+// Receives audit records published by the services and stores them
+type recordConsumer struct {
+	storage      *recordStorage
+	subscription *nats.Subscription
+}
+
+func NewRecordConsumer(storage *recordStorage, natsClient *nats.Conn) (*recordConsumer, error) {
+	consumer := &recordConsumer{
+		storage:      storage,
+		subscription: nil,
+	}
+
+	js, err := natsClient.JetStream()
+	if err != nil {
+		return nil, errors.New("Error while opening jetsteram context. " + err.Error())
+	}
+	if err := system_audit_grpc.EnsureStream(js); err != nil {
+		return nil, errors.New("Error while creating audit stream. " + err.Error())
+	}
+	_, err = js.AddConsumer(system_audit_grpc.STREAM_NAME, &nats.ConsumerConfig{
+		Durable:        RECORD_CONSUMER_NAME,
+		Name:           RECORD_CONSUMER_NAME,
+		Description:    "Stores audit records published by the services",
+		AckPolicy:      nats.AckExplicitPolicy,
+		FilterSubject:  system_audit_grpc.SUBJECT_PREFIX + ">",
+		DeliverSubject: record_deliver_subject,
+		DeliverGroup:   record_deliver_subject,
+	})
+	if err != nil {
+		return nil, errors.New("Error while creating consumer. " + err.Error())
+	}
+	subscription, err := js.QueueSubscribe(system_audit_grpc.SUBJECT_PREFIX+">", record_deliver_subject, consumer.handleRecord, nats.Bind(system_audit_grpc.STREAM_NAME, RECORD_CONSUMER_NAME))
+	if err != nil {
+		return nil, errors.New("Error while creating subscribtion. " + err.Error())
+	}
+
+	consumer.subscription = subscription
+	return consumer, nil
+}
+
+func (c *recordConsumer) Close() error {
+	err := c.subscription.Unsubscribe()
+	if err != nil {
+		return errors.New("Error while unsubscribing from audit records. " + err.Error())
+	}
+	return nil
+}
+
+func (c *recordConsumer) handleRecord(msg *nats.Msg) {
+	ctx, span := system_nats.StartTelemetrySpanFromMessage(context.Background(), msg, "Store audit record")
+	defer span.End()
+
+	var record system_audit_grpc.Record
+	err := proto.Unmarshal(msg.Data, &record)
+	if err != nil {
+		// Message will never be parsed. There is no reason to redeliver it
+		span.SetStatus(codes.Error, "Failed to unmarshal record: "+err.Error())
+		span.RecordError(err)
+		msg.Term()
+		return
+	}
+	span.SetAttributes(
+		attribute.String("namespace", record.Namespace),
+		attribute.String("service", record.Service),
+		attribute.String("resource", record.Resource),
+		attribute.String("action", record.Action),
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	if err := c.storage.Insert(ctx, &record); err != nil {
+		span.SetStatus(codes.Error, "Failed to store record: "+err.Error())
+		span.RecordError(err)
+		msg.NakWithDelay(time.Second * 5)
+		return
+	}
+
+	span.SetStatus(codes.Ok, "")
+	msg.Ack()
+}
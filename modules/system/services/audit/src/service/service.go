@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+)
+
+const (
+	default_list_limit = 100
+	max_list_limit     = 1000
+)
+
+type AuditService struct {
+	audit.UnimplementedAuditServiceServer
+
+	storage *recordStorage
+}
+
+func NewAuditGRPCService(mongoClient *mongo.Client) *AuditService {
+	return &AuditService{
+		storage: newRecordStorage(mongoClient),
+	}
+}
+
+// Storage used by the service. Records stored by the consumer are immediately available through the service.
+func (s *AuditService) Storage() *recordStorage {
+	return s.storage
+}
+
+func (s *AuditService) List(ctx context.Context, in *audit.ListRequest) (*audit.ListResponse, error) {
+	limit := in.Limit
+	if limit == 0 {
+		limit = default_list_limit
+	}
+	if limit > max_list_limit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit cant be bigger than %d", max_list_limit)
+	}
+
+	namespace := in.Filter.GetNamespace()
+	query := filterToBson(in.Filter)
+	collection := s.storage.collection(namespace)
+
+	totalCount, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		log.Error("[GRPC Audit Service]-(List) Internal error while counting records: " + err.Error())
+		return nil, status.Error(codes.Internal, "error while counting records: "+err.Error())
+	}
+
+	opts := options.Find().SetSort(bson.D{bson.E{Key: "timestamp", Value: -1}}).SetSkip(int64(in.Skip)).SetLimit(int64(limit))
+	cursor, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		log.Error("[GRPC Audit Service]-(List) Internal error while searching records: " + err.Error())
+		return nil, status.Error(codes.Internal, "error while searching records: "+err.Error())
+	}
+	defer cursor.Close(context.Background())
+
+	records := []*audit.Record{}
+	for cursor.Next(ctx) {
+		var record recordInMongo
+		if err := cursor.Decode(&record); err != nil {
+			log.Error("[GRPC Audit Service]-(List) Internal error while decoding record: " + err.Error())
+			return nil, status.Error(codes.Internal, "error while decoding record: "+err.Error())
+		}
+		records = append(records, record.ToGRPCRecord(namespace))
+	}
+	if err := cursor.Err(); err != nil {
+		log.Error("[GRPC Audit Service]-(List) Internal error while reading records: " + err.Error())
+		return nil, status.Error(codes.Internal, "error while reading records: "+err.Error())
+	}
+
+	return &audit.ListResponse{Records: records, TotalCount: uint64(totalCount)}, status.Error(codes.OK, "")
+}
+
+func (s *AuditService) Stream(in *audit.StreamRequest, out audit.AuditService_StreamServer) error {
+	ctx := out.Context()
+	namespace := in.Filter.GetNamespace()
+
+	opts := options.Find().SetSort(bson.D{bson.E{Key: "timestamp", Value: 1}})
+	cursor, err := s.storage.collection(namespace).Find(ctx, filterToBson(in.Filter), opts)
+	if err != nil {
+		log.Error("[GRPC Audit Service]-(Stream) Internal error while searching records: " + err.Error())
+		return status.Error(codes.Internal, "error while searching records: "+err.Error())
+	}
+	defer cursor.Close(context.Background())
+
+	for cursor.Next(ctx) {
+		var record recordInMongo
+		if err := cursor.Decode(&record); err != nil {
+			log.Error("[GRPC Audit Service]-(Stream) Internal error while decoding record: " + err.Error())
+			return status.Error(codes.Internal, "error while decoding record: "+err.Error())
+		}
+		if err := out.Send(&audit.StreamResponse{Record: record.ToGRPCRecord(namespace)}); err != nil {
+			return err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		log.Error("[GRPC Audit Service]-(Stream) Internal error while reading records: " + err.Error())
+		return status.Error(codes.Internal, "error while reading records: "+err.Error())
+	}
+
+	return status.Error(codes.OK, "")
+}
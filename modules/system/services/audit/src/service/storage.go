@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	system_audit_grpc "github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+)
+
+const (
+	RECORD_COLLECTION_NAME = "system_audit_record"
+)
+
+type actorInMongo struct {
+	Namespace string `bson:"namespace"`
+	Identity  string `bson:"identity"`
+	Token     string `bson:"token"`
+}
+
+type requestMetadataInMongo struct {
+	SourceIP  string `bson:"sourceIP"`
+	UserAgent string `bson:"userAgent"`
+	RequestId string `bson:"requestId"`
+}
+
+type recordInMongo struct {
+	UUID            string                 `bson:"_id"`
+	Timestamp       time.Time              `bson:"timestamp"`
+	Service         string                 `bson:"service"`
+	Actor           actorInMongo           `bson:"actor"`
+	Resource        string                 `bson:"resource"`
+	Action          string                 `bson:"action"`
+	BeforeVersion   uint64                 `bson:"beforeVersion"`
+	AfterVersion    uint64                 `bson:"afterVersion"`
+	RequestMetadata requestMetadataInMongo `bson:"requestMetadata"`
+	Details         string                 `bson:"details"`
+}
+
+func recordFromGRPC(record *system_audit_grpc.Record) *recordInMongo {
+	result := &recordInMongo{
+		UUID:          record.Uuid,
+		Timestamp:     record.Timestamp.AsTime(),
+		Service:       record.Service,
+		Resource:      record.Resource,
+		Action:        record.Action,
+		BeforeVersion: record.BeforeVersion,
+		AfterVersion:  record.AfterVersion,
+		Details:       record.Details,
+	}
+	if record.Actor != nil {
+		result.Actor = actorInMongo{
+			Namespace: record.Actor.Namespace,
+			Identity:  record.Actor.Identity,
+			Token:     record.Actor.Token,
+		}
+	}
+	if record.RequestMetadata != nil {
+		result.RequestMetadata = requestMetadataInMongo{
+			SourceIP:  record.RequestMetadata.SourceIP,
+			UserAgent: record.RequestMetadata.UserAgent,
+			RequestId: record.RequestMetadata.RequestId,
+		}
+	}
+	return result
+}
+
+func (r *recordInMongo) ToGRPCRecord(namespace string) *system_audit_grpc.Record {
+	return &system_audit_grpc.Record{
+		Uuid:      r.UUID,
+		Namespace: namespace,
+		Timestamp: timestamppb.New(r.Timestamp),
+		Service:   r.Service,
+		Actor: &system_audit_grpc.Actor{
+			Namespace: r.Actor.Namespace,
+			Identity:  r.Actor.Identity,
+			Token:     r.Actor.Token,
+		},
+		Resource:      r.Resource,
+		Action:        r.Action,
+		BeforeVersion: r.BeforeVersion,
+		AfterVersion:  r.AfterVersion,
+		RequestMetadata: &system_audit_grpc.RequestMetadata{
+			SourceIP:  r.RequestMetadata.SourceIP,
+			UserAgent: r.RequestMetadata.UserAgent,
+			RequestId: r.RequestMetadata.RequestId,
+		},
+		Details: r.Details,
+	}
+}
+
+// Append-only storage of the audit records. Records are stored in the database of the namespace they belong to.
+// Storage never updates or deletes records.
+type recordStorage struct {
+	mongoClient *mongo.Client
+
+	// Namespaces for which indexes were already created by this instance
+	indexedNamespaces sync.Map
+}
+
+func newRecordStorage(mongoClient *mongo.Client) *recordStorage {
+	return &recordStorage{mongoClient: mongoClient}
+}
+
+func (s *recordStorage) collection(namespace string) *mongo.Collection {
+	if namespace == "" {
+		return s.mongoClient.Database("openbp_global").Collection(RECORD_COLLECTION_NAME)
+	}
+	return s.mongoClient.Database(fmt.Sprintf("openbp_namespace_%s", namespace)).Collection(RECORD_COLLECTION_NAME)
+}
+
+func (s *recordStorage) ensureIndexes(ctx context.Context, namespace string) error {
+	if _, ok := s.indexedNamespaces.Load(namespace); ok {
+		return nil
+	}
+
+	_, err := s.collection(namespace).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{bson.E{Key: "timestamp", Value: -1}},
+			Options: options.Index().SetName("timestamp"),
+		},
+		{
+			Keys:    bson.D{bson.E{Key: "actor.namespace", Value: 1}, bson.E{Key: "actor.identity", Value: 1}, bson.E{Key: "timestamp", Value: -1}},
+			Options: options.Index().SetName("actor_timestamp"),
+		},
+		{
+			Keys:    bson.D{bson.E{Key: "resource", Value: 1}, bson.E{Key: "timestamp", Value: -1}},
+			Options: options.Index().SetName("resource_timestamp"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	s.indexedNamespaces.Store(namespace, true)
+	return nil
+}
+
+// Stores record. Storing the same record multiple times is not an error and only first one is kept.
+func (s *recordStorage) Insert(ctx context.Context, record *system_audit_grpc.Record) error {
+	if err := s.ensureIndexes(ctx, record.Namespace); err != nil {
+		return fmt.Errorf("failed to ensure indexes: %s", err.Error())
+	}
+
+	_, err := s.collection(record.Namespace).InsertOne(ctx, recordFromGRPC(record))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	return nil
+}
+
+func filterToBson(filter *system_audit_grpc.Filter) bson.M {
+	query := bson.M{}
+	if filter == nil {
+		return query
+	}
+
+	if filter.Actor != nil {
+		if filter.Actor.Identity != "" {
+			query["actor.namespace"] = filter.Actor.Namespace
+			query["actor.identity"] = filter.Actor.Identity
+		}
+		if filter.Actor.Token != "" {
+			query["actor.token"] = filter.Actor.Token
+		}
+	}
+	if filter.ResourcePrefix != "" {
+		query["resource"] = bson.M{"$regex": "^" + regexp.QuoteMeta(filter.ResourcePrefix)}
+	}
+	if filter.Action != "" {
+		query["action"] = filter.Action
+	}
+
+	timeRange := bson.M{}
+	if filter.From != nil {
+		timeRange["$gte"] = filter.From.AsTime()
+	}
+	if filter.To != nil {
+		timeRange["$lt"] = filter.To.AsTime()
+	}
+	if len(timeRange) != 0 {
+		query["timestamp"] = timeRange
+	}
+
+	return query
+}
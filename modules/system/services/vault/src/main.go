@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc/keepalive"
 
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
 	system_vault_grpc "github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
 	"github.com/slamy-solutions/openbp/modules/system/services/vault/src/service"
 
@@ -32,7 +33,7 @@ func getHostname() string {
 
 func main() {
 	//Registering in the OTEL
-	systemStub := system.NewSystemStub(system.NewSystemStubConfig().WithOTel(system.NewOTelConfig("system", "vault", VERSION, getHostname())))
+	systemStub := system.NewSystemStub(system.NewSystemStubConfig().WithNats().WithOTel(system.NewOTelConfig("system", "vault", VERSION, getHostname())))
 	err := systemStub.Connect(context.Background())
 	if err != nil {
 		panic("Failed to connect to the system services: " + err.Error())
@@ -65,7 +66,12 @@ func main() {
 		}),
 	)
 
-	vaultService := service.NewVaultGRPCService(pkcsCtx, sealer)
+	auditPublisher, err := audit.NewPublisher(systemStub.Nats, "system_vault")
+	if err != nil {
+		panic("Failed to create audit publisher: " + err.Error())
+	}
+
+	vaultService := service.NewVaultGRPCService(pkcsCtx, sealer, auditPublisher)
 	system_vault_grpc.RegisterVaultServiceServer(grpcServer, vaultService)
 
 	log.Info("Start listening for gRPC connections")
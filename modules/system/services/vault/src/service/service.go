@@ -7,6 +7,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
 	"github.com/slamy-solutions/openbp/modules/system/libs/golang/vault"
 	"github.com/slamy-solutions/openbp/modules/system/services/vault/src/pkcs"
 	"google.golang.org/grpc/codes"
@@ -16,19 +17,34 @@ import (
 type VaultService struct {
 	vault.UnimplementedVaultServiceServer
 
-	pkcsHandle pkcs.PKCS
-	sealer     *pkcs.Sealer
+	pkcsHandle     pkcs.PKCS
+	sealer         *pkcs.Sealer
+	auditPublisher *audit.Publisher
 }
 
-func NewVaultGRPCService(pkcsHandle pkcs.PKCS, sealer *pkcs.Sealer) *VaultService {
+func NewVaultGRPCService(pkcsHandle pkcs.PKCS, sealer *pkcs.Sealer, auditPublisher *audit.Publisher) *VaultService {
 	return &VaultService{
-		pkcsHandle: pkcsHandle,
-		sealer:     sealer,
+		pkcsHandle:     pkcsHandle,
+		sealer:         sealer,
+		auditPublisher: auditPublisher,
+	}
+}
+
+func (s *VaultService) publishAuditRecord(ctx context.Context, action string, details string) {
+	err := s.auditPublisher.Publish(ctx, audit.Change{
+		Namespace: "",
+		Resource:  "system.vault",
+		Action:    action,
+		Details:   details,
+	})
+	if err != nil {
+		log.Error("[GRPC Vault Service] Failed to publish audit record for [" + action + "]: " + err.Error())
 	}
 }
 
 func (s *VaultService) Seal(ctx context.Context, in *vault.SealRequest) (*vault.SealResponse, error) {
 	s.sealer.Seal()
+	s.publishAuditRecord(ctx, "system.vault.seal", "")
 	return nil, status.Error(codes.OK, "")
 }
 func (s *VaultService) Unseal(ctx context.Context, in *vault.UnsealRequest) (*vault.UnsealResponse, error) {
@@ -38,6 +54,12 @@ func (s *VaultService) Unseal(ctx context.Context, in *vault.UnsealRequest) (*va
 		return nil, status.Error(codes.Internal, "error while unsealing: "+err.Error())
 	}
 
+	if unsealed {
+		s.publishAuditRecord(ctx, "system.vault.unseal", "")
+	} else {
+		s.publishAuditRecord(ctx, "system.vault.unseal", "failed attempt: wrong secret")
+	}
+
 	return &vault.UnsealResponse{Success: unsealed}, status.Error(codes.OK, "")
 }
 func (s *VaultService) UpdateSealSecret(ctx context.Context, in *vault.UpdateSealSecretRequest) (*vault.UpdateSealSecretResponse, error) {
@@ -0,0 +1,129 @@
+package audit
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+	tools "github.com/slamy-solutions/openbp/modules/system/testing/tools"
+)
+
+type AuditTestSuite struct {
+	suite.Suite
+
+	systemStub *system.SystemStub
+	publisher  *audit.Publisher
+}
+
+func (suite *AuditTestSuite) SetupSuite() {
+	suite.systemStub = system.NewSystemStub(system.NewSystemStubConfig().WithNats().WithAudit())
+	err := suite.systemStub.Connect(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	suite.publisher, err = audit.NewPublisher(suite.systemStub.Nats, "system_testing")
+	if err != nil {
+		panic(err)
+	}
+}
+func (suite *AuditTestSuite) TearDownSuite() {
+	suite.systemStub.Close(context.Background())
+}
+func TestAuditTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
+
+// Records are stored asynchronously. Waits until filter matches expected number of records.
+func (s *AuditTestSuite) waitForRecords(ctx context.Context, filter *audit.Filter, count int) []*audit.Record {
+	for {
+		response, err := s.systemStub.Audit.List(ctx, &audit.ListRequest{Filter: filter})
+		require.Nil(s.T(), err)
+		if len(response.Records) >= count {
+			require.Len(s.T(), response.Records, count)
+			require.Equal(s.T(), uint64(count), response.TotalCount)
+			return response.Records
+		}
+
+		select {
+		case <-ctx.Done():
+			require.FailNow(s.T(), "timeout while waiting for the records to be stored")
+		case <-time.After(time.Millisecond * 200):
+		}
+	}
+}
+
+func (s *AuditTestSuite) TestPublishAndList() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	resource := "system.testing." + tools.GetRandomString(20)
+	actor := &audit.Actor{Namespace: "", Identity: tools.GetRandomString(20), Token: tools.GetRandomString(20)}
+	requestMetadata := &audit.RequestMetadata{SourceIP: "127.0.0.1", UserAgent: "testing", RequestId: tools.GetRandomString(20)}
+
+	// Publisher takes actor from incoming metadata, same as gRPC services do
+	outgoingMD, _ := metadata.FromOutgoingContext(audit.WithActor(context.Background(), actor, requestMetadata))
+	publishCtx := metadata.NewIncomingContext(ctx, outgoingMD)
+
+	// Timestamps are stored with millisecond precision. Small delay between records keeps their order stable.
+	start := time.Now().Add(-time.Second)
+	err := s.publisher.Publish(publishCtx, audit.Change{Namespace: "", Resource: resource + ".first", Action: "system.testing.create", AfterVersion: 0})
+	require.Nil(s.T(), err)
+	time.Sleep(time.Millisecond * 10)
+	err = s.publisher.Publish(publishCtx, audit.Change{Namespace: "", Resource: resource + ".first", Action: "system.testing.update", BeforeVersion: 0, AfterVersion: 1})
+	require.Nil(s.T(), err)
+	time.Sleep(time.Millisecond * 10)
+	err = s.publisher.Publish(ctx, audit.Change{Namespace: "", Resource: resource + ".second", Action: "system.testing.update", BeforeVersion: 3, AfterVersion: 4})
+	require.Nil(s.T(), err)
+
+	records := s.waitForRecords(ctx, &audit.Filter{Namespace: "", ResourcePrefix: resource}, 3)
+	require.Equal(s.T(), resource+".second", records[0].Resource)
+	require.Equal(s.T(), uint64(3), records[0].BeforeVersion)
+	require.Equal(s.T(), uint64(4), records[0].AfterVersion)
+	require.Equal(s.T(), "system_testing", records[0].Service)
+
+	s.Run("Filter by actor", func() {
+		records := s.waitForRecords(ctx, &audit.Filter{Namespace: "", ResourcePrefix: resource, Actor: &audit.Actor{Namespace: "", Identity: actor.Identity}}, 2)
+		for _, record := range records {
+			require.Equal(s.T(), actor.Identity, record.Actor.Identity)
+			require.Equal(s.T(), actor.Token, record.Actor.Token)
+			require.Equal(s.T(), requestMetadata.RequestId, record.RequestMetadata.RequestId)
+		}
+	})
+
+	s.Run("Filter by action", func() {
+		records := s.waitForRecords(ctx, &audit.Filter{Namespace: "", ResourcePrefix: resource, Action: "system.testing.create"}, 1)
+		require.Equal(s.T(), resource+".first", records[0].Resource)
+	})
+
+	s.Run("Filter by time range", func() {
+		s.waitForRecords(ctx, &audit.Filter{Namespace: "", ResourcePrefix: resource, From: timestamppb.New(start), To: timestamppb.New(time.Now().Add(time.Second))}, 3)
+		s.waitForRecords(ctx, &audit.Filter{Namespace: "", ResourcePrefix: resource, To: timestamppb.New(start)}, 0)
+	})
+
+	s.Run("Stream returns records from the oldest", func() {
+		stream, err := s.systemStub.Audit.Stream(ctx, &audit.StreamRequest{Filter: &audit.Filter{Namespace: "", ResourcePrefix: resource}})
+		require.Nil(s.T(), err)
+
+		streamed := []*audit.Record{}
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.Nil(s.T(), err)
+			streamed = append(streamed, response.Record)
+		}
+		require.Len(s.T(), streamed, 3)
+		require.Equal(s.T(), records[2].Uuid, streamed[0].Uuid)
+		require.Equal(s.T(), records[0].Uuid, streamed[2].Uuid)
+	})
+}
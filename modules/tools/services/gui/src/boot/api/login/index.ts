@@ -38,6 +38,8 @@ export interface RefreshTokenRequest {
 }
 export interface RefreshTokenResponse {
     accessToken: string
+    // Refresh tokens are rotated. Used refresh token is not valid anymore.
+    refreshToken: string
 }
 
 export interface ValidateTokenRequest {
@@ -67,10 +69,10 @@ export class LoginAPI extends APIModuleBase {
         return response.data
     }
 
-    // Try to refresh token and get new access token 
-    async refreshToken(params: RefreshTokenRequest): Promise<string> {
+    // Try to refresh token and get new access and refresh tokens
+    async refreshToken(params: RefreshTokenRequest): Promise<RefreshTokenResponse> {
         const response = await LoginAPI._axios.post<RefreshTokenResponse>('/auth/token/refresh', params)
-        return response.data.accessToken
+        return response.data
     }
 
     // Check it token is ok
@@ -11,6 +11,24 @@ declare module 'axios' {
 export class APIModuleBase{
     protected static _axios = APIModuleBase.init()
 
+    // Refresh token can only be used once. Requests that fail at the same time must wait for the same refresh.
+    private static _refreshPromise: Promise<string> | null = null
+
+    private static refreshAccessToken(baseURL: string | undefined): Promise<string> {
+        if (APIModuleBase._refreshPromise === null) {
+            const loginStore = useLoginStore()
+            APIModuleBase._refreshPromise = axios.post<{accessToken: string, refreshToken: string}>(`${baseURL}/auth/token/refresh`, {refreshToken: loginStore.refreshToken})
+                .then((refreshResponse) => {
+                    loginStore.updateTokens(refreshResponse.data.accessToken, refreshResponse.data.refreshToken)
+                    return refreshResponse.data.accessToken
+                })
+                .finally(() => {
+                    APIModuleBase._refreshPromise = null
+                })
+        }
+        return APIModuleBase._refreshPromise
+    }
+
     private static init(): AxiosInstance {
         const _axios = axios.create({})
 
@@ -34,9 +52,8 @@ export class APIModuleBase{
                     originalRequest._retry = true
                     try {
                         
-                        const refreshResponse = await axios.post<{accessToken: string}>(`${_axios.defaults.baseURL}/auth/token/refresh`, {refreshToken: loginStore.refreshToken})
-                        loginStore.updateAccessToken(refreshResponse.data.accessToken)
-                        originalRequest.headers.Authorization = `Bearer ${refreshResponse.data.accessToken}`;
+                        const accessToken = await APIModuleBase.refreshAccessToken(_axios.defaults.baseURL)
+                        originalRequest.headers.Authorization = `Bearer ${accessToken}`;
                         return _axios(originalRequest)
                     } catch {
                         console.log("Logged out")
@@ -33,8 +33,15 @@ export const useLoginStore = defineStore('login', {
       }
     },
 
-    updateAccessToken(newAccessToken: string) {
+    // Refresh tokens are rotated, so both tokens must be replaced after every refresh
+    updateTokens(newAccessToken: string, newRefreshToken: string) {
         this._accessToken = newAccessToken
+        this._refreshToken = newRefreshToken
+        LocalStorage.set(_LOCAL_STORAGE_KEY, {
+            originalNamespace: this._originalNamespace,
+            accessToken: newAccessToken,
+            refreshToken: newRefreshToken
+        } as LocalStorageLoginData)
     },
 
     login (originalNamespace: string, accessToken: string, refreshToken: string) {
@@ -21,6 +21,7 @@ func FillRouterGroup(group *gin.RouterGroup, nativeStub *native.NativeStub) {
 	group.PATCH("/iam/identity/removePolicy", identityRouter.RemovePolicy)
 	group.PATCH("/iam/identity/addRole", identityRouter.AddRole)
 	group.PATCH("/iam/identity/removeRole", identityRouter.RemoveRole)
+	group.GET("/iam/identity/effectivePolicies", identityRouter.GetEffectivePolicies)
 
 	policyRouter := &PolicyRouter{nativeStub: nativeStub}
 	group.POST("/iam/policy", policyRouter.Create)
@@ -28,6 +29,7 @@ func FillRouterGroup(group *gin.RouterGroup, nativeStub *native.NativeStub) {
 	group.GET("/iam/policy", policyRouter.Get)
 	group.PATCH("/iam/policy", policyRouter.Update)
 	group.DELETE("/iam/policy", policyRouter.Delete)
+	group.POST("/iam/policy/simulate", policyRouter.Simulate)
 
 	roleRouter := &RoleRouter{nativeStub: nativeStub}
 	group.POST("/iam/role", roleRouter.Create)
@@ -37,11 +39,39 @@ func FillRouterGroup(group *gin.RouterGroup, nativeStub *native.NativeStub) {
 	group.PATCH("/iam/role", roleRouter.Update)
 	group.PATCH("/iam/role/addPolicy", roleRouter.AddPolicy)
 	group.PATCH("/iam/role/removePolicy", roleRouter.RemovePolicy)
+	group.POST("/iam/role/simulate", roleRouter.Simulate)
+
+	groupRouter := &GroupRouter{nativeStub: nativeStub}
+	group.GET("/iam/group/list", groupRouter.List)
+	group.GET("/iam/group", groupRouter.Get)
+	group.POST("/iam/group", groupRouter.Create)
+	group.PATCH("/iam/group", groupRouter.Update)
+	group.DELETE("/iam/group", groupRouter.Delete)
+	group.PATCH("/iam/group/addIdentity", groupRouter.AddIdentity)
+	group.PATCH("/iam/group/removeIdentity", groupRouter.RemoveIdentity)
+	group.PATCH("/iam/group/addGroup", groupRouter.AddGroup)
+	group.PATCH("/iam/group/removeGroup", groupRouter.RemoveGroup)
+	group.PATCH("/iam/group/addRole", groupRouter.AddRole)
+	group.PATCH("/iam/group/removeRole", groupRouter.RemoveRole)
+	group.PATCH("/iam/group/addPolicy", groupRouter.AddPolicy)
+	group.PATCH("/iam/group/removePolicy", groupRouter.RemovePolicy)
+
+	oidcRouter := &OIDCRouter{nativeStub: nativeStub}
+	group.GET("/iam/oidc/client/list", oidcRouter.ListClients)
+	group.GET("/iam/oidc/client", oidcRouter.GetClient)
+	group.POST("/iam/oidc/client", oidcRouter.CreateClient)
+	group.PATCH("/iam/oidc/client", oidcRouter.UpdateClient)
+	group.DELETE("/iam/oidc/client", oidcRouter.DeleteClient)
+	group.POST("/iam/oidc/client/secret", oidcRouter.RegenerateClientSecret)
+	group.GET("/iam/oidc/consent/list", oidcRouter.ListConsents)
+	group.DELETE("/iam/oidc/consent", oidcRouter.RevokeConsent)
 
 	authenticationPasswordRouter := authAPI.NewPasswordRouter(nativeStub)
 	group.GET("/iam/auth/password", authenticationPasswordRouter.GetStatus)
 	group.DELETE("/iam/auth/password", authenticationPasswordRouter.Disable)
 	group.PUT("/iam/auth/password", authenticationPasswordRouter.SetOrUpdate)
+	group.GET("/iam/auth/password/lockouts", authenticationPasswordRouter.ListLockouts)
+	group.DELETE("/iam/auth/password/lockout", authenticationPasswordRouter.ClearLockout)
 
 	authenticationCertificateRouter := authAPI.NewCertificateRouter(nativeStub)
 	group.GET("/iam/auth/certificate/listForIdentity", authenticationCertificateRouter.ListCertificatesForIdentity)
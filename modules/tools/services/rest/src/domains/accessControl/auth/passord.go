@@ -2,6 +2,7 @@ package auth
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
@@ -114,6 +115,8 @@ type updateRequest struct {
 	Namespace    string `json:"namespace" binding:"lte=32"`
 	IdentityUUID string `json:"identityUUID" binding:"lte=64"`
 	NewPassword  string `json:"newPassword" binding:"lte=64"`
+	// Force identity to change password after the next login
+	RequireChange bool `json:"requireChange"`
 }
 
 func (r *PasswordRouter) SetOrUpdate(ctx *gin.Context) {
@@ -142,9 +145,10 @@ func (r *PasswordRouter) SetOrUpdate(ctx *gin.Context) {
 	}
 
 	_, err = r.nativeStub.Services.IAM.Authentication.Password.CreateOrUpdate(ctx.Request.Context(), &password.CreateOrUpdateRequest{
-		Namespace: requestData.Namespace,
-		Identity:  requestData.IdentityUUID,
-		Password:  requestData.NewPassword,
+		Namespace:     requestData.Namespace,
+		Identity:      requestData.IdentityUUID,
+		Password:      requestData.NewPassword,
+		RequireChange: requestData.RequireChange,
 	})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
@@ -152,6 +156,10 @@ func (r *PasswordRouter) SetOrUpdate(ctx *gin.Context) {
 				ctx.AbortWithStatusJSON(http.StatusPreconditionFailed, gin.H{"message": "The vault is sealed or namespace doesnt exist."})
 				return
 			}
+			if st.Code() == codes.InvalidArgument {
+				ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": st.Message()})
+				return
+			}
 		}
 
 		ctx.AbortWithError(http.StatusInternalServerError, err)
@@ -160,3 +168,128 @@ func (r *PasswordRouter) SetOrUpdate(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, gin.H{})
 }
+
+type formatedLockout struct {
+	// "identity" or "source"
+	Type           string `json:"type"`
+	Namespace      string `json:"namespace"`
+	IdentityUUID   string `json:"identityUUID"`
+	SourceIP       string `json:"sourceIP"`
+	FailedAttempts uint32 `json:"failedAttempts"`
+	LockedAt       string `json:"lockedAt"`
+	LockedUntil    string `json:"lockedUntil"`
+}
+
+func formatLockout(l *password.Lockout) formatedLockout {
+	lockoutType := "identity"
+	if l.Type == password.Lockout_SOURCE {
+		lockoutType = "source"
+	}
+	return formatedLockout{
+		Type:           lockoutType,
+		Namespace:      l.Namespace,
+		IdentityUUID:   l.Identity,
+		SourceIP:       l.SourceIP,
+		FailedAttempts: l.FailedAttempts,
+		LockedAt:       l.LockedAt.AsTime().UTC().Format(time.RFC3339),
+		LockedUntil:    l.LockedUntil.AsTime().UTC().Format(time.RFC3339),
+	}
+}
+
+type listLockoutsResponse struct {
+	Lockouts []formatedLockout `json:"lockouts"`
+}
+
+func (r *PasswordRouter) ListLockouts(ctx *gin.Context) {
+	// Lockouts are not bound to the namespace (source lockouts affects all the namespaces), so access to the global namespace is required
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            "",
+			Resources:            []string{"native.iam.authentication.password.*"},
+			Actions:              []string{"native.iam.authentication.password.lockout.list"},
+			NamespaceIndependent: false,
+		},
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+
+	listResponse, err := r.nativeStub.Services.IAM.Authentication.Password.ListLockouts(ctx.Request.Context(), &password.ListLockoutsRequest{})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	lockouts := make([]formatedLockout, len(listResponse.Lockouts))
+	for index, lockout := range listResponse.Lockouts {
+		lockouts[index] = formatLockout(lockout)
+	}
+
+	ctx.JSON(http.StatusOK, &listLockoutsResponse{Lockouts: lockouts})
+}
+
+type clearLockoutRequest struct {
+	Type         string `form:"type" binding:"required,oneof=identity source"`
+	Namespace    string `form:"namespace" binding:"lte=32"`
+	IdentityUUID string `form:"identityUUID" binding:"lte=64"`
+	SourceIP     string `form:"sourceIP" binding:"lte=64"`
+}
+
+type clearLockoutResponse struct {
+	Existed bool `json:"existed"`
+}
+
+func (r *PasswordRouter) ClearLockout(ctx *gin.Context) {
+	var requestData clearLockoutRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	clearRequest := &password.ClearLockoutRequest{
+		Type:      password.Lockout_IDENTITY,
+		Namespace: requestData.Namespace,
+		Identity:  requestData.IdentityUUID,
+	}
+	if requestData.Type == "source" {
+		clearRequest = &password.ClearLockoutRequest{
+			Type:     password.Lockout_SOURCE,
+			SourceIP: requestData.SourceIP,
+		}
+	}
+
+	// Source lockouts affects all the namespaces, so they can be cleared only with access to the global namespace
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            clearRequest.Namespace,
+			Resources:            []string{"native.iam.authentication.password.*"},
+			Actions:              []string{"native.iam.authentication.password.lockout.clear"},
+			NamespaceIndependent: false,
+		},
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+
+	clearResponse, err := r.nativeStub.Services.IAM.Authentication.Password.ClearLockout(ctx.Request.Context(), clearRequest)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": st.Message()})
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &clearLockoutResponse{Existed: clearResponse.Existed})
+}
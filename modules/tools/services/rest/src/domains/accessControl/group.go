@@ -0,0 +1,490 @@
+package accesscontrol
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/group"
+	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/lib/authTools"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GroupRouter struct {
+	nativeStub *native.NativeStub
+}
+
+type groupReference struct {
+	Namespace string `json:"namespace"`
+	UUID      string `json:"uuid"`
+}
+
+type formatedGroup struct {
+	Namespace   string `json:"namespace"`
+	UUID        string `json:"uuid"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	Identities []string         `json:"identities"`
+	Groups     []string         `json:"groups"`
+	Roles      []groupReference `json:"roles"`
+	Policies   []groupReference `json:"policies"`
+
+	Created string `json:"created"`
+	Updated string `json:"updated"`
+	Version uint64 `json:"version"`
+}
+
+func FormatGroup(g *group.Group) *formatedGroup {
+	roles := make([]groupReference, 0, len(g.Roles))
+	for _, r := range g.Roles {
+		roles = append(roles, groupReference{Namespace: r.Namespace, UUID: r.Uuid})
+	}
+	policies := make([]groupReference, 0, len(g.Policies))
+	for _, p := range g.Policies {
+		policies = append(policies, groupReference{Namespace: p.Namespace, UUID: p.Uuid})
+	}
+
+	return &formatedGroup{
+		Namespace:   g.Namespace,
+		UUID:        g.Uuid,
+		Name:        g.Name,
+		Description: g.Description,
+		Identities:  g.Identities,
+		Groups:      g.Groups,
+		Roles:       roles,
+		Policies:    policies,
+		Created:     g.Created.AsTime().UTC().Format(time.RFC3339),
+		Updated:     g.Updated.AsTime().UTC().Format(time.RFC3339),
+		Version:     g.Version,
+	}
+}
+
+// Checks auth and aborts request if access is not granted
+func (r *GroupRouter) checkAuth(ctx *gin.Context, scopes []*auth.Scope) bool {
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, scopes)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return false
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return false
+	}
+	return true
+}
+
+func abortWithGroupChangeError(ctx *gin.Context, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.NotFound:
+			ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "Group not found"})
+			return
+		case codes.FailedPrecondition:
+			ctx.AbortWithStatusJSON(http.StatusPreconditionFailed, gin.H{"message": st.Message()})
+			return
+		case codes.InvalidArgument:
+			ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": st.Message()})
+			return
+		}
+	}
+	ctx.AbortWithError(http.StatusInternalServerError, err)
+}
+
+type getGroupListRequest struct {
+	Namespace string `form:"namespace" binding:"lte=32"`
+	Skip      uint32 `form:"skip" binding:"gte=0"`
+	Limit     uint32 `form:"limit" binding:"lte=100,gte=0"`
+}
+
+type getGroupListResponse struct {
+	Groups []*formatedGroup `json:"groups"`
+}
+
+func (r *GroupRouter) List(ctx *gin.Context) {
+	var requestData getGroupListRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, []*auth.Scope{{
+		Namespace: requestData.Namespace,
+		Resources: []string{"native.iam.group.*"},
+		Actions:   []string{"native.iam.group.get"},
+	}}) {
+		return
+	}
+
+	listStream, err := r.nativeStub.Services.IAM.Group.List(ctx.Request.Context(), &group.ListGroupsRequest{
+		Namespace: requestData.Namespace,
+		Skip:      uint64(requestData.Skip),
+		Limit:     uint64(requestData.Limit),
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	groups := make([]*formatedGroup, 0, 100)
+	for {
+		response, err := listStream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			ctx.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		groups = append(groups, FormatGroup(response.Group))
+	}
+
+	ctx.JSON(http.StatusOK, &getGroupListResponse{Groups: groups})
+}
+
+type getGroupRequest struct {
+	Namespace string `form:"namespace" binding:"lte=32"`
+	UUID      string `form:"uuid" binding:"required,lte=64"`
+}
+
+type groupResponse struct {
+	Group *formatedGroup `json:"group"`
+}
+
+func (r *GroupRouter) Get(ctx *gin.Context) {
+	var requestData getGroupRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, []*auth.Scope{{
+		Namespace: requestData.Namespace,
+		Resources: []string{"native.iam.group." + requestData.UUID},
+		Actions:   []string{"native.iam.group.get"},
+	}}) {
+		return
+	}
+
+	response, err := r.nativeStub.Services.IAM.Group.Get(ctx.Request.Context(), &group.GetGroupRequest{
+		Namespace: requestData.Namespace,
+		Uuid:      requestData.UUID,
+	})
+	if err != nil {
+		abortWithGroupChangeError(ctx, err)
+		return
+	}
+
+	etag := strconv.FormatUint(response.Group.Version, 10)
+	if etag == ctx.Request.Header.Get("If-None-Match") {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+
+	ctx.Header("ETag", etag)
+	ctx.Header("Cache-Control", "private")
+
+	ctx.JSON(http.StatusOK, groupResponse{Group: FormatGroup(response.Group)})
+}
+
+type createGroupRequest struct {
+	Namespace   string `json:"namespace" binding:"lte=32"`
+	Name        string `json:"name" binding:"required,lte=64"`
+	Description string `json:"description" binding:"lte=256"`
+}
+
+func (r *GroupRouter) Create(ctx *gin.Context) {
+	var requestData createGroupRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, []*auth.Scope{{
+		Namespace: requestData.Namespace,
+		Resources: []string{"native.iam.group"},
+		Actions:   []string{"native.iam.group.create"},
+	}}) {
+		return
+	}
+
+	response, err := r.nativeStub.Services.IAM.Group.Create(ctx.Request.Context(), &group.CreateGroupRequest{
+		Namespace:   requestData.Namespace,
+		Name:        requestData.Name,
+		Description: requestData.Description,
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, groupResponse{Group: FormatGroup(response.Group)})
+}
+
+type updateGroupRequest struct {
+	Namespace      string `json:"namespace" binding:"lte=32"`
+	UUID           string `json:"uuid" binding:"required,lte=64"`
+	NewName        string `json:"newName" binding:"required,lte=64"`
+	NewDescription string `json:"newDescription" binding:"lte=256"`
+}
+
+func (r *GroupRouter) Update(ctx *gin.Context) {
+	var requestData updateGroupRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, []*auth.Scope{{
+		Namespace: requestData.Namespace,
+		Resources: []string{"native.iam.group." + requestData.UUID},
+		Actions:   []string{"native.iam.group.update"},
+	}}) {
+		return
+	}
+
+	response, err := r.nativeStub.Services.IAM.Group.Update(ctx.Request.Context(), &group.UpdateGroupRequest{
+		Namespace:      requestData.Namespace,
+		Uuid:           requestData.UUID,
+		NewName:        requestData.NewName,
+		NewDescription: requestData.NewDescription,
+	})
+	if err != nil {
+		abortWithGroupChangeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, groupResponse{Group: FormatGroup(response.Group)})
+}
+
+type deleteGroupRequest struct {
+	Namespace string `form:"namespace" binding:"lte=32"`
+	UUID      string `form:"uuid" binding:"required,lte=64"`
+}
+
+func (r *GroupRouter) Delete(ctx *gin.Context) {
+	var requestData deleteGroupRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, []*auth.Scope{{
+		Namespace: requestData.Namespace,
+		Resources: []string{"native.iam.group." + requestData.UUID},
+		Actions:   []string{"native.iam.group.delete"},
+	}}) {
+		return
+	}
+
+	response, err := r.nativeStub.Services.IAM.Group.Delete(ctx.Request.Context(), &group.DeleteGroupRequest{
+		Namespace: requestData.Namespace,
+		Uuid:      requestData.UUID,
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !response.Existed {
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "Group not found"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{})
+}
+
+type groupMemberRequest struct {
+	Namespace string `json:"namespace" binding:"lte=32"`
+	GroupUUID string `json:"groupUUID" binding:"required,lte=64"`
+	// Identity or nested group depending on the endpoint
+	MemberUUID string `json:"memberUUID" binding:"required,lte=64"`
+}
+
+// Adds or removes identity or nested group. Both operations require permission to update the group.
+func (r *GroupRouter) changeMember(ctx *gin.Context, change func(requestData *groupMemberRequest) (*group.Group, error)) {
+	var requestData groupMemberRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, []*auth.Scope{{
+		Namespace: requestData.Namespace,
+		Resources: []string{"native.iam.group." + requestData.GroupUUID},
+		Actions:   []string{"native.iam.group.update"},
+	}}) {
+		return
+	}
+
+	updatedGroup, err := change(&requestData)
+	if err != nil {
+		abortWithGroupChangeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, groupResponse{Group: FormatGroup(updatedGroup)})
+}
+
+func (r *GroupRouter) AddIdentity(ctx *gin.Context) {
+	r.changeMember(ctx, func(requestData *groupMemberRequest) (*group.Group, error) {
+		response, err := r.nativeStub.Services.IAM.Group.AddIdentity(ctx.Request.Context(), &group.AddIdentityRequest{
+			Namespace:    requestData.Namespace,
+			GroupUUID:    requestData.GroupUUID,
+			IdentityUUID: requestData.MemberUUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Group, nil
+	})
+}
+
+func (r *GroupRouter) RemoveIdentity(ctx *gin.Context) {
+	r.changeMember(ctx, func(requestData *groupMemberRequest) (*group.Group, error) {
+		response, err := r.nativeStub.Services.IAM.Group.RemoveIdentity(ctx.Request.Context(), &group.RemoveIdentityRequest{
+			Namespace:    requestData.Namespace,
+			GroupUUID:    requestData.GroupUUID,
+			IdentityUUID: requestData.MemberUUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Group, nil
+	})
+}
+
+func (r *GroupRouter) AddGroup(ctx *gin.Context) {
+	r.changeMember(ctx, func(requestData *groupMemberRequest) (*group.Group, error) {
+		response, err := r.nativeStub.Services.IAM.Group.AddGroup(ctx.Request.Context(), &group.AddGroupRequest{
+			Namespace:       requestData.Namespace,
+			GroupUUID:       requestData.GroupUUID,
+			MemberGroupUUID: requestData.MemberUUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Group, nil
+	})
+}
+
+func (r *GroupRouter) RemoveGroup(ctx *gin.Context) {
+	r.changeMember(ctx, func(requestData *groupMemberRequest) (*group.Group, error) {
+		response, err := r.nativeStub.Services.IAM.Group.RemoveGroup(ctx.Request.Context(), &group.RemoveGroupRequest{
+			Namespace:       requestData.Namespace,
+			GroupUUID:       requestData.GroupUUID,
+			MemberGroupUUID: requestData.MemberUUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Group, nil
+	})
+}
+
+type groupAssignmentRequest struct {
+	GroupNamespace string `json:"groupNamespace" binding:"lte=32"`
+	GroupUUID      string `json:"groupUUID" binding:"required,lte=64"`
+	// Role or policy depending on the endpoint
+	Namespace string `json:"namespace" binding:"lte=32"`
+	UUID      string `json:"uuid" binding:"required,lte=64"`
+}
+
+// Attaches or detaches role or policy. Role or policy from other namespace requires "useInOtherNamespace" permission for it, the same way as for the identity.
+func (r *GroupRouter) changeAssignment(ctx *gin.Context, resource string, change func(requestData *groupAssignmentRequest) (*group.Group, error)) {
+	var requestData groupAssignmentRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	scopes := []*auth.Scope{{
+		Namespace: requestData.GroupNamespace,
+		Resources: []string{"native.iam.group." + requestData.GroupUUID},
+		Actions:   []string{"native.iam.group.update"},
+	}}
+	if requestData.GroupNamespace != requestData.Namespace {
+		scopes = append(scopes, &auth.Scope{
+			Namespace: requestData.Namespace,
+			Resources: []string{"native.iam." + resource + "." + requestData.UUID},
+			Actions:   []string{"native.iam." + resource + ".useInOtherNamespace"},
+		})
+	}
+	if !r.checkAuth(ctx, scopes) {
+		return
+	}
+
+	updatedGroup, err := change(&requestData)
+	if err != nil {
+		abortWithGroupChangeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, groupResponse{Group: FormatGroup(updatedGroup)})
+}
+
+func (r *GroupRouter) AddRole(ctx *gin.Context) {
+	r.changeAssignment(ctx, "role", func(requestData *groupAssignmentRequest) (*group.Group, error) {
+		response, err := r.nativeStub.Services.IAM.Group.AddRole(ctx.Request.Context(), &group.AddRoleRequest{
+			GroupNamespace: requestData.GroupNamespace,
+			GroupUUID:      requestData.GroupUUID,
+			RoleNamespace:  requestData.Namespace,
+			RoleUUID:       requestData.UUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Group, nil
+	})
+}
+
+func (r *GroupRouter) RemoveRole(ctx *gin.Context) {
+	r.changeAssignment(ctx, "role", func(requestData *groupAssignmentRequest) (*group.Group, error) {
+		response, err := r.nativeStub.Services.IAM.Group.RemoveRole(ctx.Request.Context(), &group.RemoveRoleRequest{
+			GroupNamespace: requestData.GroupNamespace,
+			GroupUUID:      requestData.GroupUUID,
+			RoleNamespace:  requestData.Namespace,
+			RoleUUID:       requestData.UUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Group, nil
+	})
+}
+
+func (r *GroupRouter) AddPolicy(ctx *gin.Context) {
+	r.changeAssignment(ctx, "policy", func(requestData *groupAssignmentRequest) (*group.Group, error) {
+		response, err := r.nativeStub.Services.IAM.Group.AddPolicy(ctx.Request.Context(), &group.AddPolicyRequest{
+			GroupNamespace:  requestData.GroupNamespace,
+			GroupUUID:       requestData.GroupUUID,
+			PolicyNamespace: requestData.Namespace,
+			PolicyUUID:      requestData.UUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Group, nil
+	})
+}
+
+func (r *GroupRouter) RemovePolicy(ctx *gin.Context) {
+	r.changeAssignment(ctx, "policy", func(requestData *groupAssignmentRequest) (*group.Group, error) {
+		response, err := r.nativeStub.Services.IAM.Group.RemovePolicy(ctx.Request.Context(), &group.RemovePolicyRequest{
+			GroupNamespace:  requestData.GroupNamespace,
+			GroupUUID:       requestData.GroupUUID,
+			PolicyNamespace: requestData.Namespace,
+			PolicyUUID:      requestData.UUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Group, nil
+	})
+}
@@ -283,6 +283,120 @@ func (r *IdentityRouter) Get(ctx *gin.Context) {
 	ctx.JSON(200, &getResponse{Identity: FormatIdentity(identityResponse.Identity)})
 }
 
+type getEffectivePoliciesRequest struct {
+	Namespace string `form:"namespace" binding:"lte=32"`
+	UUID      string `form:"uuid" binding:"required,lte=64"`
+}
+
+type effectivePolicyRole struct {
+	Namespace   string `json:"namespace"`
+	UUID        string `json:"uuid"`
+	Name        string `json:"name"`
+	BuiltInType string `json:"builtInType"`
+}
+
+type effectivePolicy struct {
+	Namespace            string                 `json:"namespace"`
+	UUID                 string                 `json:"uuid"`
+	Name                 string                 `json:"name"`
+	NamespaceIndependent bool                   `json:"namespaceIndependent"`
+	Resources            []string               `json:"resources"`
+	Actions              []string               `json:"actions"`
+	Effect               string                 `json:"effect"`
+	Conditional          bool                   `json:"conditional"`
+	BuiltInType          string                 `json:"builtInType"`
+	Direct               bool                   `json:"direct"`
+	Roles                []*effectivePolicyRole `json:"roles"`
+}
+
+type getEffectivePoliciesResponse struct {
+	Policies []*effectivePolicy `json:"policies"`
+}
+
+func formatEffectivePolicy(p *identity.EffectivePolicy) *effectivePolicy {
+	effect := "allow"
+	if p.Deny {
+		effect = "deny"
+	}
+
+	roles := make([]*effectivePolicyRole, len(p.Roles))
+	for i, role := range p.Roles {
+		roles[i] = &effectivePolicyRole{
+			Namespace:   role.Namespace,
+			UUID:        role.Uuid,
+			Name:        role.Name,
+			BuiltInType: role.BuiltInType,
+		}
+	}
+
+	return &effectivePolicy{
+		Namespace:            p.Namespace,
+		UUID:                 p.Uuid,
+		Name:                 p.Name,
+		NamespaceIndependent: p.NamespaceIndependent,
+		Resources:            p.Resources,
+		Actions:              p.Actions,
+		Effect:               effect,
+		Conditional:          p.Conditional,
+		BuiltInType:          p.BuiltInType,
+		Direct:               p.Direct,
+		Roles:                roles,
+	}
+}
+
+func (r *IdentityRouter) GetEffectivePolicies(ctx *gin.Context) {
+	var requestData getEffectivePoliciesRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	// Check auth
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            requestData.Namespace,
+			Resources:            []string{"native.iam.identity." + requestData.UUID},
+			Actions:              []string{"native.iam.identity.get"},
+			NamespaceIndependent: false,
+		},
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+
+	response, err := r.nativeStub.Services.IAM.Identity.GetEffectivePolicies(ctx.Request.Context(), &identity.GetEffectivePoliciesRequest{
+		Namespace: requestData.Namespace,
+		Uuid:      requestData.UUID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.InvalidArgument {
+				ctx.AbortWithStatusJSON(422, gin.H{"message": "Invalid identity UUID or Namespace arguments."})
+				return
+			}
+			if st.Code() == codes.NotFound {
+				ctx.AbortWithStatusJSON(404, gin.H{"message": "Identity not found"})
+				return
+			}
+		}
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	policies := make([]*effectivePolicy, len(response.Policies))
+	for i, p := range response.Policies {
+		policies[i] = formatEffectivePolicy(p)
+	}
+
+	ctx.JSON(200, &getEffectivePoliciesResponse{Policies: policies})
+}
+
 type deleteRequest struct {
 	Namespace string `form:"namespace" binding:"lte=32"`
 	UUID      string `form:"uuid" binding:"required,lte=64"`
@@ -0,0 +1,456 @@
+package accesscontrol
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/oidc"
+	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/lib/authTools"
+)
+
+type OIDCRouter struct {
+	nativeStub *native.NativeStub
+}
+
+type oidcClientScope struct {
+	Name                 string   `json:"name" binding:"required,lte=64"`
+	Description          string   `json:"description" binding:"lte=256"`
+	Resources            []string `json:"resources" binding:"lte=64"`
+	Actions              []string `json:"actions" binding:"lte=64"`
+	NamespaceIndependent bool     `json:"namespaceIndependent"`
+}
+
+type formatedOIDCClient struct {
+	Namespace    string            `json:"namespace"`
+	UUID         string            `json:"uuid"`
+	ClientID     string            `json:"clientId"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	Enabled      bool              `json:"enabled"`
+	Confidential bool              `json:"confidential"`
+	RedirectURIs []string          `json:"redirectURIs"`
+	GrantTypes   []string          `json:"grantTypes"`
+	Scopes       []oidcClientScope `json:"scopes"`
+	Identity     string            `json:"identity"`
+	SkipConsent  bool              `json:"skipConsent"`
+	Created      string            `json:"created"`
+	Updated      string            `json:"updated"`
+	Version      uint64            `json:"version"`
+}
+
+func formatOIDCClient(c *oidc.Client) *formatedOIDCClient {
+	redirectURIs := make([]string, 0)
+	if len(c.RedirectURIs) > 0 {
+		redirectURIs = c.RedirectURIs
+	}
+	grantTypes := make([]string, 0, len(c.GrantTypes))
+	for _, grantType := range c.GrantTypes {
+		grantTypes = append(grantTypes, strings.ToLower(grantType.String()))
+	}
+	scopes := make([]oidcClientScope, 0, len(c.Scopes))
+	for _, scope := range c.Scopes {
+		resources := make([]string, 0)
+		if len(scope.Resources) > 0 {
+			resources = scope.Resources
+		}
+		actions := make([]string, 0)
+		if len(scope.Actions) > 0 {
+			actions = scope.Actions
+		}
+		scopes = append(scopes, oidcClientScope{
+			Name:                 scope.Name,
+			Description:          scope.Description,
+			Resources:            resources,
+			Actions:              actions,
+			NamespaceIndependent: scope.NamespaceIndependent,
+		})
+	}
+
+	return &formatedOIDCClient{
+		Namespace:    c.Namespace,
+		UUID:         c.Uuid,
+		ClientID:     c.ClientId,
+		Name:         c.Name,
+		Description:  c.Description,
+		Enabled:      c.Enabled,
+		Confidential: c.Confidential,
+		RedirectURIs: redirectURIs,
+		GrantTypes:   grantTypes,
+		Scopes:       scopes,
+		Identity:     c.Identity,
+		SkipConsent:  c.SkipConsent,
+		Created:      c.Created.AsTime().UTC().Format(time.RFC3339),
+		Updated:      c.Updated.AsTime().UTC().Format(time.RFC3339),
+		Version:      c.Version,
+	}
+}
+
+type oidcClientParameters struct {
+	Name         string            `json:"name" binding:"required,lte=64"`
+	Description  string            `json:"description" binding:"lte=256"`
+	Enabled      bool              `json:"enabled"`
+	Confidential bool              `json:"confidential"`
+	RedirectURIs []string          `json:"redirectURIs" binding:"lte=32"`
+	GrantTypes   []string          `json:"grantTypes" binding:"required,gt=0,dive,oneof=authorization_code client_credentials refresh_token"`
+	Scopes       []oidcClientScope `json:"scopes" binding:"lte=64,dive"`
+	Identity     string            `json:"identity" binding:"lte=64"`
+	SkipConsent  bool              `json:"skipConsent"`
+}
+
+func (p *oidcClientParameters) toGRPC() *oidc.ClientParameters {
+	grantTypes := make([]oidc.GrantType, 0, len(p.GrantTypes))
+	for _, grantType := range p.GrantTypes {
+		grantTypes = append(grantTypes, oidc.GrantType(oidc.GrantType_value[strings.ToUpper(grantType)]))
+	}
+	scopes := make([]*oidc.ClientScope, 0, len(p.Scopes))
+	for _, scope := range p.Scopes {
+		scopes = append(scopes, &oidc.ClientScope{
+			Name:                 scope.Name,
+			Description:          scope.Description,
+			Resources:            scope.Resources,
+			Actions:              scope.Actions,
+			NamespaceIndependent: scope.NamespaceIndependent,
+		})
+	}
+
+	return &oidc.ClientParameters{
+		Name:         p.Name,
+		Description:  p.Description,
+		Enabled:      p.Enabled,
+		Confidential: p.Confidential,
+		RedirectURIs: p.RedirectURIs,
+		GrantTypes:   grantTypes,
+		Scopes:       scopes,
+		Identity:     p.Identity,
+		SkipConsent:  p.SkipConsent,
+	}
+}
+
+func (r *OIDCRouter) checkAuth(ctx *gin.Context, namespace string, resource string, action string) bool {
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            namespace,
+			Resources:            []string{resource},
+			Actions:              []string{action},
+			NamespaceIndependent: false,
+		},
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return false
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return false
+	}
+	return true
+}
+
+type listOIDCClientsRequest struct {
+	Namespace string `form:"namespace" binding:"lte=32"`
+}
+type listOIDCClientsResponse struct {
+	Clients []*formatedOIDCClient `json:"clients"`
+}
+
+func (r *OIDCRouter) ListClients(ctx *gin.Context) {
+	var requestData listOIDCClientsRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, requestData.Namespace, "native.iam.oidc.client.*", "native.iam.oidc.client.get") {
+		return
+	}
+
+	listResponse, err := r.nativeStub.Services.IAM.OIDC.ListClients(ctx.Request.Context(), &oidc.ListClientsRequest{Namespace: requestData.Namespace})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	clients := make([]*formatedOIDCClient, 0, len(listResponse.Clients))
+	for _, client := range listResponse.Clients {
+		clients = append(clients, formatOIDCClient(client))
+	}
+	ctx.JSON(http.StatusOK, &listOIDCClientsResponse{Clients: clients})
+}
+
+type getOIDCClientRequest struct {
+	Namespace string `form:"namespace" binding:"lte=32"`
+	UUID      string `form:"uuid" binding:"required,lte=64"`
+}
+type getOIDCClientResponse struct {
+	Client *formatedOIDCClient `json:"client"`
+}
+
+func (r *OIDCRouter) GetClient(ctx *gin.Context) {
+	var requestData getOIDCClientRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, requestData.Namespace, "native.iam.oidc.client."+requestData.UUID, "native.iam.oidc.client.get") {
+		return
+	}
+
+	getResponse, err := r.nativeStub.Services.IAM.OIDC.GetClient(ctx.Request.Context(), &oidc.GetClientRequest{
+		Namespace: requestData.Namespace,
+		Uuid:      requestData.UUID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "Client not found"})
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &getOIDCClientResponse{Client: formatOIDCClient(getResponse.Client)})
+}
+
+type createOIDCClientRequest struct {
+	Namespace string `json:"namespace" binding:"lte=32"`
+	oidcClientParameters
+}
+type createOIDCClientResponse struct {
+	Client *formatedOIDCClient `json:"client"`
+	// Only returned once for confidential clients
+	ClientSecret string `json:"clientSecret"`
+}
+
+func (r *OIDCRouter) CreateClient(ctx *gin.Context) {
+	var requestData createOIDCClientRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, requestData.Namespace, "native.iam.oidc.client", "native.iam.oidc.client.create") {
+		return
+	}
+
+	createResponse, err := r.nativeStub.Services.IAM.OIDC.CreateClient(ctx.Request.Context(), &oidc.CreateClientRequest{
+		Namespace:  requestData.Namespace,
+		Parameters: requestData.oidcClientParameters.toGRPC(),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": st.Message()})
+				return
+			case codes.FailedPrecondition:
+				ctx.AbortWithStatusJSON(http.StatusPreconditionFailed, gin.H{"message": st.Message()})
+				return
+			}
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &createOIDCClientResponse{Client: formatOIDCClient(createResponse.Client), ClientSecret: createResponse.ClientSecret})
+}
+
+type updateOIDCClientRequest struct {
+	Namespace string `json:"namespace" binding:"lte=32"`
+	UUID      string `json:"uuid" binding:"required,lte=64"`
+	oidcClientParameters
+}
+type updateOIDCClientResponse struct {
+	Client *formatedOIDCClient `json:"client"`
+}
+
+func (r *OIDCRouter) UpdateClient(ctx *gin.Context) {
+	var requestData updateOIDCClientRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, requestData.Namespace, "native.iam.oidc.client."+requestData.UUID, "native.iam.oidc.client.update") {
+		return
+	}
+
+	updateResponse, err := r.nativeStub.Services.IAM.OIDC.UpdateClient(ctx.Request.Context(), &oidc.UpdateClientRequest{
+		Namespace:  requestData.Namespace,
+		Uuid:       requestData.UUID,
+		Parameters: requestData.oidcClientParameters.toGRPC(),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": st.Message()})
+				return
+			case codes.NotFound:
+				ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "Client not found"})
+				return
+			}
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &updateOIDCClientResponse{Client: formatOIDCClient(updateResponse.Client)})
+}
+
+type regenerateOIDCClientSecretRequest struct {
+	Namespace string `json:"namespace" binding:"lte=32"`
+	UUID      string `json:"uuid" binding:"required,lte=64"`
+}
+type regenerateOIDCClientSecretResponse struct {
+	ClientSecret string `json:"clientSecret"`
+}
+
+func (r *OIDCRouter) RegenerateClientSecret(ctx *gin.Context) {
+	var requestData regenerateOIDCClientSecretRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, requestData.Namespace, "native.iam.oidc.client."+requestData.UUID, "native.iam.oidc.client.update") {
+		return
+	}
+
+	regenerateResponse, err := r.nativeStub.Services.IAM.OIDC.RegenerateClientSecret(ctx.Request.Context(), &oidc.RegenerateClientSecretRequest{
+		Namespace: requestData.Namespace,
+		Uuid:      requestData.UUID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "Client not found"})
+				return
+			case codes.FailedPrecondition:
+				ctx.AbortWithStatusJSON(http.StatusPreconditionFailed, gin.H{"message": st.Message()})
+				return
+			}
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &regenerateOIDCClientSecretResponse{ClientSecret: regenerateResponse.ClientSecret})
+}
+
+type deleteOIDCClientRequest struct {
+	Namespace string `form:"namespace" binding:"lte=32"`
+	UUID      string `form:"uuid" binding:"required,lte=64"`
+}
+
+func (r *OIDCRouter) DeleteClient(ctx *gin.Context) {
+	var requestData deleteOIDCClientRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, requestData.Namespace, "native.iam.oidc.client."+requestData.UUID, "native.iam.oidc.client.delete") {
+		return
+	}
+
+	_, err := r.nativeStub.Services.IAM.OIDC.DeleteClient(ctx.Request.Context(), &oidc.DeleteClientRequest{
+		Namespace: requestData.Namespace,
+		Uuid:      requestData.UUID,
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{})
+}
+
+type formatedOIDCConsent struct {
+	ClientID string   `json:"clientId"`
+	Scopes   []string `json:"scopes"`
+	Created  string   `json:"created"`
+	Updated  string   `json:"updated"`
+}
+
+type listOIDCConsentsRequest struct {
+	Namespace string `form:"namespace" binding:"lte=32"`
+	Identity  string `form:"identity" binding:"required,lte=64"`
+}
+type listOIDCConsentsResponse struct {
+	Consents []*formatedOIDCConsent `json:"consents"`
+}
+
+func (r *OIDCRouter) ListConsents(ctx *gin.Context) {
+	var requestData listOIDCConsentsRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, requestData.Namespace, "native.iam.identity."+requestData.Identity, "native.iam.oidc.consent.list") {
+		return
+	}
+
+	listResponse, err := r.nativeStub.Services.IAM.OIDC.ListConsents(ctx.Request.Context(), &oidc.ListConsentsRequest{
+		Namespace: requestData.Namespace,
+		Identity:  requestData.Identity,
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	consents := make([]*formatedOIDCConsent, 0, len(listResponse.Consents))
+	for _, consent := range listResponse.Consents {
+		scopes := make([]string, 0)
+		if len(consent.Scopes) > 0 {
+			scopes = consent.Scopes
+		}
+		consents = append(consents, &formatedOIDCConsent{
+			ClientID: consent.ClientId,
+			Scopes:   scopes,
+			Created:  consent.Created.AsTime().UTC().Format(time.RFC3339),
+			Updated:  consent.Updated.AsTime().UTC().Format(time.RFC3339),
+		})
+	}
+	ctx.JSON(http.StatusOK, &listOIDCConsentsResponse{Consents: consents})
+}
+
+type revokeOIDCConsentRequest struct {
+	Namespace string `form:"namespace" binding:"lte=32"`
+	Identity  string `form:"identity" binding:"required,lte=64"`
+	ClientID  string `form:"clientId" binding:"required,lte=128"`
+}
+
+func (r *OIDCRouter) RevokeConsent(ctx *gin.Context) {
+	var requestData revokeOIDCConsentRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	if !r.checkAuth(ctx, requestData.Namespace, "native.iam.identity."+requestData.Identity, "native.iam.oidc.consent.revoke") {
+		return
+	}
+
+	_, err := r.nativeStub.Services.IAM.OIDC.RevokeConsent(ctx.Request.Context(), &oidc.RevokeConsentRequest{
+		Namespace: requestData.Namespace,
+		Identity:  requestData.Identity,
+		ClientId:  requestData.ClientID,
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{})
+}
@@ -4,14 +4,17 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/policy"
 	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/lib/authTools"
 )
@@ -29,6 +32,93 @@ type policyManagementInformation struct {
 	IdentityUUID      *string `json:"identityUUID"`
 }
 
+type policyTimeWindow struct {
+	NotBefore        *time.Time `json:"notBefore"`
+	NotAfter         *time.Time `json:"notAfter"`
+	DailyStartMinute uint32     `json:"dailyStartMinute" binding:"lte=1439"`
+	DailyEndMinute   uint32     `json:"dailyEndMinute" binding:"lte=1439"`
+	Timezone         string     `json:"timezone" binding:"lte=64"`
+}
+
+type policyConditions struct {
+	SourceIPs   []string          `json:"sourceIPs" binding:"lte=64"`
+	TimeWindow  *policyTimeWindow `json:"timeWindow"`
+	AuthMethods []string          `json:"authMethods" binding:"dive,oneof=password x509 oauth2 sso oauth2_client api_key"`
+	RequireMFA  bool              `json:"requireMFA"`
+}
+
+func formatPolicyConditions(c *policy.PolicyConditions) *policyConditions {
+	if c == nil {
+		return nil
+	}
+
+	conditions := &policyConditions{
+		SourceIPs:   make([]string, 0),
+		AuthMethods: make([]string, 0, len(c.AuthMethods)),
+		RequireMFA:  c.RequireMFA,
+	}
+	if c.SourceIPs != nil {
+		conditions.SourceIPs = c.SourceIPs
+	}
+	for _, method := range c.AuthMethods {
+		conditions.AuthMethods = append(conditions.AuthMethods, strings.ToLower(method.String()))
+	}
+	if c.TimeWindow != nil {
+		conditions.TimeWindow = &policyTimeWindow{
+			DailyStartMinute: c.TimeWindow.DailyStartMinute,
+			DailyEndMinute:   c.TimeWindow.DailyEndMinute,
+			Timezone:         c.TimeWindow.Timezone,
+		}
+		if c.TimeWindow.NotBefore != nil {
+			notBefore := c.TimeWindow.NotBefore.AsTime().UTC()
+			conditions.TimeWindow.NotBefore = &notBefore
+		}
+		if c.TimeWindow.NotAfter != nil {
+			notAfter := c.TimeWindow.NotAfter.AsTime().UTC()
+			conditions.TimeWindow.NotAfter = &notAfter
+		}
+	}
+
+	return conditions
+}
+
+func (c *policyConditions) toGRPC() *policy.PolicyConditions {
+	if c == nil {
+		return nil
+	}
+
+	conditions := &policy.PolicyConditions{
+		SourceIPs:   c.SourceIPs,
+		AuthMethods: make([]policy.AuthMethod, 0, len(c.AuthMethods)),
+		RequireMFA:  c.RequireMFA,
+	}
+	for _, method := range c.AuthMethods {
+		conditions.AuthMethods = append(conditions.AuthMethods, policy.AuthMethod(policy.AuthMethod_value[strings.ToUpper(method)]))
+	}
+	if c.TimeWindow != nil {
+		conditions.TimeWindow = &policy.TimeWindow{
+			DailyStartMinute: c.TimeWindow.DailyStartMinute,
+			DailyEndMinute:   c.TimeWindow.DailyEndMinute,
+			Timezone:         c.TimeWindow.Timezone,
+		}
+		if c.TimeWindow.NotBefore != nil {
+			conditions.TimeWindow.NotBefore = timestamppb.New(*c.TimeWindow.NotBefore)
+		}
+		if c.TimeWindow.NotAfter != nil {
+			conditions.TimeWindow.NotAfter = timestamppb.New(*c.TimeWindow.NotAfter)
+		}
+	}
+
+	return conditions
+}
+
+func policyEffectFromString(effect string) policy.PolicyEffect {
+	if effect == "deny" {
+		return policy.PolicyEffect_DENY
+	}
+	return policy.PolicyEffect_ALLOW
+}
+
 type formatedPolicy struct {
 	Namespace   string `json:"namespace"`
 	UUID        string `json:"uuid"`
@@ -41,6 +131,9 @@ type formatedPolicy struct {
 	Actions              []string `json:"actions"`
 	NamespaceIndependent bool     `json:"namespaceIndependent"`
 
+	Effect     string            `json:"effect"`
+	Conditions *policyConditions `json:"conditions"`
+
 	Tags    []string `json:"tags"`
 	Created string   `json:"created"`
 	Updated string   `json:"updated"`
@@ -86,6 +179,8 @@ func FormatPolicy(i *policy.Policy) *formatedPolicy {
 		Resources:            resources,
 		Actions:              actions,
 		NamespaceIndependent: i.NamespaceIndependent,
+		Effect:               strings.ToLower(i.Effect.String()),
+		Conditions:           formatPolicyConditions(i.Conditions),
 		Tags:                 tags,
 		Created:              i.Created.AsTime().UTC().Format(time.RFC3339),
 		Updated:              i.Updated.AsTime().UTC().Format(time.RFC3339),
@@ -177,6 +272,9 @@ type createPolicyRequest struct {
 	Resources            []string `json:"resources" binding:"lte=64"`
 	Actions              []string `json:"actions" binding:"lte=64"`
 	NamespaceIndependent bool     `json:"namespaceIndependent" binding:""`
+
+	Effect     string            `json:"effect" binding:"omitempty,oneof=allow deny"`
+	Conditions *policyConditions `json:"conditions"`
 }
 
 type createPolicyResponse struct {
@@ -219,11 +317,13 @@ func (r *PolicyRouter) Create(ctx *gin.Context) {
 		NamespaceIndependent: requestData.NamespaceIndependent,
 		Resources:            requestData.Resources,
 		Actions:              requestData.Actions,
+		Effect:               policyEffectFromString(requestData.Effect),
+		Conditions:           requestData.Conditions.toGRPC(),
 	})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.InvalidArgument {
-				ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": "Policy name/resource/action has bad format"})
+				ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": "Policy name/resource/action/conditions has bad format"})
 				return
 			}
 		}
@@ -343,6 +443,9 @@ type updatePolicyRequest struct {
 	Resources            []string `json:"resources" binding:"lte=64"`
 	Actions              []string `json:"actions" binding:"lte=64"`
 	NamespaceIndependent bool     `json:"namespaceIndependent"`
+
+	Effect     string            `json:"effect" binding:"omitempty,oneof=allow deny"`
+	Conditions *policyConditions `json:"conditions"`
 }
 
 type updatePolicyResponse struct {
@@ -383,11 +486,13 @@ func (r *PolicyRouter) Update(ctx *gin.Context) {
 		NamespaceIndependent: requestData.NamespaceIndependent,
 		Resources:            requestData.Resources,
 		Actions:              requestData.Actions,
+		Effect:               policyEffectFromString(requestData.Effect),
+		Conditions:           requestData.Conditions.toGRPC(),
 	})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			if st.Code() == codes.InvalidArgument {
-				ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": "Policy name/resource/action has bad format"})
+				ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": "Policy name/resource/action/conditions has bad format"})
 				return
 			}
 
@@ -403,3 +508,132 @@ func (r *PolicyRouter) Update(ctx *gin.Context) {
 
 	ctx.JSON(200, &updatePolicyResponse{Policy: FormatPolicy(response.Policy)})
 }
+
+type effectivePermission struct {
+	Namespace            string `json:"namespace"`
+	NamespaceIndependent bool   `json:"namespaceIndependent"`
+	Effect               string `json:"effect"`
+	Resource             string `json:"resource"`
+	Action               string `json:"action"`
+}
+
+type identityPermissionsDiff struct {
+	Identity string                 `json:"identity"`
+	Name     string                 `json:"name"`
+	Gained   []*effectivePermission `json:"gained"`
+	Lost     []*effectivePermission `json:"lost"`
+}
+
+func formatEffectivePermissions(permissions []*identity.EffectivePermission) []*effectivePermission {
+	formated := make([]*effectivePermission, len(permissions))
+	for i, p := range permissions {
+		effect := "allow"
+		if p.Deny {
+			effect = "deny"
+		}
+		formated[i] = &effectivePermission{
+			Namespace:            p.Namespace,
+			NamespaceIndependent: p.NamespaceIndependent,
+			Effect:               effect,
+			Resource:             p.Resource,
+			Action:               p.Action,
+		}
+	}
+	return formated
+}
+
+func formatIdentityPermissionsDiffs(diffs []*identity.IdentityPermissionsDiff) []*identityPermissionsDiff {
+	formated := make([]*identityPermissionsDiff, len(diffs))
+	for i, d := range diffs {
+		formated[i] = &identityPermissionsDiff{
+			Identity: d.Identity,
+			Name:     d.Name,
+			Gained:   formatEffectivePermissions(d.Gained),
+			Lost:     formatEffectivePermissions(d.Lost),
+		}
+	}
+	return formated
+}
+
+type simulatePolicyChangeRequest struct {
+	Namespace string `json:"namespace" binding:"lte=32"`
+	UUID      string `json:"uuid" binding:"required,lte=64"`
+	// If true, simulates policy deletion. Otherwise simulates update to the provided values
+	Delete bool `json:"delete"`
+
+	Resources            []string `json:"resources" binding:"lte=64"`
+	Actions              []string `json:"actions" binding:"lte=64"`
+	NamespaceIndependent bool     `json:"namespaceIndependent"`
+	Effect               string   `json:"effect" binding:"omitempty,oneof=allow deny"`
+}
+
+type simulatePolicyChangeResponse struct {
+	Identities []*identityPermissionsDiff `json:"identities"`
+}
+
+// Shows how effective permissions of the identities in the policy namespace will change after policy update or deletion. Nothing is persisted.
+func (r *PolicyRouter) Simulate(ctx *gin.Context) {
+	var requestData simulatePolicyChangeRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	// Check auth. Preview requires the same permissions as the change itself
+	action := "native.iam.policy.update"
+	if requestData.Delete {
+		action = "native.iam.policy.delete"
+	}
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            requestData.Namespace,
+			Resources:            []string{"native.iam.policy." + requestData.UUID},
+			Actions:              []string{action},
+			NamespaceIndependent: requestData.NamespaceIndependent,
+		},
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+
+	request := &identity.SimulatePolicyChangeRequest{Namespace: requestData.Namespace}
+	if requestData.Delete {
+		request.Change = &identity.SimulatePolicyChangeRequest_DeletePolicy{DeletePolicy: &identity.SimulatedPolicyReference{
+			Namespace: requestData.Namespace,
+			Uuid:      requestData.UUID,
+		}}
+	} else {
+		request.Change = &identity.SimulatePolicyChangeRequest_UpdatePolicy{UpdatePolicy: &identity.SimulatedPolicyUpdate{
+			Namespace:            requestData.Namespace,
+			Uuid:                 requestData.UUID,
+			NamespaceIndependent: requestData.NamespaceIndependent,
+			Resources:            requestData.Resources,
+			Actions:              requestData.Actions,
+			Deny:                 policyEffectFromString(requestData.Effect) == policy.PolicyEffect_DENY,
+		}}
+	}
+
+	response, err := r.nativeStub.Services.IAM.Identity.SimulatePolicyChange(ctx.Request.Context(), request)
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.InvalidArgument {
+				ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": "Policy UUID has bad format"})
+				return
+			}
+			if st.Code() == codes.NotFound {
+				ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "Policy not found"})
+				return
+			}
+		}
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &simulatePolicyChangeResponse{Identities: formatIdentityPermissionsDiffs(response.Identities)})
+}
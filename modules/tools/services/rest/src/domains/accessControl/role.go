@@ -9,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/role"
 	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/lib/authTools"
 	"google.golang.org/grpc/codes"
@@ -461,6 +462,84 @@ func (r *RoleRouter) RemovePolicy(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, removePolicyFromRoleResponse{Role: FormatRole(response.Role)})
 }
 
+type simulateRolePolicyChangeRequest struct {
+	RoleNamespace  string `json:"roleNamespace" binding:"lte=32"`
+	RoleUUID       string `json:"roleUUID" binding:"required,lte=64"`
+	Policyamespace string `json:"policyNamespace" binding:"lte=32"`
+	PolicyUUID     string `json:"policyUUID" binding:"required,lte=64"`
+	// Change to simulate
+	Operation string `json:"operation" binding:"required,oneof=addPolicy removePolicy"`
+}
+
+type simulateRolePolicyChangeResponse struct {
+	Identities []*identityPermissionsDiff `json:"identities"`
+}
+
+// Shows how effective permissions of the identities in the role namespace will change after adding or removing policy to/from the role. Nothing is persisted.
+func (r *RoleRouter) Simulate(ctx *gin.Context) {
+	var requestData simulateRolePolicyChangeRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	// Check auth. Preview requires the same permissions as the change itself
+	scopes := []*auth.Scope{
+		{
+			Namespace:            requestData.RoleNamespace,
+			Resources:            []string{"native.iam.role." + requestData.RoleUUID},
+			Actions:              []string{"native.iam.role.update"},
+			NamespaceIndependent: false,
+		},
+	}
+	if requestData.RoleNamespace != requestData.Policyamespace {
+		scopes = append(scopes, &auth.Scope{
+			Namespace:            requestData.Policyamespace,
+			Resources:            []string{"native.iam.policy." + requestData.PolicyUUID},
+			Actions:              []string{"native.iam.policy.useInOtherNamespace"},
+			NamespaceIndependent: false,
+		})
+	}
+
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, scopes)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+
+	change := &identity.SimulatedRolePolicyChange{
+		RoleNamespace:   requestData.RoleNamespace,
+		RoleUUID:        requestData.RoleUUID,
+		PolicyNamespace: requestData.Policyamespace,
+		PolicyUUID:      requestData.PolicyUUID,
+	}
+	request := &identity.SimulatePolicyChangeRequest{Namespace: requestData.RoleNamespace}
+	if requestData.Operation == "addPolicy" {
+		request.Change = &identity.SimulatePolicyChangeRequest_AddRolePolicy{AddRolePolicy: change}
+	} else {
+		request.Change = &identity.SimulatePolicyChangeRequest_RemoveRolePolicy{RemoveRolePolicy: change}
+	}
+
+	response, err := r.nativeStub.Services.IAM.Identity.SimulatePolicyChange(ctx.Request.Context(), request)
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.FailedPrecondition {
+				ctx.AbortWithStatusJSON(http.StatusPreconditionFailed, gin.H{"message": "Policy doesnt exist"})
+				return
+			}
+		}
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, simulateRolePolicyChangeResponse{Identities: formatIdentityPermissionsDiffs(response.Identities)})
+}
+
 type updateRoleRequest struct {
 	Namespace      string `json:"namespace" binding:"lte=32"`
 	UUID           string `json:"uuid" binding:"required,lte=64"`
@@ -0,0 +1,14 @@
+package audit
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+)
+
+func FillRouterGroup(logger *logrus.Entry, group *gin.RouterGroup, systemStub *system.SystemStub, nativeStub *native.NativeStub) {
+	recordsRouter := recordsRouter{systemStub: systemStub, nativeStub: nativeStub, logger: logger.WithField("domain.service", "records")}
+	group.GET("/records", recordsRouter.list)
+	group.GET("/records/export", recordsRouter.export)
+}
@@ -0,0 +1,214 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	systemAudit "github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
+	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/lib/authTools"
+)
+
+type recordsRouter struct {
+	systemStub *system.SystemStub
+	nativeStub *native.NativeStub
+
+	logger *logrus.Entry
+}
+
+type formatedRecord struct {
+	UUID      string    `json:"uuid"`
+	Namespace string    `json:"namespace"`
+	Timestamp time.Time `json:"timestamp"`
+	Service   string    `json:"service"`
+
+	ActorNamespace string `json:"actorNamespace"`
+	ActorIdentity  string `json:"actorIdentity"`
+	ActorToken     string `json:"actorToken"`
+
+	Resource      string `json:"resource"`
+	Action        string `json:"action"`
+	BeforeVersion uint64 `json:"beforeVersion"`
+	AfterVersion  uint64 `json:"afterVersion"`
+
+	SourceIP  string `json:"sourceIP"`
+	UserAgent string `json:"userAgent"`
+	RequestID string `json:"requestId"`
+
+	Details string `json:"details"`
+}
+
+func formatedRecordFromGRPC(r *systemAudit.Record) formatedRecord {
+	return formatedRecord{
+		UUID:      r.Uuid,
+		Namespace: r.Namespace,
+		Timestamp: r.Timestamp.AsTime(),
+		Service:   r.Service,
+
+		ActorNamespace: r.Actor.GetNamespace(),
+		ActorIdentity:  r.Actor.GetIdentity(),
+		ActorToken:     r.Actor.GetToken(),
+
+		Resource:      r.Resource,
+		Action:        r.Action,
+		BeforeVersion: r.BeforeVersion,
+		AfterVersion:  r.AfterVersion,
+
+		SourceIP:  r.RequestMetadata.GetSourceIP(),
+		UserAgent: r.RequestMetadata.GetUserAgent(),
+		RequestID: r.RequestMetadata.GetRequestId(),
+
+		Details: r.Details,
+	}
+}
+
+type recordsFilterRequest struct {
+	Namespace      string    `form:"namespace"`
+	ActorNamespace string    `form:"actorNamespace"`
+	ActorIdentity  string    `form:"actorIdentity" binding:"lte=64"`
+	ResourcePrefix string    `form:"resourcePrefix" binding:"lte=512"`
+	Action         string    `form:"action" binding:"lte=256"`
+	From           time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To             time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+func (f *recordsFilterRequest) toGRPC() *systemAudit.Filter {
+	filter := &systemAudit.Filter{
+		Namespace:      f.Namespace,
+		ResourcePrefix: f.ResourcePrefix,
+		Action:         f.Action,
+	}
+	if f.ActorIdentity != "" {
+		filter.Actor = &systemAudit.Actor{Namespace: f.ActorNamespace, Identity: f.ActorIdentity}
+	}
+	if !f.From.IsZero() {
+		filter.From = timestamppb.New(f.From)
+	}
+	if !f.To.IsZero() {
+		filter.To = timestamppb.New(f.To)
+	}
+	return filter
+}
+
+// Checks if the caller can read audit log of the namespace. Returns false if request was already aborted.
+func (r *recordsRouter) checkAuth(ctx *gin.Context, logger *logrus.Entry, namespace string) bool {
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            namespace,
+			Resources:            []string{"system.audit"},
+			Actions:              []string{"system.audit.list"},
+			NamespaceIndependent: false,
+		},
+	})
+	if err != nil {
+		err := errors.New("failed to check auth: " + err.Error())
+		logger.Error(err.Error())
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return false
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return false
+	}
+	return true
+}
+
+type listRecordsRequest struct {
+	recordsFilterRequest
+	Skip  uint64 `form:"skip"`
+	Limit uint64 `form:"limit" binding:"lte=1000"`
+}
+type listRecordsResponse struct {
+	Records    []formatedRecord `json:"records"`
+	TotalCount uint64           `json:"totalCount"`
+}
+
+func (r *recordsRouter) list(ctx *gin.Context) {
+	var req listRecordsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	logger := r.logger.WithField("namespace", req.Namespace)
+	if !r.checkAuth(ctx, logger, req.Namespace) {
+		return
+	}
+
+	response, err := r.systemStub.Audit.List(ctx.Request.Context(), &systemAudit.ListRequest{
+		Filter: req.toGRPC(),
+		Skip:   req.Skip,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": st.Message()})
+			return
+		}
+
+		err := errors.New("failed to list audit records: " + err.Error())
+		logger.Error(err.Error())
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	records := make([]formatedRecord, len(response.Records))
+	for i, record := range response.Records {
+		records[i] = formatedRecordFromGRPC(record)
+	}
+
+	ctx.JSON(http.StatusOK, listRecordsResponse{Records: records, TotalCount: response.TotalCount})
+}
+
+// Streams all the records that match filter as newline delimited JSON from the oldest to the newest
+func (r *recordsRouter) export(ctx *gin.Context) {
+	var req recordsFilterRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	logger := r.logger.WithField("namespace", req.Namespace)
+	if !r.checkAuth(ctx, logger, req.Namespace) {
+		return
+	}
+
+	stream, err := r.systemStub.Audit.Stream(ctx.Request.Context(), &systemAudit.StreamRequest{Filter: req.toGRPC()})
+	if err != nil {
+		err := errors.New("failed to open audit records stream: " + err.Error())
+		logger.Error(err.Error())
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.Header("Content-Type", "application/x-ndjson")
+	ctx.Status(http.StatusOK)
+	encoder := json.NewEncoder(ctx.Writer)
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				// Headers are already sent. The only thing we can do is to stop the stream.
+				logger.Error("failed to receive audit record from stream: " + err.Error())
+			}
+			return
+		}
+
+		if err := encoder.Encode(formatedRecordFromGRPC(response.Record)); err != nil {
+			logger.Error("failed to write audit record to the response: " + err.Error())
+			return
+		}
+		ctx.Writer.Flush()
+	}
+}
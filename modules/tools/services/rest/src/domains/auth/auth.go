@@ -10,14 +10,38 @@ import (
 func FillRouterGroup(group *gin.RouterGroup, systemStub *system.SystemStub, nativeStub *native.NativeStub) {
 	passwordRouter := &PasswordRouter{nativeStub: nativeStub}
 	oauthRouter := &OAuthRouter{nativeStub: nativeStub}
+	ssoRouter := &SSORouter{nativeStub: nativeStub}
+	oidcLoginRouter := &OIDCLoginRouter{nativeStub: nativeStub}
 	tokenRouter := &TokenRouter{nativeStub: nativeStub}
+	oidcRouter := &OIDCRouter{nativeStub: nativeStub}
+	x509Router := &X509Router{nativeStub: nativeStub}
 
 	// Login
 	group.POST("/login/password", passwordRouter.Login)
 	group.POST("/login/oauth", oauthRouter.Login)
 	group.GET("/login/oauth/providers", oauthRouter.GetSupportedProviders)
+	group.POST("/login/sso/start", ssoRouter.Start)
+	group.POST("/login/sso", ssoRouter.Login)
+	group.POST("/login/oidc/start", oidcLoginRouter.Start)
+	group.POST("/login/oidc", oidcLoginRouter.Login)
 
 	// Token
 	group.POST("/token/refresh", tokenRouter.Refresh)
 	group.POST("/token/validate", tokenRouter.Validate)
+	group.POST("/token/introspect", tokenRouter.Introspect)
+	group.POST("/token/revoke", tokenRouter.Revoke)
+	group.GET("/token/jwks", tokenRouter.JWKS)
+
+	// OpenBP as OAuth2 / OpenID Connect authorization server
+	group.GET("/oidc/.well-known/openid-configuration", oidcRouter.Discovery)
+	group.POST("/oidc/authorize", oidcRouter.Authorize)
+	group.POST("/oidc/token", oidcRouter.Token)
+	group.GET("/oidc/userinfo", oidcRouter.Userinfo)
+
+	// X509 certificates revocation status
+	group.GET("/x509/crl", x509Router.CRL)
+	group.GET("/x509/namespace/crl", x509Router.NamespaceCRL)
+	group.GET("/x509/namespace/chain", x509Router.NamespaceCAChain)
+	group.POST("/x509/ocsp", x509Router.OCSPPost)
+	group.GET("/x509/ocsp/*request", x509Router.OCSPGet)
 }
@@ -0,0 +1,366 @@
+package auth
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/identity"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/oidc"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+)
+
+// Public URL of the authorization server. If not set, it is calculated from the request
+const _ENV_OIDC_ISSUER = "OIDC_ISSUER"
+
+type OIDCRouter struct {
+	nativeStub *native.NativeStub
+}
+
+func (r *OIDCRouter) issuer(ctx *gin.Context) string {
+	if issuer := os.Getenv(_ENV_OIDC_ISSUER); issuer != "" {
+		return strings.TrimSuffix(issuer, "/")
+	}
+
+	scheme := "http"
+	if ctx.Request.TLS != nil {
+		scheme = "https"
+	}
+	if forwardedProto := ctx.GetHeader("X-Forwarded-Proto"); forwardedProto != "" {
+		scheme = forwardedProto
+	}
+	return scheme + "://" + ctx.Request.Host + "/api/auth/oidc"
+}
+
+func (r *OIDCRouter) apiBaseURL(ctx *gin.Context) string {
+	return strings.TrimSuffix(r.issuer(ctx), "/oidc")
+}
+
+type openIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+func (r *OIDCRouter) Discovery(ctx *gin.Context) {
+	issuer := r.issuer(ctx)
+	base := r.apiBaseURL(ctx)
+
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.JSON(http.StatusOK, openIDConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/authorize",
+		TokenEndpoint:                     issuer + "/token",
+		UserinfoEndpoint:                  issuer + "/userinfo",
+		JWKSURI:                           base + "/token/jwks",
+		IntrospectionEndpoint:             base + "/token/introspect",
+		RevocationEndpoint:                base + "/token/revoke",
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code", "client_credentials", "refresh_token"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{"RS512"},
+		ScopesSupported:                   []string{"openid", "profile", "offline_access"},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{"S256", "plain"},
+		ClaimsSupported:                   []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "name", "namespace"},
+	})
+}
+
+// Authorization request. It is sent by the login/consent page of the UI after identity logged in.
+// UI redirects the browser to the "redirectURL" from the response.
+type oidcAuthorizeRequest struct {
+	ClientID            string `json:"client_id" binding:"required,lte=128"`
+	RedirectURI         string `json:"redirect_uri" binding:"lte=2048"`
+	ResponseType        string `json:"response_type" binding:"required"`
+	Scope               string `json:"scope" binding:"lte=1024"`
+	State               string `json:"state" binding:"lte=1024"`
+	CodeChallenge       string `json:"code_challenge" binding:"lte=256"`
+	CodeChallengeMethod string `json:"code_challenge_method" binding:"lte=16"`
+	Nonce               string `json:"nonce" binding:"lte=1024"`
+	ConsentGranted      bool   `json:"consent_granted"`
+}
+
+type oidcConsentScope struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type oidcAuthorizeResponse struct {
+	// Where browser must be redirected. Empty if consent is required
+	RedirectURL string `json:"redirectURL,omitempty"`
+	// Scopes that identity must agree with before authorization can continue
+	ConsentRequired bool               `json:"consentRequired"`
+	ConsentScopes   []oidcConsentScope `json:"consentScopes,omitempty"`
+	// Information about the client for the consent page
+	ClientName        string `json:"clientName,omitempty"`
+	ClientDescription string `json:"clientDescription,omitempty"`
+}
+
+var oidcAuthorizeErrors = map[oidc.AuthorizeResponse_Status]string{
+	oidc.AuthorizeResponse_LOGIN_REQUIRED:            "login_required",
+	oidc.AuthorizeResponse_INVALID_REQUEST:           "invalid_request",
+	oidc.AuthorizeResponse_UNSUPPORTED_RESPONSE_TYPE: "unsupported_response_type",
+	oidc.AuthorizeResponse_INVALID_SCOPE:             "invalid_scope",
+	oidc.AuthorizeResponse_UNAUTHORIZED_CLIENT:       "unauthorized_client",
+	oidc.AuthorizeResponse_ACCESS_DENIED:             "access_denied",
+}
+
+func (r *OIDCRouter) Authorize(ctx *gin.Context) {
+	var requestData oidcAuthorizeRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	accessToken := ""
+	if splitedHeader := strings.Split(ctx.GetHeader("Authorization"), " "); len(splitedHeader) == 2 {
+		accessToken = splitedHeader[1]
+	}
+
+	authorizeResponse, err := r.nativeStub.Services.IAM.OIDC.Authorize(ctx.Request.Context(), &oidc.AuthorizeRequest{
+		AccessToken:         accessToken,
+		ClientId:            requestData.ClientID,
+		RedirectURI:         requestData.RedirectURI,
+		ResponseType:        requestData.ResponseType,
+		Scope:               requestData.Scope,
+		CodeChallenge:       requestData.CodeChallenge,
+		CodeChallengeMethod: requestData.CodeChallengeMethod,
+		Nonce:               requestData.Nonce,
+		ConsentGranted:      requestData.ConsentGranted,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"message": "The vault is sealed."})
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	switch authorizeResponse.Status {
+	case oidc.AuthorizeResponse_INVALID_CLIENT, oidc.AuthorizeResponse_INVALID_REDIRECT_URI:
+		// Redirect URI cant be trusted, so the error is displayed by the UI
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": authorizeResponse.Message})
+		return
+	case oidc.AuthorizeResponse_CONSENT_REQUIRED:
+		clientResponse, err := r.nativeStub.Services.IAM.OIDC.GetClientByClientId(ctx.Request.Context(), &oidc.GetClientByClientIdRequest{ClientId: requestData.ClientID})
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		scopes := make([]oidcConsentScope, 0, len(authorizeResponse.ConsentScopes))
+		for _, scope := range authorizeResponse.ConsentScopes {
+			scopes = append(scopes, oidcConsentScope{Name: scope.Name, Description: scope.Description})
+		}
+		ctx.JSON(http.StatusOK, oidcAuthorizeResponse{
+			ConsentRequired:   true,
+			ConsentScopes:     scopes,
+			ClientName:        clientResponse.Client.Name,
+			ClientDescription: clientResponse.Client.Description,
+		})
+		return
+	}
+
+	redirectURI := requestData.RedirectURI
+	if redirectURI == "" {
+		clientResponse, err := r.nativeStub.Services.IAM.OIDC.GetClientByClientId(ctx.Request.Context(), &oidc.GetClientByClientIdRequest{ClientId: requestData.ClientID})
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		redirectURI = clientResponse.Client.RedirectURIs[0]
+	}
+	redirectURL, err := url.Parse(redirectURI)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Redirect URI has bad format"})
+		return
+	}
+
+	query := redirectURL.Query()
+	if authorizeResponse.Status == oidc.AuthorizeResponse_OK {
+		query.Set("code", authorizeResponse.Code)
+	} else {
+		errorCode, ok := oidcAuthorizeErrors[authorizeResponse.Status]
+		if !ok {
+			errorCode = "server_error"
+		}
+		query.Set("error", errorCode)
+		query.Set("error_description", authorizeResponse.Message)
+	}
+	if requestData.State != "" {
+		query.Set("state", requestData.State)
+	}
+	redirectURL.RawQuery = query.Encode()
+
+	ctx.JSON(http.StatusOK, oidcAuthorizeResponse{RedirectURL: redirectURL.String()})
+}
+
+// RFC 6749 token request. Only form encoding is allowed by the RFC.
+type oidcTokenRequest struct {
+	GrantType    string `form:"grant_type" binding:"required"`
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+	Code         string `form:"code"`
+	RedirectURI  string `form:"redirect_uri"`
+	CodeVerifier string `form:"code_verifier"`
+	RefreshToken string `form:"refresh_token"`
+	Scope        string `form:"scope"`
+}
+
+type oidcTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    uint32 `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+var oidcTokenErrors = map[oidc.ExchangeTokenResponse_Status]string{
+	oidc.ExchangeTokenResponse_INVALID_REQUEST:        "invalid_request",
+	oidc.ExchangeTokenResponse_INVALID_CLIENT:         "invalid_client",
+	oidc.ExchangeTokenResponse_INVALID_GRANT:          "invalid_grant",
+	oidc.ExchangeTokenResponse_UNAUTHORIZED_CLIENT:    "unauthorized_client",
+	oidc.ExchangeTokenResponse_UNSUPPORTED_GRANT_TYPE: "unsupported_grant_type",
+	oidc.ExchangeTokenResponse_INVALID_SCOPE:          "invalid_scope",
+}
+
+func (r *OIDCRouter) Token(ctx *gin.Context) {
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Pragma", "no-cache")
+
+	var requestData oidcTokenRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid_request", "error_description": err.Error()})
+		return
+	}
+
+	// Client can authenticate with HTTP Basic authentication or with the form parameters
+	usedBasicAuth := false
+	if clientID, clientSecret, ok := ctx.Request.BasicAuth(); ok {
+		usedBasicAuth = true
+		requestData.ClientID, _ = url.QueryUnescape(clientID)
+		requestData.ClientSecret, _ = url.QueryUnescape(clientSecret)
+	}
+	if requestData.ClientID == "" {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid_request", "error_description": "client_id is required"})
+		return
+	}
+
+	exchangeResponse, err := r.nativeStub.Services.IAM.OIDC.ExchangeToken(ctx.Request.Context(), &oidc.ExchangeTokenRequest{
+		GrantType:    requestData.GrantType,
+		ClientId:     requestData.ClientID,
+		ClientSecret: requestData.ClientSecret,
+		Code:         requestData.Code,
+		RedirectURI:  requestData.RedirectURI,
+		CodeVerifier: requestData.CodeVerifier,
+		RefreshToken: requestData.RefreshToken,
+		Scope:        requestData.Scope,
+		Issuer:       r.issuer(ctx),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "temporarily_unavailable"})
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	if exchangeResponse.Status != oidc.ExchangeTokenResponse_OK {
+		errorCode, ok := oidcTokenErrors[exchangeResponse.Status]
+		if !ok {
+			errorCode = "server_error"
+		}
+		statusCode := http.StatusBadRequest
+		if exchangeResponse.Status == oidc.ExchangeTokenResponse_INVALID_CLIENT {
+			statusCode = http.StatusUnauthorized
+			if usedBasicAuth {
+				ctx.Header("WWW-Authenticate", "Basic")
+			}
+		}
+		ctx.AbortWithStatusJSON(statusCode, gin.H{"error": errorCode, "error_description": exchangeResponse.Message})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, oidcTokenResponse{
+		AccessToken:  exchangeResponse.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    exchangeResponse.ExpiresIn,
+		RefreshToken: exchangeResponse.RefreshToken,
+		IDToken:      exchangeResponse.IdToken,
+		Scope:        exchangeResponse.Scope,
+	})
+}
+
+type oidcUserinfoResponse struct {
+	Sub       string `json:"sub"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace"`
+}
+
+func (r *OIDCRouter) Userinfo(ctx *gin.Context) {
+	splitedHeader := strings.Split(ctx.GetHeader("Authorization"), " ")
+	if len(splitedHeader) != 2 {
+		ctx.Header("WWW-Authenticate", "Bearer")
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	validateResponse, err := r.nativeStub.Services.IAM.Token.Validate(ctx.Request.Context(), &token.ValidateRequest{
+		Token:    splitedHeader[1],
+		UseCache: true,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"message": "The vault is sealed."})
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if validateResponse.Status != token.ValidateResponse_OK {
+		ctx.Header("WWW-Authenticate", "Bearer error=\"invalid_token\"")
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	identityResponse, err := r.nativeStub.Services.IAM.Identity.Get(ctx.Request.Context(), &identity.GetIdentityRequest{
+		Namespace: validateResponse.TokenData.Namespace,
+		Uuid:      validateResponse.TokenData.Identity,
+		UseCache:  true,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			ctx.Header("WWW-Authenticate", "Bearer error=\"invalid_token\"")
+			ctx.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, oidcUserinfoResponse{
+		Sub:       identityResponse.Identity.Uuid,
+		Name:      identityResponse.Identity.Name,
+		Namespace: identityResponse.Identity.Namespace,
+	})
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/oidc"
+)
+
+// Login using OpenID Connect providers configured for the namespace (Keycloak, Azure AD, etc.)
+type OIDCLoginRouter struct {
+	nativeStub *native.NativeStub
+}
+
+type oidcLoginStartRequest struct {
+	Namespace   string `json:"namespace" binding:""`
+	Provider    string `json:"provider" binding:"required"`
+	RedirectURL string `json:"redirectURL" binding:"required"`
+}
+type oidcLoginStartResponse struct {
+	AuthorizationURL string `json:"authorizationURL"`
+	State            string `json:"state"`
+}
+
+func (r *OIDCLoginRouter) Start(ctx *gin.Context) {
+	var requestData oidcLoginStartRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	startResponse, err := r.nativeStub.Services.IAM.Authentication.OIDC.StartAuthentication(ctx.Request.Context(), &oidc.StartAuthenticationRequest{
+		Namespace:   requestData.Namespace,
+		Provider:    requestData.Provider,
+		RedirectURL: requestData.RedirectURL,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": st.Message()})
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	switch startResponse.Status {
+	case oidc.StartAuthenticationResponse_OK:
+		ctx.JSON(http.StatusOK, oidcLoginStartResponse{AuthorizationURL: startResponse.AuthorizationURL, State: startResponse.State})
+	case oidc.StartAuthenticationResponse_PROVIDER_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "OIDC provider not found."})
+	case oidc.StartAuthenticationResponse_PROVIDER_DISABLED:
+		ctx.JSON(http.StatusForbidden, gin.H{"message": "OIDC provider is disabled."})
+	case oidc.StartAuthenticationResponse_DISCOVERY_FAILED:
+		ctx.JSON(http.StatusBadGateway, gin.H{"message": "Failed to load configuration of the OIDC provider."})
+	default:
+		ctx.AbortWithError(http.StatusInternalServerError, errors.New("unsupported response status"))
+	}
+}
+
+type oidcLoginRequest struct {
+	Namespace string `json:"namespace" binding:""`
+	Provider  string `json:"provider" binding:"required"`
+	Code      string `json:"code" binding:"required"`
+	State     string `json:"state" binding:"required"`
+}
+type oidcLoginResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+func (r *OIDCLoginRouter) Login(ctx *gin.Context) {
+	var requestData oidcLoginRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	tokenResponse, err := r.nativeStub.Services.IAM.Auth.CreateTokenWithOIDC(ctx.Request.Context(), &auth.CreateTokenWithOIDCRequest{
+		Namespace: requestData.Namespace,
+		Provider:  requestData.Provider,
+		Code:      requestData.Code,
+		State:     requestData.State,
+		Metadata:  MetadataFromRequestContext(ctx).ToJSONString(),
+		Scopes:    []*auth.Scope{},
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"message": "The vault is sealed."})
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	switch tokenResponse.Status {
+	case auth.CreateTokenWithOIDCResponse_OK:
+		ctx.JSON(http.StatusOK, oidcLoginResponse{AccessToken: tokenResponse.AccessToken, RefreshToken: tokenResponse.RefreshToken})
+	case auth.CreateTokenWithOIDCResponse_UNAUTHORIZED:
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Cant create toke. You cant access requested scopes."})
+	case auth.CreateTokenWithOIDCResponse_UNAUTHENTICATED:
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Cant create token. Failed to authenticate. " + tokenResponse.Message})
+	case auth.CreateTokenWithOIDCResponse_IDENTITY_NOT_ACTIVE:
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Cant create token. Identity is not active."})
+	default:
+		ctx.AbortWithError(http.StatusInternalServerError, errors.New("unsupported response status"))
+	}
+}
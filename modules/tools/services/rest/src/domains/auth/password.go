@@ -2,6 +2,7 @@ package auth
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/codes"
@@ -22,10 +23,13 @@ type passwordLoginRequest struct {
 	Namespace string `json:"namespace" binding:""`
 	Login     string `json:"login" binding:"required"`
 	Password  string `json:"password" binding:"required"`
+	TOTP      string `json:"totp" binding:""`
 }
 type passwordLoginResponse struct {
 	AccessToken  string `json:"accessToken"`
 	RefreshToken string `json:"refreshToken"`
+	// Password expired or its change was forced. User should be asked to set new password
+	PasswordChangeRequired bool `json:"passwordChangeRequired"`
 }
 
 func (r *PasswordRouter) Login(ctx *gin.Context) {
@@ -57,8 +61,10 @@ func (r *PasswordRouter) Login(ctx *gin.Context) {
 		Namespace: requestData.Namespace,
 		Identity:  userGetResponse.User.Identity,
 		Password:  requestData.Password,
+		Totp:      requestData.TOTP,
 		Metadata:  MetadataFromRequestContext(ctx).ToJSONString(),
 		Scopes:    []*auth.Scope{},
+		Context:   &auth.AccessContext{SourceIP: ctx.ClientIP()},
 	})
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, err)
@@ -67,13 +73,22 @@ func (r *PasswordRouter) Login(ctx *gin.Context) {
 
 	switch tokenCreationResponse.Status {
 	case auth.CreateTokenWithPasswordResponse_OK:
-		ctx.JSON(http.StatusOK, passwordLoginResponse{AccessToken: tokenCreationResponse.AccessToken, RefreshToken: tokenCreationResponse.RefreshToken})
+		ctx.JSON(http.StatusOK, passwordLoginResponse{AccessToken: tokenCreationResponse.AccessToken, RefreshToken: tokenCreationResponse.RefreshToken, PasswordChangeRequired: tokenCreationResponse.PasswordChangeRequired})
 	case auth.CreateTokenWithPasswordResponse_IDENTITY_NOT_ACTIVE:
 		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthPasswordIdentityNotActive))
 	case auth.CreateTokenWithPasswordResponse_CREDENTIALS_INVALID:
 		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthPasswordCredentialsInvalid))
 	case auth.CreateTokenWithPasswordResponse_UNAUTHORIZED:
 		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthPasswordNotEnoughtPrivileges))
+	case auth.CreateTokenWithPasswordResponse_TOTP_REQUIRED:
+		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthPasswordTOTPRequired))
+	case auth.CreateTokenWithPasswordResponse_TOTP_INVALID:
+		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthPasswordTOTPInvalid))
+	case auth.CreateTokenWithPasswordResponse_TOO_MANY_ATTEMPTS:
+		ctx.Header("Retry-After", strconv.FormatUint(uint64(tokenCreationResponse.RetryAfter), 10))
+		ctx.JSON(http.StatusTooManyRequests, models.NewAPIError(models.ErrorAuthPasswordTooManyAttempts))
+	case auth.CreateTokenWithPasswordResponse_NAMESPACE_SUSPENDED:
+		ctx.JSON(http.StatusForbidden, models.NewAPIError(models.ErrorAuthPasswordNamespaceSuspended))
 	default:
 		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthPasswordUnauthorizedUnknown))
 	}
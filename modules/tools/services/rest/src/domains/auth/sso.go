@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/sso"
+)
+
+type SSORouter struct {
+	nativeStub *native.NativeStub
+}
+
+type ssoStartRequest struct {
+	Namespace  string `json:"namespace" binding:""`
+	Provider   string `json:"provider" binding:"required"`
+	RelayState string `json:"relayState" binding:""`
+}
+type ssoStartResponse struct {
+	RedirectURL string `json:"redirectURL"`
+}
+
+func (r *SSORouter) Start(ctx *gin.Context) {
+	var requestData ssoStartRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	startResponse, err := r.nativeStub.Services.IAM.Authentication.SSO.StartAuthentication(ctx.Request.Context(), &sso.StartAuthenticationRequest{
+		Namespace:  requestData.Namespace,
+		Provider:   requestData.Provider,
+		RelayState: requestData.RelayState,
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	switch startResponse.Status {
+	case sso.StartAuthenticationResponse_OK:
+		ctx.JSON(http.StatusOK, ssoStartResponse{RedirectURL: startResponse.RedirectURL})
+	case sso.StartAuthenticationResponse_PROVIDER_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "SAML provider not found."})
+	case sso.StartAuthenticationResponse_PROVIDER_DISABLED:
+		ctx.JSON(http.StatusForbidden, gin.H{"message": "SAML provider is disabled."})
+	default:
+		ctx.AbortWithError(http.StatusInternalServerError, errors.New("unsupported response status"))
+	}
+}
+
+type ssoLoginRequest struct {
+	Namespace    string `json:"namespace" binding:""`
+	Provider     string `json:"provider" binding:"required"`
+	SAMLResponse string `json:"samlResponse" binding:"required"`
+}
+type ssoLoginResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+func (r *SSORouter) Login(ctx *gin.Context) {
+	var requestData ssoLoginRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	tokenResponse, err := r.nativeStub.Services.IAM.Auth.CreateTokenWithSSO(ctx.Request.Context(), &auth.CreateTokenWithSSORequest{
+		Namespace:    requestData.Namespace,
+		Provider:     requestData.Provider,
+		SamlResponse: requestData.SAMLResponse,
+		Metadata:     MetadataFromRequestContext(ctx).ToJSONString(),
+		Scopes:       []*auth.Scope{},
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	switch tokenResponse.Status {
+	case auth.CreateTokenWithSSOResponse_OK:
+		ctx.JSON(http.StatusOK, ssoLoginResponse{AccessToken: tokenResponse.AccessToken, RefreshToken: tokenResponse.RefreshToken})
+	case auth.CreateTokenWithSSOResponse_UNAUTHORIZED:
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Cant create toke. You cant access requested scopes."})
+	case auth.CreateTokenWithSSOResponse_UNAUTHENTICATED:
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Cant create token. Failed to authenticate. " + tokenResponse.Message})
+	case auth.CreateTokenWithSSOResponse_IDENTITY_NOT_ACTIVE:
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Cant create token. Identity is not active."})
+	default:
+		ctx.AbortWithError(http.StatusInternalServerError, errors.New("unsupported response status"))
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
 
+	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/lib/authTools"
 	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/models"
 )
 
@@ -20,7 +23,8 @@ type refreshTokenRequest struct {
 	RefreshToken string `json:"refreshToken" binding:"required"`
 }
 type refreshTokenResponse struct {
-	AccessToken string `json:"accessToken"`
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
 }
 
 func (r *TokenRouter) Refresh(ctx *gin.Context) {
@@ -32,6 +36,7 @@ func (r *TokenRouter) Refresh(ctx *gin.Context) {
 
 	refreshResponse, err := r.nativeStub.Services.IAM.Auth.RefreshToken(ctx.Request.Context(), &auth.RefreshTokenRequest{
 		RefreshToken: requestData.RefreshToken,
+		Context:      &auth.AccessContext{SourceIP: ctx.ClientIP(), UserAgent: ctx.Request.UserAgent()},
 	})
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, err)
@@ -40,7 +45,7 @@ func (r *TokenRouter) Refresh(ctx *gin.Context) {
 
 	switch refreshResponse.Status {
 	case auth.RefreshTokenResponse_OK:
-		ctx.JSON(http.StatusOK, refreshTokenResponse{AccessToken: refreshResponse.AccessToken})
+		ctx.JSON(http.StatusOK, refreshTokenResponse{AccessToken: refreshResponse.AccessToken, RefreshToken: refreshResponse.RefreshToken})
 	case auth.RefreshTokenResponse_TOKEN_INVALID:
 		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthTokenRefreshTokenInvalid))
 	case auth.RefreshTokenResponse_TOKEN_NOT_FOUND:
@@ -51,10 +56,14 @@ func (r *TokenRouter) Refresh(ctx *gin.Context) {
 		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthTokenRefreshTokenExpired))
 	case auth.RefreshTokenResponse_TOKEN_IS_NOT_REFRESH_TOKEN:
 		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthTokenRefreshTokenIsNotRefreshToken))
+	case auth.RefreshTokenResponse_TOKEN_REUSED:
+		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthTokenRefreshTokenReused))
 	case auth.RefreshTokenResponse_IDENTITY_NOT_FOUND:
 		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthTokenRefreshIdentityNotFound))
 	case auth.RefreshTokenResponse_IDENTITY_NOT_ACTIVE:
 		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthTokenRefreshIdentityNotActive))
+	case auth.RefreshTokenResponse_NAMESPACE_SUSPENDED:
+		ctx.JSON(http.StatusForbidden, models.NewAPIError(models.ErrorAuthTokenRefreshNamespaceSuspended))
 	case auth.RefreshTokenResponse_IDENTITY_UNAUTHENTICATED:
 		ctx.JSON(http.StatusUnauthorized, models.NewAPIError(models.ErrorAuthTokenRefreshIdentityUnauthenticated))
 	default:
@@ -88,3 +97,145 @@ func (r *TokenRouter) Validate(ctx *gin.Context) {
 	valid := checkResponse.Status == token.ValidateResponse_OK
 	ctx.JSON(http.StatusOK, validateTokenResponse{Valid: valid})
 }
+
+// RFC 7662 token introspection request. Only form encoding is allowed by the RFC.
+type introspectTokenRequest struct {
+	Token         string `form:"token" binding:"required"`
+	TokenTypeHint string `form:"token_type_hint"`
+}
+
+// RFC 7662 token introspection response. Only "active" is returned for inactive tokens.
+type introspectTokenResponse struct {
+	Active    bool   `json:"active"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+	// Namespace of the token and identity. Empty for the global namespace
+	Namespace string `json:"namespace,omitempty"`
+}
+
+func (r *TokenRouter) Introspect(ctx *gin.Context) {
+	var requestData introspectTokenRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid_request", "error_description": err.Error()})
+		return
+	}
+
+	// Introspection exposes information about tokens of other identities, so the caller must be authorized
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            "",
+			Resources:            []string{"native.iam.token"},
+			Actions:              []string{"native.iam.token.introspect"},
+			NamespaceIndependent: false,
+		},
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+
+	introspectResponse, err := r.nativeStub.Services.IAM.Token.Introspect(ctx.Request.Context(), &token.IntrospectRequest{
+		Token:    requestData.Token,
+		UseCache: true,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"message": "The vault is sealed."})
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	if !introspectResponse.Active {
+		ctx.JSON(http.StatusOK, introspectTokenResponse{Active: false})
+		return
+	}
+
+	tokenType := "access_token"
+	if introspectResponse.Refresh {
+		tokenType = "refresh_token"
+	}
+	ctx.JSON(http.StatusOK, introspectTokenResponse{
+		Active:    true,
+		TokenType: tokenType,
+		Exp:       introspectResponse.ExpiresAt.AsTime().Unix(),
+		Iat:       introspectResponse.IssuedAt.AsTime().Unix(),
+		Iss:       introspectResponse.Issuer,
+		Sub:       introspectResponse.TokenData.Identity,
+		Jti:       introspectResponse.TokenData.Uuid,
+		Namespace: introspectResponse.TokenData.Namespace,
+	})
+}
+
+// RFC 7009 token revocation request. Only form encoding is allowed by the RFC.
+type revokeTokenRequest struct {
+	Token         string `form:"token" binding:"required"`
+	TokenTypeHint string `form:"token_type_hint"`
+}
+
+func (r *TokenRouter) Revoke(ctx *gin.Context) {
+	var requestData revokeTokenRequest
+	if err := ctx.ShouldBind(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid_request", "error_description": err.Error()})
+		return
+	}
+
+	// Possession of the token is enough to revoke it. Invalid and unknown tokens are not reported (RFC 7009, section 2.2)
+	_, err := r.nativeStub.Services.IAM.Token.Revoke(ctx.Request.Context(), &token.RevokeRequest{
+		Token: requestData.Token,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "temporarily_unavailable"})
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+func (r *TokenRouter) JWKS(ctx *gin.Context) {
+	jwksResponse, err := r.nativeStub.Services.IAM.Token.GetJWKS(ctx.Request.Context(), &token.GetJWKSRequest{})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"message": "The vault is sealed."})
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	keys := make([]jsonWebKey, len(jwksResponse.Keys))
+	for index, key := range jwksResponse.Keys {
+		keys[index] = jsonWebKey{Kty: key.Kty, Kid: key.Kid, Use: key.Use, Alg: key.Alg, N: key.N, E: key.E}
+	}
+
+	// Keys are rotated rarely. Allow clients to cache them for a short period of time
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.JSON(http.StatusOK, jsonWebKeySet{Keys: keys})
+}
@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/x509"
+)
+
+// Maximum size of the OCSP request. Real requests are a few hundreds of bytes.
+const maxOCSPRequestSize = 16 * 1024
+
+type X509Router struct {
+	nativeStub *native.NativeStub
+}
+
+func (r *X509Router) CRL(ctx *gin.Context) {
+	crlResponse, err := r.nativeStub.Services.IAM.Authentication.X509.GetCRL(ctx.Request.Context(), &x509.GetCRLRequest{})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"message": "The vault is sealed."})
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	maxAge := int(time.Until(crlResponse.NextUpdate.AsTime()).Seconds())
+	if maxAge < 0 {
+		maxAge = 0
+	}
+	ctx.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+	ctx.Data(http.StatusOK, "application/pkix-crl", crlResponse.Crl)
+}
+
+type namespaceCARequest struct {
+	Namespace string `form:"namespace"`
+}
+
+func (r *X509Router) NamespaceCRL(ctx *gin.Context) {
+	var requestData namespaceCARequest
+	if err := ctx.ShouldBindQuery(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	crlResponse, err := r.nativeStub.Services.IAM.Authentication.X509.GetNamespaceCRL(ctx.Request.Context(), &x509.GetNamespaceCRLRequest{Namespace: requestData.Namespace})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.FailedPrecondition {
+				ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"message": "The vault is sealed."})
+				return
+			}
+			if st.Code() == codes.NotFound {
+				ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": st.Message()})
+				return
+			}
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	maxAge := int(time.Until(crlResponse.NextUpdate.AsTime()).Seconds())
+	if maxAge < 0 {
+		maxAge = 0
+	}
+	ctx.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+	ctx.Data(http.StatusOK, "application/pkix-crl", crlResponse.Crl)
+}
+
+type namespaceCAChainResponse struct {
+	// PEM encoded certificates. Intermediate CA of the namespace first, root CA last.
+	Chain []string `json:"chain"`
+}
+
+func (r *X509Router) NamespaceCAChain(ctx *gin.Context) {
+	var requestData namespaceCARequest
+	if err := ctx.ShouldBindQuery(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	chainResponse, err := r.nativeStub.Services.IAM.Authentication.X509.GetNamespaceCAChain(ctx.Request.Context(), &x509.GetNamespaceCAChainRequest{Namespace: requestData.Namespace})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.FailedPrecondition {
+				ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"message": "The vault is sealed."})
+				return
+			}
+			if st.Code() == codes.NotFound {
+				ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": st.Message()})
+				return
+			}
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	chain := make([]string, 0, len(chainResponse.Chain))
+	for _, certificate := range chainResponse.Chain {
+		chain = append(chain, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})))
+	}
+
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.JSON(http.StatusOK, namespaceCAChainResponse{Chain: chain})
+}
+
+func (r *X509Router) handleOCSP(ctx *gin.Context, request []byte) {
+	ocspResponse, err := r.nativeStub.Services.IAM.Authentication.X509.OCSP(ctx.Request.Context(), &x509.OCSPRequest{Request: request})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	ctx.Data(http.StatusOK, "application/ocsp-response", ocspResponse.Response)
+}
+
+// OCSP request sent in the body of the POST request (RFC 6960, Appendix A.1)
+func (r *X509Router) OCSPPost(ctx *gin.Context) {
+	request, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxOCSPRequestSize+1))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Failed to read request body: " + err.Error()})
+		return
+	}
+	if len(request) > maxOCSPRequestSize {
+		ctx.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"message": "OCSP request is too large"})
+		return
+	}
+
+	r.handleOCSP(ctx, request)
+}
+
+// OCSP request encoded with base64 and sent as a part of the URL (RFC 6960, Appendix A.1)
+func (r *X509Router) OCSPGet(ctx *gin.Context) {
+	encoded, err := url.PathUnescape(strings.TrimPrefix(ctx.Param("request"), "/"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Bad URL encoding of the OCSP request"})
+		return
+	}
+	request, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Bad base64 encoding of the OCSP request"})
+		return
+	}
+
+	r.handleOCSP(ctx, request)
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/actor/user"
@@ -80,6 +82,10 @@ func (r *RootUserRouter) InitRootUser(ctx *gin.Context) {
 	})
 	if err != nil {
 		r.nativeStub.Services.IAM.Actor.User.Delete(ctx.Request.Context(), &user.DeleteRequest{Namespace: "", Uuid: userResponse.User.Uuid})
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": st.Message()})
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
@@ -85,6 +85,11 @@ func (r *DeviceRouter) Create(ctx *gin.Context) {
 			ctx.AbortWithStatusJSON(http.StatusConflict, gin.H{"message": "Device with same name already exist"})
 			return
 		}
+		if st, ok := status.FromError(err); ok && st.Code() == codes.ResourceExhausted {
+			logger.Debug("Failed to create device. Namespace quota exceeded")
+			ctx.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"message": st.Message()})
+			return
+		}
 
 		err = errors.New("failed to create device: " + err.Error())
 		logger.Error(err.Error())
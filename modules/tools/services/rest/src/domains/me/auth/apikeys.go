@@ -0,0 +1,346 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/authentication/apikey"
+	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/lib/authTools"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type myAPIKeyScope struct {
+	Namespace            string   `json:"namespace"`
+	Resources            []string `json:"resources" binding:"required"`
+	Actions              []string `json:"actions" binding:"required"`
+	NamespaceIndependent bool     `json:"namespaceIndependent"`
+}
+
+type myAPIKey struct {
+	UUID      string          `json:"uuid"`
+	Name      string          `json:"name"`
+	Scopes    []myAPIKeyScope `json:"scopes"`
+	Revoked   bool            `json:"revoked"`
+	ExpiresAt *time.Time      `json:"expiresAt"`
+	Created   time.Time       `json:"created"`
+	Rotated   time.Time       `json:"rotated"`
+	Version   uint64          `json:"version"`
+}
+
+func newMyAPIKeyFromGRPC(key *apikey.APIKey) myAPIKey {
+	scopes := make([]myAPIKeyScope, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, myAPIKeyScope{
+			Namespace:            scope.Namespace,
+			Resources:            scope.Resources,
+			Actions:              scope.Actions,
+			NamespaceIndependent: scope.NamespaceIndependent,
+		})
+	}
+
+	var expiresAt *time.Time
+	if key.ExpiresAt != nil {
+		t := key.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+
+	return myAPIKey{
+		UUID:      key.Uuid,
+		Name:      key.Name,
+		Scopes:    scopes,
+		Revoked:   key.Revoked,
+		ExpiresAt: expiresAt,
+		Created:   key.Created.AsTime(),
+		Rotated:   key.Rotated.AsTime(),
+		Version:   key.Version,
+	}
+}
+
+// Checks if API key belongs to the identity. Key management API of the IAM doesnt know about identities, so ownership must be checked before rotation or revocation.
+func (r *AuthRouter) isMyAPIKey(ctx context.Context, authData *authTools.CheckAuthData, uuid string) (bool, error) {
+	listResponse, err := r.nativeStub.Services.IAM.Authentication.APIKey.List(ctx, &apikey.ListRequest{
+		Namespace: authData.Namespace,
+		Identity:  authData.IdentityUUID,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, key := range listResponse.ApiKeys {
+		if key.Uuid == uuid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// type listMyAPIKeysRequest struct{}
+type listMyAPIKeysResponse struct {
+	APIKeys []myAPIKey `json:"apiKeys"`
+}
+
+func (r *AuthRouter) ListMyAPIKeys(ctx *gin.Context) {
+	// Check auth
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            "",
+			Resources:            []string{"me"},
+			Actions:              []string{"me.auth.apikey.list"},
+			NamespaceIndependent: true,
+		},
+	})
+	if err != nil {
+		err := errors.New("failed to check auth: " + err.Error())
+		r.logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+	logger := authTools.FillLoggerWithAuthMetadata(r.logger, authData)
+
+	listResponse, err := r.nativeStub.Services.IAM.Authentication.APIKey.List(ctx.Request.Context(), &apikey.ListRequest{
+		Namespace: authData.Namespace,
+		Identity:  authData.IdentityUUID,
+	})
+	if err != nil {
+		err := errors.New("failed to list API keys: " + err.Error())
+		logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	apiKeys := make([]myAPIKey, 0, len(listResponse.ApiKeys))
+	for _, key := range listResponse.ApiKeys {
+		apiKeys = append(apiKeys, newMyAPIKeyFromGRPC(key))
+	}
+
+	ctx.JSON(http.StatusOK, listMyAPIKeysResponse{APIKeys: apiKeys})
+}
+
+type createMyAPIKeyRequest struct {
+	Name      string          `json:"name" binding:"required"`
+	Scopes    []myAPIKeyScope `json:"scopes" binding:"dive"`
+	ExpiresAt *time.Time      `json:"expiresAt"`
+}
+type createMyAPIKeyResponse struct {
+	APIKey myAPIKey `json:"apiKey"`
+	Key    string   `json:"key"`
+}
+
+func (r *AuthRouter) CreateMyAPIKey(ctx *gin.Context) {
+	var requestData createMyAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	// Check auth
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            "",
+			Resources:            []string{"me"},
+			Actions:              []string{"me.auth.apikey.manage"},
+			NamespaceIndependent: true,
+		},
+	})
+	if err != nil {
+		err := errors.New("failed to check auth: " + err.Error())
+		r.logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+	logger := authTools.FillLoggerWithAuthMetadata(r.logger, authData)
+
+	scopes := make([]*apikey.Scope, 0, len(requestData.Scopes))
+	for _, scope := range requestData.Scopes {
+		scopes = append(scopes, &apikey.Scope{
+			Namespace:            scope.Namespace,
+			Resources:            scope.Resources,
+			Actions:              scope.Actions,
+			NamespaceIndependent: scope.NamespaceIndependent,
+		})
+	}
+	var expiresAt *timestamppb.Timestamp
+	if requestData.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*requestData.ExpiresAt)
+	}
+
+	createResponse, err := r.nativeStub.Services.IAM.Authentication.APIKey.Create(ctx.Request.Context(), &apikey.CreateRequest{
+		Namespace: authData.Namespace,
+		Identity:  authData.IdentityUUID,
+		Name:      requestData.Name,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": st.Message()})
+			return
+		}
+		if st, ok := status.FromError(err); ok && st.Code() == codes.PermissionDenied {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": st.Message()})
+			return
+		}
+
+		err := errors.New("failed to create API key: " + err.Error())
+		logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, createMyAPIKeyResponse{APIKey: newMyAPIKeyFromGRPC(createResponse.ApiKey), Key: createResponse.Key})
+}
+
+type rotateMyAPIKeyRequest struct {
+	UUID string `json:"uuid" binding:"required"`
+}
+type rotateMyAPIKeyResponse struct {
+	APIKey myAPIKey `json:"apiKey"`
+	Key    string   `json:"key"`
+}
+
+func (r *AuthRouter) RotateMyAPIKey(ctx *gin.Context) {
+	var requestData rotateMyAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	// Check auth
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            "",
+			Resources:            []string{"me"},
+			Actions:              []string{"me.auth.apikey.manage"},
+			NamespaceIndependent: true,
+		},
+	})
+	if err != nil {
+		err := errors.New("failed to check auth: " + err.Error())
+		r.logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+	logger := authTools.FillLoggerWithAuthMetadata(r.logger, authData)
+
+	isMine, err := r.isMyAPIKey(ctx.Request.Context(), authData, requestData.UUID)
+	if err != nil {
+		err := errors.New("failed to list API keys: " + err.Error())
+		logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !isMine {
+		ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": "API key not found"})
+		return
+	}
+
+	rotateResponse, err := r.nativeStub.Services.IAM.Authentication.APIKey.Rotate(ctx.Request.Context(), &apikey.RotateRequest{
+		Namespace: authData.Namespace,
+		Uuid:      requestData.UUID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			ctx.AbortWithStatusJSON(http.StatusNotFound, gin.H{"message": st.Message()})
+			return
+		}
+		if st, ok := status.FromError(err); ok && st.Code() == codes.PermissionDenied {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"message": st.Message()})
+			return
+		}
+
+		err := errors.New("failed to rotate API key: " + err.Error())
+		logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rotateMyAPIKeyResponse{APIKey: newMyAPIKeyFromGRPC(rotateResponse.ApiKey), Key: rotateResponse.Key})
+}
+
+type revokeMyAPIKeyRequest struct {
+	UUID string `form:"uuid" binding:"required"`
+}
+type revokeMyAPIKeyResponse struct {
+	Revoked bool `json:"revoked"`
+}
+
+func (r *AuthRouter) RevokeMyAPIKey(ctx *gin.Context) {
+	var requestData revokeMyAPIKeyRequest
+	if err := ctx.ShouldBindQuery(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	// Check auth
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            "",
+			Resources:            []string{"me"},
+			Actions:              []string{"me.auth.apikey.manage"},
+			NamespaceIndependent: true,
+		},
+	})
+	if err != nil {
+		err := errors.New("failed to check auth: " + err.Error())
+		r.logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+	logger := authTools.FillLoggerWithAuthMetadata(r.logger, authData)
+
+	isMine, err := r.isMyAPIKey(ctx.Request.Context(), authData, requestData.UUID)
+	if err != nil {
+		err := errors.New("failed to list API keys: " + err.Error())
+		logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !isMine {
+		ctx.JSON(http.StatusOK, revokeMyAPIKeyResponse{Revoked: false})
+		return
+	}
+
+	revokeResponse, err := r.nativeStub.Services.IAM.Authentication.APIKey.Revoke(ctx.Request.Context(), &apikey.RevokeRequest{
+		Namespace: authData.Namespace,
+		Uuid:      requestData.UUID,
+	})
+	if err != nil {
+		err := errors.New("failed to revoke API key: " + err.Error())
+		logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revokeMyAPIKeyResponse{Revoked: revokeResponse.Revoked})
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/lib/authTools"
 
 	oauthTools "github.com/slamy-solutions/openbp/modules/tools/services/rest/src/domains/accessControl/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AuthRouter struct {
@@ -184,6 +186,11 @@ func (r *AuthRouter) SetOrUpdatePassword(ctx *gin.Context) {
 		Password:  requestData.Password,
 	})
 	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": st.Message()})
+			return
+		}
+
 		err := errors.New("failed to set or update password: " + err.Error())
 		logger.Error(err.Error())
 
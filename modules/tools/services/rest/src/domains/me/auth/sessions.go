@@ -0,0 +1,192 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/token"
+	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/lib/authTools"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mySession struct {
+	UUID             string    `json:"uuid"`
+	Current          bool      `json:"current"`
+	CreatedAt        time.Time `json:"createdAt"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+	CreationMetadata string    `json:"creationMetadata"`
+	AuthMethod       string    `json:"authMethod"`
+	MFA              bool      `json:"mfa"`
+
+	LastActivityAt time.Time `json:"lastActivityAt"`
+	LastSourceIP   string    `json:"lastSourceIP"`
+	LastUserAgent  string    `json:"lastUserAgent"`
+	RefreshCount   uint32    `json:"refreshCount"`
+}
+
+// type listMySessionsRequest struct{}
+type listMySessionsResponse struct {
+	Sessions []mySession `json:"sessions"`
+}
+
+func (r *AuthRouter) ListMySessions(ctx *gin.Context) {
+	// Check auth
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            "",
+			Resources:            []string{"me"},
+			Actions:              []string{"me.auth.session.list"},
+			NamespaceIndependent: true,
+		},
+	})
+	if err != nil {
+		err := errors.New("failed to check auth: " + err.Error())
+		r.logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+	logger := authTools.FillLoggerWithAuthMetadata(r.logger, authData)
+
+	sessionsResponse, err := r.nativeStub.Services.IAM.Token.ListSessions(ctx.Request.Context(), &token.ListSessionsRequest{
+		Namespace: authData.Namespace,
+		Identity:  authData.IdentityUUID,
+	})
+	if err != nil {
+		err := errors.New("failed to list sessions: " + err.Error())
+		logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	sessions := make([]mySession, 0, len(sessionsResponse.Sessions))
+	for _, session := range sessionsResponse.Sessions {
+		sessions = append(sessions, mySession{
+			UUID:             session.TokenData.Uuid,
+			Current:          session.TokenData.Uuid == authData.TokenUUID,
+			CreatedAt:        session.TokenData.CreatedAt.AsTime(),
+			ExpiresAt:        session.TokenData.ExpiresAt.AsTime(),
+			CreationMetadata: session.TokenData.CreationMetadata,
+			AuthMethod:       session.TokenData.AuthMethod.String(),
+			MFA:              session.TokenData.Mfa,
+
+			LastActivityAt: session.LastActivityAt.AsTime(),
+			LastSourceIP:   session.LastSourceIP,
+			LastUserAgent:  session.LastUserAgent,
+			RefreshCount:   session.RefreshCount,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, listMySessionsResponse{Sessions: sessions})
+}
+
+type revokeMySessionRequest struct {
+	UUID string `form:"uuid" binding:"required"`
+}
+type revokeMySessionResponse struct {
+	Revoked bool `json:"revoked"`
+}
+
+func (r *AuthRouter) RevokeMySession(ctx *gin.Context) {
+	var requestData revokeMySessionRequest
+	if err := ctx.ShouldBindQuery(&requestData); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": err.Error()})
+		return
+	}
+
+	// Check auth
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            "",
+			Resources:            []string{"me"},
+			Actions:              []string{"me.auth.session.revoke"},
+			NamespaceIndependent: true,
+		},
+	})
+	if err != nil {
+		err := errors.New("failed to check auth: " + err.Error())
+		r.logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+	logger := authTools.FillLoggerWithAuthMetadata(r.logger, authData)
+
+	revokeResponse, err := r.nativeStub.Services.IAM.Token.RevokeSession(ctx.Request.Context(), &token.RevokeSessionRequest{
+		Namespace: authData.Namespace,
+		Identity:  authData.IdentityUUID,
+		Uuid:      requestData.UUID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"message": st.Message()})
+			return
+		}
+
+		err := errors.New("failed to revoke session: " + err.Error())
+		logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revokeMySessionResponse{Revoked: revokeResponse.Revoked})
+}
+
+// type revokeMyOtherSessionsRequest struct{}
+type revokeMyOtherSessionsResponse struct {
+	RevokedCount uint32 `json:"revokedCount"`
+}
+
+// Revokes all the sessions of the identity except the one that performs the request
+func (r *AuthRouter) RevokeMyOtherSessions(ctx *gin.Context) {
+	// Check auth
+	authData, err := authTools.CheckAuth(ctx, r.nativeStub, []*auth.Scope{
+		{
+			Namespace:            "",
+			Resources:            []string{"me"},
+			Actions:              []string{"me.auth.session.revoke"},
+			NamespaceIndependent: true,
+		},
+	})
+	if err != nil {
+		err := errors.New("failed to check auth: " + err.Error())
+		r.logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !authData.AccessGranted {
+		ctx.AbortWithStatusJSON(authData.StatusCode, gin.H{"message": authData.ErrorMessage})
+		return
+	}
+	logger := authTools.FillLoggerWithAuthMetadata(r.logger, authData)
+
+	revokeResponse, err := r.nativeStub.Services.IAM.Token.RevokeAllSessions(ctx.Request.Context(), &token.RevokeAllSessionsRequest{
+		Namespace:     authData.Namespace,
+		Identity:      authData.IdentityUUID,
+		ExceptSession: authData.TokenUUID,
+	})
+	if err != nil {
+		err := errors.New("failed to revoke sessions: " + err.Error())
+		logger.Error(err.Error())
+
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revokeMyOtherSessionsResponse{RevokedCount: revokeResponse.RevokedCount})
+}
@@ -18,6 +18,13 @@ func FillRouterGroup(logger *logrus.Entry, group *gin.RouterGroup, systemStub *s
 	group.DELETE("/auth/password", authRouter.DeletePassword)
 	group.POST("/auth/oauth/provider", authRouter.FinalizeOAuthRegistration)
 	group.DELETE("/auth/oauth/provider", authRouter.ForgetOAuthProvider)
+	group.GET("/auth/sessions", authRouter.ListMySessions)
+	group.DELETE("/auth/sessions/session", authRouter.RevokeMySession)
+	group.DELETE("/auth/sessions", authRouter.RevokeMyOtherSessions)
+	group.GET("/auth/apikeys", authRouter.ListMyAPIKeys)
+	group.POST("/auth/apikeys", authRouter.CreateMyAPIKey)
+	group.POST("/auth/apikeys/rotate", authRouter.RotateMyAPIKey)
+	group.DELETE("/auth/apikeys", authRouter.RevokeMyAPIKey)
 
 	userRouter := user.NewUserRouter(logger.WithField("domain.service", "user"), nativeStub)
 	group.GET("/user", userRouter.GetMyUserInfo)
@@ -47,7 +47,7 @@ func (r *ListRouter) List(ctx *gin.Context) {
 		Namespaces: []*nativeNamespace.Namespace{},
 	}
 
-	namespaceClient, err := r.nativeStub.Services.Namespace.GetAll(ctx.Request.Context(), &nativeNamespace.GetAllNamespacesRequest{UseCache: true})
+	namespaceClient, err := r.nativeStub.Services.Namespace.GetAll(ctx.Request.Context(), &nativeNamespace.GetAllNamespacesRequest{UseCache: true, LabelSelector: ctx.Query("labelSelector")})
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, err)
 		return
@@ -60,6 +60,10 @@ func (r *ListRouter) List(ctx *gin.Context) {
 			if err == io.EOF {
 				break
 			}
+			if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+				ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": st.Message()})
+				return
+			}
 
 			ctx.AbortWithError(http.StatusInternalServerError, err)
 			return
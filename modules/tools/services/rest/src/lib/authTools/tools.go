@@ -9,17 +9,25 @@ import (
 	"github.com/sirupsen/logrus"
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	"github.com/slamy-solutions/openbp/modules/native/libs/golang/iam/auth"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/audit"
 )
 
 var authHeaderName = textproto.CanonicalMIMEHeaderKey("authorization")
+var requestIdHeaderName = textproto.CanonicalMIMEHeaderKey("x-request-id")
+
+// Authorization header scheme for the long-lived API keys ("Authorization: ApiKey <key>"). Any other scheme is handled as an access token.
+const API_KEY_AUTH_SCHEME = "ApiKey"
 
 type CheckAuthData struct {
 	AccessGranted bool
 	StatusCode    int
 	ErrorMessage  string
 
-	Namespace    string
-	TokenUUID    string
+	Namespace string
+	// UUID of the access token. Empty if request was authenticated with API key
+	TokenUUID string
+	// UUID of the API key. Empty if request was authenticated with access token
+	APIKeyUUID   string
 	IdentityUUID string
 }
 
@@ -34,27 +42,63 @@ func CheckAuth(ctx *gin.Context, nativeStub *native.NativeStub, scopes []*auth.S
 		return &CheckAuthData{AccessGranted: false, StatusCode: 401, ErrorMessage: "Auth header has invalid format"}, nil
 	}
 
+	var authData *CheckAuthData
+	var err error
+	if strings.EqualFold(splitedHeader[0], API_KEY_AUTH_SCHEME) {
+		authData, err = checkAuthWithAPIKey(ctx, nativeStub, splitedHeader[1], scopes)
+	} else {
+		authData, err = checkAuthWithToken(ctx, nativeStub, splitedHeader[1], scopes)
+	}
+	if err != nil {
+		return authData, err
+	}
+
+	// All the following gRPC calls made with request context will be attributed to this identity in the audit log
+	if authData.AccessGranted {
+		credentialUUID := authData.TokenUUID
+		if credentialUUID == "" {
+			credentialUUID = authData.APIKeyUUID
+		}
+		ctx.Request = ctx.Request.WithContext(audit.WithActor(
+			ctx.Request.Context(),
+			&audit.Actor{
+				Namespace: authData.Namespace,
+				Identity:  authData.IdentityUUID,
+				Token:     credentialUUID,
+			},
+			&audit.RequestMetadata{
+				SourceIP:  ctx.ClientIP(),
+				UserAgent: ctx.Request.UserAgent(),
+				RequestId: ctx.Request.Header.Get(requestIdHeaderName),
+			},
+		))
+	}
+
+	return authData, nil
+}
+
+func checkAuthWithToken(ctx *gin.Context, nativeStub *native.NativeStub, accessToken string, scopes []*auth.Scope) (*CheckAuthData, error) {
 	authResponse, err := nativeStub.Services.IAM.Auth.CheckAccessWithToken(ctx.Request.Context(), &auth.CheckAccessWithTokenRequest{
-		AccessToken: splitedHeader[1],
+		AccessToken: accessToken,
 		Scopes:      scopes,
+		Context:     &auth.AccessContext{SourceIP: ctx.ClientIP(), UserAgent: ctx.Request.UserAgent()},
 	})
 	if err != nil {
 		return &CheckAuthData{AccessGranted: false, StatusCode: 500, ErrorMessage: "Internal server error"}, errors.New("failed to check access with token: " + err.Error())
 	}
 
-	accessGranted := authResponse.Status == auth.CheckAccessWithTokenResponse_OK
 	statusCode := 200
 	switch authResponse.Status {
 	case auth.CheckAccessWithTokenResponse_OK:
 		break
-	case auth.CheckAccessWithTokenResponse_UNAUTHORIZED:
+	case auth.CheckAccessWithTokenResponse_UNAUTHORIZED, auth.CheckAccessWithTokenResponse_NAMESPACE_SUSPENDED:
 		statusCode = 403
 	default:
 		statusCode = 401
 	}
 
 	return &CheckAuthData{
-		AccessGranted: accessGranted,
+		AccessGranted: authResponse.Status == auth.CheckAccessWithTokenResponse_OK,
 		StatusCode:    statusCode,
 		ErrorMessage:  authResponse.Message,
 		Namespace:     authResponse.GetNamespace(),
@@ -63,10 +107,41 @@ func CheckAuth(ctx *gin.Context, nativeStub *native.NativeStub, scopes []*auth.S
 	}, nil
 }
 
+func checkAuthWithAPIKey(ctx *gin.Context, nativeStub *native.NativeStub, apiKey string, scopes []*auth.Scope) (*CheckAuthData, error) {
+	authResponse, err := nativeStub.Services.IAM.Auth.CheckAccessWithAPIKey(ctx.Request.Context(), &auth.CheckAccessWithAPIKeyRequest{
+		ApiKey:  apiKey,
+		Scopes:  scopes,
+		Context: &auth.AccessContext{SourceIP: ctx.ClientIP(), UserAgent: ctx.Request.UserAgent()},
+	})
+	if err != nil {
+		return &CheckAuthData{AccessGranted: false, StatusCode: 500, ErrorMessage: "Internal server error"}, errors.New("failed to check access with API key: " + err.Error())
+	}
+
+	statusCode := 200
+	switch authResponse.Status {
+	case auth.CheckAccessWithAPIKeyResponse_OK:
+		break
+	case auth.CheckAccessWithAPIKeyResponse_UNAUTHORIZED, auth.CheckAccessWithAPIKeyResponse_IDENTITY_NOT_ACTIVE, auth.CheckAccessWithAPIKeyResponse_NAMESPACE_SUSPENDED:
+		statusCode = 403
+	default:
+		statusCode = 401
+	}
+
+	return &CheckAuthData{
+		AccessGranted: authResponse.Status == auth.CheckAccessWithAPIKeyResponse_OK,
+		StatusCode:    statusCode,
+		ErrorMessage:  authResponse.Message,
+		Namespace:     authResponse.GetNamespace(),
+		APIKeyUUID:    authResponse.GetApiKeyUUID(),
+		IdentityUUID:  authResponse.GetIdentityUUID(),
+	}, nil
+}
+
 func FillLoggerWithAuthMetadata(logger *logrus.Entry, authData *CheckAuthData) *logrus.Entry {
 	logger = logger.WithFields(logrus.Fields{
 		"auth.namespace":    authData.Namespace,
 		"auth.tokenUUID":    authData.TokenUUID,
+		"auth.apiKeyUUID":   authData.APIKeyUUID,
 		"auth.identityUUID": authData.IdentityUUID,
 	})
 
@@ -18,8 +18,10 @@ import (
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	runtime "github.com/slamy-solutions/openbp/modules/runtime/libs/golang"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
 
 	accesscontrol "github.com/slamy-solutions/openbp/modules/tools/services/rest/src/domains/accessControl"
+	auditDomain "github.com/slamy-solutions/openbp/modules/tools/services/rest/src/domains/audit"
 	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/domains/auth"
 	"github.com/slamy-solutions/openbp/modules/tools/services/rest/src/domains/bootstrap"
 	crmDomain "github.com/slamy-solutions/openbp/modules/tools/services/rest/src/domains/crm"
@@ -46,14 +48,20 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 	defer cancel()
 
-	systemStub := system.NewSystemStub(system.NewSystemStubConfig().WithOTel(system.NewOTelConfig("tools", "rest", VERSION, getHostname())).WithVault().WithCache())
+	systemStub := system.NewSystemStub(system.NewSystemStubConfig().WithOTel(system.NewOTelConfig("tools", "rest", VERSION, getHostname())).WithVault().WithCache().WithAudit())
 	err := systemStub.Connect(ctx)
 	if err != nil {
 		panic(err)
 	}
 	defer systemStub.Close(context.Background())
 
-	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService().WithStorageService())
+	serviceAuthConfig := serviceauth.NewConfigFromEnv("tools_rest")
+	serviceAuthDialOptions, err := serviceauth.DialOptions(serviceAuthConfig, native.NewServiceTokenIssuer(serviceAuthConfig.APIKey))
+	if err != nil {
+		panic("Failed to setup service authentication: " + err.Error())
+	}
+
+	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService().WithStorageService().WithDialOptions(serviceAuthDialOptions...))
 	err = nativeStub.Connect()
 	if err != nil {
 		panic(err)
@@ -109,6 +117,7 @@ func main() {
 	me.FillRouterGroup(logger.WithField("domain.name", "me"), r.Group("/api/me"), systemStub, nativeStub, iotStub)
 	runtimeDomain.FillRouterGroup(logger.WithField("domain.name", "runtime"), r.Group("/api/runtime"), nativeStub, systemStub, runtimeStub)
 	crmDomain.FillRouterGroup(logger.WithField("domain.name", "crm"), r.Group("/api/crm"), systemStub, nativeStub, crmStub)
+	auditDomain.FillRouterGroup(logger.WithField("domain.name", "audit"), r.Group("/api/audit"), systemStub, nativeStub)
 	modules.FillRouterGroup(r.Group("/api/modules"), systemStub, nativeStub, iotStub, crmStub, erpStub)
 
 	r.Run() // listen and serve on 0.0.0.0:8080 (for windows "localhost:8080")
@@ -7,15 +7,21 @@ const (
 	ErrorAuthPasswordCredentialsInvalid   = "AUTH_PASSWORD_CREDENTIALS_INVALID"
 	ErrorAuthPasswordNotEnoughtPrivileges = "AUTH_PASSWORD_NOT_ENOUGHT_PRIVILEGES"
 	ErrorAuthPasswordUnauthorizedUnknown  = "AUTH_PASSWORD_UNAUTHORIZED_UNKNOWN"
+	ErrorAuthPasswordTOTPRequired         = "AUTH_PASSWORD_TOTP_REQUIRED"
+	ErrorAuthPasswordTOTPInvalid          = "AUTH_PASSWORD_TOTP_INVALID"
+	ErrorAuthPasswordTooManyAttempts      = "AUTH_PASSWORD_TOO_MANY_ATTEMPTS"
+	ErrorAuthPasswordNamespaceSuspended   = "AUTH_PASSWORD_NAMESPACE_SUSPENDED"
 
 	ErrorAuthTokenRefreshTokenInvalid            = "AUTH_TOKEN_REFRESH_TOKEN_INVALID"
 	ErrorAuthTokenRefreshTokenNotFound           = "AUTH_TOKEN_REFRESH_TOKEN_NOT_FOUND"
 	ErrorAuthTokenRefreshTokenDisabled           = "AUTH_TOKEN_REFRESH_TOKEN_DISABLED"
 	ErrorAuthTokenRefreshTokenExpired            = "AUTH_TOKEN_REFRESH_TOKEN_EXPIRED"
 	ErrorAuthTokenRefreshTokenIsNotRefreshToken  = "AUTH_TOKEN_REFRESH_TOKEN_IS_NOT_REFRESH_TOKEN"
+	ErrorAuthTokenRefreshTokenReused             = "AUTH_TOKEN_REFRESH_TOKEN_REUSED"
 	ErrorAuthTokenRefreshIdentityNotFound        = "AUTH_TOKEN_REFRESH_IDENTITY_NOT_FOUND"
 	ErrorAuthTokenRefreshIdentityNotActive       = "AUTH_TOKEN_REFRESH_IDENTITY_NOT_ACTIVE"
 	ErrorAuthTokenRefreshIdentityUnauthenticated = "AUTH_TOKEN_REFRESH_IDENTITY_UNAUTHENTICATED"
+	ErrorAuthTokenRefreshNamespaceSuspended      = "AUTH_TOKEN_REFRESH_NAMESPACE_SUSPENDED"
 	ErrorAuthTokenRefreshUnknown                 = "AUTH_TOKEN_REFRESH_UNKNOWN"
 )
 
@@ -24,15 +30,21 @@ var errorMessage = map[APIErrorCode]string{
 	ErrorAuthPasswordCredentialsInvalid:   "Login or password is invalid.",
 	ErrorAuthPasswordNotEnoughtPrivileges: "Not enought privileges to create token with specified scopes.",
 	ErrorAuthPasswordUnauthorizedUnknown:  "Not authorized for unknown reasons.",
+	ErrorAuthPasswordTOTPRequired:         "Two factor authentication is enabled. TOTP code is required.",
+	ErrorAuthPasswordTOTPInvalid:          "TOTP code is invalid or was already used.",
+	ErrorAuthPasswordTooManyAttempts:      "Too many failed login attempts. Try again later.",
+	ErrorAuthPasswordNamespaceSuspended:   "Namespace is suspended. Contact administrator.",
 
 	ErrorAuthTokenRefreshTokenInvalid:            "Refresh token is invalid. It may have bad format or signature.",
 	ErrorAuthTokenRefreshTokenNotFound:           "Token not found. Most probably it was deleted from the system.",
 	ErrorAuthTokenRefreshTokenDisabled:           "Auth token was manually disabled.",
 	ErrorAuthTokenRefreshTokenExpired:            "Refresh token expired and cant be used to create new access tokens.",
 	ErrorAuthTokenRefreshTokenIsNotRefreshToken:  "This token is not a refresh token. Probably access token was sended onstead of refresh one.",
+	ErrorAuthTokenRefreshTokenReused:             "Refresh token was already used. The session was disabled for security reasons. Log in again.",
 	ErrorAuthTokenRefreshIdentityNotFound:        "Token identity was deleted.",
 	ErrorAuthTokenRefreshIdentityNotActive:       "Token identity is not active. Probably it was manually disabled.",
 	ErrorAuthTokenRefreshIdentityUnauthenticated: "Identity privilages changed and now you cant create new token with same scopes using this refresh token.",
+	ErrorAuthTokenRefreshNamespaceSuspended:      "Namespace is suspended. Contact administrator.",
 	ErrorAuthTokenRefreshUnknown:                 "Cant refresh token. Unknown or hidden user error.",
 }
 
@@ -16,6 +16,7 @@ import (
 	"github.com/sirupsen/logrus"
 	native "github.com/slamy-solutions/openbp/modules/native/libs/golang"
 	system "github.com/slamy-solutions/openbp/modules/system/libs/golang"
+	"github.com/slamy-solutions/openbp/modules/system/libs/golang/serviceauth"
 
 	"github.com/slamy-solutions/openbp/modules/tools/services/sdk/src/servers"
 )
@@ -45,8 +46,14 @@ func main() {
 	}
 	defer systemStub.Close(context.Background())
 
+	serviceAuthConfig := serviceauth.NewConfigFromEnv("tools_sdk")
+	serviceAuthDialOptions, err := serviceauth.DialOptions(serviceAuthConfig, native.NewServiceTokenIssuer(serviceAuthConfig.APIKey))
+	if err != nil {
+		panic("Failed to setup service authentication: " + err.Error())
+	}
+
 	// --- Setting up connection to the native services
-	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService())
+	nativeStub := native.NewNativeStub(native.NewStubConfig().WithNamespaceService().WithIAMService().WithDialOptions(serviceAuthDialOptions...))
 	err = nativeStub.Connect()
 	if err != nil {
 		panic("Failed to connect to the native services: " + err.Error())
@@ -76,7 +76,7 @@ func (s *SDKServer) RegisterPublicKeyAsUser(ctx context.Context, in *sdk.Registe
 			return nil, status.Error(codes.Internal, "")
 		}
 
-		_, err = s.nativeStub.Services.IAM.Auth.CheckAccessWithPassword(ctx, &auth.CheckAccessWithPasswordRequest{
+		checkAccessResponse, err := s.nativeStub.Services.IAM.Auth.CheckAccessWithPassword(ctx, &auth.CheckAccessWithPasswordRequest{
 			Namespace: in.Namespace,
 			Identity:  getByLoginResponse.User.Identity,
 			Password:  in.Password,
@@ -88,6 +88,10 @@ func (s *SDKServer) RegisterPublicKeyAsUser(ctx context.Context, in *sdk.Registe
 			s.loggerForEndpoint("RegisterPublicKeyAsUser").Error("Error while checking access for user: " + err.Error())
 			return nil, status.Error(codes.Internal, "")
 		}
+		// Users with TOTP enabled cant register keys, because request doesnt have TOTP code
+		if checkAccessResponse.Status != auth.CheckAccessWithPasswordResponse_OK {
+			return nil, status.Error(codes.Unauthenticated, "")
+		}
 
 		createCertificateResponse, err := s.nativeStub.Services.IAM.Authentication.X509.RegisterAndGenerate(ctx, &x509.RegisterAndGenerateRequest{
 			Namespace:   in.Namespace,